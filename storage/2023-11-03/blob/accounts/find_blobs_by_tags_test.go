@@ -0,0 +1,54 @@
+package accounts
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestFindBlobsByTagsResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example1.blob.core.windows.net/">
+  <Where>"project" = 'giovanni'</Where>
+  <Blobs>
+    <Blob>
+      <Name>blob1.txt</Name>
+      <ContainerName>container1</ContainerName>
+      <Tags>
+        <TagSet>
+          <Tag>
+            <Key>project</Key>
+            <Value>giovanni</Value>
+          </Tag>
+        </TagSet>
+      </Tags>
+    </Blob>
+    <Blob>
+      <Name>blob2.txt</Name>
+      <ContainerName>container2</ContainerName>
+    </Blob>
+  </Blobs>
+  <NextMarker>abc123</NextMarker>
+</EnumerationResults>`
+
+	var model filterBlobsResult
+	if err := xml.Unmarshal([]byte(input), &model); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	actual := model.flatten()
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 blobs but got %d", len(actual))
+	}
+	if actual[0].Name != "blob1.txt" || actual[0].ContainerName != "container1" {
+		t.Fatalf("expected the first blob to be %q in %q but got %q in %q", "blob1.txt", "container1", actual[0].Name, actual[0].ContainerName)
+	}
+	if v, _ := actual[0].Tags.Get("project"); v != "giovanni" {
+		t.Fatalf("expected the tag `project` to be %q but got %q", "giovanni", v)
+	}
+	if len(actual[1].Tags) != 0 {
+		t.Fatalf("expected the second blob to have no tags but got %d", len(actual[1].Tags))
+	}
+	if model.NextMarker == nil || *model.NextMarker != "abc123" {
+		t.Fatalf("expected NextMarker to be %q but got %v", "abc123", model.NextMarker)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetServicePropertiesResult struct {
@@ -48,7 +49,7 @@ func (c Client) GetServiceProperties(ctx context.Context, accountName string) (r
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
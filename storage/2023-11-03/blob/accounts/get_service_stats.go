@@ -0,0 +1,79 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetServiceStatsResult struct {
+	StorageServiceStats
+	HttpResponse *http.Response
+}
+
+// GetServiceStats retrieves the statistics related to the replication of the Blob service. This is only available
+// for Storage Accounts with read-access geo-redundant replication (RA-GRS/RA-GZRS), and as such the request is sent
+// to the secondary endpoint derived from the base URI, e.g. `account1-secondary.blob.core.windows.net`.
+func (c Client) GetServiceStats(ctx context.Context, accountName string) (result GetServiceStatsResult, err error) {
+	if accountName == "" {
+		return result, fmt.Errorf("`accountName` cannot be an empty string")
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: serviceStatsOptions{},
+		Path:          "/",
+	}
+
+	req, err := c.Client.NewSecondaryRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			err = resp.Unmarshal(&result)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type serviceStatsOptions struct {
+}
+
+func (serviceStatsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (serviceStatsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (serviceStatsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "stats")
+	out.Append("restype", "service")
+	return out
+}
@@ -0,0 +1,78 @@
+package accounts
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestServiceStatsUnmarshal(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected GeoReplication
+	}{
+		{
+			Name: "Live",
+			Input: `<?xml version="1.0" encoding="utf-8"?>
+<StorageServiceStats>
+  <GeoReplication>
+    <Status>live</Status>
+    <LastSyncTime>Mon, 01 Jan 2024 00:00:00 GMT</LastSyncTime>
+  </GeoReplication>
+</StorageServiceStats>`,
+			Expected: GeoReplication{
+				Status:       GeoReplicationStatusLive,
+				LastSyncTime: "Mon, 01 Jan 2024 00:00:00 GMT",
+			},
+		},
+		{
+			Name: "Bootstrap",
+			Input: `<?xml version="1.0" encoding="utf-8"?>
+<StorageServiceStats>
+  <GeoReplication>
+    <Status>bootstrap</Status>
+    <LastSyncTime />
+  </GeoReplication>
+</StorageServiceStats>`,
+			Expected: GeoReplication{
+				Status: GeoReplicationStatusBootstrap,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		var actual StorageServiceStats
+		if err := xml.Unmarshal([]byte(v.Input), &actual); err != nil {
+			t.Fatalf("unmarshalling: %+v", err)
+		}
+		if actual.GeoReplication == nil {
+			t.Fatalf("expected GeoReplication to be populated but it was nil")
+		}
+		if *actual.GeoReplication != v.Expected {
+			t.Fatalf("expected %+v but got %+v", v.Expected, *actual.GeoReplication)
+		}
+	}
+}
+
+func TestGetServiceStatsValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := NewWithBaseUri("https://127.0.0.1:10000")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	if _, err := client.GetServiceStats(ctx, ""); err == nil {
+		t.Fatalf("expected an error when the `accountName` is empty")
+	}
+
+	// the secondary endpoint can't be derived from an IP Address
+	if _, err := client.GetServiceStats(ctx, "account1"); err == nil {
+		t.Fatalf("expected an error when the base URI is an IP Address")
+	}
+}
@@ -0,0 +1,148 @@
+package accounts
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+// userDelegationKeyMaxValidity is the maximum length of time for which a User Delegation Key can be valid
+const userDelegationKeyMaxValidity = 7 * 24 * time.Hour
+
+type GetUserDelegationKeyInput struct {
+	// The time at which the User Delegation Key becomes valid
+	Start time.Time
+
+	// The time at which the User Delegation Key expires, which must be within 7 days of the Start time
+	Expiry time.Time
+}
+
+type GetUserDelegationKeyResult struct {
+	HttpResponse *http.Response
+
+	UserDelegationKey
+}
+
+// UserDelegationKey is a key which can be used to sign a User Delegation Shared Access Signature
+type UserDelegationKey struct {
+	// The Object ID of the Azure Active Directory principal which requested this key
+	SignedOid string `xml:"SignedOid"`
+
+	// The Tenant ID of the Azure Active Directory principal which requested this key
+	SignedTid string `xml:"SignedTid"`
+
+	// The time at which this key becomes valid, in ISO 8601 format
+	SignedStart string `xml:"SignedStart"`
+
+	// The time at which this key expires, in ISO 8601 format
+	SignedExpiry string `xml:"SignedExpiry"`
+
+	// The Storage Service for which this key is valid
+	SignedService string `xml:"SignedService"`
+
+	// The Storage Service version used to request this key
+	SignedVersion string `xml:"SignedVersion"`
+
+	// The base64-encoded key
+	Value string `xml:"Value"`
+}
+
+// GetUserDelegationKey retrieves a key which can be used to sign a User Delegation Shared Access Signature.
+// This operation requires that the client is authorized using Azure Active Directory.
+func (c Client) GetUserDelegationKey(ctx context.Context, accountName string, input GetUserDelegationKeyInput) (result GetUserDelegationKeyResult, err error) {
+	if accountName == "" {
+		return result, fmt.Errorf("`accountName` cannot be an empty string")
+	}
+
+	if input.Start.IsZero() {
+		return result, fmt.Errorf("`input.Start` must be specified")
+	}
+
+	if input.Expiry.IsZero() {
+		return result, fmt.Errorf("`input.Expiry` must be specified")
+	}
+
+	if !input.Expiry.After(input.Start) {
+		return result, fmt.Errorf("`input.Expiry` must be after `input.Start`")
+	}
+
+	if input.Expiry.Sub(input.Start) > userDelegationKeyMaxValidity {
+		return result, fmt.Errorf("`input.Expiry` must be within 7 days of `input.Start`")
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: getUserDelegationKeyOptions{},
+		Path:          "/",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	body := keyInfo{
+		Start:  input.Start.UTC().Format(time.RFC3339),
+		Expiry: input.Expiry.UTC().Format(time.RFC3339),
+	}
+	if err = req.Marshal(&body); err != nil {
+		err = fmt.Errorf("marshaling request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			err = resp.Unmarshal(&result.UserDelegationKey)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type keyInfo struct {
+	XMLName xml.Name `xml:"KeyInfo"`
+	Start   string   `xml:"Start"`
+	Expiry  string   `xml:"Expiry"`
+}
+
+var _ client.Options = getUserDelegationKeyOptions{}
+
+type getUserDelegationKeyOptions struct{}
+
+func (getUserDelegationKeyOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (getUserDelegationKeyOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (getUserDelegationKeyOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("restype", "service")
+	out.Append("comp", "userdelegationkey")
+	return out
+}
@@ -0,0 +1,54 @@
+package accounts
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestUserDelegationKeyUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<UserDelegationKey>
+  <SignedOid>00000000-0000-0000-0000-000000000001</SignedOid>
+  <SignedTid>00000000-0000-0000-0000-000000000002</SignedTid>
+  <SignedStart>2024-01-01T00:00:00Z</SignedStart>
+  <SignedExpiry>2024-01-02T00:00:00Z</SignedExpiry>
+  <SignedService>b</SignedService>
+  <SignedVersion>2023-11-03</SignedVersion>
+  <Value>AAECAwQ=</Value>
+</UserDelegationKey>`
+
+	var actual UserDelegationKey
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	expected := UserDelegationKey{
+		SignedOid:     "00000000-0000-0000-0000-000000000001",
+		SignedTid:     "00000000-0000-0000-0000-000000000002",
+		SignedStart:   "2024-01-01T00:00:00Z",
+		SignedExpiry:  "2024-01-02T00:00:00Z",
+		SignedService: "b",
+		SignedVersion: "2023-11-03",
+		Value:         "AAECAwQ=",
+	}
+	if actual != expected {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestKeyInfoMarshal(t *testing.T) {
+	input := keyInfo{
+		Start:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		Expiry: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+	}
+	actual, err := xml.Marshal(input)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	expected := "<KeyInfo><Start>2024-01-01T00:00:00Z</Start><Expiry>2024-01-02T00:00:00Z</Expiry></KeyInfo>"
+	if string(actual) != expected {
+		t.Fatalf("expected %q but got %q", expected, string(actual))
+	}
+}
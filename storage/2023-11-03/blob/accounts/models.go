@@ -1,5 +1,10 @@
 package accounts
 
+import (
+	"encoding/xml"
+	"strings"
+)
+
 type StorageServiceProperties struct {
 	// Cors - Specifies CORS rules for the Blob service. You can include up to five CorsRule elements in the request. If no CorsRule elements are included in the request body, all CORS rules will be deleted, and CORS will be disabled for the Blob service.
 	Cors *CorsRules `xml:"Cors,omitempty"`
@@ -25,20 +30,24 @@ type StaticWebsite struct {
 	IndexDocument string `xml:"IndexDocument,omitempty"`
 	// ErrorDocument404Path - Optional. The absolute path to a webpage that Azure Storage serves for requests that do not correspond to an existing file. For example, error/404.html. Only a single custom 404 page is supported in each static website. The value is case-sensitive.
 	ErrorDocument404Path string `xml:"ErrorDocument404Path,omitempty"`
+	// DefaultIndexDocumentPath - Optional. The absolute path to a webpage that Azure Storage serves for requests that do not correspond to an existing file, which cannot be specified alongside IndexDocument. The value is case-sensitive.
+	DefaultIndexDocumentPath string `xml:"DefaultIndexDocumentPath,omitempty"`
 }
 
 // CorsRules sets the CORS rules. You can include up to five CorsRule elements in the request.
 type CorsRules struct {
 	// CorsRules - The List of CORS rules. You can include up to five CorsRule elements in the request.
-	CorsRules []CorsRule `xml:"CorsRules,omitempty"`
+	CorsRules []CorsRule `xml:"CorsRule,omitempty"`
 }
 
 // DeleteRetentionPolicy the blob service properties for soft delete.
 type DeleteRetentionPolicy struct {
 	// Enabled - Indicates whether DeleteRetentionPolicy is enabled for the Blob service.
-	Enabled bool `xml:"Enabled,omitempty"`
+	Enabled bool `xml:"Enabled"`
 	// Days - Indicates the number of days that the deleted blob should be retained. The minimum specified value can be 1 and the maximum value can be 365.
 	Days int32 `xml:"Days,omitempty"`
+	// AllowPermanentDelete - Optional. Indicates whether soft-deleted Blob Versions and Snapshots can be permanently deleted, only applicable to the DeleteRetentionPolicy for the Blob service.
+	AllowPermanentDelete *bool `xml:"AllowPermanentDelete,omitempty"`
 }
 
 // CorsRule specifies a CORS rule for the Blob service.
@@ -55,6 +64,52 @@ type CorsRule struct {
 	AllowedHeaders []string `xml:"AllowedHeaders,omitempty"`
 }
 
+// corsRuleXML is the representation of a CorsRule sent to/returned from the Storage Service, where each list
+// is a comma-separated string
+type corsRuleXML struct {
+	AllowedOrigins  string `xml:"AllowedOrigins"`
+	AllowedMethods  string `xml:"AllowedMethods"`
+	MaxAgeInSeconds int32  `xml:"MaxAgeInSeconds"`
+	ExposedHeaders  string `xml:"ExposedHeaders"`
+	AllowedHeaders  string `xml:"AllowedHeaders"`
+}
+
+func (r CorsRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(corsRuleXML{
+		AllowedOrigins:  strings.Join(r.AllowedOrigins, ","),
+		AllowedMethods:  strings.Join(r.AllowedMethods, ","),
+		MaxAgeInSeconds: r.MaxAgeInSeconds,
+		ExposedHeaders:  strings.Join(r.ExposedHeaders, ","),
+		AllowedHeaders:  strings.Join(r.AllowedHeaders, ","),
+	}, start)
+}
+
+func (r *CorsRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var model corsRuleXML
+	if err := d.DecodeElement(&model, &start); err != nil {
+		return err
+	}
+	*r = CorsRule{
+		AllowedOrigins:  splitCorsList(model.AllowedOrigins),
+		AllowedMethods:  splitCorsList(model.AllowedMethods),
+		MaxAgeInSeconds: model.MaxAgeInSeconds,
+		ExposedHeaders:  splitCorsList(model.ExposedHeaders),
+		AllowedHeaders:  splitCorsList(model.AllowedHeaders),
+	}
+	return nil
+}
+
+func splitCorsList(input string) []string {
+	if input == "" {
+		return nil
+	}
+	out := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		out = append(out, strings.TrimSpace(v))
+	}
+	return out
+}
+
 // Logging specifies the access logging options for the Blob service.
 type Logging struct {
 	Version         string                `xml:"Version"`
@@ -65,10 +120,60 @@ type Logging struct {
 }
 
 // MetricsConfig specifies the hour and/or minute metrics options for the Blob service.
-// Elements are all expected
+// Elements are all expected, other than IncludeAPIs which is only sent when the Metrics are enabled
 type MetricsConfig struct {
 	Version         string                `xml:"Version"`
 	Enabled         bool                  `xml:"Enabled"`
 	RetentionPolicy DeleteRetentionPolicy `xml:"RetentionPolicy"`
 	IncludeAPIs     bool                  `xml:"IncludeAPIs"`
 }
+
+func (m MetricsConfig) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	model := struct {
+		Version         string                `xml:"Version"`
+		Enabled         bool                  `xml:"Enabled"`
+		IncludeAPIs     *bool                 `xml:"IncludeAPIs,omitempty"`
+		RetentionPolicy DeleteRetentionPolicy `xml:"RetentionPolicy"`
+	}{
+		Version:         m.Version,
+		Enabled:         m.Enabled,
+		RetentionPolicy: m.RetentionPolicy,
+	}
+	if m.Enabled {
+		model.IncludeAPIs = &m.IncludeAPIs
+	}
+	return e.EncodeElement(model, start)
+}
+
+// StorageServiceStats are the statistics for the replication of the Blob service to the secondary location
+type StorageServiceStats struct {
+	// GeoReplication - The status of the geo-replication to the secondary location
+	GeoReplication *GeoReplication `xml:"GeoReplication,omitempty"`
+}
+
+// GeoReplication is the status of the geo-replication to the secondary location.
+type GeoReplication struct {
+	// Status - The status of the secondary location.
+	Status GeoReplicationStatus `xml:"Status"`
+	// LastSyncTime - All primary writes preceding this value (in RFC1123 format) are guaranteed to be available for read operations at the secondary. This is empty when the Status is `bootstrap` or `unavailable`.
+	LastSyncTime string `xml:"LastSyncTime,omitempty"`
+}
+
+type GeoReplicationStatus string
+
+const (
+	// GeoReplicationStatusLive indicates that the secondary location is active and operational.
+	GeoReplicationStatusLive GeoReplicationStatus = "live"
+	// GeoReplicationStatusBootstrap indicates the initial synchronization from the primary location to the secondary location is in progress.
+	GeoReplicationStatusBootstrap GeoReplicationStatus = "bootstrap"
+	// GeoReplicationStatusUnavailable indicates that the secondary location is temporarily unavailable.
+	GeoReplicationStatusUnavailable GeoReplicationStatus = "unavailable"
+)
+
+func PossibleValuesForGeoReplicationStatus() []GeoReplicationStatus {
+	return []GeoReplicationStatus{
+		GeoReplicationStatusLive,
+		GeoReplicationStatusBootstrap,
+		GeoReplicationStatusUnavailable,
+	}
+}
@@ -0,0 +1,110 @@
+package accounts
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestServicePropertiesRoundTrip(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<StorageServiceProperties>
+  <Logging>
+    <Version>1.0</Version>
+    <Delete>true</Delete>
+    <Read>false</Read>
+    <Write>true</Write>
+    <RetentionPolicy>
+      <Enabled>true</Enabled>
+      <Days>7</Days>
+    </RetentionPolicy>
+  </Logging>
+  <HourMetrics>
+    <Version>1.0</Version>
+    <Enabled>true</Enabled>
+    <IncludeAPIs>true</IncludeAPIs>
+    <RetentionPolicy>
+      <Enabled>true</Enabled>
+      <Days>7</Days>
+    </RetentionPolicy>
+  </HourMetrics>
+  <MinuteMetrics>
+    <Version>1.0</Version>
+    <Enabled>false</Enabled>
+    <RetentionPolicy>
+      <Enabled>false</Enabled>
+    </RetentionPolicy>
+  </MinuteMetrics>
+  <Cors>
+    <CorsRule>
+      <AllowedOrigins>https://example.com,https://example.org</AllowedOrigins>
+      <AllowedMethods>GET,PUT</AllowedMethods>
+      <MaxAgeInSeconds>500</MaxAgeInSeconds>
+      <ExposedHeaders>x-ms-meta-*</ExposedHeaders>
+      <AllowedHeaders>x-ms-meta-abc,x-ms-meta-data*</AllowedHeaders>
+    </CorsRule>
+    <CorsRule>
+      <AllowedOrigins>*</AllowedOrigins>
+      <AllowedMethods>GET</AllowedMethods>
+      <MaxAgeInSeconds>60</MaxAgeInSeconds>
+      <ExposedHeaders></ExposedHeaders>
+      <AllowedHeaders></AllowedHeaders>
+    </CorsRule>
+  </Cors>
+  <DefaultServiceVersion>2023-11-03</DefaultServiceVersion>
+  <DeleteRetentionPolicy>
+    <Enabled>true</Enabled>
+    <Days>14</Days>
+    <AllowPermanentDelete>false</AllowPermanentDelete>
+  </DeleteRetentionPolicy>
+  <StaticWebsite>
+    <Enabled>true</Enabled>
+    <IndexDocument>index.html</IndexDocument>
+    <ErrorDocument404Path>error/404.html</ErrorDocument404Path>
+  </StaticWebsite>
+</StorageServiceProperties>`
+
+	var first StorageServiceProperties
+	if err := xml.Unmarshal([]byte(input), &first); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if first.Cors == nil || len(first.Cors.CorsRules) != 2 {
+		t.Fatalf("expected 2 CORS Rules but got %+v", first.Cors)
+	}
+	if expected := []string{"https://example.com", "https://example.org"}; !reflect.DeepEqual(first.Cors.CorsRules[0].AllowedOrigins, expected) {
+		t.Fatalf("expected the Allowed Origins to be %+v but got %+v", expected, first.Cors.CorsRules[0].AllowedOrigins)
+	}
+	if first.DefaultServiceVersion == nil || *first.DefaultServiceVersion != "2023-11-03" {
+		t.Fatalf("expected the Default Service Version to be %q but got %v", "2023-11-03", first.DefaultServiceVersion)
+	}
+	if first.DeleteRetentionPolicy == nil || first.DeleteRetentionPolicy.Days != 14 || first.DeleteRetentionPolicy.AllowPermanentDelete == nil {
+		t.Fatalf("expected the Delete Retention Policy to be parsed but got %+v", first.DeleteRetentionPolicy)
+	}
+	if first.Logging == nil || !first.Logging.Delete || first.Logging.RetentionPolicy.Days != 7 {
+		t.Fatalf("expected the Logging configuration to be parsed but got %+v", first.Logging)
+	}
+	if first.HourMetrics == nil || !first.HourMetrics.IncludeAPIs {
+		t.Fatalf("expected the Hour Metrics configuration to be parsed but got %+v", first.HourMetrics)
+	}
+
+	marshalled, err := xml.Marshal(first)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+	if !strings.Contains(string(marshalled), "<MinuteMetrics><Version>1.0</Version><Enabled>false</Enabled><RetentionPolicy><Enabled>false</Enabled></RetentionPolicy></MinuteMetrics>") {
+		t.Fatalf("expected `IncludeAPIs` to be omitted and `Enabled` to be sent for disabled Metrics but got %s", marshalled)
+	}
+	if !strings.Contains(string(marshalled), "<AllowedOrigins>https://example.com,https://example.org</AllowedOrigins>") {
+		t.Fatalf("expected the Allowed Origins to be sent as a comma-separated list but got %s", marshalled)
+	}
+
+	var second StorageServiceProperties
+	if err := xml.Unmarshal(marshalled, &second); err != nil {
+		t.Fatalf("unmarshalling the marshalled properties: %+v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("expected the properties to round-trip but got:\n\n%+v\n\nand\n\n%+v", first, second)
+	}
+}
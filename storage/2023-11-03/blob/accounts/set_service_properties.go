@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetServicePropertiesResult struct {
@@ -44,7 +45,7 @@ func (c Client) SetServiceProperties(ctx context.Context, accountName string, in
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
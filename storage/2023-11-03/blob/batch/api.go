@@ -0,0 +1,9 @@
+package batch
+
+import (
+	"context"
+)
+
+type StorageBatch interface {
+	Submit(ctx context.Context, input SubmitInput) (SubmitResponse, error)
+}
@@ -0,0 +1,22 @@
+package batch
+
+import (
+	"fmt"
+
+	"github.com/jackofallops/giovanni/storage/baseclient"
+)
+
+// Client is the base client for Blob Storage Batches.
+type Client struct {
+	Client *baseclient.Client
+}
+
+func NewWithBaseUri(baseUri string) (*Client, error) {
+	baseClient, err := baseclient.New(baseUri, componentName, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("building base client: %+v", err)
+	}
+	return &Client{
+		Client: baseClient,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+	"github.com/jackofallops/giovanni/storage/internal/testhelpers"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+var _ StorageBatch = Client{}
+
+func TestBatchLifecycle(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
+	defer cancel()
+
+	client, err := testhelpers.Build(ctx, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceGroup := fmt.Sprintf("acctestrg-%d", testhelpers.RandomInt())
+	accountName := fmt.Sprintf("acctestsa%s", testhelpers.RandomString())
+	containerName := fmt.Sprintf("cont-%d", testhelpers.RandomInt())
+
+	testData, err := client.BuildTestResources(ctx, resourceGroup, accountName, storageaccounts.KindStorageVTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.DestroyTestResources(ctx, resourceGroup, accountName)
+
+	domainSuffix, ok := client.Environment.Storage.DomainSuffix()
+	if !ok {
+		t.Fatalf("storage didn't return a domain suffix for this environment")
+	}
+	baseUri := fmt.Sprintf("https://%s.blob.%s", testData.StorageAccountName, *domainSuffix)
+
+	containersClient, err := containers.NewWithBaseUri(baseUri)
+	if err != nil {
+		t.Fatalf("building client for environment: %+v", err)
+	}
+	if err = client.PrepareWithSharedKeyAuth(containersClient.Client, testData, auth.SharedKey); err != nil {
+		t.Fatalf("adding authorizer to client: %+v", err)
+	}
+
+	blobClient, err := blobs.NewWithBaseUri(baseUri)
+	if err != nil {
+		t.Fatalf("building client for environment: %+v", err)
+	}
+	if err = client.PrepareWithSharedKeyAuth(blobClient.Client, testData, auth.SharedKey); err != nil {
+		t.Fatalf("adding authorizer to client: %+v", err)
+	}
+
+	batchClient, err := NewWithBaseUri(baseUri)
+	if err != nil {
+		t.Fatalf("building client for environment: %+v", err)
+	}
+	if err = client.PrepareWithSharedKeyAuth(batchClient.Client, testData, auth.SharedKey); err != nil {
+		t.Fatalf("adding authorizer to client: %+v", err)
+	}
+
+	if _, err = containersClient.Create(ctx, containerName, containers.CreateInput{}); err != nil {
+		t.Fatalf("creating container: %+v", err)
+	}
+	defer containersClient.Delete(ctx, containerName)
+
+	blobNames := []string{"blob1.txt", "blob2.txt", "blob3.txt"}
+	for _, blobName := range blobNames {
+		content := []byte(fmt.Sprintf("hello from %s", blobName))
+		if _, err = blobClient.PutBlockBlob(ctx, containerName, blobName, blobs.PutBlockBlobInput{Content: &content}); err != nil {
+			t.Fatalf("uploading %q: %+v", blobName, err)
+		}
+	}
+
+	t.Logf("[DEBUG] Setting the Tier of the Blobs..")
+	setTierInput := SubmitInput{}
+	for _, blobName := range blobNames {
+		setTierInput.Operations = append(setTierInput.Operations, Operation{
+			Type:          OperationTypeSetTier,
+			ContainerName: containerName,
+			BlobName:      blobName,
+			Tier:          blobs.Cool,
+		})
+	}
+	setTierResult, err := batchClient.Submit(ctx, setTierInput)
+	if err != nil {
+		t.Fatalf("submitting batch: %+v", err)
+	}
+	for _, v := range setTierResult.Results {
+		if v.Error != nil {
+			t.Fatalf("setting the tier of %q: %+v", v.BlobName, v.Error)
+		}
+	}
+	for _, blobName := range blobNames {
+		props, err := blobClient.GetProperties(ctx, containerName, blobName, blobs.GetPropertiesInput{})
+		if err != nil {
+			t.Fatalf("retrieving properties for %q: %+v", blobName, err)
+		}
+		if props.AccessTier != blobs.Cool {
+			t.Fatalf("expected the Access Tier for %q to be %q but got %q", blobName, blobs.Cool, props.AccessTier)
+		}
+	}
+
+	t.Logf("[DEBUG] Deleting the Blobs..")
+	deleteInput := SubmitInput{}
+	for _, blobName := range append(blobNames, "does-not-exist.txt") {
+		deleteInput.Operations = append(deleteInput.Operations, Operation{
+			Type:          OperationTypeDelete,
+			ContainerName: containerName,
+			BlobName:      blobName,
+		})
+	}
+	deleteResult, err := batchClient.Submit(ctx, deleteInput)
+	if err != nil {
+		t.Fatalf("submitting batch: %+v", err)
+	}
+	for i, v := range deleteResult.Results {
+		if i == len(blobNames) {
+			if !responseerror.IsNotFound(v.Error) {
+				t.Fatalf("expected deleting %q to fail with a Not Found error but got: %+v", v.BlobName, v.Error)
+			}
+			continue
+		}
+		if v.StatusCode != http.StatusAccepted {
+			t.Fatalf("expected deleting %q to return a 202 but got %d: %+v", v.BlobName, v.StatusCode, v.Error)
+		}
+	}
+	for _, blobName := range blobNames {
+		exists, err := blobClient.Exists(ctx, containerName, blobName)
+		if err != nil {
+			t.Fatalf("checking if %q exists: %+v", blobName, err)
+		}
+		if exists.Exists {
+			t.Fatalf("expected %q to have been deleted", blobName)
+		}
+	}
+}
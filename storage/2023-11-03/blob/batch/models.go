@@ -0,0 +1,15 @@
+package batch
+
+type OperationType string
+
+const (
+	OperationTypeDelete  OperationType = "Delete"
+	OperationTypeSetTier OperationType = "SetTier"
+)
+
+func PossibleValuesForOperationType() []string {
+	return []string{
+		string(OperationTypeDelete),
+		string(OperationTypeSetTier),
+	}
+}
@@ -0,0 +1,397 @@
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+// maxBatchOperations is the maximum number of operations which can be included within a single batch
+const maxBatchOperations = 256
+
+type Operation struct {
+	// The type of operation which should be performed on this Blob, all operations within a batch
+	// must be of the same type
+	Type OperationType
+
+	// The name of the Container and Blob this operation should be performed on
+	ContainerName string
+	BlobName      string
+
+	// Optional - The DateTime of the Snapshot this operation should be performed on, rather than the base Blob
+	Snapshot *string
+
+	// Optional - The ID of the Version this operation should be performed on, rather than the current Version
+	VersionID *string
+
+	// Optional - The ID of the Lease, which must be specified if a Lease is present on the Blob
+	LeaseID *string
+
+	// Optional - Specifies whether the base Blob and all of its Snapshots (`IncludeSnapshots`), or only the
+	// Snapshots (`OnlySnapshots`) should be deleted. This is only used for Delete operations.
+	DeleteSnapshotsOption *blobs.DeleteSnapshotsOption
+
+	// The Tier which should be set on the Blob. This is required for (and only used for) SetTier operations.
+	Tier blobs.AccessTier
+
+	// Optional - The priority with which the Blob should be rehydrated from the `Archive` Tier.
+	// This is only used for SetTier operations.
+	RehydratePriority *blobs.RehydratePriority
+}
+
+type SubmitInput struct {
+	// The operations which should be performed, up to 256 operations can be specified
+	Operations []Operation
+}
+
+type OperationResult struct {
+	// The name of the Container and Blob this operation was performed on
+	ContainerName string
+	BlobName      string
+
+	// The HTTP Status Code returned for this operation
+	StatusCode int
+
+	// The ID of the sub-request, which is useful when raising a support ticket
+	RequestID string
+
+	// The error returned for this operation, when it failed. This wraps a `responseerror.ResponseError` - unless
+	// the response didn't contain a result for this operation, in which case StatusCode is 0 and the outcome of
+	// the operation is unknown.
+	Error error
+}
+
+type SubmitResponse struct {
+	HttpResponse *http.Response
+
+	// The results of each operation, in the same order as the operations within the SubmitInput
+	Results []OperationResult
+}
+
+// Submit performs up to 256 Delete or SetTier operations within a single request. The operations
+// aren't atomic - where an operation fails the remaining operations are still performed, and the
+// error for that operation is returned within its OperationResult rather than failing the batch.
+func (c Client) Submit(ctx context.Context, input SubmitInput) (result SubmitResponse, err error) {
+	if err = validateOperations(input.Operations); err != nil {
+		return
+	}
+
+	batchBoundary := fmt.Sprintf("batch_%s", uuid.New().String())
+	body, err := c.buildBatchPayload(ctx, input.Operations, batchBoundary)
+	if err != nil {
+		return result, fmt.Errorf("building batch payload: %+v", err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: fmt.Sprintf("multipart/mixed; boundary=%s", batchBoundary),
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: submitOptions{},
+		Path:          "/",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	err = req.Marshal(body)
+	if err != nil {
+		return result, fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	result.Results, err = parseBatchResponse(resp.Response, input.Operations)
+	if err != nil {
+		return result, fmt.Errorf("parsing batch response: %+v", err)
+	}
+
+	return
+}
+
+type submitOptions struct{}
+
+func (s submitOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (s submitOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (s submitOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "batch")
+	return out
+}
+
+func validateOperations(operations []Operation) error {
+	if len(operations) == 0 {
+		return fmt.Errorf("`input.Operations` must contain at least one operation")
+	}
+	if len(operations) > maxBatchOperations {
+		return fmt.Errorf("`input.Operations` can contain at most %d operations but got %d", maxBatchOperations, len(operations))
+	}
+
+	operationType := operations[0].Type
+	for i, v := range operations {
+		if !isValidOperationType(v.Type) {
+			return fmt.Errorf("`input.Operations[%d].Type` must be one of %s but got %q", i, strings.Join(PossibleValuesForOperationType(), ", "), v.Type)
+		}
+		if v.Type != operationType {
+			return fmt.Errorf("all operations within a batch must be of the same type, `input.Operations[%d].Type` was %q but expected %q", i, v.Type, operationType)
+		}
+		if v.ContainerName == "" {
+			return fmt.Errorf("`input.Operations[%d].ContainerName` cannot be an empty string", i)
+		}
+		if err := naming.ValidateContainerName(v.ContainerName); err != nil {
+			return fmt.Errorf("`input.Operations[%d].ContainerName` is not valid: %+v", i, err)
+		}
+		if v.BlobName == "" {
+			return fmt.Errorf("`input.Operations[%d].BlobName` cannot be an empty string", i)
+		}
+		if err := naming.ValidateBlobName(v.BlobName); err != nil {
+			return fmt.Errorf("`input.Operations[%d].BlobName` is not valid: %+v", i, err)
+		}
+		if v.Snapshot != nil && v.VersionID != nil {
+			return fmt.Errorf("at most one of `input.Operations[%d].Snapshot` and `input.Operations[%d].VersionID` can be specified", i, i)
+		}
+
+		switch v.Type {
+		case OperationTypeDelete:
+			if v.Tier != "" || v.RehydratePriority != nil {
+				return fmt.Errorf("`input.Operations[%d].Tier` and `input.Operations[%d].RehydratePriority` can only be specified for %q operations", i, i, OperationTypeSetTier)
+			}
+			if v.DeleteSnapshotsOption != nil && (v.Snapshot != nil || v.VersionID != nil) {
+				return fmt.Errorf("`input.Operations[%d].DeleteSnapshotsOption` cannot be specified when deleting a single Snapshot or Version", i)
+			}
+
+		case OperationTypeSetTier:
+			if v.Tier == "" {
+				return fmt.Errorf("`input.Operations[%d].Tier` must be specified for %q operations", i, OperationTypeSetTier)
+			}
+			if v.DeleteSnapshotsOption != nil {
+				return fmt.Errorf("`input.Operations[%d].DeleteSnapshotsOption` can only be specified for %q operations", i, OperationTypeDelete)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isValidOperationType(input OperationType) bool {
+	for _, v := range PossibleValuesForOperationType() {
+		if string(input) == v {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSubRequest builds the HTTP request for a single operation within the batch
+func buildSubRequest(baseUri string, operation Operation) (*http.Request, error) {
+	query := url.Values{}
+	if operation.Snapshot != nil {
+		query.Set("snapshot", *operation.Snapshot)
+	}
+	if operation.VersionID != nil {
+		query.Set("versionid", *operation.VersionID)
+	}
+
+	method := http.MethodDelete
+	headers := http.Header{}
+	if operation.LeaseID != nil {
+		headers.Set("x-ms-lease-id", *operation.LeaseID)
+	}
+
+	switch operation.Type {
+	case OperationTypeDelete:
+		if operation.DeleteSnapshotsOption != nil {
+			headers.Set("x-ms-delete-snapshots", string(*operation.DeleteSnapshotsOption))
+		}
+
+	case OperationTypeSetTier:
+		method = http.MethodPut
+		query.Set("comp", "tier")
+		headers.Set("x-ms-access-tier", string(operation.Tier))
+		if operation.RehydratePriority != nil {
+			headers.Set("x-ms-rehydrate-priority", string(*operation.RehydratePriority))
+		}
+	}
+
+	uri := fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(baseUri, "/"), operation.ContainerName, operation.BlobName)
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", uri, err)
+	}
+	u.RawQuery = query.Encode()
+
+	return &http.Request{
+		Method: method,
+		URL:    u,
+		Header: headers,
+	}, nil
+}
+
+// buildBatchPayload builds the multipart/mixed payload for a batch, where each sub-request is
+// individually authorized using the Authorizer configured on the Client (or specified for the request)
+func (c Client) buildBatchPayload(ctx context.Context, operations []Operation, batchBoundary string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	for i, v := range operations {
+		req, err := buildSubRequest(c.Client.BaseUri, v)
+		if err != nil {
+			return nil, fmt.Errorf("building sub-request for operation %d: %+v", i, err)
+		}
+
+		authorizer := c.Client.AuthorizerFor(ctx)
+		if c.Client.AuthorizeRequest != nil {
+			err = c.Client.AuthorizeRequest(ctx, req, authorizer)
+		} else if authorizer != nil {
+			err = auth.SetAuthHeader(ctx, req, authorizer)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("authorizing sub-request for operation %d: %+v", i, err)
+		}
+
+		writeSubRequest(buf, batchBoundary, i, req)
+	}
+
+	fmt.Fprintf(buf, "--%s--\r\n", batchBoundary)
+	return buf.Bytes(), nil
+}
+
+func writeSubRequest(buf *bytes.Buffer, batchBoundary string, contentID int, req *http.Request) {
+	fmt.Fprintf(buf, "--%s\r\n", batchBoundary)
+	buf.WriteString("Content-Type: application/http\r\n")
+	buf.WriteString("Content-Transfer-Encoding: binary\r\n")
+	fmt.Fprintf(buf, "Content-ID: %d\r\n\r\n", contentID)
+	fmt.Fprintf(buf, "%s %s HTTP/1.1\r\n", req.Method, req.URL.RequestURI())
+
+	// the headers are sorted so that the payload is deterministic
+	keys := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%s: %s\r\n", k, req.Header.Get(k))
+	}
+	buf.WriteString("Content-Length: 0\r\n\r\n")
+}
+
+// parseBatchResponse parses the multipart/mixed response for a batch into the results for each operation
+func parseBatchResponse(resp *http.Response, operations []Operation) ([]OperationResult, error) {
+	if resp == nil || resp.Body == nil {
+		return nil, fmt.Errorf("the response body was empty")
+	}
+	defer resp.Body.Close()
+
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing content type %q: %+v", resp.Header.Get("Content-Type"), err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("expected a multipart content type but got %q", mediaType)
+	}
+	boundary, ok := params["boundary"]
+	if !ok {
+		return nil, fmt.Errorf("the content type %q did not contain a boundary", resp.Header.Get("Content-Type"))
+	}
+
+	results := make([]OperationResult, len(operations))
+	for i, v := range operations {
+		results[i] = OperationResult{
+			ContainerName: v.ContainerName,
+			BlobName:      v.BlobName,
+		}
+	}
+
+	received := make([]bool, len(operations))
+	reader := multipart.NewReader(resp.Body, boundary)
+	for i := 0; ; i++ {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading batch response part: %+v", err)
+		}
+
+		// the Content-ID matches the sub-request, however fall back to the order of the parts if it's omitted
+		index := i
+		if v := part.Header.Get("Content-ID"); v != "" {
+			index, err = strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing Content-ID %q: %+v", v, err)
+			}
+		}
+		if index < 0 || index >= len(results) {
+			return nil, fmt.Errorf("the response contained a result for operation %d, however only %d operations were submitted", index, len(results))
+		}
+
+		raw, err := io.ReadAll(part)
+		if err != nil {
+			return nil, fmt.Errorf("reading response for operation %d: %+v", index, err)
+		}
+		// when a sub-response has no body the CRLF terminating its headers is consumed as a part of the
+		// multipart delimiter, so this needs to be restored for the response to be parsed
+		if !bytes.Contains(raw, []byte("\r\n\r\n")) {
+			raw = append(raw, []byte("\r\n")...)
+		}
+
+		operationResp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(raw)), nil)
+		if err != nil {
+			return nil, fmt.Errorf("parsing response for operation %d: %+v", index, err)
+		}
+
+		received[index] = true
+		results[index].StatusCode = operationResp.StatusCode
+		results[index].RequestID = operationResp.Header.Get("x-ms-request-id")
+		if operationResp.StatusCode >= http.StatusBadRequest {
+			operationErr := fmt.Errorf("unexpected status %d received for operation %d", operationResp.StatusCode, index)
+			results[index].Error = responseerror.New(&client.Response{Response: operationResp}, operationErr)
+		}
+
+		// drain the body so that the next part can be read
+		_, _ = io.Copy(io.Discard, operationResp.Body)
+		operationResp.Body.Close()
+	}
+
+	// an operation without a result may or may not have been performed, so this mustn't be treated as a success
+	for i := range results {
+		if !received[i] {
+			results[i].Error = fmt.Errorf("the response did not contain a result for operation %d (Blob %q within Container %q)", i, results[i].BlobName, results[i].ContainerName)
+		}
+	}
+
+	return results, nil
+}
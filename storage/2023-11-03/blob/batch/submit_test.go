@@ -0,0 +1,360 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+func TestValidateOperations(t *testing.T) {
+	tooMany := make([]Operation, 0)
+	for i := 0; i < maxBatchOperations+1; i++ {
+		tooMany = append(tooMany, Operation{
+			Type:          OperationTypeDelete,
+			ContainerName: "container",
+			BlobName:      "blob",
+		})
+	}
+
+	testData := []struct {
+		Name          string
+		Input         []Operation
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "No Operations",
+			Input:         []Operation{},
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Too Many Operations",
+			Input:         tooMany,
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Delete",
+			Input: []Operation{
+				{
+					Type:                  OperationTypeDelete,
+					ContainerName:         "container",
+					BlobName:              "blob",
+					DeleteSnapshotsOption: pointer.To(blobs.IncludeSnapshots),
+				},
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Delete with a Tier",
+			Input: []Operation{
+				{
+					Type:          OperationTypeDelete,
+					ContainerName: "container",
+					BlobName:      "blob",
+					Tier:          blobs.Cool,
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Delete a Snapshot including Snapshots",
+			Input: []Operation{
+				{
+					Type:                  OperationTypeDelete,
+					ContainerName:         "container",
+					BlobName:              "blob",
+					Snapshot:              pointer.To("2024-01-01T00:00:00.0000000Z"),
+					DeleteSnapshotsOption: pointer.To(blobs.IncludeSnapshots),
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Set Tier",
+			Input: []Operation{
+				{
+					Type:              OperationTypeSetTier,
+					ContainerName:     "container",
+					BlobName:          "blob",
+					Tier:              blobs.Archive,
+					RehydratePriority: pointer.To(blobs.High),
+				},
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Set Tier without a Tier",
+			Input: []Operation{
+				{
+					Type:          OperationTypeSetTier,
+					ContainerName: "container",
+					BlobName:      "blob",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Mixed Operation Types",
+			Input: []Operation{
+				{
+					Type:          OperationTypeDelete,
+					ContainerName: "container",
+					BlobName:      "blob1",
+				},
+				{
+					Type:          OperationTypeSetTier,
+					ContainerName: "container",
+					BlobName:      "blob2",
+					Tier:          blobs.Cool,
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Invalid Operation Type",
+			Input: []Operation{
+				{
+					Type:          OperationType("Copy"),
+					ContainerName: "container",
+					BlobName:      "blob",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Upper-cased Container Name",
+			Input: []Operation{
+				{
+					Type:          OperationTypeDelete,
+					ContainerName: "Container",
+					BlobName:      "blob",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Container Name with Consecutive Hyphens",
+			Input: []Operation{
+				{
+					Type:          OperationTypeDelete,
+					ContainerName: "con--tainer",
+					BlobName:      "blob",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Empty Blob Name",
+			Input: []Operation{
+				{
+					Type:          OperationTypeDelete,
+					ContainerName: "container",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Snapshot and Version",
+			Input: []Operation{
+				{
+					Type:          OperationTypeDelete,
+					ContainerName: "container",
+					BlobName:      "blob",
+					Snapshot:      pointer.To("2024-01-01T00:00:00.0000000Z"),
+					VersionID:     pointer.To("2024-01-01T00:00:00.0000000Z"),
+				},
+			},
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateOperations(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected the operations to be valid but got: %+v", err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected the operations to be invalid but they were valid")
+		}
+	}
+}
+
+func TestBuildBatchPayload(t *testing.T) {
+	batchClient, err := NewWithBaseUri("https://account1.blob.core.windows.net")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	operations := []Operation{
+		{
+			Type:              OperationTypeSetTier,
+			ContainerName:     "container",
+			BlobName:          "blob1",
+			Tier:              blobs.Cool,
+			RehydratePriority: pointer.To(blobs.Standard),
+		},
+		{
+			Type:          OperationTypeSetTier,
+			ContainerName: "container",
+			BlobName:      "folder/blob2",
+			VersionID:     pointer.To("2024-01-01T00:00:00.0000000Z"),
+			LeaseID:       pointer.To("abc123"),
+			Tier:          blobs.Archive,
+		},
+	}
+
+	body, err := batchClient.buildBatchPayload(context.TODO(), operations, "batch_boundary")
+	if err != nil {
+		t.Fatalf("building payload: %+v", err)
+	}
+
+	expected := "--batch_boundary\r\n" +
+		"Content-Type: application/http\r\n" +
+		"Content-Transfer-Encoding: binary\r\n" +
+		"Content-ID: 0\r\n" +
+		"\r\n" +
+		"PUT /container/blob1?comp=tier HTTP/1.1\r\n" +
+		"X-Ms-Access-Tier: Cool\r\n" +
+		"X-Ms-Rehydrate-Priority: Standard\r\n" +
+		"Content-Length: 0\r\n" +
+		"\r\n" +
+		"--batch_boundary\r\n" +
+		"Content-Type: application/http\r\n" +
+		"Content-Transfer-Encoding: binary\r\n" +
+		"Content-ID: 1\r\n" +
+		"\r\n" +
+		"PUT /container/folder/blob2?comp=tier&versionid=2024-01-01T00%3A00%3A00.0000000Z HTTP/1.1\r\n" +
+		"X-Ms-Access-Tier: Archive\r\n" +
+		"X-Ms-Lease-Id: abc123\r\n" +
+		"Content-Length: 0\r\n" +
+		"\r\n" +
+		"--batch_boundary--\r\n"
+	if string(body) != expected {
+		t.Fatalf("expected the payload to be:\n\n%s\n\nbut got:\n\n%s", expected, string(body))
+	}
+}
+
+func TestParseBatchResponse(t *testing.T) {
+	operations := []Operation{
+		{
+			Type:          OperationTypeDelete,
+			ContainerName: "container",
+			BlobName:      "blob1",
+		},
+		{
+			Type:          OperationTypeDelete,
+			ContainerName: "container",
+			BlobName:      "blob2",
+		},
+	}
+
+	// the parts are intentionally returned out of order
+	body := "--batchresponse_abc\r\n" +
+		"Content-Type: application/http\r\n" +
+		"Content-ID: 1\r\n" +
+		"\r\n" +
+		"HTTP/1.1 404 The specified blob does not exist.\r\n" +
+		"x-ms-error-code: BlobNotFound\r\n" +
+		"x-ms-request-id: request-2\r\n" +
+		"Content-Type: application/xml\r\n" +
+		"\r\n" +
+		"<?xml version=\"1.0\" encoding=\"utf-8\"?><Error><Code>BlobNotFound</Code><Message>Not Found</Message></Error>\r\n" +
+		"--batchresponse_abc\r\n" +
+		"Content-Type: application/http\r\n" +
+		"Content-ID: 0\r\n" +
+		"\r\n" +
+		"HTTP/1.1 202 Accepted\r\n" +
+		"x-ms-request-id: request-1\r\n" +
+		"Content-Length: 0\r\n" +
+		"\r\n" +
+		"--batchresponse_abc--\r\n"
+
+	resp := &http.Response{
+		StatusCode: http.StatusAccepted,
+		Header: http.Header{
+			"Content-Type": []string{"multipart/mixed; boundary=batchresponse_abc"},
+		},
+		Body: io.NopCloser(strings.NewReader(body)),
+	}
+
+	results, err := parseBatchResponse(resp, operations)
+	if err != nil {
+		t.Fatalf("parsing response: %+v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results but got %d", len(results))
+	}
+
+	if results[0].BlobName != "blob1" || results[0].StatusCode != http.StatusAccepted || results[0].Error != nil {
+		t.Fatalf("expected the first operation to have succeeded but got: %+v", results[0])
+	}
+	if results[0].RequestID != "request-1" {
+		t.Fatalf("expected the RequestID for the first operation to be %q but got %q", "request-1", results[0].RequestID)
+	}
+
+	if results[1].BlobName != "blob2" || results[1].StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the second operation to have failed with a 404 but got: %+v", results[1])
+	}
+	if !responseerror.IsNotFound(results[1].Error) {
+		t.Fatalf("expected the error for the second operation to be a Not Found error but got: %+v", results[1].Error)
+	}
+	var responseErr responseerror.ResponseError
+	if !errors.As(results[1].Error, &responseErr) {
+		t.Fatalf("expected the error for the second operation to wrap a ResponseError but got: %+v", results[1].Error)
+	}
+	if responseErr.Code != "BlobNotFound" {
+		t.Fatalf("expected the error code for the second operation to be %q but got %q", "BlobNotFound", responseErr.Code)
+	}
+}
+
+func TestParseBatchResponseMissingResult(t *testing.T) {
+	operations := []Operation{
+		{
+			Type:          OperationTypeDelete,
+			ContainerName: "container",
+			BlobName:      "blob1",
+		},
+		{
+			Type:          OperationTypeDelete,
+			ContainerName: "container",
+			BlobName:      "blob2",
+		},
+	}
+
+	body := "--batchresponse_abc\r\n" +
+		"Content-Type: application/http\r\n" +
+		"Content-ID: 0\r\n" +
+		"\r\n" +
+		"HTTP/1.1 202 Accepted\r\n" +
+		"x-ms-request-id: request-1\r\n" +
+		"Content-Length: 0\r\n" +
+		"\r\n" +
+		"--batchresponse_abc--\r\n"
+
+	resp := &http.Response{
+		StatusCode: http.StatusAccepted,
+		Header: http.Header{
+			"Content-Type": []string{"multipart/mixed; boundary=batchresponse_abc"},
+		},
+		Body: io.NopCloser(strings.NewReader(body)),
+	}
+
+	results, err := parseBatchResponse(resp, operations)
+	if err != nil {
+		t.Fatalf("parsing response: %+v", err)
+	}
+	if results[0].StatusCode != http.StatusAccepted || results[0].Error != nil {
+		t.Fatalf("expected the first operation to have succeeded but got: %+v", results[0])
+	}
+	if results[1].StatusCode != 0 || results[1].Error == nil {
+		t.Fatalf("expected an error for the second operation, which has no result, but got: %+v", results[1])
+	}
+}
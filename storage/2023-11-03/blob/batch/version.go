@@ -0,0 +1,5 @@
+package batch
+
+// APIVersion is the version of the API used for all Storage API Operations
+const apiVersion = "2023-11-03"
+const componentName = "blob/batch"
@@ -7,22 +7,33 @@ import (
 
 type StorageBlob interface {
 	AppendBlock(ctx context.Context, containerName string, blobName string, input AppendBlockInput) (AppendBlockResponse, error)
+	AppendBlockFromURL(ctx context.Context, containerName string, blobName string, input AppendBlockFromURLInput) (AppendBlockFromURLResponse, error)
 	Copy(ctx context.Context, containerName string, blobName string, input CopyInput) (CopyResponse, error)
 	AbortCopy(ctx context.Context, containerName string, blobName string, input AbortCopyInput) (CopyAbortResponse, error)
 	CopyAndWait(ctx context.Context, containerName string, blobName string, input CopyInput) error
+	CopyFromURL(ctx context.Context, containerName string, blobName string, input CopyFromURLInput) (CopyFromURLResponse, error)
 	Delete(ctx context.Context, containerName string, blobName string, input DeleteInput) (DeleteResponse, error)
 	DeleteSnapshot(ctx context.Context, containerName string, blobName string, input DeleteSnapshotInput) (DeleteSnapshotResponse, error)
 	DeleteSnapshots(ctx context.Context, containerName string, blobName string, input DeleteSnapshotsInput) (DeleteSnapshotsResponse, error)
+	DownloadToFile(ctx context.Context, containerName string, blobName string, localPath string, input DownloadToFileInput) (DownloadToFileResponse, error)
 	Get(ctx context.Context, containerName string, blobName string, input GetInput) (GetResponse, error)
 	GetBlockList(ctx context.Context, containerName string, blobName string, input GetBlockListInput) (GetBlockListResponse, error)
+	GetCopyStatus(ctx context.Context, containerName string, blobName string, input GetCopyStatusInput) (GetCopyStatusResponse, error)
 	GetPageRanges(ctx context.Context, containerName, blobName string, input GetPageRangesInput) (GetPageRangesResponse, error)
+	GetPageRangesDiff(ctx context.Context, containerName, blobName string, input GetPageRangesDiffInput) (GetPageRangesDiffResponse, error)
+	GetReader(ctx context.Context, containerName string, blobName string, input GetReaderInput) (GetReaderResponse, error)
+	GetResumableReader(ctx context.Context, containerName string, blobName string, input GetResumableReaderInput) (GetResumableReaderResponse, error)
+	GetTags(ctx context.Context, containerName string, blobName string, input GetTagsInput) (GetTagsResponse, error)
 	IncrementalCopyBlob(ctx context.Context, containerName string, blobName string, input IncrementalCopyBlobInput) (IncrementalCopyBlob, error)
+	IncrementalCopyBlobAndWait(ctx context.Context, containerName string, blobName string, input IncrementalCopyBlobInput) (IncrementalCopyBlob, error)
 	AcquireLease(ctx context.Context, containerName string, blobName string, input AcquireLeaseInput) (AcquireLeaseResponse, error)
 	BreakLease(ctx context.Context, containerName string, blobName string, input BreakLeaseInput) (BreakLeaseResponse, error)
 	ChangeLease(ctx context.Context, containerName string, blobName string, input ChangeLeaseInput) (ChangeLeaseResponse, error)
 	ReleaseLease(ctx context.Context, containerName string, blobName string, input ReleaseLeaseInput) (ReleaseLeaseResponse, error)
 	RenewLease(ctx context.Context, containerName string, blobName string, input RenewLeaseInput) (RenewLeaseResponse, error)
+	GetMetaData(ctx context.Context, containerName string, blobName string, input GetMetaDataInput) (GetMetaDataResponse, error)
 	SetMetaData(ctx context.Context, containerName string, blobName string, input SetMetaDataInput) (SetMetaDataResponse, error)
+	Exists(ctx context.Context, containerName string, blobName string) (ExistsResponse, error)
 	GetProperties(ctx context.Context, containerName string, blobName string, input GetPropertiesInput) (GetPropertiesResponse, error)
 	SetProperties(ctx context.Context, containerName string, blobName string, input SetPropertiesInput) (SetPropertiesResponse, error)
 	PutAppendBlob(ctx context.Context, containerName string, blobName string, input PutAppendBlobInput) (PutAppendBlobResponse, error)
@@ -34,8 +45,17 @@ type StorageBlob interface {
 	PutPageBlob(ctx context.Context, containerName string, blobName string, input PutPageBlobInput) (PutPageBlobResponse, error)
 	PutPageClear(ctx context.Context, containerName string, blobName string, input PutPageClearInput) (PutPageClearResponse, error)
 	PutPageUpdate(ctx context.Context, containerName string, blobName string, input PutPageUpdateInput) (PutPageUpdateResponse, error)
+	Query(ctx context.Context, containerName string, blobName string, input QueryInput) (QueryResponse, error)
+	PromoteVersion(ctx context.Context, containerName string, blobName string, input PromoteVersionInput) error
+	SetImmutabilityPolicy(ctx context.Context, containerName string, blobName string, input SetImmutabilityPolicyInput) (SetImmutabilityPolicyResponse, error)
+	DeleteImmutabilityPolicy(ctx context.Context, containerName string, blobName string, input DeleteImmutabilityPolicyInput) (DeleteImmutabilityPolicyResponse, error)
+	SetLegalHold(ctx context.Context, containerName string, blobName string, input SetLegalHoldInput) (SetLegalHoldResponse, error)
+	SetExpiry(ctx context.Context, containerName string, blobName string, input SetExpiryInput) (SetExpiryResponse, error)
+	SetTags(ctx context.Context, containerName string, blobName string, input SetTagsInput) (SetTagsResponse, error)
 	SetTier(ctx context.Context, containerName string, blobName string, input SetTierInput) (SetTierResponse, error)
+	Rehydrate(ctx context.Context, containerName string, blobName string, input RehydrateInput) (RehydrateResponse, error)
 	Snapshot(ctx context.Context, containerName string, blobName string, input SnapshotInput) (SnapshotResponse, error)
 	GetSnapshotProperties(ctx context.Context, containerName string, blobName string, input GetSnapshotPropertiesInput) (GetPropertiesResponse, error)
 	Undelete(ctx context.Context, containerName string, blobName string) (UndeleteResponse, error)
+	UploadFile(ctx context.Context, containerName string, blobName string, localPath string, input UploadFileInput) (UploadFileResponse, error)
 }
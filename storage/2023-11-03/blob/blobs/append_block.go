@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type AppendBlockInput struct {
@@ -43,6 +46,9 @@ type AppendBlockInput struct {
 
 	// The encryption scope to set for the request content.
 	EncryptionScope *string
+
+	// Optional - A Customer-Provided Key used to encrypt the Block, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
 }
 
 type AppendBlockResponse struct {
@@ -52,7 +58,7 @@ type AppendBlockResponse struct {
 	BlobCommittedBlockCount int64
 	ContentMD5              string
 	ETag                    string
-	LastModified            string
+	LastModified            time.Time
 }
 
 // AppendBlock commits a new block of data to the end of an existing append blob.
@@ -62,8 +68,8 @@ func (c Client) AppendBlock(ctx context.Context, containerName, blobName string,
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -71,12 +77,30 @@ func (c Client) AppendBlock(ctx context.Context, containerName, blobName string,
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.Content != nil && len(*input.Content) > (4*1024*1024) {
 		err = fmt.Errorf("`input.Content` must be at most 4MB")
 		return
 	}
 
+	if input.BlobConditionAppendPosition != nil && *input.BlobConditionAppendPosition < 0 {
+		err = fmt.Errorf("`input.BlobConditionAppendPosition` must be greater than or equal to 0, if specified")
+		return
+	}
+
+	if input.BlobConditionMaxSize != nil && *input.BlobConditionMaxSize < 0 {
+		err = fmt.Errorf("`input.BlobConditionMaxSize` must be greater than or equal to 0, if specified")
+		return
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
@@ -110,21 +134,23 @@ func (c Client) AppendBlock(ctx context.Context, containerName, blobName string,
 				result.BlobAppendOffset = resp.Header.Get("x-ms-blob-append-offset")
 				result.ContentMD5 = resp.Header.Get("Content-MD5")
 				result.ETag = resp.Header.Get("ETag")
-				result.LastModified = resp.Header.Get("Last-Modified")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
 
 				if v := resp.Header.Get("x-ms-blob-committed-block-count"); v != "" {
-					i, innerErr := strconv.Atoi(v)
+					i, innerErr := strconv.ParseInt(v, 10, 64)
 					if innerErr != nil {
 						err = fmt.Errorf("parsing `x-ms-blob-committed-block-count` header value %q: %+v", v, innerErr)
 						return
 					}
-					result.BlobCommittedBlockCount = int64(i)
+					result.BlobCommittedBlockCount = i
 				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -138,13 +164,15 @@ type appendBlockOptions struct {
 func (a appendBlockOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
 	if a.input.BlobConditionAppendPosition != nil {
-		headers.Append("x-ms-blob-condition-appendpos", strconv.Itoa(int(*a.input.BlobConditionAppendPosition)))
+		headers.Append("x-ms-blob-condition-appendpos", strconv.FormatInt(*a.input.BlobConditionAppendPosition, 10))
 	}
 	if a.input.BlobConditionMaxSize != nil {
-		headers.Append("x-ms-blob-condition-maxsize", strconv.Itoa(int(*a.input.BlobConditionMaxSize)))
+		headers.Append("x-ms-blob-condition-maxsize", strconv.FormatInt(*a.input.BlobConditionMaxSize, 10))
 	}
 	if a.input.ContentMD5 != nil {
-		headers.Append("x-ms-blob-content-md5", *a.input.ContentMD5)
+		// this is the MD5 of the block being appended, used for transactional integrity - as such
+		// this is sent as `Content-MD5` rather than `x-ms-blob-content-md5` (which is the MD5 of the entire blob)
+		headers.Append("Content-MD5", *a.input.ContentMD5)
 	}
 	if a.input.LeaseID != nil {
 		headers.Append("x-ms-lease-id", *a.input.LeaseID)
@@ -155,6 +183,8 @@ func (a appendBlockOptions) ToHeaders() *client.Headers {
 	if a.input.Content != nil {
 		headers.Append("Content-Length", strconv.Itoa(len(*a.input.Content)))
 	}
+	headers.Merge(a.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
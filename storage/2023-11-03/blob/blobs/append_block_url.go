@@ -0,0 +1,223 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type AppendBlockFromURLInput struct {
+	// The URL of the source Blob, up to 2 KB in length, from which the contents of the Block are read. The source
+	// Blob must either be public, be authorized using a Shared Access Signature within the URL, or be
+	// authorized using CopySourceAuthorization.
+	CopySource string
+
+	// An optional OAuth Bearer Token (in the format `Bearer {token}`) used to authorize access to the source Blob
+	CopySourceAuthorization *string
+
+	// The (inclusive) range of bytes to read from the source Blob, in the format `bytes={start}-{end}`.
+	// The entire source Blob is read when this isn't specified.
+	SourceRange *string
+
+	// An MD5 hash of the contents of the source range, which the Storage Service verifies the contents read from
+	// the source against
+	SourceContentMD5 *string
+
+	// A number indicating the byte offset to compare.
+	// Append Block From URL will succeed only if the append position is equal to this number.
+	// If it is not, the request will fail with an AppendPositionConditionNotMet
+	// error (HTTP status code 412 – Precondition Failed)
+	BlobConditionAppendPosition *int64
+
+	// The max length in bytes permitted for the append blob.
+	// If the Append Block From URL operation would cause the blob to exceed that limit or if the blob size
+	// is already greater than the value specified in this header, the request will fail with
+	// an MaxBlobSizeConditionNotMet error (HTTP status code 412 – Precondition Failed).
+	BlobConditionMaxSize *int64
+
+	// Required if the blob has an active lease.
+	// To perform this operation on a blob with an active lease, specify the valid lease ID for this header.
+	LeaseID *string
+
+	// The encryption scope to set for the request content.
+	EncryptionScope *string
+
+	// Optional - A Customer-Provided Key used to encrypt the Block, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
+}
+
+type AppendBlockFromURLResponse struct {
+	HttpResponse *http.Response
+
+	// The offset at which the Block was appended, in bytes
+	BlobAppendOffset string
+
+	// The number of committed Blocks present in the Blob
+	BlobCommittedBlockCount int64
+
+	// The MD5 hash of the Block, when reported by the Storage Service
+	ContentMD5 string
+
+	// The CRC64 checksum of the Block, when reported by the Storage Service
+	ContentCRC64 string
+
+	ETag         string
+	LastModified time.Time
+}
+
+// AppendBlockFromURL commits a new block of data to the end of an existing append blob, where the contents are read
+// from a URL - rather than being sent in the request.
+func (c Client) AppendBlockFromURL(ctx context.Context, containerName, blobName string, input AppendBlockFromURLInput) (result AppendBlockFromURLResponse, err error) {
+	if containerName == "" {
+		err = fmt.Errorf("`containerName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
+
+	if blobName == "" {
+		err = fmt.Errorf("`blobName` cannot be an empty string")
+		return
+	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+
+	if input.CopySource == "" {
+		err = fmt.Errorf("`input.CopySource` cannot be an empty string")
+		return
+	}
+
+	if input.CopySourceAuthorization != nil && *input.CopySourceAuthorization == "" {
+		err = fmt.Errorf("`input.CopySourceAuthorization` should either be specified or nil, not an empty string")
+		return
+	}
+
+	if input.SourceRange != nil {
+		if err = validateSourceRange(*input.SourceRange); err != nil {
+			err = fmt.Errorf("`input.SourceRange` is not valid: %+v", err)
+			return
+		}
+	}
+
+	if input.BlobConditionAppendPosition != nil && *input.BlobConditionAppendPosition < 0 {
+		err = fmt.Errorf("`input.BlobConditionAppendPosition` must be greater than or equal to 0, if specified")
+		return
+	}
+
+	if input.BlobConditionMaxSize != nil && *input.BlobConditionMaxSize < 0 {
+		err = fmt.Errorf("`input.BlobConditionMaxSize` must be greater than or equal to 0, if specified")
+		return
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: appendBlockFromURLOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.BlobAppendOffset = resp.Header.Get("x-ms-blob-append-offset")
+				result.ContentMD5 = resp.Header.Get("Content-MD5")
+				result.ContentCRC64 = resp.Header.Get("x-ms-content-crc64")
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+
+				if v := resp.Header.Get("x-ms-blob-committed-block-count"); v != "" {
+					i, innerErr := strconv.ParseInt(v, 10, 64)
+					if innerErr != nil {
+						err = fmt.Errorf("parsing `x-ms-blob-committed-block-count` header value %q: %+v", v, innerErr)
+						return
+					}
+					result.BlobCommittedBlockCount = i
+				}
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type appendBlockFromURLOptions struct {
+	input AppendBlockFromURLInput
+}
+
+func (a appendBlockFromURLOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-copy-source", a.input.CopySource)
+	headers.Append("Content-Length", "0")
+
+	if a.input.CopySourceAuthorization != nil {
+		headers.Append("x-ms-copy-source-authorization", *a.input.CopySourceAuthorization)
+	}
+	if a.input.SourceRange != nil {
+		headers.Append("x-ms-source-range", *a.input.SourceRange)
+	}
+	if a.input.SourceContentMD5 != nil {
+		headers.Append("x-ms-source-content-md5", *a.input.SourceContentMD5)
+	}
+	if a.input.BlobConditionAppendPosition != nil {
+		headers.Append("x-ms-blob-condition-appendpos", strconv.FormatInt(*a.input.BlobConditionAppendPosition, 10))
+	}
+	if a.input.BlobConditionMaxSize != nil {
+		headers.Append("x-ms-blob-condition-maxsize", strconv.FormatInt(*a.input.BlobConditionMaxSize, 10))
+	}
+	if a.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *a.input.LeaseID)
+	}
+	if a.input.EncryptionScope != nil {
+		headers.Append("x-ms-encryption-scope", *a.input.EncryptionScope)
+	}
+	headers.Merge(a.input.CustomerProvidedKey.headers())
+
+	return headers
+}
+
+func (a appendBlockFromURLOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (a appendBlockFromURLOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "appendblock")
+	return out
+}
@@ -0,0 +1,80 @@
+package blobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestAppendBlockFromURLOptions(t *testing.T) {
+	options := appendBlockFromURLOptions{
+		input: AppendBlockFromURLInput{
+			CopySource:                  "https://example.blob.core.windows.net/container/source?sv=2023-11-03&sig=abc",
+			CopySourceAuthorization:     pointer.To("Bearer abc123"),
+			SourceRange:                 pointer.To("bytes=0-1023"),
+			BlobConditionAppendPosition: pointer.To(int64(2048)),
+			BlobConditionMaxSize:        pointer.To(int64(4096)),
+		},
+	}
+
+	headers := options.ToHeaders().Headers()
+	expectedHeaders := map[string]string{
+		"x-ms-copy-source":               "https://example.blob.core.windows.net/container/source?sv=2023-11-03&sig=abc",
+		"x-ms-copy-source-authorization": "Bearer abc123",
+		"x-ms-source-range":              "bytes=0-1023",
+		"x-ms-blob-condition-appendpos":  "2048",
+		"x-ms-blob-condition-maxsize":    "4096",
+	}
+	for k, v := range expectedHeaders {
+		if actual := headers.Get(k); actual != v {
+			t.Fatalf("expected the header %q to be %q but got %q", k, v, actual)
+		}
+	}
+
+	if actual := options.ToQuery().Values().Get("comp"); actual != "appendblock" {
+		t.Fatalf("expected `comp` to be %q but got %q", "appendblock", actual)
+	}
+}
+
+func TestAppendBlockFromURLValidation(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input AppendBlockFromURLInput
+	}{
+		{
+			Name:  "No Copy Source",
+			Input: AppendBlockFromURLInput{},
+		},
+		{
+			Name: "Empty Copy Source Authorization",
+			Input: AppendBlockFromURLInput{
+				CopySource:              "https://example.blob.core.windows.net/container/source",
+				CopySourceAuthorization: pointer.To(""),
+			},
+		},
+		{
+			Name: "Invalid Source Range",
+			Input: AppendBlockFromURLInput{
+				CopySource:  "https://example.blob.core.windows.net/container/source",
+				SourceRange: pointer.To("0-1023"),
+			},
+		},
+		{
+			Name: "Negative Append Position",
+			Input: AppendBlockFromURLInput{
+				CopySource:                  "https://example.blob.core.windows.net/container/source",
+				BlobConditionAppendPosition: pointer.To(int64(-1)),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		// validation happens before the request is built, so a Client isn't required
+		if _, err := (Client{}).AppendBlockFromURL(context.TODO(), "container", "blob", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -86,9 +86,16 @@ func TestAppendBlobLifecycle(t *testing.T) {
 			10,
 		},
 	}
-	if _, err := blobClient.AppendBlock(ctx, containerName, fileName, appendInput); err != nil {
+	firstAppend, err := blobClient.AppendBlock(ctx, containerName, fileName, appendInput)
+	if err != nil {
 		t.Fatalf("Error appending first block: %s", err)
 	}
+	if firstAppend.BlobAppendOffset != "0" {
+		t.Fatalf("Expected the first block to be appended at offset 0 but got %q", firstAppend.BlobAppendOffset)
+	}
+	if firstAppend.BlobCommittedBlockCount != 1 {
+		t.Fatalf("Expected there to be 1 committed block but got %d", firstAppend.BlobCommittedBlockCount)
+	}
 
 	t.Logf("[DEBUG] Re-Retrieving Properties..")
 	props, err = blobClient.GetProperties(ctx, containerName, fileName, GetPropertiesInput{})
@@ -0,0 +1,128 @@
+package blobs
+
+import (
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/jackofallops/giovanni/storage/internal/checksum"
+)
+
+var _ error = ChecksumMismatchError{}
+
+// ChecksumMismatchError is returned when the checksum returned by the service doesn't match the
+// checksum computed locally for the data which was received
+type ChecksumMismatchError struct {
+	// The header containing the checksum which was compared, either `Content-MD5` or `x-ms-content-crc64`
+	Header string
+
+	// The (base64-encoded) checksum returned by the service
+	Expected string
+
+	// The (base64-encoded) checksum computed locally
+	Actual string
+}
+
+func (e ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch: the %s returned by the service was %q but the data received has a checksum of %q", e.Header, e.Expected, e.Actual)
+}
+
+// verifyChecksum compares the checksum for the specified data against the `Content-MD5` (or, if that's not
+// returned, the `x-ms-content-crc64`) value returned by the service - if neither is returned no check is made
+func verifyChecksum(data []byte, contentMD5, contentCRC64 string) error {
+	if contentMD5 != "" {
+		if actual := checksum.MD5(data); actual != contentMD5 {
+			return ChecksumMismatchError{
+				Header:   "Content-MD5",
+				Expected: contentMD5,
+				Actual:   actual,
+			}
+		}
+		return nil
+	}
+
+	if contentCRC64 != "" {
+		if actual := checksum.CRC64(data); actual != contentCRC64 {
+			return ChecksumMismatchError{
+				Header:   "x-ms-content-crc64",
+				Expected: contentCRC64,
+				Actual:   actual,
+			}
+		}
+	}
+
+	return nil
+}
+
+var _ io.ReadCloser = &checksumVerifyingReader{}
+
+// checksumVerifyingReader computes the checksum of the data as it's streamed, returning a ChecksumMismatchError
+// (rather than io.EOF) once the underlying reader is exhausted if the checksums don't match
+type checksumVerifyingReader struct {
+	body     io.ReadCloser
+	header   string
+	expected string
+
+	md5   hash.Hash
+	crc64 hash.Hash64
+}
+
+// newChecksumVerifyingReader wraps the specified body such that it'll be verified against the `Content-MD5`
+// (or, if that's not returned, the `x-ms-content-crc64`) value once read - if neither is returned then the body
+// is returned as-is
+func newChecksumVerifyingReader(body io.ReadCloser, contentMD5, contentCRC64 string) io.ReadCloser {
+	if contentMD5 != "" {
+		return &checksumVerifyingReader{
+			body:     body,
+			header:   "Content-MD5",
+			expected: contentMD5,
+			md5:      checksum.NewMD5(),
+		}
+	}
+
+	if contentCRC64 != "" {
+		return &checksumVerifyingReader{
+			body:     body,
+			header:   "x-ms-content-crc64",
+			expected: contentCRC64,
+			crc64:    checksum.NewCRC64(),
+		}
+	}
+
+	return body
+}
+
+func (r *checksumVerifyingReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	if n > 0 {
+		if r.md5 != nil {
+			r.md5.Write(p[:n])
+		}
+		if r.crc64 != nil {
+			r.crc64.Write(p[:n])
+		}
+	}
+
+	if err == io.EOF {
+		if actual := r.checksum(); actual != r.expected {
+			return n, ChecksumMismatchError{
+				Header:   r.header,
+				Expected: r.expected,
+				Actual:   actual,
+			}
+		}
+	}
+
+	return n, err
+}
+
+func (r *checksumVerifyingReader) Close() error {
+	return r.body.Close()
+}
+
+func (r *checksumVerifyingReader) checksum() string {
+	if r.md5 != nil {
+		return checksum.MD5Encode(r.md5.Sum(nil))
+	}
+	return checksum.EncodeCRC64(r.crc64.Sum64())
+}
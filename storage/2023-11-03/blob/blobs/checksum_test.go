@@ -0,0 +1,92 @@
+package blobs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jackofallops/giovanni/storage/internal/checksum"
+)
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("hello world")
+
+	if err := verifyChecksum(data, "", ""); err != nil {
+		t.Fatalf("expected no error when no checksum is returned but got: %+v", err)
+	}
+
+	if err := verifyChecksum(data, checksum.MD5(data), ""); err != nil {
+		t.Fatalf("expected no error for a matching Content-MD5 but got: %+v", err)
+	}
+
+	if err := verifyChecksum(data, "", checksum.CRC64(data)); err != nil {
+		t.Fatalf("expected no error for a matching x-ms-content-crc64 but got: %+v", err)
+	}
+
+	var mismatch ChecksumMismatchError
+	err := verifyChecksum(data, checksum.MD5([]byte("hello there")), "")
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected a ChecksumMismatchError but got: %+v", err)
+	}
+	if mismatch.Header != "Content-MD5" {
+		t.Fatalf("expected the mismatched header to be %q but got %q", "Content-MD5", mismatch.Header)
+	}
+}
+
+func TestChecksumVerifyingReader(t *testing.T) {
+	data := []byte("hello world")
+
+	testData := []struct {
+		Name          string
+		ContentMD5    string
+		ContentCRC64  string
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "no checksum",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "matching md5",
+			ContentMD5:    checksum.MD5(data),
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "mismatched md5",
+			ContentMD5:    checksum.MD5([]byte("hello there")),
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "matching crc64",
+			ContentCRC64:  checksum.CRC64(data),
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "mismatched crc64",
+			ContentCRC64:  checksum.CRC64([]byte("hello there")),
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		reader := newChecksumVerifyingReader(io.NopCloser(bytes.NewReader(data)), v.ContentMD5, v.ContentCRC64)
+		actual, err := io.ReadAll(reader)
+		if v.ShouldBeValid {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			if !bytes.Equal(actual, data) {
+				t.Fatalf("expected %q but got %q", string(data), string(actual))
+			}
+			continue
+		}
+
+		var mismatch ChecksumMismatchError
+		if !errors.As(err, &mismatch) {
+			t.Fatalf("expected a ChecksumMismatchError but got: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package blobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/baseclient"
+)
+
+func TestAnonymousClientRequiresAuthorizationForWrites(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	blobClient, err := NewAnonymousWithBaseUri("https://account1.blob.core.windows.net")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	_, err = blobClient.Delete(ctx, "container", "blob", DeleteInput{})
+	var anonymousAccessErr baseclient.AnonymousAccessError
+	if !errors.As(err, &anonymousAccessErr) {
+		t.Fatalf("expected an AnonymousAccessError but got: %+v", err)
+	}
+}
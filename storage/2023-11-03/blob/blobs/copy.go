@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type CopyInput struct {
@@ -113,6 +116,9 @@ type CopyResponse struct {
 
 	CopyID     string
 	CopyStatus string
+
+	ETag         string
+	LastModified time.Time
 }
 
 // Copy copies a blob to a destination within the storage account asynchronously.
@@ -121,13 +127,16 @@ func (c Client) Copy(ctx context.Context, containerName, blobName string, input
 		return result, fmt.Errorf("`containerName` cannot be an empty string")
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		return result, fmt.Errorf("`containerName` must be a lower-cased string")
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
 	}
 
 	if blobName == "" {
 		return result, fmt.Errorf("`blobName` cannot be an empty string")
 	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
 
 	if input.CopySource == "" {
 		return result, fmt.Errorf("`input.CopySource` cannot be an empty string")
@@ -159,11 +168,15 @@ func (c Client) Copy(ctx context.Context, containerName, blobName string, input
 			if resp.Header != nil {
 				result.CopyID = resp.Header.Get("x-ms-copy-id")
 				result.CopyStatus = resp.Header.Get("x-ms-copy-status")
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/jackofallops/giovanni/storage/internal/poll"
 )
 
 var _ pollers.PollerType = &copyAndWaitPoller{}
@@ -25,6 +26,9 @@ type copyAndWaitPoller struct {
 	containerName      string
 	blobName           string
 	getPropertiesInput GetPropertiesInput
+
+	// latest contains the Blob Properties retrieved during the most recent poll
+	latest *GetPropertiesResponse
 }
 
 func (p *copyAndWaitPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
@@ -32,17 +36,56 @@ func (p *copyAndWaitPoller) Poll(ctx context.Context) (*pollers.PollResult, erro
 	if err != nil {
 		return nil, fmt.Errorf("retrieving properties (container: %s blob: %s) : %+v", p.containerName, p.blobName, err)
 	}
+	p.latest = &props
 
-	if strings.EqualFold(string(props.CopyStatus), string(Success)) {
-		return &pollers.PollResult{
-			Status:       pollers.PollingStatusSucceeded,
-			PollInterval: 10 * time.Second,
-		}, nil
+	done, err := copyCompleted(p.containerName, p.blobName, props)
+	if err != nil {
+		return nil, err
 	}
 
-	// Processing
+	status := pollers.PollingStatusInProgress
+	if done {
+		status = pollers.PollingStatusSucceeded
+	}
 	return &pollers.PollResult{
-		Status:       pollers.PollingStatusInProgress,
+		Status:       status,
 		PollInterval: 10 * time.Second,
 	}, nil
 }
+
+// copyCompleted returns whether the Copy operation (where this Blob is the destination) has succeeded, or an
+// error when the Copy has failed or was aborted
+func copyCompleted(containerName, blobName string, props GetPropertiesResponse) (bool, error) {
+	if strings.EqualFold(string(props.CopyStatus), string(Success)) {
+		return true, nil
+	}
+
+	if strings.EqualFold(string(props.CopyStatus), string(Failed)) {
+		return false, pollers.PollingFailedError{
+			Message: fmt.Sprintf("copy %q (container: %s blob: %s) failed: %s", props.CopyID, containerName, blobName, props.CopyStatusDescription),
+		}
+	}
+
+	if strings.EqualFold(string(props.CopyStatus), string(Aborted)) {
+		return false, pollers.PollingCancelledError{
+			Message: fmt.Sprintf("copy %q (container: %s blob: %s) was aborted: %s", props.CopyID, containerName, blobName, props.CopyStatusDescription),
+		}
+	}
+
+	// Processing
+	return false, nil
+}
+
+// waitForCopy polls the properties of the specified Blob until the pending Copy operation completes, returning
+// the properties retrieved during the most recent poll
+func (c Client) waitForCopy(ctx context.Context, containerName, blobName string, input GetPropertiesInput, interval time.Duration) (*GetPropertiesResponse, error) {
+	return poll.Until(ctx, interval, func(ctx context.Context) (*GetPropertiesResponse, bool, error) {
+		props, err := c.GetProperties(ctx, containerName, blobName, input)
+		if err != nil {
+			return nil, false, fmt.Errorf("retrieving properties (container: %s blob: %s) : %+v", containerName, blobName, err)
+		}
+
+		done, err := copyCompleted(containerName, blobName, props)
+		return &props, done, err
+	})
+}
@@ -0,0 +1,79 @@
+package blobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+func TestCopyWithConditions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	const sourceETag = "0x1"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/container/destination.txt" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get("If-None-Match") != "*" {
+			t.Errorf("expected the `If-None-Match` header to be %q but got %q", "*", r.Header.Get("If-None-Match"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get("x-ms-source-if-modified-since") != "Mon, 01 Jan 2024 00:00:00 GMT" {
+			t.Errorf("expected the `x-ms-source-if-modified-since` header to be set but got %q", r.Header.Get("x-ms-source-if-modified-since"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// the source blob is unchanged when its ETag still matches
+		if r.Header.Get("x-ms-source-if-none-match") == sourceETag {
+			w.Header().Set("x-ms-error-code", "SourceConditionNotMet")
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		w.Header().Set("x-ms-copy-id", "copy-id")
+		w.Header().Set("x-ms-copy-status", "pending")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	input := CopyInput{
+		CopySource:            "https://account1.blob.core.windows.net/container/source.txt",
+		IfNoneMatch:           pointer.To("*"),
+		SourceIfModifiedSince: pointer.To("Mon, 01 Jan 2024 00:00:00 GMT"),
+		SourceIfNoneMatch:     pointer.To("0x2"),
+	}
+	result, err := blobClient.Copy(ctx, "container", "destination.txt", input)
+	if err != nil {
+		t.Fatalf("copying: %+v", err)
+	}
+	if result.CopyID != "copy-id" {
+		t.Fatalf("expected the CopyID to be %q but got %q", "copy-id", result.CopyID)
+	}
+
+	input.SourceIfNoneMatch = pointer.To(sourceETag)
+	_, err = blobClient.Copy(ctx, "container", "destination.txt", input)
+	if !responseerror.IsConditionNotMet(err) {
+		t.Fatalf("expected a ConditionNotMetError but got: %+v", err)
+	}
+	if !responseerror.IsSourceConditionNotMet(err) {
+		t.Fatalf("expected the condition for the source to not be met but got: %+v", err)
+	}
+
+	if err := blobClient.CopyAndWait(ctx, "container", "destination.txt", input); !responseerror.IsSourceConditionNotMet(err) {
+		t.Fatalf("expected CopyAndWait to return the ConditionNotMetError but got: %+v", err)
+	}
+}
@@ -0,0 +1,230 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type CopyFromURLInput struct {
+	// The URL of the source Blob, up to 2 KB in length, which should be copied.
+	// The source Blob must either be public or authenticated via a shared access signature, and be at most 256 MiB.
+	CopySource string
+
+	// The ID of the Lease
+	// Required if the destination blob has an active lease.
+	LeaseID *string
+
+	// Specifies the tier to be set on the target blob
+	AccessTier *AccessTier
+
+	// A user-defined name-value pair associated with the blob.
+	// If no name-value pairs are specified, the operation will copy the metadata from the source blob to
+	// the destination blob.
+	MetaData map[string]string
+
+	// An MD5 hash of the source Blob's content, used to verify the integrity of the Blob during transport.
+	// When this is specified, the storage service compares the hash of the content that has arrived
+	// from the copy source with this value.
+	SourceContentMD5 *string
+
+	// An optional OAuth Bearer Token (in the format `Bearer {token}`) used to authorize access to the source Blob
+	CopySourceAuthorization *string
+
+	// An ETag value to copy the blob only if the specified ETag value matches the ETag value for an existing destination blob.
+	IfMatch *string
+
+	// An ETag value, or the wildcard character (*) to copy the blob only if the destination blob does not match this ETag.
+	IfNoneMatch *string
+
+	// A DateTime value to copy the blob only if the destination blob has been modified since the specified date/time.
+	IfModifiedSince *string
+
+	// A DateTime value to copy the blob only if the destination blob has not been modified since the specified date/time.
+	IfUnmodifiedSince *string
+
+	// An ETag value to copy the blob only if the ETag of the source blob matches this value.
+	SourceIfMatch *string
+
+	// An ETag value to copy the blob only if the ETag of the source blob does not match this value.
+	SourceIfNoneMatch *string
+
+	// A DateTime value to copy the blob only if the source blob has been modified since the specified date/time.
+	SourceIfModifiedSince *string
+
+	// A DateTime value to copy the blob only if the source blob has not been modified since the specified date/time.
+	SourceIfUnmodifiedSince *string
+
+	// The encryption scope to set for the request content.
+	EncryptionScope *string
+
+	// Optional - A Customer-Provided Key used to encrypt the destination Blob, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
+}
+
+type CopyFromURLResponse struct {
+	HttpResponse *http.Response
+
+	CopyID     string
+	CopyStatus CopyStatus
+
+	ContentMD5   string
+	ETag         string
+	LastModified time.Time
+}
+
+// CopyFromURL synchronously copies a blob from the specified URL to a destination within the storage account,
+// the response is not returned until the copy is complete.
+func (c Client) CopyFromURL(ctx context.Context, containerName, blobName string, input CopyFromURLInput) (result CopyFromURLResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.CopySource == "" {
+		return result, fmt.Errorf("`input.CopySource` cannot be an empty string")
+	}
+
+	if err = metadata.Validate(input.MetaData); err != nil {
+		return result, fmt.Errorf("`input.MetaData` is not valid: %s", err)
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: copyFromURLOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.CopyID = resp.Header.Get("x-ms-copy-id")
+				result.CopyStatus = CopyStatus(resp.Header.Get("x-ms-copy-status"))
+				result.ContentMD5 = resp.Header.Get("Content-MD5")
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type copyFromURLOptions struct {
+	input CopyFromURLInput
+}
+
+func (c copyFromURLOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-copy-source", c.input.CopySource)
+	headers.Append("x-ms-requires-sync", "true")
+
+	if c.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *c.input.LeaseID)
+	}
+
+	if c.input.AccessTier != nil {
+		headers.Append("x-ms-access-tier", string(*c.input.AccessTier))
+	}
+
+	if c.input.SourceContentMD5 != nil {
+		headers.Append("x-ms-source-content-md5", *c.input.SourceContentMD5)
+	}
+
+	if c.input.CopySourceAuthorization != nil {
+		headers.Append("x-ms-copy-source-authorization", *c.input.CopySourceAuthorization)
+	}
+
+	if c.input.IfMatch != nil {
+		headers.Append("If-Match", *c.input.IfMatch)
+	}
+
+	if c.input.IfNoneMatch != nil {
+		headers.Append("If-None-Match", *c.input.IfNoneMatch)
+	}
+
+	if c.input.IfModifiedSince != nil {
+		headers.Append("If-Modified-Since", *c.input.IfModifiedSince)
+	}
+
+	if c.input.IfUnmodifiedSince != nil {
+		headers.Append("If-Unmodified-Since", *c.input.IfUnmodifiedSince)
+	}
+
+	if c.input.SourceIfMatch != nil {
+		headers.Append("x-ms-source-if-match", *c.input.SourceIfMatch)
+	}
+
+	if c.input.SourceIfNoneMatch != nil {
+		headers.Append("x-ms-source-if-none-match", *c.input.SourceIfNoneMatch)
+	}
+
+	if c.input.SourceIfModifiedSince != nil {
+		headers.Append("x-ms-source-if-modified-since", *c.input.SourceIfModifiedSince)
+	}
+
+	if c.input.SourceIfUnmodifiedSince != nil {
+		headers.Append("x-ms-source-if-unmodified-since", *c.input.SourceIfUnmodifiedSince)
+	}
+
+	if c.input.EncryptionScope != nil {
+		headers.Append("x-ms-encryption-scope", *c.input.EncryptionScope)
+	}
+
+	headers.Merge(metadata.SetMetaDataHeaders(c.input.MetaData))
+
+	headers.Merge(c.input.CustomerProvidedKey.headers())
+
+	return headers
+}
+
+func (c copyFromURLOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (c copyFromURLOptions) ToQuery() *client.QueryParams {
+	return nil
+}
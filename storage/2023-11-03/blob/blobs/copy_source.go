@@ -0,0 +1,114 @@
+package blobs
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CopySource builds the URL of a source Blob (or a Snapshot/Version of a source Blob) for use as the
+// CopySource of a Copy, CopyFromURL, IncrementalCopyBlob or AppendBlockFromURL operation (which is sent
+// as the `x-ms-copy-source` header), including an optional Shared Access Signature.
+type CopySource struct {
+	// The URI of the source Blob, for example `https://account.blob.core.windows.net/container/blob.txt`, which
+	// must not contain a query string. Any reserved characters within the Blob name (e.g. `?` or `#`) must be
+	// percent-encoded - other characters (such as spaces) are encoded as required.
+	BlobURI string
+
+	// Optional - The DateTime of the Snapshot of the source Blob which should be copied.
+	// This cannot be specified alongside VersionID.
+	Snapshot *string
+
+	// Optional - The ID of the Version of the source Blob which should be copied.
+	// This cannot be specified alongside Snapshot.
+	VersionID *string
+
+	// Optional - An (encoded) Shared Access Signature granting read access to the source Blob, for example as
+	// returned from `sas.BuildBlobSAS` - with or without a leading `?`.
+	SASToken *string
+}
+
+// Build validates the CopySource and returns the URL of the source, where the Shared Access Signature (if any)
+// is followed by the `snapshot` or `versionid` query-string parameter
+func (c CopySource) Build() (string, error) {
+	if c.BlobURI == "" {
+		return "", fmt.Errorf("`BlobURI` cannot be an empty string")
+	}
+
+	uri, err := url.Parse(c.BlobURI)
+	if err != nil {
+		return "", fmt.Errorf("`BlobURI` is not a valid URL: %+v", err)
+	}
+	if uri.Scheme != "http" && uri.Scheme != "https" {
+		return "", fmt.Errorf("`BlobURI` must be an `http` or `https` URL but got %q", c.BlobURI)
+	}
+	if uri.Host == "" {
+		return "", fmt.Errorf("`BlobURI` must contain a host but got %q", c.BlobURI)
+	}
+	if uri.RawQuery != "" || uri.ForceQuery || uri.Fragment != "" {
+		return "", fmt.Errorf("`BlobURI` cannot contain a query string or fragment - a Snapshot, Version or Shared Access Signature should be specified using the `Snapshot`, `VersionID` or `SASToken` fields")
+	}
+	if segments := strings.SplitN(strings.TrimPrefix(uri.Path, "/"), "/", 2); len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", fmt.Errorf("`BlobURI` must be the URI of a Blob within a Container but got %q", c.BlobURI)
+	}
+
+	if c.Snapshot != nil && c.VersionID != nil {
+		return "", fmt.Errorf("at most one of `Snapshot` and `VersionID` can be specified")
+	}
+	if c.Snapshot != nil {
+		if err := validateCopySourceDateTime("Snapshot", *c.Snapshot); err != nil {
+			return "", err
+		}
+	}
+	if c.VersionID != nil {
+		if err := validateCopySourceDateTime("VersionID", *c.VersionID); err != nil {
+			return "", err
+		}
+	}
+
+	query := make([]string, 0)
+	if c.SASToken != nil {
+		token := strings.TrimPrefix(*c.SASToken, "?")
+		if token == "" {
+			return "", fmt.Errorf("`SASToken` should either be specified or nil, not an empty string")
+		}
+		values, err := url.ParseQuery(token)
+		if err != nil {
+			return "", fmt.Errorf("`SASToken` is not a valid query string: %+v", err)
+		}
+		if values.Get("sig") == "" {
+			return "", fmt.Errorf("`SASToken` must be a Shared Access Signature, including the `sig` query-string parameter")
+		}
+		for _, key := range []string{"snapshot", "versionid"} {
+			if values.Has(key) {
+				return "", fmt.Errorf("`SASToken` cannot contain the %q query-string parameter, which should be specified using the `Snapshot` or `VersionID` fields", key)
+			}
+		}
+
+		// the token is used as-is, since it's already encoded and its parameters are covered by the signature
+		query = append(query, token)
+	}
+	if c.Snapshot != nil {
+		query = append(query, fmt.Sprintf("snapshot=%s", url.QueryEscape(*c.Snapshot)))
+	}
+	if c.VersionID != nil {
+		query = append(query, fmt.Sprintf("versionid=%s", url.QueryEscape(*c.VersionID)))
+	}
+
+	source := fmt.Sprintf("%s://%s%s", uri.Scheme, uri.Host, uri.EscapedPath())
+	if len(query) > 0 {
+		source = fmt.Sprintf("%s?%s", source, strings.Join(query, "&"))
+	}
+	return source, nil
+}
+
+func validateCopySourceDateTime(field, input string) error {
+	if input == "" {
+		return fmt.Errorf("`%s` should either be specified or nil, not an empty string", field)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, input); err != nil {
+		return fmt.Errorf("`%s` must be an RFC3339 DateTime but got %q: %+v", field, input, err)
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package blobs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestCopySourceBuild(t *testing.T) {
+	sasToken := "sv=2023-11-03&sr=b&sp=r&se=2024-01-02T03%3A04%3A05Z&sig=abc%2Bdef%3D"
+
+	testData := []struct {
+		Name     string
+		Input    CopySource
+		Expected string
+	}{
+		{
+			Name: "Blob",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/blob.txt",
+			},
+			Expected: "https://account.blob.core.windows.net/container/blob.txt",
+		},
+		{
+			Name: "Blob within a Virtual Directory",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/some/nested/blob.txt",
+			},
+			Expected: "https://account.blob.core.windows.net/container/some/nested/blob.txt",
+		},
+		{
+			Name: "Blob Name with Spaces",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/my blob.txt",
+			},
+			Expected: "https://account.blob.core.windows.net/container/my%20blob.txt",
+		},
+		{
+			Name: "Blob Name which is already Encoded",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/my%20blob%3F.txt",
+			},
+			Expected: "https://account.blob.core.windows.net/container/my%20blob%3F.txt",
+		},
+		{
+			Name: "Blob Name with Unicode Characters",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/blåbær.txt",
+			},
+			Expected: "https://account.blob.core.windows.net/container/bl%C3%A5b%C3%A6r.txt",
+		},
+		{
+			Name: "Blob Name with a Plus",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/a+b.txt",
+			},
+			Expected: "https://account.blob.core.windows.net/container/a+b.txt",
+		},
+		{
+			Name: "Snapshot",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/blob.txt",
+				Snapshot: pointer.To("2024-01-02T03:04:05.1234567Z"),
+			},
+			Expected: "https://account.blob.core.windows.net/container/blob.txt?snapshot=2024-01-02T03%3A04%3A05.1234567Z",
+		},
+		{
+			Name: "Version",
+			Input: CopySource{
+				BlobURI:   "https://account.blob.core.windows.net/container/blob.txt",
+				VersionID: pointer.To("2024-01-02T03:04:05.1234567Z"),
+			},
+			Expected: "https://account.blob.core.windows.net/container/blob.txt?versionid=2024-01-02T03%3A04%3A05.1234567Z",
+		},
+		{
+			Name: "SAS",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/blob.txt",
+				SASToken: pointer.To(sasToken),
+			},
+			Expected: "https://account.blob.core.windows.net/container/blob.txt?" + sasToken,
+		},
+		{
+			Name: "SAS with a Leading Question Mark",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/blob.txt",
+				SASToken: pointer.To("?" + sasToken),
+			},
+			Expected: "https://account.blob.core.windows.net/container/blob.txt?" + sasToken,
+		},
+		{
+			Name: "SAS and Snapshot",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/my blob.txt",
+				Snapshot: pointer.To("2024-01-02T03:04:05.1234567Z"),
+				SASToken: pointer.To(sasToken),
+			},
+			Expected: "https://account.blob.core.windows.net/container/my%20blob.txt?" + sasToken + "&snapshot=2024-01-02T03%3A04%3A05.1234567Z",
+		},
+		{
+			Name: "SAS and Version",
+			Input: CopySource{
+				BlobURI:   "https://account.blob.core.windows.net/container/blob.txt",
+				VersionID: pointer.To("2024-01-02T03:04:05.1234567Z"),
+				SASToken:  pointer.To(sasToken),
+			},
+			Expected: "https://account.blob.core.windows.net/container/blob.txt?" + sasToken + "&versionid=2024-01-02T03%3A04%3A05.1234567Z",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := v.Input.Build()
+		if err != nil {
+			t.Fatalf("building copy source: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestCopySourceBuildInvalid(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input CopySource
+	}{
+		{
+			Name:  "Empty URI",
+			Input: CopySource{},
+		},
+		{
+			Name: "Relative URI",
+			Input: CopySource{
+				BlobURI: "container/blob.txt",
+			},
+		},
+		{
+			Name: "Unsupported Scheme",
+			Input: CopySource{
+				BlobURI: "ftp://account.blob.core.windows.net/container/blob.txt",
+			},
+		},
+		{
+			Name: "Container without a Blob",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container",
+			},
+		},
+		{
+			Name: "URI with a Query String",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/blob.txt?snapshot=2024-01-02T03:04:05.1234567Z",
+			},
+		},
+		{
+			Name: "URI with an Empty Query String",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/blob.txt?",
+			},
+		},
+		{
+			Name: "URI with a Fragment",
+			Input: CopySource{
+				BlobURI: "https://account.blob.core.windows.net/container/blob#1.txt",
+			},
+		},
+		{
+			Name: "Snapshot and Version",
+			Input: CopySource{
+				BlobURI:   "https://account.blob.core.windows.net/container/blob.txt",
+				Snapshot:  pointer.To("2024-01-02T03:04:05.1234567Z"),
+				VersionID: pointer.To("2024-01-02T03:04:05.1234567Z"),
+			},
+		},
+		{
+			Name: "Empty Snapshot",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/blob.txt",
+				Snapshot: pointer.To(""),
+			},
+		},
+		{
+			Name: "Invalid Version",
+			Input: CopySource{
+				BlobURI:   "https://account.blob.core.windows.net/container/blob.txt",
+				VersionID: pointer.To("latest"),
+			},
+		},
+		{
+			Name: "Empty SAS",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/blob.txt",
+				SASToken: pointer.To("?"),
+			},
+		},
+		{
+			Name: "SAS without a Signature",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/blob.txt",
+				SASToken: pointer.To("sv=2023-11-03&sr=b&sp=r"),
+			},
+		},
+		{
+			Name: "SAS containing a Snapshot",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/blob.txt",
+				SASToken: pointer.To("sv=2023-11-03&sig=abc&snapshot=2024-01-02T03%3A04%3A05.1234567Z"),
+			},
+		},
+		{
+			Name: "SAS with Invalid Encoding",
+			Input: CopySource{
+				BlobURI:  "https://account.blob.core.windows.net/container/blob.txt",
+				SASToken: pointer.To("sv=2023-11-03&sig=abc%ZZ"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := v.Input.Build(); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
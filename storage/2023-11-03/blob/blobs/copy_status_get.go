@@ -0,0 +1,75 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/naming"
+)
+
+type GetCopyStatusInput struct {
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+}
+
+type GetCopyStatusResponse struct {
+	// The ID of the most recent Copy operation where this Blob was the destination
+	CopyID string
+
+	// The final state of the Copy operation
+	CopyStatus CopyStatus
+
+	// Describes the cause of a fatal or non-fatal Copy operation failure
+	CopyStatusDescription string
+
+	// The number of bytes copied and the total bytes in the source, e.g. `1024/1024`
+	CopyProgress string
+
+	// The DateTime at which the Copy operation concluded
+	CopyCompletionTime string
+
+	// The Snapshot of this Blob which was created by a successful Incremental Copy operation
+	CopyDestinationSnapshot string
+}
+
+// GetCopyStatus is a convenience method which doesn't exist in the API, which polls the properties of the
+// specified Blob until the pending Copy operation (where this Blob is the destination) completes, returning
+// an error containing the `x-ms-copy-status-description` should the Copy fail or be aborted.
+func (c Client) GetCopyStatus(ctx context.Context, containerName, blobName string, input GetCopyStatusInput) (result GetCopyStatusResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	getInput := GetPropertiesInput{
+		LeaseID: input.LeaseID,
+	}
+
+	props, pollErr := c.waitForCopy(ctx, containerName, blobName, getInput, 1*time.Second)
+	if props != nil {
+		result.CopyID = props.CopyID
+		result.CopyStatus = props.CopyStatus
+		result.CopyStatusDescription = props.CopyStatusDescription
+		result.CopyProgress = props.CopyProgress
+		result.CopyCompletionTime = props.CopyCompletionTime
+		result.CopyDestinationSnapshot = props.CopyDestinationSnapshot
+	}
+
+	if pollErr != nil {
+		return result, fmt.Errorf("waiting for copy to complete: %w", pollErr)
+	}
+
+	return
+}
@@ -180,3 +180,86 @@ func TestCopyFromURL(t *testing.T) {
 		t.Fatalf("Error deleting file: %s", err)
 	}
 }
+
+func TestCopyFromURLSynchronously(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
+	defer cancel()
+
+	client, err := testhelpers.Build(ctx, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceGroup := fmt.Sprintf("acctestrg-%d", testhelpers.RandomInt())
+	accountName := fmt.Sprintf("acctestsa%s", testhelpers.RandomString())
+	containerName := fmt.Sprintf("cont-%d", testhelpers.RandomInt())
+	fileName := "example.txt"
+	copiedFileName := "copied.txt"
+
+	testData, err := client.BuildTestResources(ctx, resourceGroup, accountName, storageaccounts.KindBlobStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.DestroyTestResources(ctx, resourceGroup, accountName)
+
+	domainSuffix, ok := client.Environment.Storage.DomainSuffix()
+	if !ok {
+		t.Fatalf("storage didn't return a domain suffix for this environment")
+	}
+
+	baseUri := fmt.Sprintf("https://%s.blob.%s", testData.StorageAccountName, *domainSuffix)
+	containersClient, err := containers.NewWithBaseUri(baseUri)
+	if err != nil {
+		t.Fatalf("building client for environment: %+v", err)
+	}
+
+	if err = client.PrepareWithSharedKeyAuth(containersClient.Client, testData, auth.SharedKey); err != nil {
+		t.Fatalf("adding authorizer to client: %+v", err)
+	}
+
+	// the source needs to be readable anonymously (or via a SAS) for a synchronous copy
+	_, err = containersClient.Create(ctx, containerName, containers.CreateInput{AccessLevel: containers.Blob})
+	if err != nil {
+		t.Fatal(fmt.Errorf("Error creating: %s", err))
+	}
+	defer containersClient.Delete(ctx, containerName)
+
+	blobClient, err := NewWithBaseUri(baseUri)
+	if err != nil {
+		t.Fatalf("building client for environment: %+v", err)
+	}
+
+	if err = client.PrepareWithSharedKeyAuth(blobClient.Client, testData, auth.SharedKey); err != nil {
+		t.Fatalf("adding authorizer to client: %+v", err)
+	}
+
+	t.Logf("[DEBUG] Uploading the source file..")
+	contents := []byte("hello world")
+	putInput := PutBlockBlobInput{
+		Content: &contents,
+	}
+	if _, err := blobClient.PutBlockBlob(ctx, containerName, fileName, putInput); err != nil {
+		t.Fatalf("Error uploading: %s", err)
+	}
+
+	t.Logf("[DEBUG] Copying the file synchronously..")
+	copyInput := CopyFromURLInput{
+		CopySource: fmt.Sprintf("%s/%s/%s", baseUri, containerName, fileName),
+	}
+	result, err := blobClient.CopyFromURL(ctx, containerName, copiedFileName, copyInput)
+	if err != nil {
+		t.Fatalf("Error copying: %s", err)
+	}
+	if result.CopyStatus != Success {
+		t.Fatalf("Expected the CopyStatus to be %q but got %q", Success, result.CopyStatus)
+	}
+
+	t.Logf("[DEBUG] Retrieving the Copy Status..")
+	status, err := blobClient.GetCopyStatus(ctx, containerName, copiedFileName, GetCopyStatusInput{})
+	if err != nil {
+		t.Fatalf("Error retrieving the copy status: %s", err)
+	}
+	if status.CopyID != result.CopyID {
+		t.Fatalf("Expected the CopyID to be %q but got %q", result.CopyID, status.CopyID)
+	}
+}
@@ -0,0 +1,88 @@
+package blobs
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// customerProvidedKeyAlgorithm is the only encryption algorithm supported for a Customer-Provided Key
+const customerProvidedKeyAlgorithm = "AES256"
+
+// customerProvidedKeySize is the size (in bytes) of an AES-256 key
+const customerProvidedKeySize = 32
+
+// CustomerProvidedKey is an AES-256 encryption key which is provided on each request, rather than being managed by
+// the Storage Service. A Blob written using a Customer-Provided Key can only be read (including its properties and
+// metadata) when the same key is provided. The key is never stored by the Storage Service, only its SHA256 hash.
+type CustomerProvidedKey struct {
+	// The base64-encoded 256-bit (32 byte) encryption key
+	Key string
+
+	// Optional - The base64-encoded SHA256 hash of the encryption key, which is computed from Key when not specified
+	KeySHA256 *string
+}
+
+// NewCustomerProvidedKey returns a CustomerProvidedKey for the specified 256-bit (32 byte) encryption key
+func NewCustomerProvidedKey(key []byte) (*CustomerProvidedKey, error) {
+	if len(key) != customerProvidedKeySize {
+		return nil, fmt.Errorf("the key must be %d bytes but got %d bytes", customerProvidedKeySize, len(key))
+	}
+
+	hash := sha256.Sum256(key)
+	keySHA256 := base64.StdEncoding.EncodeToString(hash[:])
+	return &CustomerProvidedKey{
+		Key:       base64.StdEncoding.EncodeToString(key),
+		KeySHA256: &keySHA256,
+	}, nil
+}
+
+// validateCustomerProvidedKey validates the (optional) Customer-Provided Key, which can't be combined with an Encryption Scope
+func validateCustomerProvidedKey(input *CustomerProvidedKey, encryptionScope *string) error {
+	if input == nil {
+		return nil
+	}
+	if encryptionScope != nil {
+		return fmt.Errorf("at most one of `input.CustomerProvidedKey` and `input.EncryptionScope` can be specified")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(input.Key)
+	if err != nil {
+		return fmt.Errorf("`input.CustomerProvidedKey.Key` must be base64-encoded: %+v", err)
+	}
+	if len(key) != customerProvidedKeySize {
+		return fmt.Errorf("`input.CustomerProvidedKey.Key` must be a base64-encoded %d byte key but got %d bytes", customerProvidedKeySize, len(key))
+	}
+
+	if input.KeySHA256 != nil {
+		hash := sha256.Sum256(key)
+		if *input.KeySHA256 != base64.StdEncoding.EncodeToString(hash[:]) {
+			return fmt.Errorf("`input.CustomerProvidedKey.KeySHA256` doesn't match the SHA256 hash of `input.CustomerProvidedKey.Key`")
+		}
+	}
+
+	return nil
+}
+
+// headers returns the headers used to send the Customer-Provided Key, which are empty when no key is specified
+func (k *CustomerProvidedKey) headers() client.Headers {
+	headers := client.Headers{}
+	if k == nil {
+		return headers
+	}
+
+	keySHA256 := ""
+	if k.KeySHA256 != nil {
+		keySHA256 = *k.KeySHA256
+	} else if key, err := base64.StdEncoding.DecodeString(k.Key); err == nil {
+		hash := sha256.Sum256(key)
+		keySHA256 = base64.StdEncoding.EncodeToString(hash[:])
+	}
+
+	headers.Append("x-ms-encryption-key", k.Key)
+	headers.Append("x-ms-encryption-key-sha256", keySHA256)
+	headers.Append("x-ms-encryption-algorithm", customerProvidedKeyAlgorithm)
+	return headers
+}
@@ -0,0 +1,133 @@
+package blobs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+const (
+	testCustomerProvidedKey       = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+	testCustomerProvidedKeySHA256 = "Yw3NKWbEM2aRElRIu7JbT/QSpJxzLbLIq8G4WBvXEN0="
+)
+
+func TestNewCustomerProvidedKey(t *testing.T) {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	actual, err := NewCustomerProvidedKey(key)
+	if err != nil {
+		t.Fatalf("building key: %+v", err)
+	}
+	if actual.Key != testCustomerProvidedKey {
+		t.Fatalf("expected the Key to be %q but got %q", testCustomerProvidedKey, actual.Key)
+	}
+	if actual.KeySHA256 == nil || *actual.KeySHA256 != testCustomerProvidedKeySHA256 {
+		t.Fatalf("expected the KeySHA256 to be %q but got %v", testCustomerProvidedKeySHA256, actual.KeySHA256)
+	}
+
+	if _, err := NewCustomerProvidedKey(key[:16]); err == nil {
+		t.Fatalf("expected an error for a 16 byte key but didn't get one")
+	}
+}
+
+func TestValidateCustomerProvidedKey(t *testing.T) {
+	testData := []struct {
+		Name            string
+		Input           *CustomerProvidedKey
+		EncryptionScope *string
+		ShouldBeValid   bool
+	}{
+		{
+			Name:          "No Key",
+			Input:         nil,
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Key",
+			Input: &CustomerProvidedKey{
+				Key: testCustomerProvidedKey,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Key and Hash",
+			Input: &CustomerProvidedKey{
+				Key:       testCustomerProvidedKey,
+				KeySHA256: pointer.To(testCustomerProvidedKeySHA256),
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Key and Encryption Scope",
+			Input: &CustomerProvidedKey{
+				Key: testCustomerProvidedKey,
+			},
+			EncryptionScope: pointer.To("scope1"),
+			ShouldBeValid:   false,
+		},
+		{
+			Name: "Not Base64-Encoded",
+			Input: &CustomerProvidedKey{
+				Key: "not-base64!",
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Too Short",
+			Input: &CustomerProvidedKey{
+				Key: "AAECAwQFBgcICQoLDA0ODw==",
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Mismatched Hash",
+			Input: &CustomerProvidedKey{
+				Key:       testCustomerProvidedKey,
+				KeySHA256: pointer.To("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="),
+			},
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateCustomerProvidedKey(v.Input, v.EncryptionScope)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected the key to be valid but got: %+v", err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected the key to be invalid but it was valid")
+		}
+	}
+}
+
+func TestCustomerProvidedKeyHeaders(t *testing.T) {
+	input := GetInput{
+		CustomerProvidedKey: &CustomerProvidedKey{
+			Key: testCustomerProvidedKey,
+		},
+	}
+	headers := getOptions{input: input}.ToHeaders().Headers()
+
+	expected := map[string]string{
+		"x-ms-encryption-key":        testCustomerProvidedKey,
+		"x-ms-encryption-key-sha256": testCustomerProvidedKeySHA256,
+		"x-ms-encryption-algorithm":  "AES256",
+	}
+	for k, v := range expected {
+		if actual := headers.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+
+	headers = getOptions{input: GetInput{}}.ToHeaders().Headers()
+	for k := range expected {
+		if headers.Get(k) != "" {
+			t.Fatalf("expected %q not to be set when no Customer-Provided Key is specified", k)
+		}
+	}
+}
@@ -4,20 +4,37 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteInput struct {
 	// Should any Snapshots for this Blob also be deleted?
 	// If the Blob has Snapshots and this is set to False a 409 Conflict will be returned
+	// This is equivalent to setting `DeleteSnapshotsOption` to `IncludeSnapshots`.
 	DeleteSnapshots bool
 
+	// Specifies whether the base Blob and all of its Snapshots (`IncludeSnapshots`), or only the
+	// Snapshots (`OnlySnapshots`) should be deleted. This cannot be combined with `DeleteSnapshots`
+	// and cannot be specified when deleting a single Snapshot.
+	DeleteSnapshotsOption *DeleteSnapshotsOption
+
+	// The DateTime of the Snapshot which should be deleted, rather than the base Blob
+	Snapshot *string
+
+	// The ID of the Version which should be deleted, rather than the current Version of the Blob
+	VersionID *string
+
 	// The ID of the Lease
 	// This must be specified if a Lease is present on the Blob, else a 403 is returned
 	LeaseID *string
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
 }
 
 type DeleteResponse struct {
@@ -30,13 +47,45 @@ func (c Client) Delete(ctx context.Context, containerName, blobName string, inpu
 		return result, fmt.Errorf("`containerName` cannot be an empty string")
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		return result, fmt.Errorf("`containerName` must be a lower-cased string")
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
 	}
 
 	if blobName == "" {
 		return result, fmt.Errorf("`blobName` cannot be an empty string")
 	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.DeleteSnapshots && input.DeleteSnapshotsOption != nil {
+		return result, fmt.Errorf("at most one of `input.DeleteSnapshots` and `input.DeleteSnapshotsOption` can be specified")
+	}
+
+	if input.DeleteSnapshotsOption != nil && *input.DeleteSnapshotsOption != IncludeSnapshots && *input.DeleteSnapshotsOption != OnlySnapshots {
+		return result, fmt.Errorf("`input.DeleteSnapshotsOption` must be either %q or %q", IncludeSnapshots, OnlySnapshots)
+	}
+
+	if input.Snapshot != nil {
+		if *input.Snapshot == "" {
+			return result, fmt.Errorf("`input.Snapshot` cannot be an empty string, if specified")
+		}
+		if input.DeleteSnapshots || input.DeleteSnapshotsOption != nil {
+			return result, fmt.Errorf("`input.DeleteSnapshots` and `input.DeleteSnapshotsOption` cannot be specified when deleting a single Snapshot")
+		}
+	}
+
+	if input.VersionID != nil {
+		if *input.VersionID == "" {
+			return result, fmt.Errorf("`input.VersionID` cannot be an empty string, if specified")
+		}
+		if input.Snapshot != nil {
+			return result, fmt.Errorf("at most one of `input.Snapshot` and `input.VersionID` can be specified")
+		}
+		if input.DeleteSnapshots || input.DeleteSnapshotsOption != nil {
+			return result, fmt.Errorf("`input.DeleteSnapshots` and `input.DeleteSnapshotsOption` cannot be specified when deleting a single Version")
+		}
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -61,7 +110,7 @@ func (c Client) Delete(ctx context.Context, containerName, blobName string, inpu
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -80,9 +129,15 @@ func (d deleteOptions) ToHeaders() *client.Headers {
 	}
 
 	if d.input.DeleteSnapshots {
-		headers.Append("x-ms-delete-snapshots", "include")
+		headers.Append("x-ms-delete-snapshots", string(IncludeSnapshots))
+	}
+
+	if d.input.DeleteSnapshotsOption != nil {
+		headers.Append("x-ms-delete-snapshots", string(*d.input.DeleteSnapshotsOption))
 	}
 
+	headers.Merge(accessconditions.SetIntoHeaders(d.input.AccessConditions))
+
 	return headers
 }
 
@@ -91,5 +146,12 @@ func (d deleteOptions) ToOData() *odata.Query {
 }
 
 func (d deleteOptions) ToQuery() *client.QueryParams {
-	return nil
+	out := &client.QueryParams{}
+	if d.input.Snapshot != nil {
+		out.Append("snapshot", *d.input.Snapshot)
+	}
+	if d.input.VersionID != nil {
+		out.Append("versionid", *d.input.VersionID)
+	}
+	return out
 }
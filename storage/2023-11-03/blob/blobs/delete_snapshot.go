@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteSnapshotInput struct {
@@ -30,8 +31,8 @@ func (c Client) DeleteSnapshot(ctx context.Context, containerName, blobName stri
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -39,6 +40,10 @@ func (c Client) DeleteSnapshot(ctx context.Context, containerName, blobName stri
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.SnapshotDateTime == "" {
 		err = fmt.Errorf("`input.SnapshotDateTime` cannot be an empty string")
@@ -68,7 +73,7 @@ func (c Client) DeleteSnapshot(ctx context.Context, containerName, blobName stri
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
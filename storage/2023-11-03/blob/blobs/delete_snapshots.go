@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteSnapshotsInput struct {
@@ -27,8 +28,8 @@ func (c Client) DeleteSnapshots(ctx context.Context, containerName, blobName str
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -36,6 +37,10 @@ func (c Client) DeleteSnapshots(ctx context.Context, containerName, blobName str
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -60,7 +65,7 @@ func (c Client) DeleteSnapshots(ctx context.Context, containerName, blobName str
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -73,7 +78,7 @@ type deleteSnapshotsOptions struct {
 
 func (d deleteSnapshotsOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	headers.Append("x-ms-delete-snapshots", "only")
+	headers.Append("x-ms-delete-snapshots", string(OnlySnapshots))
 
 	if d.input.LeaseID != nil {
 		headers.Append("x-ms-lease-id", *d.input.LeaseID)
@@ -0,0 +1,147 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/progress"
+	"github.com/jackofallops/giovanni/storage/transfermanager"
+)
+
+type DownloadToFileInput struct {
+	// The number of ranges which should be downloaded in parallel, defaults to 4
+	Parallelism int
+
+	// Optional - A TransferManager shared with other uploads and downloads, which limits the number of ranges
+	// downloaded at once (and the bandwidth used) across all of them, in addition to Parallelism
+	TransferManager *transfermanager.TransferManager
+
+	// The size (in bytes) of each range which should be downloaded, defaults to 4 MiB
+	ChunkSize int64
+
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+
+	// Optional - The Customer-Provided Key which was used to write the Blob, which must be specified to read a Blob
+	// written using a Customer-Provided Key
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - A callback which is fired as each range is downloaded, with the cumulative number of bytes
+	// downloaded and the size of the Blob. A final callback is fired once the Blob has been downloaded.
+	Progress func(bytesTransferred, totalBytes int64)
+}
+
+type DownloadToFileResponse struct {
+	HttpResponse *http.Response
+
+	// The number of bytes written to the file, which is the size of the Blob
+	ContentLength int64
+
+	// The ETag of the Blob which was downloaded
+	ETag string
+}
+
+// DownloadToFile is a helper method which downloads a Blob to the file at `localPath` by retrieving ranges of the Blob
+// in parallel. The file is created (or truncated) and pre-allocated to the size of the Blob, and each range is only
+// downloaded when the Blob hasn't changed (using the ETag of the Blob) - once downloaded the size of the file is
+// verified against the `Content-Length` of the Blob.
+func (c Client) DownloadToFile(ctx context.Context, containerName, blobName, localPath string, input DownloadToFileInput) (result DownloadToFileResponse, err error) {
+	if localPath == "" {
+		return result, fmt.Errorf("`localPath` cannot be an empty string")
+	}
+
+	if input.Parallelism < 0 {
+		return result, fmt.Errorf("`input.Parallelism` must be greater than or equal to 0")
+	}
+
+	if input.ChunkSize < 0 {
+		return result, fmt.Errorf("`input.ChunkSize` must be greater than or equal to 0")
+	}
+
+	properties, err := c.GetProperties(ctx, containerName, blobName, GetPropertiesInput{
+		LeaseID:             input.LeaseID,
+		CustomerProvidedKey: input.CustomerProvidedKey,
+	})
+	result.HttpResponse = properties.HttpResponse
+	if err != nil {
+		return result, fmt.Errorf("retrieving properties: %w", err)
+	}
+	result.ContentLength = properties.ContentLength
+	result.ETag = properties.ETag
+
+	file, err := os.OpenFile(localPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return result, fmt.Errorf("opening %q: %+v", localPath, err)
+	}
+	defer file.Close()
+
+	if err = file.Truncate(properties.ContentLength); err != nil {
+		return result, fmt.Errorf("allocating %d bytes for %q: %+v", properties.ContentLength, localPath, err)
+	}
+
+	chunkSize := input.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultTransferChunkSize
+	}
+	chunks := splitIntoChunks(properties.ContentLength, chunkSize)
+	tracker := progress.NewTracker(input.Progress, properties.ContentLength)
+
+	err = transferInParallel(ctx, chunks, transferParallelism(input.Parallelism, len(chunks)), func(ctx context.Context, chunk transferChunk) error {
+		return input.TransferManager.Do(ctx, chunk.length, func(ctx context.Context) error {
+			return c.downloadChunkToFile(ctx, containerName, blobName, file, chunk, properties.ETag, input, tracker)
+		})
+	})
+	if err != nil {
+		return result, fmt.Errorf("downloading %q: %w", blobName, err)
+	}
+
+	if err = file.Sync(); err != nil {
+		return result, fmt.Errorf("flushing %q: %+v", localPath, err)
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return result, fmt.Errorf("loading file info for %q: %+v", localPath, err)
+	}
+	if fileInfo.Size() != properties.ContentLength {
+		return result, fmt.Errorf("expected %q to be %d bytes (the `Content-Length` of the Blob) but got %d bytes", localPath, properties.ContentLength, fileInfo.Size())
+	}
+
+	tracker.Complete()
+	return result, nil
+}
+
+func (c Client) downloadChunkToFile(ctx context.Context, containerName, blobName string, file *os.File, chunk transferChunk, etag string, input DownloadToFileInput, tracker *progress.Tracker) error {
+	startByte := chunk.offset
+	endByte := chunk.offset + chunk.length - 1
+
+	resp, err := c.GetReader(ctx, containerName, blobName, GetReaderInput{
+		LeaseID:             input.LeaseID,
+		StartByte:           &startByte,
+		EndByte:             &endByte,
+		CustomerProvidedKey: input.CustomerProvidedKey,
+		AccessConditions: accessconditions.AccessConditions{
+			IfMatch: &etag,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("retrieving bytes %d-%d: %w", startByte, endByte, err)
+	}
+	defer resp.Body.Close()
+
+	written, err := io.Copy(io.NewOffsetWriter(file, chunk.offset), resp.Body)
+	if err != nil {
+		return fmt.Errorf("writing bytes %d-%d: %+v", startByte, endByte, err)
+	}
+	if written != chunk.length {
+		return fmt.Errorf("expected %d bytes for the range %d-%d but got %d bytes", chunk.length, startByte, endByte, written)
+	}
+	tracker.Add(written)
+
+	return nil
+}
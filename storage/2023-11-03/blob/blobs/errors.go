@@ -0,0 +1,53 @@
+package blobs
+
+import (
+	"fmt"
+
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+var _ error = BlobNotSoftDeletedError{}
+
+// BlobNotSoftDeletedError is returned when attempting to Undelete a Blob which doesn't exist as a soft
+// deleted Blob - for example because soft delete isn't enabled, or the retention period has elapsed - and
+// wraps the ResponseError for the 404 response.
+type BlobNotSoftDeletedError struct {
+	// The name of the Container the Blob was expected to be in
+	ContainerName string
+
+	// The name of the Blob which isn't soft deleted
+	BlobName string
+
+	ResponseError responseerror.ResponseError
+}
+
+func (e BlobNotSoftDeletedError) Error() string {
+	return fmt.Sprintf("the blob %q in container %q is not soft deleted", e.BlobName, e.ContainerName)
+}
+
+// Unwrap returns the ResponseError for the 404 response
+func (e BlobNotSoftDeletedError) Unwrap() error {
+	return e.ResponseError
+}
+
+var _ error = BlobModifiedError{}
+
+// BlobModifiedError is returned when a Blob is modified whilst it's being read using a ResumableReader, meaning
+// that the remaining contents can't be read from the same version of the Blob
+type BlobModifiedError struct {
+	// The name of the Container the Blob is in
+	ContainerName string
+
+	// The name of the Blob which was modified
+	BlobName string
+
+	// The ETag of the Blob when it was first read
+	ETag string
+
+	// The number of bytes which had been read before the Blob was modified
+	BytesRead int64
+}
+
+func (e BlobModifiedError) Error() string {
+	return fmt.Sprintf("the blob %q in container %q was modified after %d bytes were read (the ETag no longer matches %q)", e.BlobName, e.ContainerName, e.BytesRead, e.ETag)
+}
@@ -0,0 +1,73 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ExistsResponse struct {
+	HttpResponse *http.Response
+
+	// Whether the Blob exists
+	Exists bool
+}
+
+// Exists determines whether the specified Blob exists, returning `false` rather than an error
+// when the Blob is not found
+func (c Client) Exists(ctx context.Context, containerName, blobName string) (result ExistsResponse, err error) {
+	if containerName == "" {
+		err = fmt.Errorf("`containerName` cannot be an empty string")
+		return
+	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
+	if blobName == "" {
+		err = fmt.Errorf("`blobName` cannot be an empty string")
+		return
+	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodHead,
+		OptionsObject: nil,
+		Path:          fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if resp.StatusCode == http.StatusNotFound {
+			err = nil
+			return
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	result.Exists = true
+	return
+}
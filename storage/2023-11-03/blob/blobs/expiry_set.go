@@ -0,0 +1,167 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type SetExpiryInput struct {
+	// Specifies how the ExpiryTime should be interpreted
+	ExpiryOption ExpiryOption
+
+	// The expiry of the Blob, which must be specified unless the ExpiryOption is `NeverExpire`.
+	// For `RelativeToCreation` and `RelativeToNow` this is the number of milliseconds until the Blob
+	// expires, for `Absolute` this is an RFC1123 date/time (for example `Mon, 01 Jan 2024 00:00:00 GMT`).
+	ExpiryTime *string
+
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+}
+
+type SetExpiryResponse struct {
+	HttpResponse *http.Response
+
+	// The date/time at which the Blob expires, where returned by the service
+	ExpiryTime string
+
+	// The ETag contains a value that you can use to perform operations conditionally
+	ETag string
+
+	// The date/time that the Blob was last modified
+	LastModified time.Time
+}
+
+// SetExpiry sets (or removes) the date/time at which the Blob is automatically deleted.
+// This is only supported for Storage Accounts with a Hierarchical Namespace enabled.
+func (c Client) SetExpiry(ctx context.Context, containerName, blobName string, input SetExpiryInput) (result SetExpiryResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if err = validateSetExpiryInput(input); err != nil {
+		return
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: setExpiryOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ExpiryTime = resp.Header.Get("x-ms-expiry-time")
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+func validateSetExpiryInput(input SetExpiryInput) error {
+	switch input.ExpiryOption {
+	case NeverExpire:
+		if input.ExpiryTime != nil {
+			return fmt.Errorf("`input.ExpiryTime` cannot be specified when `input.ExpiryOption` is %q", NeverExpire)
+		}
+
+	case RelativeToCreation, RelativeToNow:
+		if input.ExpiryTime == nil {
+			return fmt.Errorf("`input.ExpiryTime` must be specified when `input.ExpiryOption` is %q", input.ExpiryOption)
+		}
+		if v, err := strconv.ParseInt(*input.ExpiryTime, 10, 64); err != nil || v <= 0 {
+			return fmt.Errorf("`input.ExpiryTime` must be a positive number of milliseconds when `input.ExpiryOption` is %q but got %q", input.ExpiryOption, *input.ExpiryTime)
+		}
+
+	case Absolute:
+		if input.ExpiryTime == nil {
+			return fmt.Errorf("`input.ExpiryTime` must be specified when `input.ExpiryOption` is %q", Absolute)
+		}
+		if _, err := time.Parse(time.RFC1123, *input.ExpiryTime); err != nil {
+			return fmt.Errorf("`input.ExpiryTime` must be an RFC1123 date/time when `input.ExpiryOption` is %q but got %q", Absolute, *input.ExpiryTime)
+		}
+
+	default:
+		return fmt.Errorf("`input.ExpiryOption` must be one of %q, %q, %q or %q but got %q", Absolute, NeverExpire, RelativeToCreation, RelativeToNow, input.ExpiryOption)
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		return fmt.Errorf("`input.LeaseID` should either be specified or nil, not an empty string")
+	}
+
+	return nil
+}
+
+type setExpiryOptions struct {
+	input SetExpiryInput
+}
+
+func (s setExpiryOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-expiry-option", string(s.input.ExpiryOption))
+
+	if s.input.ExpiryTime != nil {
+		headers.Append("x-ms-expiry-time", *s.input.ExpiryTime)
+	}
+
+	if s.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *s.input.LeaseID)
+	}
+
+	return headers
+}
+
+func (s setExpiryOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (s setExpiryOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "expiry")
+	return out
+}
@@ -0,0 +1,115 @@
+package blobs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestValidateSetExpiryInput(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         SetExpiryInput
+		ShouldBeValid bool
+	}{
+		{
+			Name: "Never Expire",
+			Input: SetExpiryInput{
+				ExpiryOption: NeverExpire,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Never Expire with an Expiry Time",
+			Input: SetExpiryInput{
+				ExpiryOption: NeverExpire,
+				ExpiryTime:   pointer.To("1000"),
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Relative To Now",
+			Input: SetExpiryInput{
+				ExpiryOption: RelativeToNow,
+				ExpiryTime:   pointer.To("86400000"),
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Relative To Creation without an Expiry Time",
+			Input: SetExpiryInput{
+				ExpiryOption: RelativeToCreation,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Relative To Creation with a date",
+			Input: SetExpiryInput{
+				ExpiryOption: RelativeToCreation,
+				ExpiryTime:   pointer.To("Mon, 01 Jan 2024 00:00:00 GMT"),
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Relative To Now with a negative duration",
+			Input: SetExpiryInput{
+				ExpiryOption: RelativeToNow,
+				ExpiryTime:   pointer.To("-1"),
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Absolute",
+			Input: SetExpiryInput{
+				ExpiryOption: Absolute,
+				ExpiryTime:   pointer.To("Mon, 01 Jan 2024 00:00:00 GMT"),
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Absolute with a duration",
+			Input: SetExpiryInput{
+				ExpiryOption: Absolute,
+				ExpiryTime:   pointer.To("1000"),
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "No Expiry Option",
+			Input:         SetExpiryInput{},
+			ShouldBeValid: false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateSetExpiryInput(v.Input)
+		valid := err == nil
+		if valid != v.ShouldBeValid {
+			t.Fatalf("expected valid to be %t but got %t: %+v", v.ShouldBeValid, valid, err)
+		}
+	}
+}
+
+func TestSetExpiryOptions(t *testing.T) {
+	options := setExpiryOptions{input: SetExpiryInput{
+		ExpiryOption: RelativeToNow,
+		ExpiryTime:   pointer.To("1000"),
+	}}
+
+	headers := options.ToHeaders().Headers()
+	if v := headers.Get("x-ms-expiry-option"); v != string(RelativeToNow) {
+		t.Fatalf("expected `x-ms-expiry-option` to be %q but got %q", RelativeToNow, v)
+	}
+	if v := headers.Get("x-ms-expiry-time"); v != "1000" {
+		t.Fatalf("expected `x-ms-expiry-time` to be %q but got %q", "1000", v)
+	}
+	if v := options.ToQuery().Values().Get("comp"); v != "expiry" {
+		t.Fatalf("expected `comp` to be %q but got %q", "expiry", v)
+	}
+
+	options = setExpiryOptions{input: SetExpiryInput{ExpiryOption: NeverExpire}}
+	if options.ToHeaders().Headers().Get("x-ms-expiry-time") != "" {
+		t.Fatalf("expected `x-ms-expiry-time` to be omitted when not specified")
+	}
+}
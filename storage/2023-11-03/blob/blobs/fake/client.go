@@ -0,0 +1,577 @@
+// Package fake provides an in-memory implementation of the Blobs API, which allows code using the
+// `blobs.StorageBlob` interface to be unit tested without access to a Storage Account.
+package fake
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+var _ blobs.StorageBlob = &Client{}
+
+// defaultMaxResults is the number of Blobs returned by ListBlobs when `MaxResults` isn't specified
+const defaultMaxResults = 5000
+
+// Client is an in-memory implementation of `blobs.StorageBlob`, storing Block Blobs in a map keyed on
+// the Container and Blob name. Containers are created implicitly when a Blob is first uploaded into them.
+//
+// Only PutBlockBlob, Get, GetReader, Delete, Exists, GetProperties, GetMetaData and SetMetaData are
+// implemented (alongside ListBlobs from the Containers API) - all other methods return an error wrapping
+// ErrNotImplemented. The AccessConditions for Get and PutBlockBlob are honoured.
+//
+// Errors returned for missing Blobs wrap a `responseerror.ResponseError` in the same way as the real
+// client, so `responseerror.IsNotFound` can be used to check for them.
+type Client struct {
+	mu     sync.Mutex
+	blobs  map[string]*blob
+	etagID int64
+}
+
+type blob struct {
+	contents           []byte
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentLanguage    string
+	contentMD5         string
+	contentType        string
+	creationTime       time.Time
+	etag               string
+	lastModified       time.Time
+	metaData           map[string]string
+}
+
+// New returns an empty in-memory Blobs client
+func New() *Client {
+	return &Client{
+		blobs: map[string]*blob{},
+	}
+}
+
+// PutBlockBlob creates (or overwrites) the specified Blob with the Contents and Properties from `input`
+func (c *Client) PutBlockBlob(ctx context.Context, containerName, blobName string, input blobs.PutBlockBlobInput) (result blobs.PutBlockBlobResponse, err error) {
+	if err = validateNames(containerName, blobName); err != nil {
+		return
+	}
+
+	if input.Content != nil && len(*input.Content) == 0 {
+		return result, fmt.Errorf("`input.Content` must either be nil or not empty")
+	}
+
+	if err = metadata.Validate(input.MetaData); err != nil {
+		return result, fmt.Errorf("`input.MetaData` is not valid: %s", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing := c.blobs[key(containerName, blobName)]
+	if err = checkAccessConditions(existing, input.AccessConditions, false); err != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	item := &blob{
+		contents:     []byte{},
+		creationTime: now,
+		metaData:     copyMetaData(input.MetaData),
+	}
+	if existing != nil {
+		item.creationTime = existing.creationTime
+	}
+	if input.Content != nil {
+		item.contents = append([]byte{}, *input.Content...)
+	}
+	item.cacheControl = valueOrEmpty(input.CacheControl)
+	item.contentDisposition = valueOrEmpty(input.ContentDisposition)
+	item.contentEncoding = valueOrEmpty(input.ContentEncoding)
+	item.contentLanguage = valueOrEmpty(input.ContentLanguage)
+	item.contentMD5 = valueOrEmpty(input.ContentMD5)
+	item.contentType = valueOrEmpty(input.ContentType)
+	if item.contentType == "" {
+		item.contentType = "application/octet-stream"
+	}
+
+	c.touch(item, now)
+	c.blobs[key(containerName, blobName)] = item
+
+	result.ETag = item.etag
+	result.LastModified = item.lastModified.Truncate(time.Second)
+
+	return
+}
+
+// Get returns the contents of the specified Blob, or the requested range of bytes within it
+func (c *Client) Get(ctx context.Context, containerName, blobName string, input blobs.GetInput) (result blobs.GetResponse, err error) {
+	if err = validateNames(containerName, blobName); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.get(containerName, blobName)
+	if err != nil {
+		return
+	}
+	if err = checkAccessConditions(item, input.AccessConditions, true); err != nil {
+		return
+	}
+
+	contents, _, err := readRange(item.contents, input.StartByte, input.EndByte)
+	if err != nil {
+		return
+	}
+	result.Contents = &contents
+
+	return
+}
+
+// GetReader returns a reader for the contents of the specified Blob, or the requested range of bytes within it
+func (c *Client) GetReader(ctx context.Context, containerName, blobName string, input blobs.GetReaderInput) (result blobs.GetReaderResponse, err error) {
+	if err = validateNames(containerName, blobName); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.get(containerName, blobName)
+	if err != nil {
+		return
+	}
+
+	contents, contentRange, err := readRange(item.contents, input.StartByte, input.EndByte)
+	if err != nil {
+		return
+	}
+
+	result.Body = io.NopCloser(bytes.NewReader(contents))
+	result.BlobContentMD5 = item.contentMD5
+	result.BlobType = blobs.BlockBlob
+	result.ContentLength = int64(len(contents))
+	result.ContentRange = contentRange
+	result.ContentType = item.contentType
+	result.ETag = item.etag
+	result.LastModified = item.lastModified.Format(http.TimeFormat)
+	result.MetaData = copyMetaData(item.metaData)
+
+	return
+}
+
+// Delete removes the specified Blob
+func (c *Client) Delete(ctx context.Context, containerName, blobName string, input blobs.DeleteInput) (result blobs.DeleteResponse, err error) {
+	if err = validateNames(containerName, blobName); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err = c.get(containerName, blobName); err != nil {
+		return
+	}
+	delete(c.blobs, key(containerName, blobName))
+
+	return
+}
+
+// Exists determines whether the specified Blob exists
+func (c *Client) Exists(ctx context.Context, containerName, blobName string) (result blobs.ExistsResponse, err error) {
+	if err = validateNames(containerName, blobName); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, result.Exists = c.blobs[key(containerName, blobName)]
+	return
+}
+
+// GetProperties returns the Properties and MetaData of the specified Blob
+func (c *Client) GetProperties(ctx context.Context, containerName, blobName string, input blobs.GetPropertiesInput) (result blobs.GetPropertiesResponse, err error) {
+	if err = validateNames(containerName, blobName); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.get(containerName, blobName)
+	if err != nil {
+		return
+	}
+
+	result.AccessTier = blobs.Hot
+	result.AccessTierInferred = true
+	result.BlobType = blobs.BlockBlob
+	result.CacheControl = item.cacheControl
+	result.ContentDisposition = item.contentDisposition
+	result.ContentEncoding = item.contentEncoding
+	result.ContentLanguage = item.contentLanguage
+	result.ContentLength = int64(len(item.contents))
+	result.ContentMD5 = item.contentMD5
+	result.ContentType = item.contentType
+	result.CreationTime = item.creationTime.Format(http.TimeFormat)
+	result.ETag = item.etag
+	result.LastModified = item.lastModified.Format(http.TimeFormat)
+	result.LeaseState = blobs.Available
+	result.LeaseStatus = blobs.Unlocked
+	result.MetaData = copyMetaData(item.metaData)
+	result.ServerEncrypted = true
+
+	return
+}
+
+// GetMetaData returns the MetaData of the specified Blob
+func (c *Client) GetMetaData(ctx context.Context, containerName, blobName string, input blobs.GetMetaDataInput) (result blobs.GetMetaDataResponse, err error) {
+	if err = validateNames(containerName, blobName); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.get(containerName, blobName)
+	if err != nil {
+		return
+	}
+	result.MetaData = copyMetaData(item.metaData)
+
+	return
+}
+
+// SetMetaData replaces the MetaData of the specified Blob
+func (c *Client) SetMetaData(ctx context.Context, containerName, blobName string, input blobs.SetMetaDataInput) (result blobs.SetMetaDataResponse, err error) {
+	if err = validateNames(containerName, blobName); err != nil {
+		return
+	}
+
+	if err = metadata.Validate(input.MetaData); err != nil {
+		return result, fmt.Errorf("`input.MetaData` is not valid: %s", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.get(containerName, blobName)
+	if err != nil {
+		return
+	}
+	item.metaData = copyMetaData(input.MetaData)
+	c.touch(item, time.Now().UTC())
+
+	result.ETag = item.etag
+	result.LastModified = item.lastModified.Truncate(time.Second)
+
+	return
+}
+
+// ListBlobs lists the Blobs within the specified Container, in the same manner as `containers.Client.ListBlobs`.
+// The `Delimiter`, `Marker`, `MaxResults` and `Prefix` fields are supported, and MetaData is returned when
+// `containers.MetaData` is included.
+func (c *Client) ListBlobs(ctx context.Context, containerName string, input containers.ListBlobsInput) (result containers.ListBlobsResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	maxResults := defaultMaxResults
+	if input.MaxResults != nil {
+		if *input.MaxResults <= 0 || *input.MaxResults > defaultMaxResults {
+			return result, fmt.Errorf("`input.MaxResults` can either be nil or between 1 and %d", defaultMaxResults)
+		}
+		maxResults = *input.MaxResults
+	}
+
+	includeMetaData := false
+	if input.Include != nil {
+		for _, v := range *input.Include {
+			if v == containers.MetaData {
+				includeMetaData = true
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefix := valueOrEmpty(input.Prefix)
+	delimiter := valueOrEmpty(input.Delimiter)
+	marker := valueOrEmpty(input.Marker)
+
+	names := make([]string, 0)
+	containerPrefix := key(containerName, "")
+	for k := range c.blobs {
+		if name := strings.TrimPrefix(k, containerPrefix); name != k && strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	result.Delimiter = delimiter
+	result.Marker = marker
+	result.MaxResults = maxResults
+	result.Prefix = prefix
+
+	seenPrefixes := map[string]struct{}{}
+	count := 0
+	for _, name := range names {
+		if marker != "" && name < marker {
+			continue
+		}
+
+		// when a Delimiter is specified, Blobs nested beneath it are grouped into a single BlobPrefix
+		if delimiter != "" {
+			if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+				blobPrefix := name[:len(prefix)+i+len(delimiter)]
+				if _, ok := seenPrefixes[blobPrefix]; ok {
+					continue
+				}
+				if count == maxResults {
+					result.NextMarker = &name
+					break
+				}
+				seenPrefixes[blobPrefix] = struct{}{}
+				result.Blobs.BlobPrefixes = append(result.Blobs.BlobPrefixes, containers.BlobPrefix{
+					Name: blobPrefix,
+				})
+				count++
+				continue
+			}
+		}
+
+		if count == maxResults {
+			result.NextMarker = &name
+			break
+		}
+
+		item := c.blobs[key(containerName, name)]
+		details := containers.BlobDetails{
+			Name:       name,
+			Properties: blobProperties(item),
+		}
+		if includeMetaData {
+			details.MetaData = copyMetaData(item.metaData)
+		}
+		result.Blobs.Blobs = append(result.Blobs.Blobs, details)
+		count++
+	}
+
+	return
+}
+
+func (c *Client) get(containerName, blobName string) (*blob, error) {
+	item, ok := c.blobs[key(containerName, blobName)]
+	if !ok {
+		return nil, newResponseError(http.StatusNotFound, "BlobNotFound", "The specified blob does not exist.")
+	}
+	return item, nil
+}
+
+// checkAccessConditions returns the error returned by the service when the AccessConditions aren't met for the
+// Blob, where `item` is nil when the Blob doesn't exist. When an `If-None-Match` or `If-Modified-Since` condition
+// isn't met a read returns a 304 Not Modified, whereas a write returns a 412 Precondition Failed (or a 409 Conflict
+// when `If-None-Match: *` is specified for a Blob which exists).
+func checkAccessConditions(item *blob, input accessconditions.AccessConditions, read bool) error {
+	notMet := newConditionNotMetError
+	if read {
+		notMet = newNotModifiedError
+	}
+
+	if input.IfMatch != nil {
+		if item == nil || (*input.IfMatch != "*" && *input.IfMatch != item.etag) {
+			return newConditionNotMetError()
+		}
+	}
+
+	if input.IfNoneMatch != nil && item != nil {
+		if *input.IfNoneMatch == "*" && !read {
+			return newResponseError(http.StatusConflict, "BlobAlreadyExists", "The specified blob already exists.")
+		}
+		if *input.IfNoneMatch == "*" || *input.IfNoneMatch == item.etag {
+			return notMet()
+		}
+	}
+
+	// the Last Modified date is returned (and so compared) to the second
+	if input.IfModifiedSince != nil && item != nil {
+		since, err := http.ParseTime(*input.IfModifiedSince)
+		if err != nil {
+			return fmt.Errorf("`input.IfModifiedSince` is not valid: %+v", err)
+		}
+		if !item.lastModified.Truncate(time.Second).After(since) {
+			return notMet()
+		}
+	}
+
+	if input.IfUnmodifiedSince != nil && item != nil {
+		since, err := http.ParseTime(*input.IfUnmodifiedSince)
+		if err != nil {
+			return fmt.Errorf("`input.IfUnmodifiedSince` is not valid: %+v", err)
+		}
+		if item.lastModified.Truncate(time.Second).After(since) {
+			return newConditionNotMetError()
+		}
+	}
+
+	return nil
+}
+
+// touch updates the ETag and Last Modified date of the Blob, as the service does for each write
+func (c *Client) touch(item *blob, now time.Time) {
+	c.etagID++
+	item.etag = fmt.Sprintf("\"0x%X\"", now.UnixNano()+c.etagID)
+	item.lastModified = now
+}
+
+func blobProperties(item *blob) *containers.BlobProperties {
+	accessTier := string(blobs.Hot)
+	accessTierInferred := true
+	blobType := string(blobs.BlockBlob)
+	contentLength := int64(len(item.contents))
+	creationTime := item.creationTime.Format(http.TimeFormat)
+	lastModified := item.lastModified.Format(http.TimeFormat)
+	leaseState := string(blobs.Available)
+	leaseStatus := string(blobs.Unlocked)
+	serverEncrypted := true
+
+	return &containers.BlobProperties{
+		AccessTier:         &accessTier,
+		AccessTierInferred: &accessTierInferred,
+		BlobType:           &blobType,
+		CacheControl:       &item.cacheControl,
+		ContentEncoding:    &item.contentEncoding,
+		ContentLanguage:    &item.contentLanguage,
+		ContentLength:      &contentLength,
+		ContentMD5:         &item.contentMD5,
+		ContentType:        &item.contentType,
+		CreationTime:       &creationTime,
+		ETag:               &item.etag,
+		LastModified:       &lastModified,
+		LeaseState:         &leaseState,
+		LeaseStatus:        &leaseStatus,
+		ServerEncrypted:    &serverEncrypted,
+	}
+}
+
+// readRange returns the requested (inclusive) range of bytes from `contents`, alongside the Content-Range
+// which is returned by the service when a range is requested
+func readRange(contents []byte, startByte, endByte *int64) ([]byte, string, error) {
+	if (startByte != nil && endByte == nil) || (startByte == nil && endByte != nil) {
+		return nil, "", fmt.Errorf("`input.StartByte` and `input.EndByte` must both be specified, or both be nil")
+	}
+
+	if startByte == nil {
+		return append([]byte{}, contents...), "", nil
+	}
+
+	length := int64(len(contents))
+	if *startByte < 0 || *endByte < *startByte {
+		return nil, "", fmt.Errorf("`input.StartByte` must be at least 0 and `input.EndByte` must be greater than or equal to `input.StartByte`")
+	}
+	if *startByte >= length {
+		return nil, "", newResponseError(http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "The range specified is invalid for the current size of the resource.")
+	}
+
+	end := *endByte
+	if end >= length {
+		end = length - 1
+	}
+	contentRange := fmt.Sprintf("bytes %d-%d/%d", *startByte, end, length)
+	return append([]byte{}, contents[*startByte:end+1]...), contentRange, nil
+}
+
+// newResponseError returns an error matching that returned by the real client when the service returns an error
+func newResponseError(statusCode int, code, message string) error {
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: statusCode,
+			Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+			Header: http.Header{
+				"X-Ms-Error-Code": []string{code},
+			},
+			Body: io.NopCloser(strings.NewReader(fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><Error><Code>%s</Code><Message>%s</Message></Error>", code, message))),
+		},
+	}
+	err := fmt.Errorf("unexpected status %d with error: %s: %s", statusCode, code, message)
+	return fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+}
+
+// newConditionNotMetError returns the error returned by the service when a conditional header isn't met for a write
+func newConditionNotMetError() error {
+	return newResponseError(http.StatusPreconditionFailed, "ConditionNotMet", "The condition specified using HTTP conditional header(s) is not met.")
+}
+
+// newNotModifiedError returns an error matching that returned by the real client when the service returns a
+// 304 Not Modified for a conditional read, which has no body
+func newNotModifiedError() error {
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusNotModified,
+			Status:     fmt.Sprintf("%d %s", http.StatusNotModified, http.StatusText(http.StatusNotModified)),
+			Header:     http.Header{},
+			Body:       http.NoBody,
+		},
+	}
+	err := fmt.Errorf("unexpected status %d (%s) received with no body", http.StatusNotModified, http.StatusText(http.StatusNotModified))
+	return fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+}
+
+func validateNames(containerName, blobName string) error {
+	if containerName == "" {
+		return fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return fmt.Errorf("`blobName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	return nil
+}
+
+func key(containerName, blobName string) string {
+	return fmt.Sprintf("%s/%s", containerName, blobName)
+}
+
+func copyMetaData(input map[string]string) map[string]string {
+	out := make(map[string]string, len(input))
+	for k, v := range input {
+		out[k] = v
+	}
+	return out
+}
+
+func valueOrEmpty(input *string) string {
+	if input == nil {
+		return ""
+	}
+	return *input
+}
@@ -0,0 +1,313 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+func TestBlobLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	client := New()
+	containerName := "container"
+	blobName := "example.txt"
+
+	if _, err := client.Get(ctx, containerName, blobName, blobs.GetInput{}); !responseerror.IsNotFound(err) {
+		t.Fatalf("expected a Not Found error prior to creation but got: %+v", err)
+	}
+
+	contents := []byte("hello world")
+	putInput := blobs.PutBlockBlobInput{
+		Content:     &contents,
+		ContentType: pointer.To("text/plain"),
+		MetaData: map[string]string{
+			"hello": "world",
+		},
+	}
+	put, err := client.PutBlockBlob(ctx, containerName, blobName, putInput)
+	if err != nil {
+		t.Fatalf("putting blob: %+v", err)
+	}
+
+	exists, err := client.Exists(ctx, containerName, blobName)
+	if err != nil {
+		t.Fatalf("checking if the blob exists: %+v", err)
+	}
+	if !exists.Exists {
+		t.Fatalf("expected the blob to exist")
+	}
+
+	blob, err := client.Get(ctx, containerName, blobName, blobs.GetInput{})
+	if err != nil {
+		t.Fatalf("retrieving blob: %+v", err)
+	}
+	if string(*blob.Contents) != "hello world" {
+		t.Fatalf("expected the contents to be %q but got %q", "hello world", string(*blob.Contents))
+	}
+	if put.ETag == "" || put.LastModified.IsZero() {
+		t.Fatalf("expected the ETag and LastModified to be returned when putting the blob")
+	}
+
+	// modifying the input after uploading shouldn't modify the stored blob
+	contents[0] = 'j'
+
+	reader, err := client.GetReader(ctx, containerName, blobName, blobs.GetReaderInput{
+		StartByte: pointer.To(int64(6)),
+		EndByte:   pointer.To(int64(10)),
+	})
+	if err != nil {
+		t.Fatalf("retrieving reader: %+v", err)
+	}
+	readContents, err := io.ReadAll(reader.Body)
+	reader.Body.Close()
+	if err != nil {
+		t.Fatalf("reading body: %+v", err)
+	}
+	if string(readContents) != "world" {
+		t.Fatalf("expected the contents of the range to be %q but got %q", "world", string(readContents))
+	}
+	if reader.ContentRange != "bytes 6-10/11" {
+		t.Fatalf("expected the Content Range to be %q but got %q", "bytes 6-10/11", reader.ContentRange)
+	}
+
+	props, err := client.GetProperties(ctx, containerName, blobName, blobs.GetPropertiesInput{})
+	if err != nil {
+		t.Fatalf("retrieving properties: %+v", err)
+	}
+	if props.ContentLength != 11 {
+		t.Fatalf("expected the Content Length to be 11 but got %d", props.ContentLength)
+	}
+	if props.ContentType != "text/plain" {
+		t.Fatalf("expected the Content Type to be %q but got %q", "text/plain", props.ContentType)
+	}
+	if props.MetaData["hello"] != "world" {
+		t.Fatalf("expected the MetaData `hello` to be %q but got %q", "world", props.MetaData["hello"])
+	}
+
+	if _, err = client.SetMetaData(ctx, containerName, blobName, blobs.SetMetaDataInput{MetaData: map[string]string{"abc": "123"}}); err != nil {
+		t.Fatalf("setting metadata: %+v", err)
+	}
+	metaData, err := client.GetMetaData(ctx, containerName, blobName, blobs.GetMetaDataInput{})
+	if err != nil {
+		t.Fatalf("retrieving metadata: %+v", err)
+	}
+	if len(metaData.MetaData) != 1 || metaData.MetaData["abc"] != "123" {
+		t.Fatalf("expected the MetaData to be replaced but got: %+v", metaData.MetaData)
+	}
+
+	updated, err := client.GetProperties(ctx, containerName, blobName, blobs.GetPropertiesInput{})
+	if err != nil {
+		t.Fatalf("retrieving properties: %+v", err)
+	}
+	if updated.ETag == props.ETag {
+		t.Fatalf("expected the ETag to change when the MetaData was updated")
+	}
+
+	if _, err = client.Delete(ctx, containerName, blobName, blobs.DeleteInput{}); err != nil {
+		t.Fatalf("deleting blob: %+v", err)
+	}
+	if _, err = client.Delete(ctx, containerName, blobName, blobs.DeleteInput{}); !responseerror.IsNotFound(err) {
+		t.Fatalf("expected a Not Found error when deleting the blob again but got: %+v", err)
+	}
+}
+
+func TestListBlobs(t *testing.T) {
+	ctx := context.TODO()
+	client := New()
+
+	contents := []byte("hello")
+	for _, v := range []string{"a.txt", "b.txt", "folder/c.txt", "folder/d.txt", "other/e.txt"} {
+		if _, err := client.PutBlockBlob(ctx, "container", v, blobs.PutBlockBlobInput{Content: &contents}); err != nil {
+			t.Fatalf("putting %q: %+v", v, err)
+		}
+	}
+	if _, err := client.PutBlockBlob(ctx, "other", "a.txt", blobs.PutBlockBlobInput{Content: &contents}); err != nil {
+		t.Fatalf("putting blob into other container: %+v", err)
+	}
+
+	testData := []struct {
+		Name             string
+		Input            containers.ListBlobsInput
+		ExpectedBlobs    []string
+		ExpectedPrefixes []string
+		ExpectNextMarker bool
+	}{
+		{
+			Name:          "All",
+			Input:         containers.ListBlobsInput{},
+			ExpectedBlobs: []string{"a.txt", "b.txt", "folder/c.txt", "folder/d.txt", "other/e.txt"},
+		},
+		{
+			Name: "Prefix",
+			Input: containers.ListBlobsInput{
+				Prefix: pointer.To("folder/"),
+			},
+			ExpectedBlobs: []string{"folder/c.txt", "folder/d.txt"},
+		},
+		{
+			Name: "Delimiter",
+			Input: containers.ListBlobsInput{
+				Delimiter: pointer.To("/"),
+			},
+			ExpectedBlobs:    []string{"a.txt", "b.txt"},
+			ExpectedPrefixes: []string{"folder/", "other/"},
+		},
+		{
+			Name: "Max Results",
+			Input: containers.ListBlobsInput{
+				MaxResults: pointer.To(2),
+			},
+			ExpectedBlobs:    []string{"a.txt", "b.txt"},
+			ExpectNextMarker: true,
+		},
+		{
+			Name: "Marker",
+			Input: containers.ListBlobsInput{
+				Marker: pointer.To("folder/d.txt"),
+			},
+			ExpectedBlobs: []string{"folder/d.txt", "other/e.txt"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		result, err := client.ListBlobs(ctx, "container", v.Input)
+		if err != nil {
+			t.Fatalf("listing blobs: %+v", err)
+		}
+
+		names := make([]string, 0)
+		for _, blob := range result.Blobs.Blobs {
+			names = append(names, blob.Name)
+		}
+		if !equal(names, v.ExpectedBlobs) {
+			t.Fatalf("expected the blobs %+v but got %+v", v.ExpectedBlobs, names)
+		}
+
+		prefixes := make([]string, 0)
+		for _, prefix := range result.Blobs.BlobPrefixes {
+			prefixes = append(prefixes, prefix.Name)
+		}
+		if !equal(prefixes, v.ExpectedPrefixes) {
+			t.Fatalf("expected the prefixes %+v but got %+v", v.ExpectedPrefixes, prefixes)
+		}
+
+		if v.ExpectNextMarker != (result.NextMarker != nil) {
+			t.Fatalf("expected a NextMarker to be returned: %t but got %+v", v.ExpectNextMarker, result.NextMarker)
+		}
+	}
+}
+
+func TestAccessConditions(t *testing.T) {
+	ctx := context.TODO()
+	client := New()
+	containerName := "container"
+	blobName := "example.txt"
+
+	contents := []byte("hello world")
+	put, err := client.PutBlockBlob(ctx, containerName, blobName, blobs.PutBlockBlobInput{
+		Content: &contents,
+		AccessConditions: accessconditions.AccessConditions{
+			IfNoneMatch: pointer.To("*"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("putting blob: %+v", err)
+	}
+
+	_, err = client.PutBlockBlob(ctx, containerName, blobName, blobs.PutBlockBlobInput{
+		Content: &contents,
+		AccessConditions: accessconditions.AccessConditions{
+			IfNoneMatch: pointer.To("*"),
+		},
+	})
+	if !responseerror.IsConflict(err) {
+		t.Fatalf("expected a Conflict when the blob already exists but got: %+v", err)
+	}
+
+	_, err = client.PutBlockBlob(ctx, containerName, blobName, blobs.PutBlockBlobInput{
+		Content: &contents,
+		AccessConditions: accessconditions.AccessConditions{
+			IfMatch: pointer.To("\"0x0\""),
+		},
+	})
+	if !responseerror.IsConditionNotMet(err) || responseerror.IsNotModified(err) {
+		t.Fatalf("expected a 412 when the ETag doesn't match but got: %+v", err)
+	}
+
+	updated, err := client.PutBlockBlob(ctx, containerName, blobName, blobs.PutBlockBlobInput{
+		Content: &contents,
+		AccessConditions: accessconditions.AccessConditions{
+			IfMatch: pointer.To(put.ETag),
+		},
+	})
+	if err != nil {
+		t.Fatalf("putting blob with a matching ETag: %+v", err)
+	}
+	if updated.ETag == put.ETag {
+		t.Fatalf("expected the ETag to change when the blob was overwritten")
+	}
+
+	_, err = client.Get(ctx, containerName, blobName, blobs.GetInput{
+		AccessConditions: accessconditions.AccessConditions{
+			IfNoneMatch: pointer.To(updated.ETag),
+		},
+	})
+	if !responseerror.IsNotModified(err) {
+		t.Fatalf("expected a 304 when the ETag matches but got: %+v", err)
+	}
+
+	_, err = client.Get(ctx, containerName, blobName, blobs.GetInput{
+		AccessConditions: accessconditions.AccessConditions{
+			IfMatch: pointer.To(put.ETag),
+		},
+	})
+	if !responseerror.IsConditionNotMet(err) || responseerror.IsNotModified(err) {
+		t.Fatalf("expected a 412 when the ETag doesn't match but got: %+v", err)
+	}
+
+	blob, err := client.Get(ctx, containerName, blobName, blobs.GetInput{
+		AccessConditions: accessconditions.AccessConditions{
+			IfMatch: pointer.To(updated.ETag),
+		},
+	})
+	if err != nil {
+		t.Fatalf("retrieving blob with a matching ETag: %+v", err)
+	}
+	if string(*blob.Contents) != "hello world" {
+		t.Fatalf("expected the contents to be %q but got %q", "hello world", string(*blob.Contents))
+	}
+}
+
+func TestUnimplementedMethods(t *testing.T) {
+	var client blobs.StorageBlob = New()
+
+	_, err := client.AppendBlock(context.TODO(), "container", "example.txt", blobs.AppendBlockInput{})
+	if !errors.Is(err, ErrNotImplemented) {
+		t.Fatalf("expected an error wrapping ErrNotImplemented but got: %+v", err)
+	}
+
+	if err := client.CopyAndWait(context.TODO(), "container", "example.txt", blobs.CopyInput{}); !errors.Is(err, ErrNotImplemented) {
+		t.Fatalf("expected an error wrapping ErrNotImplemented but got: %+v", err)
+	}
+}
+
+func equal(first, second []string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,237 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+// ErrNotImplemented is wrapped by the error returned from each method which isn't implemented by the fake
+var ErrNotImplemented = errors.New("not implemented by fake")
+
+func notImplemented(method string) error {
+	return fmt.Errorf("%s: %w", method, ErrNotImplemented)
+}
+
+// the remaining methods of `blobs.StorageBlob` aren't implemented by the fake, and return an error wrapping
+// ErrNotImplemented rather than an empty response - so that a test fails clearly when code under test uses one
+
+func (c *Client) AppendBlock(ctx context.Context, containerName string, blobName string, input blobs.AppendBlockInput) (result blobs.AppendBlockResponse, err error) {
+	err = notImplemented("AppendBlock")
+	return
+}
+
+func (c *Client) AppendBlockFromURL(ctx context.Context, containerName string, blobName string, input blobs.AppendBlockFromURLInput) (result blobs.AppendBlockFromURLResponse, err error) {
+	err = notImplemented("AppendBlockFromURL")
+	return
+}
+
+func (c *Client) Copy(ctx context.Context, containerName string, blobName string, input blobs.CopyInput) (result blobs.CopyResponse, err error) {
+	err = notImplemented("Copy")
+	return
+}
+
+func (c *Client) AbortCopy(ctx context.Context, containerName string, blobName string, input blobs.AbortCopyInput) (result blobs.CopyAbortResponse, err error) {
+	err = notImplemented("AbortCopy")
+	return
+}
+
+func (c *Client) CopyAndWait(ctx context.Context, containerName string, blobName string, input blobs.CopyInput) error {
+	return notImplemented("CopyAndWait")
+}
+
+func (c *Client) CopyFromURL(ctx context.Context, containerName string, blobName string, input blobs.CopyFromURLInput) (result blobs.CopyFromURLResponse, err error) {
+	err = notImplemented("CopyFromURL")
+	return
+}
+
+func (c *Client) DeleteSnapshot(ctx context.Context, containerName string, blobName string, input blobs.DeleteSnapshotInput) (result blobs.DeleteSnapshotResponse, err error) {
+	err = notImplemented("DeleteSnapshot")
+	return
+}
+
+func (c *Client) DeleteSnapshots(ctx context.Context, containerName string, blobName string, input blobs.DeleteSnapshotsInput) (result blobs.DeleteSnapshotsResponse, err error) {
+	err = notImplemented("DeleteSnapshots")
+	return
+}
+
+func (c *Client) DownloadToFile(ctx context.Context, containerName string, blobName string, localPath string, input blobs.DownloadToFileInput) (result blobs.DownloadToFileResponse, err error) {
+	err = notImplemented("DownloadToFile")
+	return
+}
+
+func (c *Client) GetBlockList(ctx context.Context, containerName string, blobName string, input blobs.GetBlockListInput) (result blobs.GetBlockListResponse, err error) {
+	err = notImplemented("GetBlockList")
+	return
+}
+
+func (c *Client) GetCopyStatus(ctx context.Context, containerName string, blobName string, input blobs.GetCopyStatusInput) (result blobs.GetCopyStatusResponse, err error) {
+	err = notImplemented("GetCopyStatus")
+	return
+}
+
+func (c *Client) GetPageRanges(ctx context.Context, containerName, blobName string, input blobs.GetPageRangesInput) (result blobs.GetPageRangesResponse, err error) {
+	err = notImplemented("GetPageRanges")
+	return
+}
+
+func (c *Client) GetPageRangesDiff(ctx context.Context, containerName, blobName string, input blobs.GetPageRangesDiffInput) (result blobs.GetPageRangesDiffResponse, err error) {
+	err = notImplemented("GetPageRangesDiff")
+	return
+}
+
+func (c *Client) GetResumableReader(ctx context.Context, containerName string, blobName string, input blobs.GetResumableReaderInput) (result blobs.GetResumableReaderResponse, err error) {
+	err = notImplemented("GetResumableReader")
+	return
+}
+
+func (c *Client) GetTags(ctx context.Context, containerName string, blobName string, input blobs.GetTagsInput) (result blobs.GetTagsResponse, err error) {
+	err = notImplemented("GetTags")
+	return
+}
+
+func (c *Client) IncrementalCopyBlob(ctx context.Context, containerName string, blobName string, input blobs.IncrementalCopyBlobInput) (result blobs.IncrementalCopyBlob, err error) {
+	err = notImplemented("IncrementalCopyBlob")
+	return
+}
+
+func (c *Client) IncrementalCopyBlobAndWait(ctx context.Context, containerName string, blobName string, input blobs.IncrementalCopyBlobInput) (result blobs.IncrementalCopyBlob, err error) {
+	err = notImplemented("IncrementalCopyBlobAndWait")
+	return
+}
+
+func (c *Client) AcquireLease(ctx context.Context, containerName string, blobName string, input blobs.AcquireLeaseInput) (result blobs.AcquireLeaseResponse, err error) {
+	err = notImplemented("AcquireLease")
+	return
+}
+
+func (c *Client) BreakLease(ctx context.Context, containerName string, blobName string, input blobs.BreakLeaseInput) (result blobs.BreakLeaseResponse, err error) {
+	err = notImplemented("BreakLease")
+	return
+}
+
+func (c *Client) ChangeLease(ctx context.Context, containerName string, blobName string, input blobs.ChangeLeaseInput) (result blobs.ChangeLeaseResponse, err error) {
+	err = notImplemented("ChangeLease")
+	return
+}
+
+func (c *Client) ReleaseLease(ctx context.Context, containerName string, blobName string, input blobs.ReleaseLeaseInput) (result blobs.ReleaseLeaseResponse, err error) {
+	err = notImplemented("ReleaseLease")
+	return
+}
+
+func (c *Client) RenewLease(ctx context.Context, containerName string, blobName string, input blobs.RenewLeaseInput) (result blobs.RenewLeaseResponse, err error) {
+	err = notImplemented("RenewLease")
+	return
+}
+
+func (c *Client) SetProperties(ctx context.Context, containerName string, blobName string, input blobs.SetPropertiesInput) (result blobs.SetPropertiesResponse, err error) {
+	err = notImplemented("SetProperties")
+	return
+}
+
+func (c *Client) PutAppendBlob(ctx context.Context, containerName string, blobName string, input blobs.PutAppendBlobInput) (result blobs.PutAppendBlobResponse, err error) {
+	err = notImplemented("PutAppendBlob")
+	return
+}
+
+func (c *Client) PutBlock(ctx context.Context, containerName string, blobName string, input blobs.PutBlockInput) (result blobs.PutBlockResponse, err error) {
+	err = notImplemented("PutBlock")
+	return
+}
+
+func (c *Client) PutBlockBlobFromFile(ctx context.Context, containerName string, blobName string, file *os.File, input blobs.PutBlockBlobInput) error {
+	return notImplemented("PutBlockBlobFromFile")
+}
+
+func (c *Client) PutBlockList(ctx context.Context, containerName string, blobName string, input blobs.PutBlockListInput) (result blobs.PutBlockListResponse, err error) {
+	err = notImplemented("PutBlockList")
+	return
+}
+
+func (c *Client) PutBlockFromURL(ctx context.Context, containerName string, blobName string, input blobs.PutBlockFromURLInput) (result blobs.PutBlockFromURLResponse, err error) {
+	err = notImplemented("PutBlockFromURL")
+	return
+}
+
+func (c *Client) PutPageBlob(ctx context.Context, containerName string, blobName string, input blobs.PutPageBlobInput) (result blobs.PutPageBlobResponse, err error) {
+	err = notImplemented("PutPageBlob")
+	return
+}
+
+func (c *Client) PutPageClear(ctx context.Context, containerName string, blobName string, input blobs.PutPageClearInput) (result blobs.PutPageClearResponse, err error) {
+	err = notImplemented("PutPageClear")
+	return
+}
+
+func (c *Client) PutPageUpdate(ctx context.Context, containerName string, blobName string, input blobs.PutPageUpdateInput) (result blobs.PutPageUpdateResponse, err error) {
+	err = notImplemented("PutPageUpdate")
+	return
+}
+
+func (c *Client) Query(ctx context.Context, containerName string, blobName string, input blobs.QueryInput) (result blobs.QueryResponse, err error) {
+	err = notImplemented("Query")
+	return
+}
+
+func (c *Client) PromoteVersion(ctx context.Context, containerName string, blobName string, input blobs.PromoteVersionInput) error {
+	return notImplemented("PromoteVersion")
+}
+
+func (c *Client) SetImmutabilityPolicy(ctx context.Context, containerName string, blobName string, input blobs.SetImmutabilityPolicyInput) (result blobs.SetImmutabilityPolicyResponse, err error) {
+	err = notImplemented("SetImmutabilityPolicy")
+	return
+}
+
+func (c *Client) DeleteImmutabilityPolicy(ctx context.Context, containerName string, blobName string, input blobs.DeleteImmutabilityPolicyInput) (result blobs.DeleteImmutabilityPolicyResponse, err error) {
+	err = notImplemented("DeleteImmutabilityPolicy")
+	return
+}
+
+func (c *Client) SetLegalHold(ctx context.Context, containerName string, blobName string, input blobs.SetLegalHoldInput) (result blobs.SetLegalHoldResponse, err error) {
+	err = notImplemented("SetLegalHold")
+	return
+}
+
+func (c *Client) SetExpiry(ctx context.Context, containerName string, blobName string, input blobs.SetExpiryInput) (result blobs.SetExpiryResponse, err error) {
+	err = notImplemented("SetExpiry")
+	return
+}
+
+func (c *Client) SetTags(ctx context.Context, containerName string, blobName string, input blobs.SetTagsInput) (result blobs.SetTagsResponse, err error) {
+	err = notImplemented("SetTags")
+	return
+}
+
+func (c *Client) SetTier(ctx context.Context, containerName string, blobName string, input blobs.SetTierInput) (result blobs.SetTierResponse, err error) {
+	err = notImplemented("SetTier")
+	return
+}
+
+func (c *Client) Rehydrate(ctx context.Context, containerName string, blobName string, input blobs.RehydrateInput) (result blobs.RehydrateResponse, err error) {
+	err = notImplemented("Rehydrate")
+	return
+}
+
+func (c *Client) Snapshot(ctx context.Context, containerName string, blobName string, input blobs.SnapshotInput) (result blobs.SnapshotResponse, err error) {
+	err = notImplemented("Snapshot")
+	return
+}
+
+func (c *Client) GetSnapshotProperties(ctx context.Context, containerName string, blobName string, input blobs.GetSnapshotPropertiesInput) (result blobs.GetPropertiesResponse, err error) {
+	err = notImplemented("GetSnapshotProperties")
+	return
+}
+
+func (c *Client) Undelete(ctx context.Context, containerName string, blobName string) (result blobs.UndeleteResponse, err error) {
+	err = notImplemented("Undelete")
+	return
+}
+
+func (c *Client) UploadFile(ctx context.Context, containerName string, blobName string, localPath string, input blobs.UploadFileInput) (result blobs.UploadFileResponse, err error) {
+	err = notImplemented("UploadFile")
+	return
+}
@@ -5,16 +5,36 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetInput struct {
 	LeaseID   *string
 	StartByte *int64
 	EndByte   *int64
+
+	// The DateTime of the Snapshot which should be read, rather than the base Blob
+	Snapshot *string
+
+	// The ID of the Version which should be read, rather than the current Version of the Blob
+	VersionID *string
+
+	// Should the contents be verified against the `Content-MD5` (or `x-ms-content-crc64`) returned by the service?
+	// When the checksums don't match a ChecksumMismatchError is returned. No verification is performed if the
+	// service doesn't return a checksum (for example when reading a range of a Blob which has no Content-MD5).
+	VerifyChecksum bool
+
+	// Optional - The Customer-Provided Key which was used to write the Blob, which must be specified to read a Blob
+	// written using a Customer-Provided Key
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
 }
 
 type GetResponse struct {
@@ -29,13 +49,16 @@ func (c Client) Get(ctx context.Context, containerName, blobName string, input G
 		return result, fmt.Errorf("`containerName` cannot be an empty string")
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		return result, fmt.Errorf("`containerName` must be a lower-cased string")
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
 	}
 
 	if blobName == "" {
 		return result, fmt.Errorf("`blobName` cannot be an empty string")
 	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
 
 	if input.LeaseID != nil && *input.LeaseID == "" {
 		return result, fmt.Errorf("`input.LeaseID` should either be specified or nil, not an empty string")
@@ -45,6 +68,22 @@ func (c Client) Get(ctx context.Context, containerName, blobName string, input G
 		return result, fmt.Errorf("`input.StartByte` and `input.EndByte` must both be specified, or both be nil")
 	}
 
+	if input.Snapshot != nil && *input.Snapshot == "" {
+		return result, fmt.Errorf("`input.Snapshot` should either be specified or nil, not an empty string")
+	}
+
+	if input.VersionID != nil && *input.VersionID == "" {
+		return result, fmt.Errorf("`input.VersionID` should either be specified or nil, not an empty string")
+	}
+
+	if input.Snapshot != nil && input.VersionID != nil {
+		return result, fmt.Errorf("at most one of `input.Snapshot` and `input.VersionID` can be specified")
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, nil); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusOK,
@@ -76,12 +115,18 @@ func (c Client) Get(ctx context.Context, containerName, blobName string, input G
 					return result, fmt.Errorf("could not parse response body")
 				}
 
+				if input.VerifyChecksum && resp.Header != nil {
+					if err := verifyChecksum(respBody, resp.Header.Get("Content-MD5"), resp.Header.Get("x-ms-content-crc64")); err != nil {
+						return result, err
+					}
+				}
+
 				result.Contents = &respBody
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -94,11 +139,18 @@ type getOptions struct {
 
 func (g getOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
+	if g.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *g.input.LeaseID)
+	}
 	if g.input.StartByte != nil && g.input.EndByte != nil {
 		headers.Append("x-ms-range", fmt.Sprintf("bytes=%d-%d", *g.input.StartByte, *g.input.EndByte))
 	}
-	return headers
 
+	headers.Merge(accessconditions.SetIntoHeaders(g.input.AccessConditions))
+
+	headers.Merge(g.input.CustomerProvidedKey.headers())
+
+	return headers
 }
 
 func (g getOptions) ToOData() *odata.Query {
@@ -106,5 +158,12 @@ func (g getOptions) ToOData() *odata.Query {
 }
 
 func (g getOptions) ToQuery() *client.QueryParams {
-	return nil
+	out := &client.QueryParams{}
+	if g.input.Snapshot != nil {
+		out.Append("snapshot", *g.input.Snapshot)
+	}
+	if g.input.VersionID != nil {
+		out.Append("versionid", *g.input.VersionID)
+	}
+	return out
 }
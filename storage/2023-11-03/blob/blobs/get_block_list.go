@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetBlockListInput struct {
+	// Specifies whether to return the list of committed blocks, the list of uncommitted blocks, or both lists together.
+	// If omitted, the list of committed blocks is returned.
 	BlockListType BlockListType
-	LeaseID       *string
+
+	LeaseID *string
 }
 
 type GetBlockListResponse struct {
@@ -41,13 +45,20 @@ func (c Client) GetBlockList(ctx context.Context, containerName, blobName string
 		return result, fmt.Errorf("`containerName` cannot be an empty string")
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		return result, fmt.Errorf("`containerName` must be a lower-cased string")
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
 	}
 
 	if blobName == "" {
 		return result, fmt.Errorf("`blobName` cannot be an empty string")
 	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.BlockListType != "" && input.BlockListType != All && input.BlockListType != Committed && input.BlockListType != Uncommitted {
+		return result, fmt.Errorf("`input.BlockListType` must be one of %q, %q or %q, got %q", All, Committed, Uncommitted, input.BlockListType)
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -77,14 +88,13 @@ func (c Client) GetBlockList(ctx context.Context, containerName, blobName string
 				result.ETag = resp.Header.Get("ETag")
 
 				if v := resp.Header.Get("x-ms-blob-content-length"); v != "" {
-					i, innerErr := strconv.Atoi(v)
+					i, innerErr := strconv.ParseInt(v, 10, 64)
 					if innerErr != nil {
 						err = fmt.Errorf("parsing `x-ms-blob-content-length` header value %q: %s", v, innerErr)
 						return
 					}
 
-					i64 := int64(i)
-					result.BlobContentLength = &i64
+					result.BlobContentLength = &i
 				}
 			}
 
@@ -96,7 +106,7 @@ func (c Client) GetBlockList(ctx context.Context, containerName, blobName string
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -121,7 +131,9 @@ func (g getBlockListOptions) ToOData() *odata.Query {
 
 func (g getBlockListOptions) ToQuery() *client.QueryParams {
 	out := &client.QueryParams{}
-	out.Append("blocklisttype", string(g.input.BlockListType))
+	if g.input.BlockListType != "" {
+		out.Append("blocklisttype", string(g.input.BlockListType))
+	}
 	out.Append("comp", "blocklist")
 	return out
 }
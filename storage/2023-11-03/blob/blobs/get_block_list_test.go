@@ -0,0 +1,64 @@
+package blobs
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestGetBlockListResponseUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<BlockList>
+  <CommittedBlocks>
+    <Block>
+      <Name>YmxvY2stMQ==</Name>
+      <Size>4194304</Size>
+    </Block>
+    <Block>
+      <Name>YmxvY2stMg==</Name>
+      <Size>1024</Size>
+    </Block>
+  </CommittedBlocks>
+  <UncommittedBlocks>
+    <Block>
+      <Name>YmxvY2stMw==</Name>
+      <Size>512</Size>
+    </Block>
+  </UncommittedBlocks>
+</BlockList>`
+
+	var actual GetBlockListResponse
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if len(actual.CommittedBlocks.Blocks) != 2 {
+		t.Fatalf("expected 2 committed blocks but got %d", len(actual.CommittedBlocks.Blocks))
+	}
+	if actual.CommittedBlocks.Blocks[0].Name != "YmxvY2stMQ==" {
+		t.Fatalf("expected the first committed block to be %q but got %q", "YmxvY2stMQ==", actual.CommittedBlocks.Blocks[0].Name)
+	}
+	if actual.CommittedBlocks.Blocks[0].Size != 4194304 {
+		t.Fatalf("expected the first committed block to be %d bytes but got %d", 4194304, actual.CommittedBlocks.Blocks[0].Size)
+	}
+	if len(actual.UncommittedBlocks.Blocks) != 1 {
+		t.Fatalf("expected 1 uncommitted block but got %d", len(actual.UncommittedBlocks.Blocks))
+	}
+	if actual.UncommittedBlocks.Blocks[0].Size != 512 {
+		t.Fatalf("expected the uncommitted block to be %d bytes but got %d", 512, actual.UncommittedBlocks.Blocks[0].Size)
+	}
+}
+
+func TestGetBlockListOptionsOmitsEmptyBlockListType(t *testing.T) {
+	query := getBlockListOptions{input: GetBlockListInput{}}.ToQuery().Values()
+	if v := query.Get("comp"); v != "blocklist" {
+		t.Fatalf("expected `comp` to be %q but got %q", "blocklist", v)
+	}
+	if query.Has("blocklisttype") {
+		t.Fatalf("expected `blocklisttype` to be omitted when unset")
+	}
+
+	query = getBlockListOptions{input: GetBlockListInput{BlockListType: Uncommitted}}.ToQuery().Values()
+	if v := query.Get("blocklisttype"); v != string(Uncommitted) {
+		t.Fatalf("expected `blocklisttype` to be %q but got %q", Uncommitted, v)
+	}
+}
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetPageRangesInput struct {
@@ -47,18 +48,27 @@ func (c Client) GetPageRanges(ctx context.Context, containerName, blobName strin
 		return result, fmt.Errorf("`containerName` cannot be an empty string")
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		return result, fmt.Errorf("`containerName` must be a lower-cased string")
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
 	}
 
 	if blobName == "" {
 		return result, fmt.Errorf("`blobName` cannot be an empty string")
 	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
 
 	if (input.StartByte != nil && input.EndByte == nil) || (input.StartByte == nil && input.EndByte != nil) {
 		return result, fmt.Errorf("`input.StartByte` and `input.EndByte` must both be specified, or both be nil")
 	}
 
+	if input.StartByte != nil && input.EndByte != nil {
+		if err = validatePageRange(*input.StartByte, *input.EndByte); err != nil {
+			return
+		}
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusOK,
@@ -106,7 +116,7 @@ func (c Client) GetPageRanges(ctx context.Context, containerName, blobName strin
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
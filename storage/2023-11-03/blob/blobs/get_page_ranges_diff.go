@@ -0,0 +1,181 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetPageRangesDiffInput struct {
+	LeaseID *string
+
+	// The DateTime of the Snapshot which the changes should be calculated from
+	PrevSnapshot string
+
+	// Optional - The DateTime of the Snapshot which the changes should be calculated to, which must be later
+	// than PrevSnapshot. When nil, the changes up to the current (base) Blob are returned.
+	Snapshot *string
+
+	StartByte *int64
+	EndByte   *int64
+}
+
+type GetPageRangesDiffResponse struct {
+	HttpResponse *http.Response
+
+	// The size of the blob in bytes
+	ContentLength *int64
+
+	// The Content Type of the blob
+	ContentType string
+
+	// The ETag associated with this blob
+	ETag string
+
+	// The ranges of pages which have been written to since PrevSnapshot
+	PageRanges []PageRange `xml:"PageRange"`
+
+	// The ranges of pages which have been cleared since PrevSnapshot
+	ClearRanges []PageRange `xml:"ClearRange"`
+}
+
+// GetPageRangesDiff returns the ranges of pages within a page blob (or snapshot of a page blob) which have been
+// changed or cleared since the specified previous snapshot - for example to take an incremental backup.
+func (c Client) GetPageRangesDiff(ctx context.Context, containerName, blobName string, input GetPageRangesDiffInput) (result GetPageRangesDiffResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		return result, fmt.Errorf("`input.LeaseID` should either be specified or nil, not an empty string")
+	}
+
+	if input.PrevSnapshot == "" {
+		return result, fmt.Errorf("`input.PrevSnapshot` cannot be an empty string")
+	}
+	prevSnapshot, err := time.Parse(time.RFC3339Nano, input.PrevSnapshot)
+	if err != nil {
+		return result, fmt.Errorf("`input.PrevSnapshot` must be an RFC3339 DateTime but got %q: %+v", input.PrevSnapshot, err)
+	}
+
+	if input.Snapshot != nil {
+		snapshot, err := time.Parse(time.RFC3339Nano, *input.Snapshot)
+		if err != nil {
+			return result, fmt.Errorf("`input.Snapshot` must be an RFC3339 DateTime but got %q: %+v", *input.Snapshot, err)
+		}
+		if !prevSnapshot.Before(snapshot) {
+			return result, fmt.Errorf("`input.PrevSnapshot` (%s) must be earlier than `input.Snapshot` (%s)", input.PrevSnapshot, *input.Snapshot)
+		}
+	}
+
+	if (input.StartByte != nil && input.EndByte == nil) || (input.StartByte == nil && input.EndByte != nil) {
+		return result, fmt.Errorf("`input.StartByte` and `input.EndByte` must both be specified, or both be nil")
+	}
+
+	if input.StartByte != nil && input.EndByte != nil {
+		if err = validatePageRange(*input.StartByte, *input.EndByte); err != nil {
+			return
+		}
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: getPageRangesDiffOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ContentType = resp.Header.Get("Content-Type")
+				result.ETag = resp.Header.Get("ETag")
+
+				if v := resp.Header.Get("x-ms-blob-content-length"); v != "" {
+					i, innerErr := strconv.ParseInt(v, 10, 64)
+					if innerErr != nil {
+						err = fmt.Errorf("parsing `x-ms-blob-content-length` header value %q: %+v", v, innerErr)
+						return
+					}
+					result.ContentLength = &i
+				}
+			}
+
+			err = resp.Unmarshal(&result)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type getPageRangesDiffOptions struct {
+	input GetPageRangesDiffInput
+}
+
+func (g getPageRangesDiffOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+
+	if g.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *g.input.LeaseID)
+	}
+
+	if g.input.StartByte != nil && g.input.EndByte != nil {
+		headers.Append("x-ms-range", fmt.Sprintf("bytes=%d-%d", *g.input.StartByte, *g.input.EndByte))
+	}
+
+	return headers
+}
+
+func (g getPageRangesDiffOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (g getPageRangesDiffOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "pagelist")
+	out.Append("prevsnapshot", g.input.PrevSnapshot)
+	if g.input.Snapshot != nil {
+		out.Append("snapshot", *g.input.Snapshot)
+	}
+	return out
+}
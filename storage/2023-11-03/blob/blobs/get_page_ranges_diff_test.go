@@ -0,0 +1,136 @@
+package blobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestGetPageRangesDiff(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Method != http.MethodGet || r.URL.Path != "/container/disk.vhd" || query.Get("comp") != "pagelist" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if v := query.Get("prevsnapshot"); v != "2024-01-01T00:00:00.0000000Z" {
+			t.Errorf("expected `prevsnapshot` to be %q but got %q", "2024-01-01T00:00:00.0000000Z", v)
+		}
+		if v := query.Get("snapshot"); v != "2024-01-02T00:00:00.0000000Z" {
+			t.Errorf("expected `snapshot` to be %q but got %q", "2024-01-02T00:00:00.0000000Z", v)
+		}
+
+		w.Header().Set("Content-Type", "application/xml")
+		w.Header().Set("ETag", "0x1")
+		w.Header().Set("x-ms-blob-content-length", "4096")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?>
+<PageList>
+  <PageRange><Start>0</Start><End>511</End></PageRange>
+  <ClearRange><Start>512</Start><End>1023</End></ClearRange>
+  <PageRange><Start>2048</Start><End>4095</End></PageRange>
+</PageList>`))
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.GetPageRangesDiff(ctx, "container", "disk.vhd", GetPageRangesDiffInput{
+		PrevSnapshot: "2024-01-01T00:00:00.0000000Z",
+		Snapshot:     pointer.To("2024-01-02T00:00:00.0000000Z"),
+	})
+	if err != nil {
+		t.Fatalf("retrieving page ranges diff: %+v", err)
+	}
+
+	expectedPages := []PageRange{{Start: 0, End: 511}, {Start: 2048, End: 4095}}
+	if len(result.PageRanges) != len(expectedPages) {
+		t.Fatalf("expected %d page ranges but got %d", len(expectedPages), len(result.PageRanges))
+	}
+	for i, v := range expectedPages {
+		if result.PageRanges[i] != v {
+			t.Fatalf("expected page range %d to be %+v but got %+v", i, v, result.PageRanges[i])
+		}
+	}
+	if len(result.ClearRanges) != 1 || result.ClearRanges[0] != (PageRange{Start: 512, End: 1023}) {
+		t.Fatalf("unexpected clear ranges: %+v", result.ClearRanges)
+	}
+	if result.ContentLength == nil || *result.ContentLength != 4096 {
+		t.Fatalf("expected the ContentLength to be 4096 but got %+v", result.ContentLength)
+	}
+}
+
+func TestGetPageRangesDiffValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input GetPageRangesDiffInput
+	}{
+		{
+			Name:  "No PrevSnapshot",
+			Input: GetPageRangesDiffInput{},
+		},
+		{
+			Name: "Invalid PrevSnapshot",
+			Input: GetPageRangesDiffInput{
+				PrevSnapshot: "yesterday",
+			},
+		},
+		{
+			Name: "Invalid Snapshot",
+			Input: GetPageRangesDiffInput{
+				PrevSnapshot: "2024-01-01T00:00:00.0000000Z",
+				Snapshot:     pointer.To("today"),
+			},
+		},
+		{
+			Name: "PrevSnapshot after Snapshot",
+			Input: GetPageRangesDiffInput{
+				PrevSnapshot: "2024-01-02T00:00:00.0000000Z",
+				Snapshot:     pointer.To("2024-01-01T00:00:00.0000000Z"),
+			},
+		},
+		{
+			Name: "PrevSnapshot equal to Snapshot",
+			Input: GetPageRangesDiffInput{
+				PrevSnapshot: "2024-01-01T00:00:00.0000000Z",
+				Snapshot:     pointer.To("2024-01-01T00:00:00.0000000Z"),
+			},
+		},
+		{
+			Name: "Unaligned Range",
+			Input: GetPageRangesDiffInput{
+				PrevSnapshot: "2024-01-01T00:00:00.0000000Z",
+				StartByte:    pointer.To(int64(1)),
+				EndByte:      pointer.To(int64(511)),
+			},
+		},
+		{
+			Name: "Empty LeaseID",
+			Input: GetPageRangesDiffInput{
+				LeaseID:      pointer.To(""),
+				PrevSnapshot: "2024-01-01T00:00:00.0000000Z",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).GetPageRangesDiff(ctx, "container", "disk.vhd", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/progress"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetReaderInput struct {
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+
+	// The (inclusive) range of bytes which should be read, both must be specified or neither
+	StartByte *int64
+	EndByte   *int64
+
+	// Should the service return the MD5 hash of the requested range in the `Content-MD5` header?
+	// This requires that a range is specified, and that the range is at most 4 MiB in size.
+	RangeGetContentMD5 bool
+
+	// Should the service return the CRC64 checksum of the requested range in the `x-ms-content-crc64` header?
+	// This requires that a range is specified, and that the range is at most 4 MiB in size.
+	// This cannot be specified alongside RangeGetContentMD5.
+	RangeGetContentCRC64 bool
+
+	// The DateTime of the Snapshot which should be read, rather than the base Blob
+	Snapshot *string
+
+	// The ID of the Version which should be read, rather than the current Version of the Blob
+	VersionID *string
+
+	// Should the streamed contents be verified against the `Content-MD5` (or `x-ms-content-crc64`) returned
+	// by the service? When enabled the checksum is computed as the Body is read, and a ChecksumMismatchError
+	// is returned from the final Read (in place of io.EOF) when the checksums don't match.
+	VerifyChecksum bool
+
+	// Optional - A callback which is fired as the Body is read, with the cumulative number of bytes read
+	// and the number of bytes present in the response body. A final callback is fired once the Body
+	// has been read in its entirety.
+	Progress func(bytesTransferred, totalBytes int64)
+
+	// Optional - The Customer-Provided Key which was used to write the Blob, which must be specified to read a Blob
+	// written using a Customer-Provided Key
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
+}
+
+type GetReaderResponse struct {
+	HttpResponse *http.Response
+
+	// Body streams the contents of the Blob (or the requested range), this must be closed by the caller.
+	Body io.ReadCloser
+
+	// The MD5 hash of the entire Blob, if one has been set
+	BlobContentMD5 string
+
+	// The type of the Blob
+	BlobType BlobType
+
+	// The number of bytes present in the response body
+	ContentLength int64
+
+	// The MD5 hash of the returned range, returned when `RangeGetContentMD5` is set (or when the entire
+	// Blob is read and the Blob has a Content-MD5 set)
+	ContentMD5 string
+
+	// The CRC64 checksum of the returned range, returned when `RangeGetContentCRC64` is set
+	ContentCRC64 string
+
+	// The range of bytes returned, for example `bytes 0-1023/2048`, when a range was requested
+	ContentRange string
+
+	// The content type specified for the Blob
+	ContentType string
+
+	// The ETag contains a value that you can use to perform operations conditionally
+	ETag string
+
+	// The date/time that the Blob was last modified
+	LastModified string
+
+	// A set of name-value pairs that correspond to the user-defined metadata associated with this Blob
+	MetaData map[string]string
+}
+
+// GetReader reads the contents of a blob (or a range of bytes from within it) without buffering the response in
+// memory, the returned Body must be closed by the caller once they've finished reading from it.
+func (c Client) GetReader(ctx context.Context, containerName, blobName string, input GetReaderInput) (result GetReaderResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		return result, fmt.Errorf("`input.LeaseID` should either be specified or nil, not an empty string")
+	}
+
+	if (input.StartByte != nil && input.EndByte == nil) || (input.StartByte == nil && input.EndByte != nil) {
+		return result, fmt.Errorf("`input.StartByte` and `input.EndByte` must both be specified, or both be nil")
+	}
+
+	if input.StartByte != nil && input.EndByte != nil {
+		if *input.StartByte < 0 || *input.EndByte < *input.StartByte {
+			return result, fmt.Errorf("`input.EndByte` must be greater than or equal to `input.StartByte`, which must be greater than or equal to 0")
+		}
+	}
+
+	if input.RangeGetContentMD5 && input.RangeGetContentCRC64 {
+		return result, fmt.Errorf("at most one of `input.RangeGetContentMD5` and `input.RangeGetContentCRC64` can be set")
+	}
+
+	if input.RangeGetContentMD5 || input.RangeGetContentCRC64 {
+		if input.StartByte == nil || input.EndByte == nil {
+			return result, fmt.Errorf("`input.StartByte` and `input.EndByte` must be specified when requesting the checksum of a range")
+		}
+		if (*input.EndByte-*input.StartByte)+1 > 4*1024*1024 {
+			return result, fmt.Errorf("the requested range must be at most 4 MiB when requesting the checksum of a range")
+		}
+	}
+
+	if input.Snapshot != nil && *input.Snapshot == "" {
+		return result, fmt.Errorf("`input.Snapshot` should either be specified or nil, not an empty string")
+	}
+
+	if input.VersionID != nil && *input.VersionID == "" {
+		return result, fmt.Errorf("`input.VersionID` should either be specified or nil, not an empty string")
+	}
+
+	if input.Snapshot != nil && input.VersionID != nil {
+		return result, fmt.Errorf("at most one of `input.Snapshot` and `input.VersionID` can be specified")
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, nil); err != nil {
+		return
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+			http.StatusPartialContent,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: getReaderOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			// the response body is intentionally not read here, instead it's handed to the caller to stream
+			result.Body = resp.Body
+
+			if resp.Header != nil {
+				result.BlobContentMD5 = resp.Header.Get("x-ms-blob-content-md5")
+				result.BlobType = BlobType(resp.Header.Get("x-ms-blob-type"))
+				result.ContentMD5 = resp.Header.Get("Content-MD5")
+				result.ContentCRC64 = resp.Header.Get("x-ms-content-crc64")
+				result.ContentRange = resp.Header.Get("Content-Range")
+				result.ContentType = resp.Header.Get("Content-Type")
+				result.ETag = resp.Header.Get("ETag")
+				result.LastModified = resp.Header.Get("Last-Modified")
+				result.MetaData = metadata.ParseFromHeaders(resp.Header)
+
+				if v := resp.Header.Get("Content-Length"); v != "" {
+					i, innerErr := strconv.ParseInt(v, 10, 64)
+					if innerErr != nil {
+						resp.Body.Close()
+						result.Body = nil
+						err = fmt.Errorf("parsing `Content-Length` header value %q: %+v", v, innerErr)
+						return
+					}
+					result.ContentLength = i
+				}
+
+				if input.VerifyChecksum {
+					result.Body = newChecksumVerifyingReader(resp.Body, result.ContentMD5, result.ContentCRC64)
+				}
+
+				result.Body = progress.NewReadCloser(result.Body, progress.NewTracker(input.Progress, result.ContentLength))
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type getReaderOptions struct {
+	input GetReaderInput
+}
+
+func (g getReaderOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if g.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *g.input.LeaseID)
+	}
+	if g.input.StartByte != nil && g.input.EndByte != nil {
+		headers.Append("x-ms-range", fmt.Sprintf("bytes=%d-%d", *g.input.StartByte, *g.input.EndByte))
+	}
+	if g.input.RangeGetContentMD5 {
+		headers.Append("x-ms-range-get-content-md5", "true")
+	}
+	if g.input.RangeGetContentCRC64 {
+		headers.Append("x-ms-range-get-content-crc64", "true")
+	}
+
+	headers.Merge(accessconditions.SetIntoHeaders(g.input.AccessConditions))
+
+	headers.Merge(g.input.CustomerProvidedKey.headers())
+
+	return headers
+}
+
+func (g getReaderOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (g getReaderOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	if g.input.Snapshot != nil {
+		out.Append("snapshot", *g.input.Snapshot)
+	}
+	if g.input.VersionID != nil {
+		out.Append("versionid", *g.input.VersionID)
+	}
+	return out
+}
@@ -0,0 +1,45 @@
+package blobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+func TestGetNotModified(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "\"0x8D9\"" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set("ETag", "\"0x8D9\"")
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	_, err = blobClient.Get(ctx, "container", "blob.txt", GetInput{
+		AccessConditions: accessconditions.AccessConditions{
+			IfNoneMatch: pointer.To("\"0x8D9\""),
+		},
+	})
+	if !responseerror.IsConditionNotMet(err) {
+		t.Fatalf("expected a ConditionNotMetError but got: %+v", err)
+	}
+	if !responseerror.IsNotModified(err) {
+		t.Fatalf("expected IsNotModified to be true but got: %+v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type DeleteImmutabilityPolicyInput struct {
+	// Optional - The ID of the Version whose Immutability Policy should be deleted,
+	// rather than the current Version of the Blob
+	VersionID *string
+}
+
+type DeleteImmutabilityPolicyResponse struct {
+	HttpResponse *http.Response
+}
+
+// DeleteImmutabilityPolicy deletes the Unlocked Immutability Policy from the specified Blob (or Version).
+// Locked Immutability Policies cannot be deleted.
+func (c Client) DeleteImmutabilityPolicy(ctx context.Context, containerName, blobName string, input DeleteImmutabilityPolicyInput) (result DeleteImmutabilityPolicyResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.VersionID != nil && *input.VersionID == "" {
+		return result, fmt.Errorf("`input.VersionID` should either be specified or nil, not an empty string")
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		OptionsObject: deleteImmutabilityPolicyOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type deleteImmutabilityPolicyOptions struct {
+	input DeleteImmutabilityPolicyInput
+}
+
+func (d deleteImmutabilityPolicyOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (d deleteImmutabilityPolicyOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (d deleteImmutabilityPolicyOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "immutabilityPolicies")
+
+	if d.input.VersionID != nil {
+		out.Append("versionid", *d.input.VersionID)
+	}
+
+	return out
+}
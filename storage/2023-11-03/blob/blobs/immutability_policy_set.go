@@ -0,0 +1,146 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type SetImmutabilityPolicyInput struct {
+	// The date/time until which the Blob is immutable, in RFC1123 format
+	// (for example `Mon, 01 Jan 2024 00:00:00 GMT`)
+	ExpiryTime string
+
+	// Optional - The mode of the Immutability Policy, defaults to `Unlocked`.
+	// Once an Immutability Policy is `Locked` it can no longer be deleted.
+	Mode *ImmutabilityPolicyMode
+
+	// Optional - The ID of the Version to which the Immutability Policy should be applied,
+	// rather than the current Version of the Blob
+	VersionID *string
+
+	// Optional - A DateTime value, the Immutability Policy is only set if the Blob hasn't been
+	// modified since the specified date/time
+	IfUnmodifiedSince *string
+}
+
+type SetImmutabilityPolicyResponse struct {
+	HttpResponse *http.Response
+
+	// The date/time until which the Blob is immutable
+	ExpiryTime string
+
+	// The mode of the Immutability Policy
+	Mode ImmutabilityPolicyMode
+}
+
+// SetImmutabilityPolicy sets a time-based retention policy on the specified Blob (or Version), during
+// which the Blob cannot be modified or deleted.
+func (c Client) SetImmutabilityPolicy(ctx context.Context, containerName, blobName string, input SetImmutabilityPolicyInput) (result SetImmutabilityPolicyResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.ExpiryTime == "" {
+		return result, fmt.Errorf("`input.ExpiryTime` cannot be an empty string")
+	}
+
+	if _, err = time.Parse(time.RFC1123, input.ExpiryTime); err != nil {
+		return result, fmt.Errorf("`input.ExpiryTime` must be an RFC1123 date/time but got %q: %+v", input.ExpiryTime, err)
+	}
+
+	if input.Mode != nil && *input.Mode != ImmutabilityPolicyModeLocked && *input.Mode != ImmutabilityPolicyModeUnlocked {
+		return result, fmt.Errorf("`input.Mode` must be either %q or %q", ImmutabilityPolicyModeLocked, ImmutabilityPolicyModeUnlocked)
+	}
+
+	if input.VersionID != nil && *input.VersionID == "" {
+		return result, fmt.Errorf("`input.VersionID` should either be specified or nil, not an empty string")
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: setImmutabilityPolicyOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ExpiryTime = resp.Header.Get("x-ms-immutability-policy-until-date")
+				result.Mode = ImmutabilityPolicyMode(resp.Header.Get("x-ms-immutability-policy-mode"))
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type setImmutabilityPolicyOptions struct {
+	input SetImmutabilityPolicyInput
+}
+
+func (s setImmutabilityPolicyOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-immutability-policy-until-date", s.input.ExpiryTime)
+
+	if s.input.Mode != nil {
+		headers.Append("x-ms-immutability-policy-mode", string(*s.input.Mode))
+	}
+
+	if s.input.IfUnmodifiedSince != nil {
+		headers.Append("If-Unmodified-Since", *s.input.IfUnmodifiedSince)
+	}
+
+	return headers
+}
+
+func (s setImmutabilityPolicyOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (s setImmutabilityPolicyOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "immutabilityPolicies")
+
+	if s.input.VersionID != nil {
+		out.Append("versionid", *s.input.VersionID)
+	}
+
+	return out
+}
@@ -0,0 +1,80 @@
+package blobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestSetImmutabilityPolicyOptions(t *testing.T) {
+	options := setImmutabilityPolicyOptions{input: SetImmutabilityPolicyInput{
+		ExpiryTime: "Mon, 01 Jan 2024 00:00:00 GMT",
+		Mode:       pointer.To(ImmutabilityPolicyModeLocked),
+		VersionID:  pointer.To("2024-01-01T00:00:00.0000000Z"),
+	}}
+
+	headers := options.ToHeaders().Headers()
+	if v := headers.Get("x-ms-immutability-policy-until-date"); v != "Mon, 01 Jan 2024 00:00:00 GMT" {
+		t.Fatalf("expected `x-ms-immutability-policy-until-date` to be %q but got %q", "Mon, 01 Jan 2024 00:00:00 GMT", v)
+	}
+	if v := headers.Get("x-ms-immutability-policy-mode"); v != string(ImmutabilityPolicyModeLocked) {
+		t.Fatalf("expected `x-ms-immutability-policy-mode` to be %q but got %q", ImmutabilityPolicyModeLocked, v)
+	}
+
+	query := options.ToQuery().Values()
+	if v := query.Get("comp"); v != "immutabilityPolicies" {
+		t.Fatalf("expected `comp` to be %q but got %q", "immutabilityPolicies", v)
+	}
+	if v := query.Get("versionid"); v != "2024-01-01T00:00:00.0000000Z" {
+		t.Fatalf("expected `versionid` to be %q but got %q", "2024-01-01T00:00:00.0000000Z", v)
+	}
+}
+
+func TestSetImmutabilityPolicyValidation(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input SetImmutabilityPolicyInput
+	}{
+		{
+			Name:  "No Expiry Time",
+			Input: SetImmutabilityPolicyInput{},
+		},
+		{
+			Name: "Invalid Expiry Time",
+			Input: SetImmutabilityPolicyInput{
+				ExpiryTime: "2024-01-01T00:00:00Z",
+			},
+		},
+		{
+			Name: "Invalid Mode",
+			Input: SetImmutabilityPolicyInput{
+				ExpiryTime: "Mon, 01 Jan 2024 00:00:00 GMT",
+				Mode:       pointer.To(ImmutabilityPolicyMode("Mutable")),
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).SetImmutabilityPolicy(context.Background(), "container", "blob.txt", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestSetLegalHoldOptions(t *testing.T) {
+	for _, legalHold := range []bool{true, false} {
+		options := setLegalHoldOptions{input: SetLegalHoldInput{LegalHold: legalHold}}
+		expected := "false"
+		if legalHold {
+			expected = "true"
+		}
+		if v := options.ToHeaders().Headers().Get("x-ms-legal-hold"); v != expected {
+			t.Fatalf("expected `x-ms-legal-hold` to be %q but got %q", expected, v)
+		}
+		if v := options.ToQuery().Values().Get("comp"); v != "legalhold" {
+			t.Fatalf("expected `comp` to be %q but got %q", "legalhold", v)
+		}
+	}
+}
@@ -4,14 +4,21 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"net/url"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type IncrementalCopyBlobInput struct {
-	CopySource        string
+	// The URL of the Snapshot of the source Page Blob which should be copied, including the `snapshot` query-string
+	// parameter (and a SAS Token, unless the source is public or within the same Storage Account)
+	CopySource string
+
 	IfModifiedSince   *string
 	IfUnmodifiedSince *string
 	IfMatch           *string
@@ -20,6 +27,19 @@ type IncrementalCopyBlobInput struct {
 
 type IncrementalCopyBlob struct {
 	HttpResponse *http.Response
+
+	// The ID of the Copy operation, which can be used to check its status or abort it
+	CopyID string
+
+	// The state of the Copy operation, which is typically `pending` when the request completes
+	CopyStatus CopyStatus
+
+	// The Snapshot of the destination Blob which was created by the Copy operation, which is only populated by
+	// IncrementalCopyBlobAndWait once the Copy has succeeded
+	CopyDestinationSnapshot string
+
+	ETag         string
+	LastModified time.Time
 }
 
 // IncrementalCopyBlob copies a snapshot of the source page blob to a destination page blob.
@@ -32,8 +52,8 @@ func (c Client) IncrementalCopyBlob(ctx context.Context, containerName, blobName
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -41,11 +61,24 @@ func (c Client) IncrementalCopyBlob(ctx context.Context, containerName, blobName
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.CopySource == "" {
 		err = fmt.Errorf("`input.CopySource` cannot be an empty string")
 		return
 	}
+	source, err := url.Parse(input.CopySource)
+	if err != nil {
+		err = fmt.Errorf("`input.CopySource` is not a valid URL: %+v", err)
+		return
+	}
+	if source.Query().Get("snapshot") == "" {
+		err = fmt.Errorf("`input.CopySource` must be the URL of a Snapshot, including the `snapshot` query-string parameter")
+		return
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -68,9 +101,20 @@ func (c Client) IncrementalCopyBlob(ctx context.Context, containerName, blobName
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.CopyID = resp.Header.Get("x-ms-copy-id")
+				result.CopyStatus = CopyStatus(resp.Header.Get("x-ms-copy-status"))
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
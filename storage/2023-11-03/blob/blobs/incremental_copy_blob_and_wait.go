@@ -0,0 +1,28 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// IncrementalCopyBlobAndWait is a convenience method which doesn't exist in the API, which starts an Incremental Copy
+// of a Snapshot of the source Page Blob and then polls the properties of the destination Blob until the Copy has
+// completed - returning the Snapshot of the destination Blob which was created by the Copy.
+func (c Client) IncrementalCopyBlobAndWait(ctx context.Context, containerName, blobName string, input IncrementalCopyBlobInput) (result IncrementalCopyBlob, err error) {
+	result, err = c.IncrementalCopyBlob(ctx, containerName, blobName, input)
+	if err != nil {
+		return result, fmt.Errorf("starting incremental copy: %w", err)
+	}
+
+	props, err := c.waitForCopy(ctx, containerName, blobName, GetPropertiesInput{}, 10*time.Second)
+	if props != nil {
+		result.CopyStatus = props.CopyStatus
+		result.CopyDestinationSnapshot = props.CopyDestinationSnapshot
+	}
+	if err != nil {
+		return result, fmt.Errorf("waiting for incremental copy %q to complete: %w", result.CopyID, err)
+	}
+
+	return result, nil
+}
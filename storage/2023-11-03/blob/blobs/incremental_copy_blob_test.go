@@ -0,0 +1,102 @@
+package blobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIncrementalCopyBlobAndWait(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	source := "https://source.blob.core.windows.net/disks/disk.vhd?snapshot=2024-01-01T00:00:00.0000000Z"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/backups/disk.vhd" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			if r.URL.Query().Get("comp") != "incrementalcopy" {
+				t.Errorf("expected `comp` to be %q but got %q", "incrementalcopy", r.URL.Query().Get("comp"))
+			}
+			if v := r.Header.Get("x-ms-copy-source"); v != source {
+				t.Errorf("expected the `x-ms-copy-source` header to be %q but got %q", source, v)
+			}
+			w.Header().Set("ETag", "0x1")
+			w.Header().Set("x-ms-copy-id", "copy-id")
+			w.Header().Set("x-ms-copy-status", string(Pending))
+			w.WriteHeader(http.StatusAccepted)
+
+		case http.MethodHead:
+			w.Header().Set("x-ms-copy-id", "copy-id")
+			w.Header().Set("x-ms-copy-status", string(Success))
+			w.Header().Set("x-ms-copy-destination-snapshot", "2024-01-01T00:05:00.0000000Z")
+			w.WriteHeader(http.StatusOK)
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.IncrementalCopyBlobAndWait(ctx, "backups", "disk.vhd", IncrementalCopyBlobInput{
+		CopySource: source,
+	})
+	if err != nil {
+		t.Fatalf("incrementally copying: %+v", err)
+	}
+	if result.CopyID != "copy-id" || result.ETag != "0x1" {
+		t.Fatalf("unexpected response: %+v", result)
+	}
+	if result.CopyStatus != Success {
+		t.Fatalf("expected the CopyStatus to be %q but got %q", Success, result.CopyStatus)
+	}
+	if result.CopyDestinationSnapshot != "2024-01-01T00:05:00.0000000Z" {
+		t.Fatalf("expected the CopyDestinationSnapshot to be %q but got %q", "2024-01-01T00:05:00.0000000Z", result.CopyDestinationSnapshot)
+	}
+}
+
+func TestIncrementalCopyBlobValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input IncrementalCopyBlobInput
+	}{
+		{
+			Name:  "No Copy Source",
+			Input: IncrementalCopyBlobInput{},
+		},
+		{
+			Name: "Not a Snapshot",
+			Input: IncrementalCopyBlobInput{
+				CopySource: "https://source.blob.core.windows.net/disks/disk.vhd",
+			},
+		},
+		{
+			Name: "Invalid URL",
+			Input: IncrementalCopyBlobInput{
+				CopySource: "https://source.blob.core.windows.net/%zz",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).IncrementalCopyBlob(ctx, "backups", "disk.vhd", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
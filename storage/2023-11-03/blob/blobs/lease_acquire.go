@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type AcquireLeaseInput struct {
@@ -36,8 +37,8 @@ func (c Client) AcquireLease(ctx context.Context, containerName, blobName string
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -45,6 +46,10 @@ func (c Client) AcquireLease(ctx context.Context, containerName, blobName string
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.LeaseID != nil && *input.LeaseID == "" {
 		err = fmt.Errorf("`input.LeaseID` cannot be an empty string, if specified")
@@ -55,8 +60,9 @@ func (c Client) AcquireLease(ctx context.Context, containerName, blobName string
 		err = fmt.Errorf("`input.ProposedLeaseID` cannot be an empty string, if specified")
 		return
 	}
+
 	// An infinite lease duration is -1 seconds. A non-infinite lease can be between 15 and 60 seconds
-	if input.LeaseDuration != -1 && (input.LeaseDuration <= 15 || input.LeaseDuration >= 60) {
+	if input.LeaseDuration != -1 && (input.LeaseDuration < 15 || input.LeaseDuration > 60) {
 		err = fmt.Errorf("`input.LeaseDuration` must be -1 (infinite), or between 15 and 60 seconds")
 		return
 	}
@@ -90,7 +96,7 @@ func (c Client) AcquireLease(ctx context.Context, containerName, blobName string
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type BreakLeaseInput struct {
@@ -40,8 +41,8 @@ func (c Client) BreakLease(ctx context.Context, containerName, blobName string,
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -49,12 +50,21 @@ func (c Client) BreakLease(ctx context.Context, containerName, blobName string,
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.LeaseID == "" {
 		err = fmt.Errorf("`input.LeaseID` cannot be an empty string")
 		return
 	}
 
+	if input.BreakPeriod != nil && (*input.BreakPeriod < 0 || *input.BreakPeriod > 60) {
+		err = fmt.Errorf("`input.BreakPeriod` must be between 0 and 60 seconds, if specified")
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusAccepted,
@@ -76,9 +86,22 @@ func (c Client) BreakLease(ctx context.Context, containerName, blobName string,
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				if v := resp.Header.Get("x-ms-lease-time"); v != "" {
+					i, innerErr := strconv.Atoi(v)
+					if innerErr != nil {
+						err = fmt.Errorf("parsing `x-ms-lease-time` header value %q: %+v", v, innerErr)
+						return
+					}
+					result.LeaseTime = i
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
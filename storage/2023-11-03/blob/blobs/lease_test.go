@@ -117,7 +117,9 @@ func TestLeaseLifecycle(t *testing.T) {
 	breakLeaseInput := BreakLeaseInput{
 		LeaseID: leaseInfo.LeaseID,
 	}
-	if _, err := blobClient.BreakLease(ctx, containerName, fileName, breakLeaseInput); err != nil {
+	breakLeaseResult, err := blobClient.BreakLease(ctx, containerName, fileName, breakLeaseInput)
+	if err != nil {
 		t.Fatalf("Error breaking lease: %s", err)
 	}
+	t.Logf("[DEBUG] Lease Time remaining: %d", breakLeaseResult.LeaseTime)
 }
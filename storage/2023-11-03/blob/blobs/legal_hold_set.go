@@ -0,0 +1,117 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type SetLegalHoldInput struct {
+	// Should a Legal Hold be applied to the Blob? Setting this to false clears any existing Legal Hold.
+	LegalHold bool
+
+	// Optional - The ID of the Version to which the Legal Hold should be applied,
+	// rather than the current Version of the Blob
+	VersionID *string
+}
+
+type SetLegalHoldResponse struct {
+	HttpResponse *http.Response
+
+	// Whether a Legal Hold is applied to the Blob
+	LegalHold bool
+}
+
+// SetLegalHold applies (or clears) a Legal Hold on the specified Blob (or Version), during which the
+// Blob cannot be modified or deleted.
+func (c Client) SetLegalHold(ctx context.Context, containerName, blobName string, input SetLegalHoldInput) (result SetLegalHoldResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.VersionID != nil && *input.VersionID == "" {
+		return result, fmt.Errorf("`input.VersionID` should either be specified or nil, not an empty string")
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: setLegalHoldOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if v := resp.Header.Get("x-ms-legal-hold"); v != "" {
+				b, innerErr := strconv.ParseBool(v)
+				if innerErr != nil {
+					err = fmt.Errorf("parsing `x-ms-legal-hold` header value %q: %s", v, innerErr)
+					return
+				}
+				result.LegalHold = b
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type setLegalHoldOptions struct {
+	input SetLegalHoldInput
+}
+
+func (s setLegalHoldOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-legal-hold", strconv.FormatBool(s.input.LegalHold))
+	return headers
+}
+
+func (s setLegalHoldOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (s setLegalHoldOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "legalhold")
+
+	if s.input.VersionID != nil {
+		out.Append("versionid", *s.input.VersionID)
+	}
+
+	return out
+}
@@ -2,14 +2,20 @@ package blobs
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/jackofallops/giovanni/storage/2020-08-04/blob/containers"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
 	"github.com/jackofallops/giovanni/storage/internal/testhelpers"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 var _ StorageBlob = Client{}
@@ -63,6 +69,15 @@ func TestLifecycle(t *testing.T) {
 		t.Fatalf("adding authorizer to client: %+v", err)
 	}
 
+	t.Logf("[DEBUG] Checking the blob doesn't exist..")
+	exists, err := blobClient.Exists(ctx, containerName, fileName)
+	if err != nil {
+		t.Fatalf("checking if the blob exists: %+v", err)
+	}
+	if exists.Exists {
+		t.Fatalf("Expected the blob not to exist")
+	}
+
 	t.Logf("[DEBUG] Copying file to Blob Storage..")
 	copyInput := CopyInput{
 		CopySource: "http://releases.ubuntu.com/14.04/ubuntu-14.04.6-desktop-amd64.iso",
@@ -132,6 +147,18 @@ func TestLifecycle(t *testing.T) {
 		t.Fatalf("Expected `hello` to be `there` but got %q", details.MetaData["there"])
 	}
 
+	t.Logf("[DEBUG] Retrieving MetaData..")
+	metaData, err := blobClient.GetMetaData(ctx, containerName, fileName, GetMetaDataInput{})
+	if err != nil {
+		t.Fatalf("Error retrieving MetaData: %s", err)
+	}
+	if len(metaData.MetaData) != 1 {
+		t.Fatalf("Expected there to be 1 item of metadata but got %d", len(metaData.MetaData))
+	}
+	if metaData.MetaData["hello"] != "there" {
+		t.Fatalf("Expected `hello` to be `there` but got %q", metaData.MetaData["hello"])
+	}
+
 	t.Logf("[DEBUG] Retrieving the Block List..")
 	getBlockListInput := GetBlockListInput{
 		BlockListType: All,
@@ -149,10 +176,56 @@ func TestLifecycle(t *testing.T) {
 		t.Fatalf("Expected all blocks to be committed but got %d uncommitted blocks", len(blockList.UncommittedBlocks.Blocks))
 	}
 
+	t.Logf("[DEBUG] Streaming a range of the Blob..")
+	getReaderInput := GetReaderInput{
+		StartByte:          pointer.To(int64(0)),
+		EndByte:            pointer.To(int64(1023)),
+		RangeGetContentMD5: true,
+	}
+	reader, err := blobClient.GetReader(ctx, containerName, fileName, getReaderInput)
+	if err != nil {
+		t.Fatalf("Error streaming the Blob: %s", err)
+	}
+	contents, err := io.ReadAll(reader.Body)
+	reader.Body.Close()
+	if err != nil {
+		t.Fatalf("Error reading the streamed Blob: %s", err)
+	}
+	if len(contents) != 1024 {
+		t.Fatalf("Expected 1024 bytes to be streamed but got %d", len(contents))
+	}
+	hash := md5.Sum(contents)
+	if expected := base64.StdEncoding.EncodeToString(hash[:]); reader.ContentMD5 != expected {
+		t.Fatalf("Expected the Content-MD5 to be %q but got %q", expected, reader.ContentMD5)
+	}
+
+	t.Logf("[DEBUG] Setting Tags..")
+	setTagsInput := SetTagsInput{
+		Tags: BlobTags{
+			{Key: "project", Value: "giovanni"},
+		},
+	}
+	if _, err := blobClient.SetTags(ctx, containerName, fileName, setTagsInput); err != nil {
+		t.Fatalf("Error setting Tags: %s", err)
+	}
+
+	t.Logf("[DEBUG] Retrieving Tags..")
+	tagsResult, err := blobClient.GetTags(ctx, containerName, fileName, GetTagsInput{})
+	if err != nil {
+		t.Fatalf("Error retrieving Tags: %s", err)
+	}
+	if len(tagsResult.Tags) != 1 {
+		t.Fatalf("Expected there to be 1 Tag but got %d", len(tagsResult.Tags))
+	}
+	if v, _ := tagsResult.Tags.Get("project"); v != "giovanni" {
+		t.Fatalf("Expected `project` to be `giovanni` but got %q", v)
+	}
+
 	t.Logf("[DEBUG] Changing the Access Tiers..")
 	tiers := []AccessTier{
 		Hot,
 		Cool,
+		Cold,
 		Archive,
 	}
 	for _, tier := range tiers {
@@ -172,8 +245,27 @@ func TestLifecycle(t *testing.T) {
 		}
 	}
 
+	t.Logf("[DEBUG] Deleting Blob with a mismatched ETag")
+	mismatchedDeleteInput := DeleteInput{
+		AccessConditions: accessconditions.AccessConditions{
+			IfMatch: pointer.To("\"0x8D000000000000\""),
+		},
+	}
+	if _, err := blobClient.Delete(ctx, containerName, fileName, mismatchedDeleteInput); !responseerror.IsConditionNotMet(err) {
+		t.Fatalf("Expected a ConditionNotMetError when deleting with a mismatched ETag but got: %+v", err)
+	}
+
 	t.Logf("[DEBUG] Deleting Blob")
 	if _, err := blobClient.Delete(ctx, containerName, fileName, DeleteInput{}); err != nil {
 		t.Fatalf("Error deleting Blob: %s", err)
 	}
+
+	t.Logf("[DEBUG] Checking the blob doesn't exist..")
+	exists, err = blobClient.Exists(ctx, containerName, fileName)
+	if err != nil {
+		t.Fatalf("checking if the blob exists: %+v", err)
+	}
+	if exists.Exists {
+		t.Fatalf("Expected the blob not to exist")
+	}
 }
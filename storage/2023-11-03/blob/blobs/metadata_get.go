@@ -0,0 +1,114 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetMetaDataInput struct {
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+
+	// Optional - The Customer-Provided Key which was used to write the Blob, which must be specified to read a Blob
+	// written using a Customer-Provided Key
+	CustomerProvidedKey *CustomerProvidedKey
+}
+
+type GetMetaDataResponse struct {
+	HttpResponse *http.Response
+
+	MetaData map[string]string
+}
+
+// GetMetaData returns the MetaData associated with the specified Blob
+func (c Client) GetMetaData(ctx context.Context, containerName, blobName string, input GetMetaDataInput) (result GetMetaDataResponse, err error) {
+	if containerName == "" {
+		err = fmt.Errorf("`containerName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
+
+	if blobName == "" {
+		err = fmt.Errorf("`blobName` cannot be an empty string")
+		return
+	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, nil); err != nil {
+		return
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: getMetaDataOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.MetaData = metadata.ParseFromHeaders(resp.Header)
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type getMetaDataOptions struct {
+	input GetMetaDataInput
+}
+
+func (g getMetaDataOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if g.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *g.input.LeaseID)
+	}
+	headers.Merge(g.input.CustomerProvidedKey.headers())
+
+	return headers
+}
+
+func (g getMetaDataOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (g getMetaDataOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "metadata")
+	return out
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetMetaDataInput struct {
@@ -16,26 +20,36 @@ type SetMetaDataInput struct {
 	// This must be specified if a Lease is present on the Blob, else a 403 is returned
 	LeaseID *string
 
-	// Any metadata which should be added to this blob
+	// The metadata which should be assigned to this blob, replacing any existing metadata.
+	// Specifying an empty (or nil) map removes all existing metadata from the blob.
 	MetaData map[string]string
 
 	// The encryption scope for the blob.
 	EncryptionScope *string
+
+	// Optional - A Customer-Provided Key used to encrypt the Blob (which must match the key used to write the Blob), which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
 }
 
 type SetMetaDataResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
-// SetMetaData marks the specified blob or snapshot for deletion. The blob is later deleted during garbage collection.
+// SetMetaData sets the user-defined metadata for the specified blob, replacing any existing metadata.
 func (c Client) SetMetaData(ctx context.Context, containerName, blobName string, input SetMetaDataInput) (result SetMetaDataResponse, err error) {
 	if containerName == "" {
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -43,12 +57,25 @@ func (c Client) SetMetaData(ctx context.Context, containerName, blobName string,
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		err = fmt.Errorf("`input.LeaseID` should either be specified or nil, not an empty string")
+		return
+	}
 
 	if err = metadata.Validate(input.MetaData); err != nil {
 		err = fmt.Errorf(fmt.Sprintf("`input.MetaData` is not valid: %s.", err))
 		return
 	}
 
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusOK,
@@ -70,9 +97,18 @@ func (c Client) SetMetaData(ctx context.Context, containerName, blobName string,
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -92,6 +128,9 @@ func (s setMetadataOptions) ToHeaders() *client.Headers {
 		headers.Append("x-ms-encryption-scope", *s.input.EncryptionScope)
 	}
 	headers.Merge(metadata.SetMetaDataHeaders(s.input.MetaData))
+	headers.Merge(accessconditions.SetIntoHeaders(s.input.AccessConditions))
+	headers.Merge(s.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
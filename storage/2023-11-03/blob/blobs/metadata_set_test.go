@@ -0,0 +1,177 @@
+package blobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/metadata"
+)
+
+func TestSetMetaData(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var actual http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/container/blob.txt" || r.URL.Query().Get("comp") != "metadata" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		actual = r.Header.Clone()
+
+		w.Header().Set("ETag", "0x2")
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.SetMetaData(ctx, "container", "blob.txt", SetMetaDataInput{
+		LeaseID: pointer.To("lease-id"),
+		MetaData: map[string]string{
+			"hello": "world",
+		},
+		AccessConditions: accessconditions.AccessConditions{
+			IfMatch: pointer.To("0x1"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("setting metadata: %+v", err)
+	}
+	if result.ETag != "0x2" || !result.LastModified.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected response: %+v", result)
+	}
+	expected := map[string]string{
+		"x-ms-meta-hello": "world",
+		"x-ms-lease-id":   "lease-id",
+		"If-Match":        "0x1",
+	}
+	for k, v := range expected {
+		if actual.Get(k) != v {
+			t.Fatalf("expected the %q header to be %q but got %q", k, v, actual.Get(k))
+		}
+	}
+
+	// an empty map clears all of the existing metadata, so no metadata headers are sent
+	if _, err := blobClient.SetMetaData(ctx, "container", "blob.txt", SetMetaDataInput{
+		MetaData: map[string]string{},
+	}); err != nil {
+		t.Fatalf("clearing metadata: %+v", err)
+	}
+	for k := range actual {
+		if strings.HasPrefix(strings.ToLower(k), "x-ms-meta-") {
+			t.Fatalf("expected no metadata headers when clearing the metadata but got %q", k)
+		}
+	}
+}
+
+func TestSetMetaDataValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input SetMetaDataInput
+	}{
+		{
+			Name: "Empty LeaseID",
+			Input: SetMetaDataInput{
+				LeaseID: pointer.To(""),
+			},
+		},
+		{
+			Name: "Invalid MetaData Key",
+			Input: SetMetaDataInput{
+				MetaData: map[string]string{
+					"1invalid": "value",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).SetMetaData(ctx, "container", "blob.txt", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestSetAndGetMetaDataWithMixedCaseKeys(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	stored := http.Header{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/container/blob.txt" || r.URL.Query().Get("comp") != "metadata" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			for k, v := range r.Header {
+				if strings.HasPrefix(strings.ToLower(k), "x-ms-meta-") {
+					stored[k] = v
+				}
+			}
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			for k, v := range stored {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	input := map[string]string{
+		"HelloWorld": "value",
+		"ABC_123":    "another",
+		"lower":      "case",
+	}
+	if _, err := blobClient.SetMetaData(ctx, "container", "blob.txt", SetMetaDataInput{MetaData: input}); err != nil {
+		t.Fatalf("setting metadata: %+v", err)
+	}
+
+	result, err := blobClient.GetMetaData(ctx, "container", "blob.txt", GetMetaDataInput{})
+	if err != nil {
+		t.Fatalf("retrieving metadata: %+v", err)
+	}
+
+	// the casing of the keys isn't retained in the headers, so they're returned lower-cased..
+	for k, v := range input {
+		if actual := result.MetaData[strings.ToLower(k)]; actual != v {
+			t.Fatalf("expected %q to be %q but got %q", strings.ToLower(k), v, actual)
+		}
+	}
+
+	// ..but can be restored from the MetaData which was set
+	restored := metadata.WithCasingOf(result.MetaData, input)
+	if len(restored) != len(input) {
+		t.Fatalf("expected %d keys but got %d: %+v", len(input), len(restored), restored)
+	}
+	for k, v := range input {
+		if actual := restored[k]; actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+}
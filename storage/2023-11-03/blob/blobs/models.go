@@ -4,6 +4,7 @@ type AccessTier string
 
 var (
 	Archive AccessTier = "Archive"
+	Cold    AccessTier = "Cold"
 	Cool    AccessTier = "Cool"
 	Hot     AccessTier = "Hot"
 )
@@ -12,6 +13,7 @@ type ArchiveStatus string
 
 var (
 	None                   ArchiveStatus = ""
+	RehydratePendingToCold ArchiveStatus = "rehydrate-pending-to-cold"
 	RehydratePendingToCool ArchiveStatus = "rehydrate-pending-to-cool"
 	RehydratePendingToHot  ArchiveStatus = "rehydrate-pending-to-hot"
 )
@@ -53,6 +55,42 @@ var (
 	Success CopyStatus = "success"
 )
 
+type DeleteSnapshotsOption string
+
+var (
+	// IncludeSnapshots deletes the base Blob along with all of its Snapshots
+	IncludeSnapshots DeleteSnapshotsOption = "include"
+
+	// OnlySnapshots deletes all of the Snapshots for the Blob, but not the base Blob
+	OnlySnapshots DeleteSnapshotsOption = "only"
+)
+
+type ExpiryOption string
+
+var (
+	// Absolute sets the expiry to the specified RFC1123 date/time
+	Absolute ExpiryOption = "Absolute"
+
+	// NeverExpire removes any existing expiry from the Blob
+	NeverExpire ExpiryOption = "NeverExpire"
+
+	// RelativeToCreation sets the expiry to the specified number of milliseconds after the Blob was created
+	RelativeToCreation ExpiryOption = "RelativeToCreation"
+
+	// RelativeToNow sets the expiry to the specified number of milliseconds from now
+	RelativeToNow ExpiryOption = "RelativeToNow"
+)
+
+type ImmutabilityPolicyMode string
+
+var (
+	// Locked Immutability Policies cannot be deleted, and their expiry can only be extended
+	ImmutabilityPolicyModeLocked ImmutabilityPolicyMode = "Locked"
+
+	// Unlocked Immutability Policies can be modified or deleted
+	ImmutabilityPolicyModeUnlocked ImmutabilityPolicyMode = "Unlocked"
+)
+
 type LeaseDuration string
 
 var (
@@ -77,6 +115,13 @@ var (
 	Unlocked LeaseStatus = "unlocked"
 )
 
+type RehydratePriority string
+
+var (
+	High     RehydratePriority = "High"
+	Standard RehydratePriority = "Standard"
+)
+
 type UncommittedBlocks struct {
 	Blocks []Block `xml:"Block"`
 }
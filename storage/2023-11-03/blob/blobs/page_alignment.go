@@ -0,0 +1,28 @@
+package blobs
+
+import "fmt"
+
+// pageSizeInBytes is the size of a single Page within a Page Blob, all ranges must be aligned to this boundary
+const pageSizeInBytes = 512
+
+// validatePageRange ensures that the inclusive range [startByte, endByte] is aligned to a 512-byte page boundary,
+// that is, that the range starts at a multiple of 512 bytes and covers a whole number of pages
+func validatePageRange(startByte, endByte int64) error {
+	if startByte < 0 {
+		return fmt.Errorf("`input.StartByte` must be greater than or equal to 0")
+	}
+
+	if endByte <= startByte {
+		return fmt.Errorf("`input.EndByte` (%d) must be greater than `input.StartByte` (%d)", endByte, startByte)
+	}
+
+	if startByte%pageSizeInBytes != 0 {
+		return fmt.Errorf("`input.StartByte` (%d) must be aligned to a %d-byte boundary", startByte, pageSizeInBytes)
+	}
+
+	if (endByte+1)%pageSizeInBytes != 0 {
+		return fmt.Errorf("`input.EndByte` (%d) must be one less than a multiple of %d bytes, such that the range covers whole pages", endByte, pageSizeInBytes)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package blobs
+
+import "testing"
+
+func TestValidatePageRange(t *testing.T) {
+	testData := []struct {
+		StartByte     int64
+		EndByte       int64
+		ShouldBeValid bool
+	}{
+		{
+			StartByte:     0,
+			EndByte:       511,
+			ShouldBeValid: true,
+		},
+		{
+			StartByte:     512,
+			EndByte:       2047,
+			ShouldBeValid: true,
+		},
+		{
+			StartByte:     0,
+			EndByte:       512,
+			ShouldBeValid: false,
+		},
+		{
+			StartByte:     1,
+			EndByte:       511,
+			ShouldBeValid: false,
+		},
+		{
+			StartByte:     -512,
+			EndByte:       511,
+			ShouldBeValid: false,
+		},
+		{
+			StartByte:     1024,
+			EndByte:       511,
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %d-%d", v.StartByte, v.EndByte)
+
+		err := validatePageRange(v.StartByte, v.EndByte)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("Expected %d-%d to be valid but got an error: %s", v.StartByte, v.EndByte, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("Expected %d-%d to be invalid but didn't get an error", v.StartByte, v.EndByte)
+		}
+	}
+}
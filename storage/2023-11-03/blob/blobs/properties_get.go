@@ -5,17 +5,25 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetPropertiesInput struct {
 	// The ID of the Lease
 	// This must be specified if a Lease is present on the Blob, else a 403 is returned
 	LeaseID *string
+
+	// The ID of the Version whose properties should be returned, rather than the current Version of the Blob
+	VersionID *string
+
+	// Optional - The Customer-Provided Key which was used to write the Blob, which must be specified to retrieve
+	// the properties of a Blob written using a Customer-Provided Key
+	CustomerProvidedKey *CustomerProvidedKey
 }
 
 type GetPropertiesResponse struct {
@@ -139,6 +147,9 @@ type GetPropertiesResponse struct {
 	// The ETag contains a value that you can use to perform operations conditionally
 	ETag string
 
+	// The date/time at which the blob expires, returned when an expiry has been set using SetExpiry
+	ExpiryTime string
+
 	// Included if the blob is incremental copy blob.
 	IncrementalCopy bool
 
@@ -161,6 +172,16 @@ type GetPropertiesResponse struct {
 
 	// The encryption scope for the request content.
 	EncryptionScope string
+
+	// The base64-encoded SHA256 hash of the Customer-Provided Key used to encrypt the Blob, if any
+	EncryptionKeySHA256 string
+
+	// The ID of the Version of the Blob, returned when Versioning is enabled for the Storage Account
+	VersionID string
+
+	// Is this the current Version of the Blob? This is only returned when Versioning is enabled
+	// for the Storage Account and a Version other than the current Version is requested.
+	IsCurrentVersion *bool
 }
 
 // GetProperties returns all user-defined metadata, standard HTTP properties, and system properties for the blob
@@ -169,14 +190,25 @@ func (c Client) GetProperties(ctx context.Context, containerName, blobName strin
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 	if blobName == "" {
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+	if input.VersionID != nil && *input.VersionID == "" {
+		err = fmt.Errorf("`input.VersionID` should either be specified or nil, not an empty string")
+		return
+	}
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, nil); err != nil {
+		return
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -184,7 +216,9 @@ func (c Client) GetProperties(ctx context.Context, containerName, blobName strin
 		},
 		HttpMethod: http.MethodHead,
 		OptionsObject: getPropertiesOptions{
-			leaseID: input.LeaseID,
+			customerProvidedKey: input.CustomerProvidedKey,
+			leaseID:             input.LeaseID,
+			versionID:           input.VersionID,
 		},
 		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
 	}
@@ -223,12 +257,15 @@ func (c Client) GetProperties(ctx context.Context, containerName, blobName strin
 				result.CopyStatusDescription = resp.Header.Get("x-ms-copy-status-description")
 				result.CreationTime = resp.Header.Get("x-ms-creation-time")
 				result.ETag = resp.Header.Get("Etag")
+				result.ExpiryTime = resp.Header.Get("x-ms-expiry-time")
 				result.LastModified = resp.Header.Get("Last-Modified")
 				result.LeaseDuration = LeaseDuration(resp.Header.Get("x-ms-lease-duration"))
 				result.LeaseState = LeaseState(resp.Header.Get("x-ms-lease-state"))
 				result.LeaseStatus = LeaseStatus(resp.Header.Get("x-ms-lease-status"))
 				result.EncryptionScope = resp.Header.Get("x-ms-encryption-scope")
+				result.EncryptionKeySHA256 = resp.Header.Get("x-ms-encryption-key-sha256")
 				result.MetaData = metadata.ParseFromHeaders(resp.Header)
+				result.VersionID = resp.Header.Get("x-ms-version-id")
 
 				if v := resp.Header.Get("x-ms-access-tier-inferred"); v != "" {
 					b, innerErr := strconv.ParseBool(v)
@@ -264,11 +301,20 @@ func (c Client) GetProperties(ctx context.Context, containerName, blobName strin
 					}
 					result.ServerEncrypted = b
 				}
+
+				if v := resp.Header.Get("x-ms-is-current-version"); v != "" {
+					b, innerErr := strconv.ParseBool(v)
+					if innerErr != nil {
+						err = fmt.Errorf("parsing `x-ms-is-current-version` header value %q: %s", v, innerErr)
+						return
+					}
+					result.IsCurrentVersion = &b
+				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -276,7 +322,9 @@ func (c Client) GetProperties(ctx context.Context, containerName, blobName strin
 }
 
 type getPropertiesOptions struct {
-	leaseID *string
+	customerProvidedKey *CustomerProvidedKey
+	leaseID             *string
+	versionID           *string
 }
 
 func (g getPropertiesOptions) ToHeaders() *client.Headers {
@@ -285,6 +333,7 @@ func (g getPropertiesOptions) ToHeaders() *client.Headers {
 	if g.leaseID != nil {
 		headers.Append("x-ms-lease-id", *g.leaseID)
 	}
+	headers.Merge(g.customerProvidedKey.headers())
 	return headers
 }
 
@@ -293,5 +342,9 @@ func (g getPropertiesOptions) ToOData() *odata.Query {
 }
 
 func (g getPropertiesOptions) ToQuery() *client.QueryParams {
-	return nil
+	out := &client.QueryParams{}
+	if g.versionID != nil {
+		out.Append("versionid", *g.versionID)
+	}
+	return out
 }
@@ -5,30 +5,56 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
+// SetPropertiesInput specifies the System Properties which should be set on the Blob.
+//
+// NOTE: the service replaces all of the HTTP Headers for the Blob (CacheControl, ContentType, ContentMD5,
+// ContentEncoding, ContentLanguage and ContentDisposition) - as such any of these which aren't specified
+// are cleared. To update a single property, the existing values should be retrieved using GetProperties
+// and specified alongside the updated value.
 type SetPropertiesInput struct {
-	CacheControl         *string
-	ContentType          *string
-	ContentMD5           *string
-	ContentEncoding      *string
-	ContentLanguage      *string
-	LeaseID              *string
-	ContentDisposition   *string
-	ContentLength        *int64
+	CacheControl       *string
+	ContentType        *string
+	ContentMD5         *string
+	ContentEncoding    *string
+	ContentLanguage    *string
+	LeaseID            *string
+	ContentDisposition *string
+
+	// Optional - Resizes the Blob to the specified size, which must be aligned to a 512-byte boundary.
+	// This is only supported for Page Blobs.
+	ContentLength *int64
+
+	// Optional - Specifies how the BlobSequenceNumber of a Page Blob should be modified
 	SequenceNumberAction *SequenceNumberAction
 	BlobSequenceNumber   *string
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
 }
 
 type SetPropertiesResponse struct {
 	HttpResponse *http.Response
 
 	BlobSequenceNumber string
-	Etag               string
+
+	// The ETag of the Blob after the operation
+	ETag string
+
+	// Deprecated: use ETag instead - this is retained for compatibility and contains the same value
+	Etag string
+
+	// The date/time that the Blob was last modified
+	LastModified time.Time
 }
 
 // SetProperties sets system properties on the blob.
@@ -37,13 +63,24 @@ func (c Client) SetProperties(ctx context.Context, containerName, blobName strin
 		return result, fmt.Errorf("`containerName` cannot be an empty string")
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		return result, fmt.Errorf("`containerName` must be a lower-cased string")
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
 	}
 
 	if blobName == "" {
 		return result, fmt.Errorf("`blobName` cannot be an empty string")
 	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		return result, fmt.Errorf("`input.LeaseID` should either be specified or nil, not an empty string")
+	}
+
+	if input.ContentLength != nil && (*input.ContentLength < 0 || *input.ContentLength%pageSizeInBytes != 0) {
+		return result, fmt.Errorf("`input.ContentLength` must be aligned to a 512-byte boundary but got %d", *input.ContentLength)
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -66,9 +103,20 @@ func (c Client) SetProperties(ctx context.Context, containerName, blobName strin
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.BlobSequenceNumber = resp.Header.Get("x-ms-blob-sequence-number")
+				result.ETag = resp.Header.Get("ETag")
+				result.Etag = result.ETag
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -109,7 +157,7 @@ func (s setPropertiesOptions) ToHeaders() *client.Headers {
 		headers.Append("x-ms-blob-content-type", *s.input.ContentType)
 	}
 	if s.input.ContentLength != nil {
-		headers.Append("x-ms-blob-content-length", strconv.Itoa(int(*s.input.ContentLength)))
+		headers.Append("x-ms-blob-content-length", strconv.FormatInt(*s.input.ContentLength, 10))
 	}
 	if s.input.LeaseID != nil {
 		headers.Append("x-ms-lease-id", *s.input.LeaseID)
@@ -121,6 +169,8 @@ func (s setPropertiesOptions) ToHeaders() *client.Headers {
 		headers.Append("x-ms-blob-sequence-number", *s.input.BlobSequenceNumber)
 	}
 
+	headers.Merge(accessconditions.SetIntoHeaders(s.input.AccessConditions))
+
 	return headers
 }
 
@@ -0,0 +1,41 @@
+package blobs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestSetPropertiesHeaders(t *testing.T) {
+	input := SetPropertiesInput{
+		CacheControl:       pointer.To("no-cache"),
+		ContentDisposition: pointer.To("attachment"),
+		ContentEncoding:    pointer.To("gzip"),
+		ContentLanguage:    pointer.To("en-GB"),
+		ContentMD5:         pointer.To("abc123=="),
+		ContentType:        pointer.To("text/plain"),
+		ContentLength:      pointer.To(int64(5 * 1024 * 1024 * 1024)),
+	}
+	headers := setPropertiesOptions{input: input}.ToHeaders().Headers()
+
+	expected := map[string]string{
+		"x-ms-blob-cache-control":       "no-cache",
+		"x-ms-blob-content-disposition": "attachment",
+		"x-ms-blob-content-encoding":    "gzip",
+		"x-ms-blob-content-language":    "en-GB",
+		"x-ms-blob-content-md5":         "abc123==",
+		"x-ms-blob-content-type":        "text/plain",
+		"x-ms-blob-content-length":      "5368709120",
+	}
+	for k, v := range expected {
+		if actual := headers.Get(k); actual != v {
+			t.Fatalf("expected the header %q to be %q but got %q", k, v, actual)
+		}
+	}
+
+	// unspecified properties are omitted, which clears them
+	headers = setPropertiesOptions{input: SetPropertiesInput{ContentType: pointer.To("text/plain")}}.ToHeaders().Headers()
+	if v := headers.Get("x-ms-blob-cache-control"); v != "" {
+		t.Fatalf("expected the header `x-ms-blob-cache-control` to be omitted but got %q", v)
+	}
+}
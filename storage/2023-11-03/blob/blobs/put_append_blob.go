@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/tags"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PutAppendBlobInput struct {
@@ -21,10 +26,23 @@ type PutAppendBlobInput struct {
 	LeaseID            *string
 	EncryptionScope    *string
 	MetaData           map[string]string
+
+	// Optional - The Index Tags which should be assigned to this Blob, replacing any existing Tags.
+	// At most 10 Tags can be specified, Keys must be between 1 and 128 characters and Values at most 256 characters.
+	Tags map[string]string
+
+	// Optional - A Customer-Provided Key used to encrypt the Blob, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
 }
 
 type PutAppendBlobResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // PutAppendBlob is a wrapper around the Put API call (with a stricter input object)
@@ -35,8 +53,8 @@ func (c Client) PutAppendBlob(ctx context.Context, containerName, blobName strin
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -44,12 +62,25 @@ func (c Client) PutAppendBlob(ctx context.Context, containerName, blobName strin
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if err = metadata.Validate(input.MetaData); err != nil {
 		err = fmt.Errorf(fmt.Sprintf("`input.MetaData` is not valid: %s.", err))
 		return
 	}
 
+	if err = tags.Validate(input.Tags); err != nil {
+		err = fmt.Errorf("`input.Tags` is not valid: %s", err)
+		return
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
@@ -71,9 +102,18 @@ func (c Client) PutAppendBlob(ctx context.Context, containerName, blobName strin
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -115,7 +155,16 @@ func (p putAppendBlobOptions) ToHeaders() *client.Headers {
 		headers.Append("x-ms-encryption-scope", *p.input.EncryptionScope)
 	}
 
+	if len(p.input.Tags) > 0 {
+		headers.Append("x-ms-tags", tags.ToHeaderValue(p.input.Tags))
+	}
+
 	headers.Merge(metadata.SetMetaDataHeaders(p.input.MetaData))
+
+	headers.Merge(accessconditions.SetIntoHeaders(p.input.AccessConditions))
+
+	headers.Merge(p.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
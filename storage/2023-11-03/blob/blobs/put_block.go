@@ -7,10 +7,13 @@ import (
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/checksum"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PutBlockInput struct {
@@ -19,11 +22,27 @@ type PutBlockInput struct {
 	ContentMD5      *string
 	LeaseID         *string
 	EncryptionScope *string
+
+	// The base64-encoded CRC64 checksum of the block content, used to verify the integrity of the block
+	// during transport. This cannot be specified alongside ContentMD5.
+	ContentCRC64 *string
+
+	// Should the MD5 hash of the block content be computed and sent as the `Content-MD5` header?
+	// This cannot be specified alongside ContentMD5, ContentCRC64 or ComputeContentCRC64.
+	ComputeContentMD5 bool
+
+	// Should the CRC64 checksum of the block content be computed and sent as the `x-ms-content-crc64` header?
+	// This cannot be specified alongside ContentMD5, ContentCRC64 or ComputeContentMD5.
+	ComputeContentCRC64 bool
+
+	// Optional - A Customer-Provided Key used to encrypt the Block, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
 }
 
 type PutBlockResponse struct {
 	HttpResponse *http.Response
 	ContentMD5   string
+	ContentCRC64 string
 }
 
 // PutBlock creates a new block to be committed as part of a blob.
@@ -33,8 +52,8 @@ func (c Client) PutBlock(ctx context.Context, containerName, blobName string, in
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -42,6 +61,10 @@ func (c Client) PutBlock(ctx context.Context, containerName, blobName string, in
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.BlockID == "" {
 		err = fmt.Errorf("`input.BlockID` cannot be an empty string")
@@ -53,6 +76,29 @@ func (c Client) PutBlock(ctx context.Context, containerName, blobName string, in
 		return
 	}
 
+	checksums := 0
+	for _, v := range []bool{input.ContentMD5 != nil, input.ContentCRC64 != nil, input.ComputeContentMD5, input.ComputeContentCRC64} {
+		if v {
+			checksums++
+		}
+	}
+	if checksums > 1 {
+		err = fmt.Errorf("at most one of `input.ContentMD5`, `input.ContentCRC64`, `input.ComputeContentMD5` and `input.ComputeContentCRC64` can be specified")
+		return
+	}
+
+	if input.ComputeContentMD5 {
+		input.ContentMD5 = pointer.To(checksum.MD5(input.Content))
+	}
+
+	if input.ComputeContentCRC64 {
+		input.ContentCRC64 = pointer.To(checksum.CRC64(input.Content))
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
@@ -77,9 +123,16 @@ func (c Client) PutBlock(ctx context.Context, containerName, blobName string, in
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ContentMD5 = resp.Header.Get("Content-MD5")
+				result.ContentCRC64 = resp.Header.Get("x-ms-content-crc64")
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -95,7 +148,10 @@ func (p putBlockOptions) ToHeaders() *client.Headers {
 	headers.Append("Content-Length", strconv.Itoa(len(p.input.Content)))
 
 	if p.input.ContentMD5 != nil {
-		headers.Append("x-ms-blob-content-md5", *p.input.ContentMD5)
+		headers.Append("Content-MD5", *p.input.ContentMD5)
+	}
+	if p.input.ContentCRC64 != nil {
+		headers.Append("x-ms-content-crc64", *p.input.ContentCRC64)
 	}
 	if p.input.LeaseID != nil {
 		headers.Append("x-ms-lease-id", *p.input.LeaseID)
@@ -104,6 +160,8 @@ func (p putBlockOptions) ToHeaders() *client.Headers {
 		headers.Append("x-ms-encryption-scope", *p.input.EncryptionScope)
 	}
 
+	headers.Merge(p.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
@@ -7,13 +7,22 @@ import (
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/tags"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
+// maxPutBlockBlobSize is the maximum size of the content which can be uploaded in a single Put Blob request,
+// larger Block Blobs must instead be uploaded as Blocks which are then committed using PutBlockList
+const maxPutBlockBlobSize = 5000 * 1024 * 1024
+
 type PutBlockBlobInput struct {
 	CacheControl       *string
 	Content            *[]byte
@@ -25,22 +34,36 @@ type PutBlockBlobInput struct {
 	LeaseID            *string
 	EncryptionScope    *string
 	MetaData           map[string]string
+
+	// Optional - The Index Tags which should be assigned to this Blob, replacing any existing Tags.
+	// At most 10 Tags can be specified, Keys must be between 1 and 128 characters and Values at most 256 characters.
+	Tags map[string]string
+
+	// Optional - A Customer-Provided Key used to encrypt the Blob, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
 }
 
 type PutBlockBlobResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // PutBlockBlob is a wrapper around the Put API call (with a stricter input object)
-// which creates a new block append blob, or updates the content of an existing block blob.
+// which creates a new block blob, or updates the content of an existing block blob, in a single request.
+// The Content can be at most 5000 MiB, larger Blobs must be uploaded using PutBlock and PutBlockList.
 func (c Client) PutBlockBlob(ctx context.Context, containerName, blobName string, input PutBlockBlobInput) (result PutBlockBlobResponse, err error) {
 	if containerName == "" {
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -48,17 +71,35 @@ func (c Client) PutBlockBlob(ctx context.Context, containerName, blobName string
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.Content != nil && len(*input.Content) == 0 {
 		err = fmt.Errorf("`input.Content` must either be nil or not empty")
 		return
 	}
 
+	if input.Content != nil && len(*input.Content) > maxPutBlockBlobSize {
+		err = fmt.Errorf("`input.Content` can be at most %d bytes (5000 MiB) but got %d bytes, larger Blobs must be uploaded using PutBlock and PutBlockList", maxPutBlockBlobSize, len(*input.Content))
+		return
+	}
+
 	if err = metadata.Validate(input.MetaData); err != nil {
 		err = fmt.Errorf(fmt.Sprintf("`input.MetaData` is not valid: %s.", err))
 		return
 	}
 
+	if err = tags.Validate(input.Tags); err != nil {
+		err = fmt.Errorf("`input.Tags` is not valid: %s", err)
+		return
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
@@ -89,9 +130,18 @@ func (c Client) PutBlockBlob(ctx context.Context, containerName, blobName string
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -134,8 +184,16 @@ func (p putBlockBlobOptions) ToHeaders() *client.Headers {
 		headers.Append("Content-Length", strconv.Itoa(len(*p.input.Content)))
 	}
 
+	if len(p.input.Tags) > 0 {
+		headers.Append("x-ms-tags", tags.ToHeaderValue(p.input.Tags))
+	}
+
 	headers.Merge(metadata.SetMetaDataHeaders(p.input.MetaData))
 
+	headers.Merge(accessconditions.SetIntoHeaders(p.input.AccessConditions))
+
+	headers.Merge(p.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
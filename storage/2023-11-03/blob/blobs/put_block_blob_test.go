@@ -0,0 +1,111 @@
+package blobs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestPutBlockBlob(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/container/blob.txt" || string(body) != "hello world" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		expected := map[string]string{
+			"x-ms-blob-type":         "BlockBlob",
+			"x-ms-blob-content-type": "text/plain",
+			"x-ms-meta-hello":        "world",
+			"x-ms-tags":              "env=test&project=giovanni",
+		}
+		for k, v := range expected {
+			if actual := r.Header.Get(k); actual != v {
+				t.Errorf("expected the %q header to be %q but got %q", k, v, actual)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		w.Header().Set("ETag", "0x1")
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.PutBlockBlob(ctx, "container", "blob.txt", PutBlockBlobInput{
+		Content:     pointer.To([]byte("hello world")),
+		ContentType: pointer.To("text/plain"),
+		MetaData: map[string]string{
+			"hello": "world",
+		},
+		Tags: map[string]string{
+			"project": "giovanni",
+			"env":     "test",
+		},
+	})
+	if err != nil {
+		t.Fatalf("putting block blob: %+v", err)
+	}
+	if result.ETag != "0x1" {
+		t.Fatalf("expected the ETag to be %q but got %q", "0x1", result.ETag)
+	}
+	if expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); !result.LastModified.Equal(expected) {
+		t.Fatalf("expected the LastModified to be %s but got %s", expected, result.LastModified)
+	}
+}
+
+func TestPutBlockBlobValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input PutBlockBlobInput
+	}{
+		{
+			Name: "Empty Content",
+			Input: PutBlockBlobInput{
+				Content: pointer.To([]byte{}),
+			},
+		},
+		{
+			Name: "Invalid Tag Key",
+			Input: PutBlockBlobInput{
+				Tags: map[string]string{
+					"": "value",
+				},
+			},
+		},
+		{
+			Name: "Too Many Tags",
+			Input: PutBlockBlobInput{
+				Tags: map[string]string{
+					"a": "1", "b": "2", "c": "3", "d": "4", "e": "5", "f": "6",
+					"g": "7", "h": "8", "i": "9", "j": "10", "k": "11",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).PutBlockBlob(ctx, "container", "blob.txt", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/tags"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type BlockList struct {
@@ -32,6 +37,16 @@ type PutBlockListInput struct {
 	LeaseID            *string
 	EncryptionScope    *string
 	MetaData           map[string]string
+
+	// Optional - The Index Tags which should be assigned to this Blob, replacing any existing Tags.
+	// At most 10 Tags can be specified, Keys must be between 1 and 128 characters and Values at most 256 characters.
+	Tags map[string]string
+
+	// Optional - A Customer-Provided Key used to encrypt the Blob, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
 }
 
 type PutBlockListResponse struct {
@@ -39,7 +54,7 @@ type PutBlockListResponse struct {
 
 	ContentMD5   string
 	ETag         string
-	LastModified string
+	LastModified time.Time
 }
 
 // PutBlockList writes a blob by specifying the list of block IDs that make up the blob.
@@ -51,8 +66,8 @@ func (c Client) PutBlockList(ctx context.Context, containerName, blobName string
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -60,8 +75,22 @@ func (c Client) PutBlockList(ctx context.Context, containerName, blobName string
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+
+	if err = tags.Validate(input.Tags); err != nil {
+		err = fmt.Errorf("`input.Tags` is not valid: %s", err)
+		return
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
 
 	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
 		},
@@ -93,12 +122,14 @@ func (c Client) PutBlockList(ctx context.Context, containerName, blobName string
 			if resp.Header != nil {
 				result.ContentMD5 = resp.Header.Get("Content-MD5")
 				result.ETag = resp.Header.Get("ETag")
-				result.LastModified = resp.Header.Get("Last-Modified")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -137,8 +168,16 @@ func (p putBlockListOptions) ToHeaders() *client.Headers {
 		headers.Append("x-ms-encryption-scope", *p.input.EncryptionScope)
 	}
 
+	if len(p.input.Tags) > 0 {
+		headers.Append("x-ms-tags", tags.ToHeaderValue(p.input.Tags))
+	}
+
 	headers.Merge(metadata.SetMetaDataHeaders(p.input.MetaData))
 
+	headers.Merge(accessconditions.SetIntoHeaders(p.input.AccessConditions))
+
+	headers.Merge(p.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
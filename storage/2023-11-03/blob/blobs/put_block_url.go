@@ -4,25 +4,54 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PutBlockFromURLInput struct {
-	BlockID    string
+	// The ID of the Block, which must be a base64-encoded string of at most 64 bytes (before encoding) - and the
+	// same length for all Blocks within the Blob
+	BlockID string
+
+	// The URL of the source Blob, up to 2 KB in length, from which the contents of the Block are read. The source
+	// Blob must either be public, be authorized using a Shared Access Signature within the URL, or be
+	// authorized using CopySourceAuthorization.
 	CopySource string
 
-	ContentMD5      *string
-	LeaseID         *string
-	Range           *string
+	// An optional OAuth Bearer Token (in the format `Bearer {token}`) used to authorize access to the source Blob
+	CopySourceAuthorization *string
+
+	// An MD5 hash of the contents of the source range, which the Storage Service verifies the contents read from
+	// the source against
+	ContentMD5 *string
+
+	// The ID of the Lease, which must be specified if the destination Blob has an active Lease
+	LeaseID *string
+
+	// The (inclusive) range of bytes to read from the source Blob, in the format `bytes={start}-{end}`.
+	// The entire source Blob is read when this isn't specified.
+	Range *string
+
+	// The encryption scope to set for the request content.
 	EncryptionScope *string
+
+	// Optional - A Customer-Provided Key used to encrypt the Block, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
 }
 
 type PutBlockFromURLResponse struct {
-	ContentMD5   string
 	HttpResponse *http.Response
+
+	// The MD5 hash of the Block, when reported by the Storage Service
+	ContentMD5 string
+
+	// The CRC64 checksum of the Block, when reported by the Storage Service
+	ContentCRC64 string
 }
 
 // PutBlockFromURL creates a new block to be committed as part of a blob where the contents are read from a URL
@@ -32,8 +61,8 @@ func (c Client) PutBlockFromURL(ctx context.Context, containerName, blobName str
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -41,6 +70,10 @@ func (c Client) PutBlockFromURL(ctx context.Context, containerName, blobName str
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.BlockID == "" {
 		err = fmt.Errorf("`input.BlockID` cannot be an empty string")
@@ -52,6 +85,22 @@ func (c Client) PutBlockFromURL(ctx context.Context, containerName, blobName str
 		return
 	}
 
+	if input.CopySourceAuthorization != nil && *input.CopySourceAuthorization == "" {
+		err = fmt.Errorf("`input.CopySourceAuthorization` should either be specified or nil, not an empty string")
+		return
+	}
+
+	if input.Range != nil {
+		if err = validateSourceRange(*input.Range); err != nil {
+			err = fmt.Errorf("`input.Range` is not valid: %+v", err)
+			return
+		}
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
@@ -77,11 +126,12 @@ func (c Client) PutBlockFromURL(ctx context.Context, containerName, blobName str
 		if err == nil {
 			if resp.Header != nil {
 				result.ContentMD5 = resp.Header.Get("Content-MD5")
+				result.ContentCRC64 = resp.Header.Get("x-ms-content-crc64")
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -97,6 +147,9 @@ func (p putBlockUrlOptions) ToHeaders() *client.Headers {
 
 	headers.Append("x-ms-copy-source", p.input.CopySource)
 
+	if p.input.CopySourceAuthorization != nil {
+		headers.Append("x-ms-copy-source-authorization", *p.input.CopySourceAuthorization)
+	}
 	if p.input.ContentMD5 != nil {
 		headers.Append("x-ms-source-content-md5", *p.input.ContentMD5)
 	}
@@ -109,6 +162,8 @@ func (p putBlockUrlOptions) ToHeaders() *client.Headers {
 	if p.input.EncryptionScope != nil {
 		headers.Append("x-ms-encryption-scope", *p.input.EncryptionScope)
 	}
+	headers.Merge(p.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
@@ -122,3 +177,27 @@ func (p putBlockUrlOptions) ToQuery() *client.QueryParams {
 	out.Append("blockid", p.input.BlockID)
 	return out
 }
+
+// validateSourceRange validates that `input` is an (inclusive) range of bytes in the format `bytes={start}-{end}`
+func validateSourceRange(input string) error {
+	rangeRaw, ok := strings.CutPrefix(input, "bytes=")
+	if !ok {
+		return fmt.Errorf("expected the range to be in the format `bytes={start}-{end}` but got %q", input)
+	}
+
+	startRaw, endRaw, ok := strings.Cut(rangeRaw, "-")
+	if !ok {
+		return fmt.Errorf("expected the range to be in the format `bytes={start}-{end}` but got %q", input)
+	}
+
+	start, err := strconv.ParseInt(startRaw, 10, 64)
+	if err != nil || start < 0 {
+		return fmt.Errorf("expected the start of the range to be a non-negative integer but got %q", startRaw)
+	}
+	end, err := strconv.ParseInt(endRaw, 10, 64)
+	if err != nil || end < start {
+		return fmt.Errorf("expected the end of the range to be an integer greater than or equal to the start of the range but got %q", endRaw)
+	}
+
+	return nil
+}
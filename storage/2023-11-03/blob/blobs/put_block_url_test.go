@@ -0,0 +1,86 @@
+package blobs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestValidateSourceRange(t *testing.T) {
+	testData := []struct {
+		Input         string
+		ShouldBeValid bool
+	}{
+		{
+			Input:         "bytes=0-1023",
+			ShouldBeValid: true,
+		},
+		{
+			Input:         "bytes=512-512",
+			ShouldBeValid: true,
+		},
+		{
+			Input:         "0-1023",
+			ShouldBeValid: false,
+		},
+		{
+			Input:         "bytes=1024",
+			ShouldBeValid: false,
+		},
+		{
+			Input:         "bytes=0-",
+			ShouldBeValid: false,
+		},
+		{
+			Input:         "bytes=1024-0",
+			ShouldBeValid: false,
+		},
+		{
+			Input:         "bytes=-1-10",
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		err := validateSourceRange(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Input)
+		}
+	}
+}
+
+func TestPutBlockFromURLOptions(t *testing.T) {
+	options := putBlockUrlOptions{
+		input: PutBlockFromURLInput{
+			BlockID:                 "YmxvY2sx",
+			CopySource:              "https://example.blob.core.windows.net/container/source?sv=2023-11-03&sig=abc",
+			CopySourceAuthorization: pointer.To("Bearer abc123"),
+			Range:                   pointer.To("bytes=0-1023"),
+		},
+	}
+
+	headers := options.ToHeaders().Headers()
+	expectedHeaders := map[string]string{
+		"x-ms-copy-source":               "https://example.blob.core.windows.net/container/source?sv=2023-11-03&sig=abc",
+		"x-ms-copy-source-authorization": "Bearer abc123",
+		"x-ms-source-range":              "bytes=0-1023",
+	}
+	for k, v := range expectedHeaders {
+		if actual := headers.Get(k); actual != v {
+			t.Fatalf("expected the header %q to be %q but got %q", k, v, actual)
+		}
+	}
+
+	query := options.ToQuery().Values()
+	if actual := query.Get("comp"); actual != "block" {
+		t.Fatalf("expected `comp` to be %q but got %q", "block", actual)
+	}
+	if actual := query.Get("blockid"); actual != "YmxvY2sx" {
+		t.Fatalf("expected `blockid` to be %q but got %q", "YmxvY2sx", actual)
+	}
+}
@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/tags"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PutPageBlobInput struct {
@@ -23,13 +28,26 @@ type PutPageBlobInput struct {
 	EncryptionScope    *string
 	MetaData           map[string]string
 
+	// Optional - The Index Tags which should be assigned to this Blob, replacing any existing Tags.
+	// At most 10 Tags can be specified, Keys must be between 1 and 128 characters and Values at most 256 characters.
+	Tags map[string]string
+
 	BlobContentLengthBytes int64
 	BlobSequenceNumber     *int64
 	AccessTier             *AccessTier
+
+	// Optional - A Customer-Provided Key used to encrypt the Blob, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
 }
 
 type PutPageBlobResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // PutPageBlob is a wrapper around the Put API call (with a stricter input object)
@@ -40,8 +58,8 @@ func (c Client) PutPageBlob(ctx context.Context, containerName, blobName string,
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -49,12 +67,25 @@ func (c Client) PutPageBlob(ctx context.Context, containerName, blobName string,
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
-	if input.BlobContentLengthBytes == 0 || input.BlobContentLengthBytes%512 != 0 {
+	if input.BlobContentLengthBytes <= 0 || input.BlobContentLengthBytes%pageSizeInBytes != 0 {
 		err = fmt.Errorf("`input.BlobContentLengthBytes` must be aligned to a 512-byte boundary")
 		return
 	}
 
+	if err = tags.Validate(input.Tags); err != nil {
+		err = fmt.Errorf("`input.Tags` is not valid: %s", err)
+		return
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
@@ -76,9 +107,18 @@ func (c Client) PutPageBlob(ctx context.Context, containerName, blobName string,
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -142,7 +182,16 @@ func (p putPageBlobOptions) ToHeaders() *client.Headers {
 		headers.Append("x-ms-encryption-scope", *p.input.EncryptionScope)
 	}
 
+	if len(p.input.Tags) > 0 {
+		headers.Append("x-ms-tags", tags.ToHeaderValue(p.input.Tags))
+	}
+
 	headers.Merge(metadata.SetMetaDataHeaders(p.input.MetaData))
+
+	headers.Merge(accessconditions.SetIntoHeaders(p.input.AccessConditions))
+
+	headers.Merge(p.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PutPageClearInput struct {
@@ -20,6 +23,9 @@ type PutPageClearInput struct {
 
 type PutPageClearResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // PutPageClear clears a range of pages within a page blob.
@@ -29,8 +35,8 @@ func (c Client) PutPageClear(ctx context.Context, containerName, blobName string
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -38,14 +44,12 @@ func (c Client) PutPageClear(ctx context.Context, containerName, blobName string
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
-
-	if input.StartByte < 0 {
-		err = fmt.Errorf("`input.StartByte` must be greater than or equal to 0")
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
 		return
 	}
 
-	if input.EndByte <= 0 {
-		err = fmt.Errorf("`input.EndByte` must be greater than 0")
+	if err = validatePageRange(input.StartByte, input.EndByte); err != nil {
 		return
 	}
 
@@ -70,9 +74,18 @@ func (c Client) PutPageClear(ctx context.Context, containerName, blobName string
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
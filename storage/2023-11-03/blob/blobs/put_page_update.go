@@ -7,10 +7,13 @@ import (
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PutPageUpdateInput struct {
@@ -27,6 +30,9 @@ type PutPageUpdateInput struct {
 	IfNoneMatch        *string
 	LeaseID            *string
 	EncryptionScope    *string
+
+	// Optional - A Customer-Provided Key used to encrypt the Pages, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
 }
 
 type PutPageUpdateResponse struct {
@@ -34,7 +40,8 @@ type PutPageUpdateResponse struct {
 
 	BlobSequenceNumber string
 	ContentMD5         string
-	LastModified       string
+	ETag               string
+	LastModified       time.Time
 }
 
 // PutPageUpdate writes a range of pages to a page blob.
@@ -44,8 +51,8 @@ func (c Client) PutPageUpdate(ctx context.Context, containerName, blobName strin
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -53,14 +60,12 @@ func (c Client) PutPageUpdate(ctx context.Context, containerName, blobName strin
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
-
-	if input.StartByte < 0 {
-		err = fmt.Errorf("`input.StartByte` must be greater than or equal to 0")
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
 		return
 	}
 
-	if input.EndByte <= 0 {
-		err = fmt.Errorf("`input.EndByte` must be greater than 0")
+	if err = validatePageRange(input.StartByte, input.EndByte); err != nil {
 		return
 	}
 
@@ -71,6 +76,10 @@ func (c Client) PutPageUpdate(ctx context.Context, containerName, blobName strin
 		return
 	}
 
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
@@ -101,12 +110,15 @@ func (c Client) PutPageUpdate(ctx context.Context, containerName, blobName strin
 			if resp.Header != nil {
 				result.BlobSequenceNumber = resp.Header.Get("x-ms-blob-sequence-number")
 				result.ContentMD5 = resp.Header.Get("Content-MD5")
-				result.LastModified = resp.Header.Get("Last-Modified")
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -159,6 +171,8 @@ func (p putPageUpdateOptions) ToHeaders() *client.Headers {
 		headers.Append("If-None-Match", *p.input.IfNoneMatch)
 	}
 
+	headers.Merge(p.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
@@ -0,0 +1,109 @@
+package blobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestPutWithTags(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var actual string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actual = r.Header.Get("x-ms-tags")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	input := map[string]string{
+		"project": "giovanni",
+		"env":     "test value",
+	}
+	expected := "env=test+value&project=giovanni"
+
+	testData := []struct {
+		Name string
+		Put  func() error
+	}{
+		{
+			Name: "PutAppendBlob",
+			Put: func() error {
+				_, err := blobClient.PutAppendBlob(ctx, "container", "append.txt", PutAppendBlobInput{
+					Tags: input,
+				})
+				return err
+			},
+		},
+		{
+			Name: "PutBlockBlob",
+			Put: func() error {
+				_, err := blobClient.PutBlockBlob(ctx, "container", "block.txt", PutBlockBlobInput{
+					Content: pointer.To([]byte("hello world")),
+					Tags:    input,
+				})
+				return err
+			},
+		},
+		{
+			Name: "PutBlockList",
+			Put: func() error {
+				_, err := blobClient.PutBlockList(ctx, "container", "block.txt", PutBlockListInput{
+					Tags: input,
+				})
+				return err
+			},
+		},
+		{
+			Name: "PutPageBlob",
+			Put: func() error {
+				_, err := blobClient.PutPageBlob(ctx, "container", "page.vhd", PutPageBlobInput{
+					BlobContentLengthBytes: 512,
+					Tags:                   input,
+				})
+				return err
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual = ""
+		if err := v.Put(); err != nil {
+			t.Fatalf("putting blob: %+v", err)
+		}
+		if actual != expected {
+			t.Fatalf("expected the `x-ms-tags` header to be %q but got %q", expected, actual)
+		}
+	}
+}
+
+func TestPutWithInvalidTags(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	input := map[string]string{
+		"invalid!": "value",
+	}
+
+	if _, err := (Client{}).PutAppendBlob(ctx, "container", "append.txt", PutAppendBlobInput{Tags: input}); err == nil {
+		t.Fatalf("expected an error for PutAppendBlob but didn't get one")
+	}
+	if _, err := (Client{}).PutBlockList(ctx, "container", "block.txt", PutBlockListInput{Tags: input}); err == nil {
+		t.Fatalf("expected an error for PutBlockList but didn't get one")
+	}
+	if _, err := (Client{}).PutPageBlob(ctx, "container", "page.vhd", PutPageBlobInput{BlobContentLengthBytes: 512, Tags: input}); err == nil {
+		t.Fatalf("expected an error for PutPageBlob but didn't get one")
+	}
+}
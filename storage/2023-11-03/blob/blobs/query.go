@@ -0,0 +1,286 @@
+package blobs
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type QueryFormatType string
+
+var (
+	// QueryFormatTypeDelimited is delimited text, for example CSV
+	QueryFormatTypeDelimited QueryFormatType = "delimited"
+
+	// QueryFormatTypeJSON is JSON, with each record separated by a record separator
+	QueryFormatTypeJSON QueryFormatType = "json"
+
+	// QueryFormatTypeParquet is Apache Parquet, which can only be used for the InputSerialization
+	QueryFormatTypeParquet QueryFormatType = "parquet"
+)
+
+func PossibleValuesForQueryFormatType() []QueryFormatType {
+	return []QueryFormatType{
+		QueryFormatTypeDelimited,
+		QueryFormatTypeJSON,
+		QueryFormatTypeParquet,
+	}
+}
+
+// QuerySerialization specifies the format of the contents of the Blob (the InputSerialization), or of the results
+// of the Query (the OutputSerialization)
+type QuerySerialization struct {
+	Format QueryFormat `xml:"Format"`
+}
+
+type QueryFormat struct {
+	// The type of the format, which determines which of the configurations below can be specified
+	Type QueryFormatType `xml:"Type"`
+
+	// Optional - The configuration for the `delimited` format
+	DelimitedTextConfiguration *DelimitedTextConfiguration `xml:"DelimitedTextConfiguration,omitempty"`
+
+	// Optional - The configuration for the `json` format
+	JSONTextConfiguration *JSONTextConfiguration `xml:"JsonTextConfiguration,omitempty"`
+}
+
+type DelimitedTextConfiguration struct {
+	// The character used to separate the columns, for example `,`
+	ColumnSeparator string `xml:"ColumnSeparator,omitempty"`
+
+	// The character used to quote a field, for example `"`
+	FieldQuote string `xml:"FieldQuote,omitempty"`
+
+	// The character used to separate records, for example `\n`
+	RecordSeparator string `xml:"RecordSeparator,omitempty"`
+
+	// The character used as an escape character, for example `\`
+	EscapeChar string `xml:"EscapeChar,omitempty"`
+
+	// Does the data contain a header row? When specified for the OutputSerialization a header row is output.
+	HasHeaders bool `xml:"HasHeaders"`
+}
+
+type JSONTextConfiguration struct {
+	// The character used to separate records, for example `\n`
+	RecordSeparator string `xml:"RecordSeparator,omitempty"`
+}
+
+type QueryInput struct {
+	// The SQL expression used to query the contents of the Blob, for example `SELECT * FROM BlobStorage`
+	Expression string
+
+	// Optional - The format of the contents of the Blob, the service defaults to delimited text (CSV)
+	InputSerialization *QuerySerialization
+
+	// Optional - The format of the results of the query, the service defaults to the format of the InputSerialization.
+	// Parquet cannot be used as an OutputSerialization.
+	OutputSerialization *QuerySerialization
+
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+
+	// The DateTime of the Snapshot which should be queried, rather than the base Blob
+	Snapshot *string
+
+	// Optional - A callback which is fired as the Blob is scanned by the service, with the number of bytes of the
+	// Blob which have been scanned and the size of the Blob. A final callback is fired once the query has completed.
+	Progress func(bytesScanned, totalBytes int64)
+
+	// Optional - A callback which is fired for each non-fatal error encountered whilst processing the query (for
+	// example a record which couldn't be parsed) - when not specified a non-fatal error is returned from Body.Read.
+	// Fatal errors are always returned from Body.Read as a QueryError.
+	OnError func(QueryError)
+
+	// Optional - The Customer-Provided Key which was used to write the Blob, which must be specified to query a Blob
+	// written using a Customer-Provided Key
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
+}
+
+type QueryResponse struct {
+	HttpResponse *http.Response
+
+	// Body streams the results of the query (in the format specified by the OutputSerialization), having decoded
+	// the records the service returns - this must be closed by the caller.
+	Body io.ReadCloser
+
+	// The ETag of the Blob which was queried
+	ETag string
+
+	// The date/time that the Blob was last modified
+	LastModified string
+}
+
+// Query queries the contents of a Blob using a SQL expression, so that only the subset of the Blob matching the query
+// is returned (rather than downloading and filtering the entire Blob). The results are streamed from the returned
+// Body, which must be closed by the caller once they've finished reading from it.
+func (c Client) Query(ctx context.Context, containerName, blobName string, input QueryInput) (result QueryResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return result, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return result, fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.Expression == "" {
+		return result, fmt.Errorf("`input.Expression` cannot be an empty string")
+	}
+
+	if input.InputSerialization != nil {
+		if err := validateQuerySerialization(*input.InputSerialization, true); err != nil {
+			return result, fmt.Errorf("`input.InputSerialization` is not valid: %+v", err)
+		}
+	}
+
+	if input.OutputSerialization != nil {
+		if err := validateQuerySerialization(*input.OutputSerialization, false); err != nil {
+			return result, fmt.Errorf("`input.OutputSerialization` is not valid: %+v", err)
+		}
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		return result, fmt.Errorf("`input.LeaseID` should either be specified or nil, not an empty string")
+	}
+
+	if input.Snapshot != nil && *input.Snapshot == "" {
+		return result, fmt.Errorf("`input.Snapshot` should either be specified or nil, not an empty string")
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, nil); err != nil {
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+			http.StatusPartialContent,
+		},
+		HttpMethod: http.MethodPost,
+		OptionsObject: queryOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	body := queryRequest{
+		QueryType:           "SQL",
+		Expression:          input.Expression,
+		InputSerialization:  input.InputSerialization,
+		OutputSerialization: input.OutputSerialization,
+	}
+	err = req.Marshal(&body)
+	if err != nil {
+		err = fmt.Errorf("marshalling request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			// the response body is intentionally not read here, instead it's decoded as the caller reads from it
+			result.Body = newQueryReader(resp.Body, input.Progress, input.OnError)
+
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				result.LastModified = resp.Header.Get("Last-Modified")
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+func validateQuerySerialization(input QuerySerialization, isInput bool) error {
+	switch input.Format.Type {
+	case QueryFormatTypeDelimited:
+		if input.Format.JSONTextConfiguration != nil {
+			return fmt.Errorf("`JSONTextConfiguration` cannot be specified for the %q format", input.Format.Type)
+		}
+	case QueryFormatTypeJSON:
+		if input.Format.DelimitedTextConfiguration != nil {
+			return fmt.Errorf("`DelimitedTextConfiguration` cannot be specified for the %q format", input.Format.Type)
+		}
+	case QueryFormatTypeParquet:
+		if !isInput {
+			return fmt.Errorf("the %q format can only be used for the InputSerialization", input.Format.Type)
+		}
+		if input.Format.DelimitedTextConfiguration != nil || input.Format.JSONTextConfiguration != nil {
+			return fmt.Errorf("no configuration can be specified for the %q format", input.Format.Type)
+		}
+	default:
+		return fmt.Errorf("`Format.Type` must be one of %q, %q or %q but got %q", QueryFormatTypeDelimited, QueryFormatTypeJSON, QueryFormatTypeParquet, input.Format.Type)
+	}
+	return nil
+}
+
+type queryRequest struct {
+	XMLName             xml.Name            `xml:"QueryRequest"`
+	QueryType           string              `xml:"QueryType"`
+	Expression          string              `xml:"Expression"`
+	InputSerialization  *QuerySerialization `xml:"InputSerialization,omitempty"`
+	OutputSerialization *QuerySerialization `xml:"OutputSerialization,omitempty"`
+}
+
+type queryOptions struct {
+	input QueryInput
+}
+
+func (q queryOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if q.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *q.input.LeaseID)
+	}
+
+	headers.Merge(accessconditions.SetIntoHeaders(q.input.AccessConditions))
+
+	headers.Merge(q.input.CustomerProvidedKey.headers())
+
+	return headers
+}
+
+func (q queryOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (q queryOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "query")
+	if q.input.Snapshot != nil {
+		out.Append("snapshot", *q.input.Snapshot)
+	}
+	return out
+}
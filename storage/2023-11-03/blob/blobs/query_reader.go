@@ -0,0 +1,135 @@
+package blobs
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jackofallops/giovanni/storage/internal/avro"
+)
+
+// QueryError is an error encountered by the service whilst processing a Query
+type QueryError struct {
+	// Is this error fatal? When true the query has been terminated, otherwise the query continues
+	Fatal bool
+
+	// The name of the error, for example `InvalidColumnOrdinal`
+	Name string
+
+	// A description of the error
+	Description string
+
+	// The position (within the Blob) at which the error occurred
+	Position int64
+}
+
+func (e QueryError) Error() string {
+	severity := "non-fatal"
+	if e.Fatal {
+		severity = "fatal"
+	}
+	return fmt.Sprintf("%s error %q at position %d: %s", severity, e.Name, e.Position, e.Description)
+}
+
+// queryReader decodes the Avro records returned by the service for a Query - which are either a chunk of the results
+// (`resultData`), an error (`error`), the progress of the query (`progress`) or the end of the results (`end`) - so
+// that the results can be read as-is
+type queryReader struct {
+	body     io.ReadCloser
+	records  *avro.Reader
+	progress func(bytesScanned, totalBytes int64)
+	onError  func(QueryError)
+
+	// pending contains the results from the most recent `resultData` record which haven't yet been read
+	pending []byte
+
+	// ended is whether the `end` record has been read
+	ended bool
+
+	// err is the error (if any) which terminated the results, which is returned from each subsequent Read
+	err error
+}
+
+func newQueryReader(body io.ReadCloser, progress func(bytesScanned, totalBytes int64), onError func(QueryError)) *queryReader {
+	return &queryReader{
+		body:     body,
+		records:  avro.NewReader(body),
+		progress: progress,
+		onError:  onError,
+	}
+}
+
+func (r *queryReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.ended {
+			return 0, io.EOF
+		}
+		if err := r.readRecord(); err != nil {
+			r.err = err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *queryReader) Close() error {
+	return r.body.Close()
+}
+
+func (r *queryReader) readRecord() error {
+	v, err := r.records.Next()
+	if err == io.EOF {
+		return fmt.Errorf("the response ended before the end of the query results: %w", io.ErrUnexpectedEOF)
+	}
+	if err != nil {
+		return fmt.Errorf("reading the query results: %+v", err)
+	}
+
+	record, ok := v.(avro.Record)
+	if !ok {
+		return fmt.Errorf("expected the query results to contain records but got %T", v)
+	}
+
+	switch record.ShortName() {
+	case "resultData":
+		data, ok := record.Fields["data"].([]byte)
+		if !ok {
+			return fmt.Errorf("the `data` field of a `resultData` record was %T rather than bytes", record.Fields["data"])
+		}
+		r.pending = data
+
+	case "progress":
+		if r.progress != nil {
+			scanned, _ := record.Fields["bytesScanned"].(int64)
+			total, _ := record.Fields["totalBytes"].(int64)
+			r.progress(scanned, total)
+		}
+
+	case "error":
+		queryErr := QueryError{}
+		queryErr.Fatal, _ = record.Fields["fatal"].(bool)
+		queryErr.Name, _ = record.Fields["name"].(string)
+		queryErr.Description, _ = record.Fields["description"].(string)
+		queryErr.Position, _ = record.Fields["position"].(int64)
+		if queryErr.Fatal || r.onError == nil {
+			return queryErr
+		}
+		r.onError(queryErr)
+
+	case "end":
+		if r.progress != nil {
+			total, _ := record.Fields["totalBytes"].(int64)
+			r.progress(total, total)
+		}
+		r.ended = true
+
+	default:
+		return fmt.Errorf("unexpected record %q within the query results", record.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package blobs
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// queryResultsSchema is the schema of the Avro records returned by the service for a Query
+const queryResultsSchema = `[
+  {"type": "record", "name": "com.microsoft.azure.storage.queryBlobContents.resultData", "fields": [{"name": "data", "type": "bytes"}]},
+  {"type": "record", "name": "com.microsoft.azure.storage.queryBlobContents.error", "fields": [{"name": "fatal", "type": "boolean"}, {"name": "name", "type": "string"}, {"name": "description", "type": "string"}, {"name": "position", "type": "long"}]},
+  {"type": "record", "name": "com.microsoft.azure.storage.queryBlobContents.progress", "fields": [{"name": "bytesScanned", "type": "long"}, {"name": "totalBytes", "type": "long"}]},
+  {"type": "record", "name": "com.microsoft.azure.storage.queryBlobContents.end", "fields": [{"name": "totalBytes", "type": "long"}]}
+]`
+
+var querySyncMarker = []byte("sync-marker-0001")
+
+type queryRecords struct {
+	objects [][]byte
+}
+
+func (r *queryRecords) data(v string) *queryRecords {
+	b := binary.AppendVarint(nil, 0)
+	b = appendAvroBytes(b, []byte(v))
+	r.objects = append(r.objects, b)
+	return r
+}
+
+func (r *queryRecords) error(fatal bool, name, description string, position int64) *queryRecords {
+	b := binary.AppendVarint(nil, 1)
+	if fatal {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+	b = appendAvroBytes(b, []byte(name))
+	b = appendAvroBytes(b, []byte(description))
+	b = binary.AppendVarint(b, position)
+	r.objects = append(r.objects, b)
+	return r
+}
+
+func (r *queryRecords) progress(scanned, total int64) *queryRecords {
+	b := binary.AppendVarint(nil, 2)
+	b = binary.AppendVarint(b, scanned)
+	b = binary.AppendVarint(b, total)
+	r.objects = append(r.objects, b)
+	return r
+}
+
+func (r *queryRecords) end(total int64) *queryRecords {
+	b := binary.AppendVarint(nil, 3)
+	b = binary.AppendVarint(b, total)
+	r.objects = append(r.objects, b)
+	return r
+}
+
+// bytes returns the Avro Object Container File containing the records, with each record in a separate block
+func (r *queryRecords) bytes() []byte {
+	b := []byte("Obj\x01")
+	b = binary.AppendVarint(b, 1)
+	b = appendAvroBytes(b, []byte("avro.schema"))
+	b = appendAvroBytes(b, []byte(queryResultsSchema))
+	b = binary.AppendVarint(b, 0)
+	b = append(b, querySyncMarker...)
+	for _, object := range r.objects {
+		b = binary.AppendVarint(b, 1)
+		b = appendAvroBytes(b, object)
+		b = append(b, querySyncMarker...)
+	}
+	return b
+}
+
+func appendAvroBytes(b []byte, v []byte) []byte {
+	b = binary.AppendVarint(b, int64(len(v)))
+	return append(b, v...)
+}
+
+func TestQueryReader(t *testing.T) {
+	testData := []struct {
+		Name             string
+		Input            *queryRecords
+		WithErrorHandler bool
+		Expected         string
+		ExpectedProgress []int64
+		ExpectedErrors   int
+		ExpectError      bool
+	}{
+		{
+			Name:             "Results",
+			Input:            (&queryRecords{}).data("a,b\n").progress(5, 10).data("c,d\n").end(10),
+			Expected:         "a,b\nc,d\n",
+			ExpectedProgress: []int64{5, 10},
+		},
+		{
+			Name:             "Non-Fatal Error with a Handler",
+			Input:            (&queryRecords{}).data("a,b\n").error(false, "InvalidColumnOrdinal", "the column doesn't exist", 4).data("c,d\n").end(10),
+			WithErrorHandler: true,
+			Expected:         "a,b\nc,d\n",
+			ExpectedProgress: []int64{10},
+			ExpectedErrors:   1,
+		},
+		{
+			Name:        "Non-Fatal Error without a Handler",
+			Input:       (&queryRecords{}).data("a,b\n").error(false, "InvalidColumnOrdinal", "the column doesn't exist", 4).end(10),
+			Expected:    "a,b\n",
+			ExpectError: true,
+		},
+		{
+			Name:             "Fatal Error",
+			Input:            (&queryRecords{}).data("a,b\n").error(true, "ParseError", "unexpected token", 4),
+			WithErrorHandler: true,
+			Expected:         "a,b\n",
+			ExpectError:      true,
+		},
+		{
+			Name:        "Missing End Record",
+			Input:       (&queryRecords{}).data("a,b\n"),
+			Expected:    "a,b\n",
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		progress := make([]int64, 0)
+		onProgress := func(bytesScanned, totalBytes int64) {
+			progress = append(progress, bytesScanned)
+		}
+		errorCount := 0
+		var onError func(QueryError)
+		if v.WithErrorHandler {
+			onError = func(QueryError) {
+				errorCount++
+			}
+		}
+
+		reader := newQueryReader(io.NopCloser(strings.NewReader(string(v.Input.bytes()))), onProgress, onError)
+		actual, err := io.ReadAll(reader)
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("reading results: %+v", err)
+		}
+		if string(actual) != v.Expected {
+			t.Fatalf("expected the results to be %q but got %q", v.Expected, string(actual))
+		}
+		if !v.ExpectError && len(progress) != len(v.ExpectedProgress) {
+			t.Fatalf("expected %d progress callbacks but got %d", len(v.ExpectedProgress), len(progress))
+		}
+		for i := range v.ExpectedProgress {
+			if progress[i] != v.ExpectedProgress[i] {
+				t.Fatalf("expected progress %d to be %d but got %d", i, v.ExpectedProgress[i], progress[i])
+			}
+		}
+		if errorCount != v.ExpectedErrors {
+			t.Fatalf("expected %d errors to be handled but got %d", v.ExpectedErrors, errorCount)
+		}
+	}
+}
+
+func TestQueryFatalErrorIsQueryError(t *testing.T) {
+	input := (&queryRecords{}).error(true, "ParseError", "unexpected token", 4).bytes()
+	reader := newQueryReader(io.NopCloser(strings.NewReader(string(input))), nil, nil)
+
+	_, err := io.ReadAll(reader)
+	var queryErr QueryError
+	if !errors.As(err, &queryErr) {
+		t.Fatalf("expected a QueryError but got: %+v", err)
+	}
+	if !queryErr.Fatal || queryErr.Name != "ParseError" || queryErr.Position != 4 {
+		t.Fatalf("unexpected QueryError: %+v", queryErr)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.URL.Path != "/container/data.csv" || r.URL.Query().Get("comp") != "query" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		expected := "<QueryRequest><QueryType>SQL</QueryType><Expression>SELECT _2 FROM BlobStorage</Expression>" +
+			"<InputSerialization><Format><Type>delimited</Type><DelimitedTextConfiguration><ColumnSeparator>,</ColumnSeparator><HasHeaders>true</HasHeaders></DelimitedTextConfiguration></Format></InputSerialization>" +
+			"<OutputSerialization><Format><Type>json</Type></Format></OutputSerialization></QueryRequest>"
+		if actual := strings.TrimPrefix(string(body), xml.Header); actual != expected {
+			t.Errorf("expected the request body to be %q but got %q", expected, actual)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "avro/binary")
+		w.Header().Set("ETag", "0x1")
+		w.WriteHeader(http.StatusOK)
+		w.Write((&queryRecords{}).data(`{"_2":"b"}`).end(8).bytes())
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.Query(ctx, "container", "data.csv", QueryInput{
+		Expression: "SELECT _2 FROM BlobStorage",
+		InputSerialization: &QuerySerialization{
+			Format: QueryFormat{
+				Type: QueryFormatTypeDelimited,
+				DelimitedTextConfiguration: &DelimitedTextConfiguration{
+					ColumnSeparator: ",",
+					HasHeaders:      true,
+				},
+			},
+		},
+		OutputSerialization: &QuerySerialization{
+			Format: QueryFormat{
+				Type: QueryFormatTypeJSON,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("querying: %+v", err)
+	}
+	defer result.Body.Close()
+
+	actual, err := io.ReadAll(result.Body)
+	if err != nil {
+		t.Fatalf("reading results: %+v", err)
+	}
+	if string(actual) != `{"_2":"b"}` {
+		t.Fatalf("expected the results to be %q but got %q", `{"_2":"b"}`, string(actual))
+	}
+	if result.ETag != "0x1" {
+		t.Fatalf("expected the ETag to be %q but got %q", "0x1", result.ETag)
+	}
+}
+
+func TestQueryValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input QueryInput
+	}{
+		{
+			Name:  "No Expression",
+			Input: QueryInput{},
+		},
+		{
+			Name: "Parquet Output",
+			Input: QueryInput{
+				Expression: "SELECT * FROM BlobStorage",
+				OutputSerialization: &QuerySerialization{
+					Format: QueryFormat{
+						Type: QueryFormatTypeParquet,
+					},
+				},
+			},
+		},
+		{
+			Name: "Mismatched Configuration",
+			Input: QueryInput{
+				Expression: "SELECT * FROM BlobStorage",
+				InputSerialization: &QuerySerialization{
+					Format: QueryFormat{
+						Type:                       QueryFormatTypeJSON,
+						DelimitedTextConfiguration: &DelimitedTextConfiguration{},
+					},
+				},
+			},
+		},
+		{
+			Name: "Unknown Format",
+			Input: QueryInput{
+				Expression: "SELECT * FROM BlobStorage",
+				InputSerialization: &QuerySerialization{
+					Format: QueryFormat{
+						Type: QueryFormatType("avro"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).Query(ctx, "container", "blob", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
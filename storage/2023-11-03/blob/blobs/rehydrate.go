@@ -0,0 +1,111 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// defaultRehydratePollInterval is the interval between checks of the Archive Status whilst rehydrating a Blob, since
+// rehydration takes at least an hour (using High priority) and up to 15 hours (using Standard priority)
+const defaultRehydratePollInterval = 1 * time.Minute
+
+type RehydrateInput struct {
+	// The online Access Tier which the archived Blob should be rehydrated to, either `Hot`, `Cool` or `Cold`
+	Tier AccessTier
+
+	// The priority with which to rehydrate the archived Blob, either `High` or `Standard`.
+	// Defaults to `Standard` when not specified.
+	RehydratePriority *RehydratePriority
+
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+
+	// The initial interval between checks of the Archive Status of the Blob, defaults to 1 minute. Successive checks
+	// back off (with jitter) up to the longer of this interval or 1 minute.
+	PollInterval time.Duration
+}
+
+type RehydrateResponse struct {
+	HttpResponse *http.Response
+
+	// The Access Tier of the Blob once it's been rehydrated
+	AccessTier AccessTier
+}
+
+// Rehydrate is a helper method which rehydrates an archived Blob to an online Access Tier, by setting the Access Tier
+// of the Blob and then polling the Archive Status of the Blob until the rehydration has completed - returning once the
+// Blob is online, or the context expires. When the Blob is already online (or is already being rehydrated to the
+// specified Access Tier) the Access Tier isn't set.
+func (c Client) Rehydrate(ctx context.Context, containerName, blobName string, input RehydrateInput) (result RehydrateResponse, err error) {
+	switch input.Tier {
+	case Hot, Cool, Cold:
+	default:
+		return result, fmt.Errorf("`input.Tier` must be one of %q, %q or %q but got %q", Hot, Cool, Cold, input.Tier)
+	}
+
+	if input.PollInterval < 0 {
+		return result, fmt.Errorf("`input.PollInterval` must be greater than or equal to 0")
+	}
+
+	getInput := GetPropertiesInput{
+		LeaseID: input.LeaseID,
+	}
+	props, err := c.GetProperties(ctx, containerName, blobName, getInput)
+	result.HttpResponse = props.HttpResponse
+	if err != nil {
+		return result, fmt.Errorf("retrieving properties: %w", err)
+	}
+
+	if props.ArchiveStatus == None && props.AccessTier != Archive {
+		result.AccessTier = props.AccessTier
+		return result, nil
+	}
+
+	if tier, ok := rehydrationTier(props.ArchiveStatus); ok {
+		// the rehydration can't be redirected to another Access Tier once it's started
+		if tier != input.Tier {
+			return result, fmt.Errorf("the Blob is already being rehydrated to %q (Archive Status %q) and not %q", tier, props.ArchiveStatus, input.Tier)
+		}
+	} else {
+		resp, err := c.SetTier(ctx, containerName, blobName, SetTierInput{
+			Tier:              input.Tier,
+			RehydratePriority: input.RehydratePriority,
+			LeaseID:           input.LeaseID,
+		})
+		result.HttpResponse = resp.HttpResponse
+		if err != nil {
+			return result, fmt.Errorf("setting the Access Tier to %q: %w", input.Tier, err)
+		}
+	}
+
+	pollInterval := input.PollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultRehydratePollInterval
+	}
+	latest, err := c.waitForRehydration(ctx, containerName, blobName, input.Tier, getInput, pollInterval)
+	if latest != nil {
+		result.HttpResponse = latest.HttpResponse
+		result.AccessTier = latest.AccessTier
+	}
+	if err != nil {
+		return result, fmt.Errorf("waiting for the Blob to be rehydrated to %q: %w", input.Tier, err)
+	}
+
+	return result, nil
+}
+
+// rehydrationTier returns the Access Tier which the Blob is being rehydrated to, for the specified Archive Status
+func rehydrationTier(status ArchiveStatus) (AccessTier, bool) {
+	switch status {
+	case RehydratePendingToHot:
+		return Hot, true
+	case RehydratePendingToCool:
+		return Cool, true
+	case RehydratePendingToCold:
+		return Cold, true
+	}
+	return "", false
+}
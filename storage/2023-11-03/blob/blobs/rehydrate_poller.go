@@ -0,0 +1,95 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/jackofallops/giovanni/storage/internal/poll"
+)
+
+var _ pollers.PollerType = &rehydratePoller{}
+
+func NewRehydratePoller(client *Client, containerName, blobName string, tier AccessTier, pollInterval time.Duration, getPropertiesInput GetPropertiesInput) *rehydratePoller {
+	return &rehydratePoller{
+		client:             client,
+		containerName:      containerName,
+		blobName:           blobName,
+		tier:               tier,
+		pollInterval:       pollInterval,
+		getPropertiesInput: getPropertiesInput,
+	}
+}
+
+type rehydratePoller struct {
+	client             *Client
+	containerName      string
+	blobName           string
+	tier               AccessTier
+	pollInterval       time.Duration
+	getPropertiesInput GetPropertiesInput
+
+	// latest contains the Blob Properties retrieved during the most recent poll
+	latest *GetPropertiesResponse
+}
+
+func (p *rehydratePoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	props, err := p.client.GetProperties(ctx, p.containerName, p.blobName, p.getPropertiesInput)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving properties (container: %s blob: %s) : %+v", p.containerName, p.blobName, err)
+	}
+	p.latest = &props
+
+	status, err := rehydrationStatus(props, p.tier)
+	if err != nil {
+		return nil, pollers.PollingFailedError{
+			Message: fmt.Sprintf("rehydrating (container: %s blob: %s) to %q: %+v", p.containerName, p.blobName, p.tier, err),
+		}
+	}
+
+	return &pollers.PollResult{
+		Status:       status,
+		PollInterval: p.pollInterval,
+	}, nil
+}
+
+// waitForRehydration polls the properties of the specified Blob until the rehydration to `tier` has completed,
+// returning the properties retrieved during the most recent poll
+func (c Client) waitForRehydration(ctx context.Context, containerName, blobName string, tier AccessTier, input GetPropertiesInput, interval time.Duration) (*GetPropertiesResponse, error) {
+	return poll.Until(ctx, interval, func(ctx context.Context) (*GetPropertiesResponse, bool, error) {
+		props, err := c.GetProperties(ctx, containerName, blobName, input)
+		if err != nil {
+			return nil, false, fmt.Errorf("retrieving properties (container: %s blob: %s) : %+v", containerName, blobName, err)
+		}
+
+		status, err := rehydrationStatus(props, tier)
+		if err != nil {
+			return &props, false, fmt.Errorf("rehydrating (container: %s blob: %s) to %q: %+v", containerName, blobName, tier, err)
+		}
+		return &props, status == pollers.PollingStatusSucceeded, nil
+	})
+}
+
+// rehydrationStatus determines whether the rehydration of the Blob to `tier` has completed, is in progress or has failed
+func rehydrationStatus(props GetPropertiesResponse, tier AccessTier) (pollers.PollingStatus, error) {
+	if pendingTier, ok := rehydrationTier(props.ArchiveStatus); ok {
+		if pendingTier != tier {
+			return pollers.PollingStatusFailed, fmt.Errorf("the Blob is being rehydrated to %q (Archive Status %q) rather than %q", pendingTier, props.ArchiveStatus, tier)
+		}
+		return pollers.PollingStatusInProgress, nil
+	}
+
+	if props.ArchiveStatus != None {
+		return pollers.PollingStatusFailed, fmt.Errorf("unexpected Archive Status %q", props.ArchiveStatus)
+	}
+
+	// once the rehydration has completed the Archive Status is no longer returned
+	switch props.AccessTier {
+	case tier:
+		return pollers.PollingStatusSucceeded, nil
+	case Archive:
+		return pollers.PollingStatusFailed, fmt.Errorf("the Blob is no longer being rehydrated and remains in the %q Access Tier", Archive)
+	}
+	return pollers.PollingStatusFailed, fmt.Errorf("the Blob was rehydrated to %q rather than %q", props.AccessTier, tier)
+}
@@ -0,0 +1,162 @@
+package blobs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+func TestRehydrationStatus(t *testing.T) {
+	testData := []struct {
+		Name          string
+		AccessTier    AccessTier
+		ArchiveStatus ArchiveStatus
+		Tier          AccessTier
+		Expected      pollers.PollingStatus
+		ExpectError   bool
+	}{
+		{
+			Name:          "Pending to Hot",
+			AccessTier:    Archive,
+			ArchiveStatus: RehydratePendingToHot,
+			Tier:          Hot,
+			Expected:      pollers.PollingStatusInProgress,
+		},
+		{
+			Name:          "Pending to Cool",
+			AccessTier:    Archive,
+			ArchiveStatus: RehydratePendingToCool,
+			Tier:          Cool,
+			Expected:      pollers.PollingStatusInProgress,
+		},
+		{
+			Name:          "Pending to Cool rather than Hot",
+			AccessTier:    Archive,
+			ArchiveStatus: RehydratePendingToCool,
+			Tier:          Hot,
+			Expected:      pollers.PollingStatusFailed,
+			ExpectError:   true,
+		},
+		{
+			Name:       "Rehydrated to Hot",
+			AccessTier: Hot,
+			Tier:       Hot,
+			Expected:   pollers.PollingStatusSucceeded,
+		},
+		{
+			Name:        "Rehydrated to Cool rather than Hot",
+			AccessTier:  Cool,
+			Tier:        Hot,
+			Expected:    pollers.PollingStatusFailed,
+			ExpectError: true,
+		},
+		{
+			Name:        "No Longer being Rehydrated",
+			AccessTier:  Archive,
+			Tier:        Hot,
+			Expected:    pollers.PollingStatusFailed,
+			ExpectError: true,
+		},
+		{
+			Name:          "Unknown Archive Status",
+			AccessTier:    Archive,
+			ArchiveStatus: ArchiveStatus("rehydrate-pending-to-frozen"),
+			Tier:          Hot,
+			Expected:      pollers.PollingStatusFailed,
+			ExpectError:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		props := GetPropertiesResponse{
+			AccessTier:    v.AccessTier,
+			ArchiveStatus: v.ArchiveStatus,
+		}
+		actual, err := rehydrationStatus(props, v.Tier)
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected the status to be %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestRehydrateValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input RehydrateInput
+	}{
+		{
+			Name:  "No Tier",
+			Input: RehydrateInput{},
+		},
+		{
+			Name: "Archive Tier",
+			Input: RehydrateInput{
+				Tier: Archive,
+			},
+		},
+		{
+			Name: "Negative Poll Interval",
+			Input: RehydrateInput{
+				Tier:         Hot,
+				PollInterval: -1 * time.Second,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).Rehydrate(ctx, "container", "blob", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestRehydrateTimeoutReturnsLatestState(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Errorf("expected the Access Tier not to be set but got a %s request", r.Method)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("x-ms-access-tier", string(Archive))
+		w.Header().Set("x-ms-archive-status", string(RehydratePendingToHot))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.Rehydrate(ctx, "container", "blob.txt", RehydrateInput{
+		Tier:         Hot,
+		PollInterval: 10 * time.Millisecond,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a context.DeadlineExceeded error but got %+v", err)
+	}
+	if result.AccessTier != Archive {
+		t.Fatalf("expected the last observed Access Tier %q to be returned but got %q", Archive, result.AccessTier)
+	}
+}
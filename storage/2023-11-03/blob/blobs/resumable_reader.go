@@ -0,0 +1,264 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/progress"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+// defaultMaxResumes is the number of times a ResumableReader resumes reading after consecutive failures
+const defaultMaxResumes = 3
+
+type GetResumableReaderInput struct {
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+
+	// The (inclusive) range of bytes which should be read, both must be specified or neither
+	StartByte *int64
+	EndByte   *int64
+
+	// The DateTime of the Snapshot which should be read, rather than the base Blob
+	Snapshot *string
+
+	// The ID of the Version which should be read, rather than the current Version of the Blob
+	VersionID *string
+
+	// The number of times reading should be resumed after consecutive failures (where no bytes
+	// are read in-between), defaults to 3
+	MaxResumes int
+
+	// Optional - A callback which is fired as the Body is read, with the cumulative number of bytes read
+	// and the number of bytes being read. A final callback is fired once the Body has been read in its entirety.
+	Progress func(bytesTransferred, totalBytes int64)
+
+	// Optional - The Customer-Provided Key which was used to write the Blob, which must be specified to read a Blob
+	// written using a Customer-Provided Key
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - The conditions which must be met for the initial request to be performed
+	accessconditions.AccessConditions
+}
+
+type GetResumableReaderResponse struct {
+	// The HttpResponse of the initial request
+	HttpResponse *http.Response
+
+	// Body streams the contents of the Blob (or the requested range), this must be closed by the caller.
+	Body io.ReadCloser
+
+	// The type of the Blob
+	BlobType BlobType
+
+	// The number of bytes which will be read from the Body
+	ContentLength int64
+
+	// The content type specified for the Blob
+	ContentType string
+
+	// The ETag of the Blob, which each subsequent request requires to match
+	ETag string
+
+	// The date/time that the Blob was last modified
+	LastModified string
+
+	// A set of name-value pairs that correspond to the user-defined metadata associated with this Blob
+	MetaData map[string]string
+}
+
+// GetResumableReader reads the contents of a blob (or a range of bytes from within it) without buffering the response
+// in memory, the returned Body must be closed by the caller once they've finished reading from it.
+//
+// Unlike GetReader, when reading the Body fails (for example because the connection was reset) the Body transparently
+// resumes by requesting the remaining range of bytes - requiring that the ETag of the Blob still matches the ETag
+// returned for the initial request. A BlobModifiedError is returned from Read if the Blob has since been modified.
+func (c Client) GetResumableReader(ctx context.Context, containerName, blobName string, input GetResumableReaderInput) (result GetResumableReaderResponse, err error) {
+	if input.MaxResumes < 0 {
+		return result, fmt.Errorf("`input.MaxResumes` must be greater than or equal to 0")
+	}
+
+	resp, err := c.GetReader(ctx, containerName, blobName, GetReaderInput{
+		LeaseID:             input.LeaseID,
+		StartByte:           input.StartByte,
+		EndByte:             input.EndByte,
+		Snapshot:            input.Snapshot,
+		VersionID:           input.VersionID,
+		CustomerProvidedKey: input.CustomerProvidedKey,
+		AccessConditions:    input.AccessConditions,
+	})
+	result.HttpResponse = resp.HttpResponse
+	if err != nil {
+		return
+	}
+
+	result.BlobType = resp.BlobType
+	result.ContentLength = resp.ContentLength
+	result.ContentType = resp.ContentType
+	result.ETag = resp.ETag
+	result.LastModified = resp.LastModified
+	result.MetaData = resp.MetaData
+
+	startByte := int64(0)
+	if input.StartByte != nil {
+		startByte = *input.StartByte
+	}
+
+	maxResumes := input.MaxResumes
+	if maxResumes == 0 {
+		maxResumes = defaultMaxResumes
+	}
+
+	reader := &resumableReader{
+		ctx:           ctx,
+		client:        c,
+		containerName: containerName,
+		blobName:      blobName,
+		input:         input,
+		etag:          resp.ETag,
+		startByte:     startByte,
+		offset:        startByte,
+		endByte:       startByte + resp.ContentLength - 1,
+		maxResumes:    maxResumes,
+		body:          resp.Body,
+	}
+	result.Body = progress.NewReadCloser(reader, progress.NewTracker(input.Progress, resp.ContentLength))
+
+	return
+}
+
+// resumableReader reads the (inclusive) range of bytes from `startByte` to `endByte`, re-requesting the range from
+// `offset` (the next byte to be read) when reading the current response body fails
+type resumableReader struct {
+	ctx           context.Context
+	client        Client
+	containerName string
+	blobName      string
+	input         GetResumableReaderInput
+	etag          string
+
+	startByte int64
+	offset    int64
+	endByte   int64
+
+	// attempts is the number of consecutive times reading has been resumed without any bytes being read
+	attempts   int
+	maxResumes int
+
+	body   io.ReadCloser
+	closed bool
+}
+
+func (r *resumableReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, fmt.Errorf("read on a closed reader")
+	}
+
+	for {
+		remaining := r.endByte - r.offset + 1
+		if remaining <= 0 {
+			return 0, io.EOF
+		}
+		if int64(len(p)) > remaining {
+			p = p[:remaining]
+		}
+
+		if r.body == nil {
+			if err := r.resume(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := r.body.Read(p)
+		r.offset += int64(n)
+		if n > 0 {
+			r.attempts = 0
+		}
+		if err == nil || (err == io.EOF && r.offset > r.endByte) {
+			return n, err
+		}
+
+		// the context being cancelled isn't transient, so there's no sense in resuming
+		if ctxErr := r.ctx.Err(); ctxErr != nil {
+			return n, ctxErr
+		}
+
+		r.body.Close()
+		r.body = nil
+
+		if r.attempts >= r.maxResumes {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return n, fmt.Errorf("reading bytes %d-%d after resuming %d times: %w", r.offset, r.endByte, r.attempts, err)
+		}
+		r.attempts++
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// resume requests the remaining range of bytes, providing the Blob hasn't been modified since it was first read
+func (r *resumableReader) resume() error {
+	if r.etag == "" {
+		return fmt.Errorf("reading cannot be resumed since no ETag was returned for the blob %q", r.blobName)
+	}
+
+	startByte := r.offset
+	endByte := r.endByte
+	etag := r.etag
+	resp, err := r.client.GetReader(r.ctx, r.containerName, r.blobName, GetReaderInput{
+		LeaseID:             r.input.LeaseID,
+		StartByte:           &startByte,
+		EndByte:             &endByte,
+		Snapshot:            r.input.Snapshot,
+		VersionID:           r.input.VersionID,
+		CustomerProvidedKey: r.input.CustomerProvidedKey,
+		AccessConditions: accessconditions.AccessConditions{
+			IfMatch: &etag,
+		},
+	})
+	if err != nil {
+		if responseerror.IsConditionNotMet(err) {
+			return r.modifiedError()
+		}
+		return fmt.Errorf("resuming from byte %d: %w", startByte, err)
+	}
+
+	if resp.ETag != "" && resp.ETag != r.etag {
+		resp.Body.Close()
+		return r.modifiedError()
+	}
+
+	r.body = resp.Body
+	return nil
+}
+
+func (r *resumableReader) modifiedError() error {
+	return BlobModifiedError{
+		ContainerName: r.containerName,
+		BlobName:      r.blobName,
+		ETag:          r.etag,
+		BytesRead:     r.offset - r.startByte,
+	}
+}
+
+func (r *resumableReader) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	if r.body == nil {
+		return nil
+	}
+	err := r.body.Close()
+	r.body = nil
+	return err
+}
@@ -0,0 +1,186 @@
+package blobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// resumableServer serves `contents`, truncating the first `truncatedResponses` responses after `truncateAfter` bytes
+type resumableServer struct {
+	contents           string
+	etag               string
+	truncateAfter      int
+	truncatedResponses int
+
+	mu     sync.Mutex
+	ranges []string
+}
+
+func (s *resumableServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Method != http.MethodGet || r.URL.Path != "/container/blob.txt" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	start, end := 0, len(s.contents)-1
+	statusCode := http.StatusOK
+	if v := r.Header.Get("x-ms-range"); v != "" {
+		s.ranges = append(s.ranges, v)
+		if ifMatch := r.Header.Get("If-Match"); ifMatch != s.etag {
+			w.Header().Set("x-ms-error-code", "ConditionNotMet")
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		startValue, endValue, _ := strings.Cut(strings.TrimPrefix(v, "bytes="), "-")
+		start, _ = strconv.Atoi(startValue)
+		end, _ = strconv.Atoi(endValue)
+		statusCode = http.StatusPartialContent
+	}
+
+	body := s.contents[start : end+1]
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.Header().Set("ETag", s.etag)
+	w.Header().Set("x-ms-blob-type", "BlockBlob")
+	w.WriteHeader(statusCode)
+
+	if s.truncatedResponses > 0 && len(body) > s.truncateAfter {
+		s.truncatedResponses--
+		// writing fewer bytes than the Content-Length causes the connection to be closed
+		w.Write([]byte(body[:s.truncateAfter]))
+		return
+	}
+	w.Write([]byte(body))
+}
+
+func TestGetResumableReader(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := &resumableServer{
+		contents:           "hello world",
+		etag:               "0x1",
+		truncateAfter:      3,
+		truncatedResponses: 2,
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	blobClient, err := NewWithBaseUri(httpServer.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.GetResumableReader(ctx, "container", "blob.txt", GetResumableReaderInput{})
+	if err != nil {
+		t.Fatalf("retrieving reader: %+v", err)
+	}
+	defer result.Body.Close()
+
+	actual, err := io.ReadAll(result.Body)
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	if string(actual) != "hello world" {
+		t.Fatalf("expected the contents to be %q but got %q", "hello world", string(actual))
+	}
+	if result.ContentLength != 11 {
+		t.Fatalf("expected the ContentLength to be 11 but got %d", result.ContentLength)
+	}
+
+	expectedRanges := []string{"bytes=3-10", "bytes=6-10"}
+	if len(server.ranges) != len(expectedRanges) {
+		t.Fatalf("expected the ranges %q to be requested but got %q", expectedRanges, server.ranges)
+	}
+	for i, v := range expectedRanges {
+		if server.ranges[i] != v {
+			t.Fatalf("expected range %d to be %q but got %q", i, v, server.ranges[i])
+		}
+	}
+}
+
+func TestGetResumableReaderBlobModified(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := &resumableServer{
+		contents:           "hello world",
+		etag:               "0x1",
+		truncateAfter:      3,
+		truncatedResponses: 1,
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	blobClient, err := NewWithBaseUri(httpServer.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.GetResumableReader(ctx, "container", "blob.txt", GetResumableReaderInput{})
+	if err != nil {
+		t.Fatalf("retrieving reader: %+v", err)
+	}
+	defer result.Body.Close()
+
+	// the Blob is modified after the initial request
+	server.mu.Lock()
+	server.etag = "0x2"
+	server.mu.Unlock()
+
+	actual, err := io.ReadAll(result.Body)
+	var modifiedErr BlobModifiedError
+	if !errors.As(err, &modifiedErr) {
+		t.Fatalf("expected a BlobModifiedError but got: %+v", err)
+	}
+	if modifiedErr.BytesRead != 3 || modifiedErr.ETag != "0x1" {
+		t.Fatalf("unexpected BlobModifiedError: %+v", modifiedErr)
+	}
+	if string(actual) != "hel" {
+		t.Fatalf("expected the contents to be %q but got %q", "hel", string(actual))
+	}
+}
+
+func TestGetResumableReaderMaxResumes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := &resumableServer{
+		contents:           "hello world",
+		etag:               "0x1",
+		truncateAfter:      0,
+		truncatedResponses: 10,
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	blobClient, err := NewWithBaseUri(httpServer.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := blobClient.GetResumableReader(ctx, "container", "blob.txt", GetResumableReaderInput{
+		MaxResumes: 2,
+	})
+	if err != nil {
+		t.Fatalf("retrieving reader: %+v", err)
+	}
+	defer result.Body.Close()
+
+	if _, err := io.ReadAll(result.Body); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected an unexpected EOF error after resuming twice but got: %+v", err)
+	}
+	if len(server.ranges) != 2 {
+		t.Fatalf("expected reading to be resumed twice but got %d", len(server.ranges))
+	}
+}
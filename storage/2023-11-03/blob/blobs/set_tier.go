@@ -4,14 +4,30 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetTierInput struct {
+	// The Access Tier which should be set on the Blob
 	Tier AccessTier
+
+	// The priority with which to rehydrate an archived Blob, either `High` or `Standard`.
+	// This can only be specified when changing the Tier of a Blob from `Archive` to an online Tier.
+	RehydratePriority *RehydratePriority
+
+	// The DateTime of the Snapshot whose Tier should be set, rather than the base Blob
+	Snapshot *string
+
+	// The ID of the Version whose Tier should be set, rather than the current Version of the Blob
+	VersionID *string
+
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
 }
 
 type SetTierResponse struct {
@@ -25,8 +41,8 @@ func (c Client) SetTier(ctx context.Context, containerName, blobName string, inp
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -34,6 +50,25 @@ func (c Client) SetTier(ctx context.Context, containerName, blobName string, inp
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+
+	if input.Tier == "" {
+		err = fmt.Errorf("`input.Tier` cannot be an empty string")
+		return
+	}
+
+	if input.RehydratePriority != nil && *input.RehydratePriority != High && *input.RehydratePriority != Standard {
+		err = fmt.Errorf("`input.RehydratePriority` must be either %q or %q", High, Standard)
+		return
+	}
+
+	if input.Snapshot != nil && input.VersionID != nil {
+		err = fmt.Errorf("at most one of `input.Snapshot` and `input.VersionID` can be specified")
+		return
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -42,7 +77,7 @@ func (c Client) SetTier(ctx context.Context, containerName, blobName string, inp
 		},
 		HttpMethod: http.MethodPut,
 		OptionsObject: setTierOptions{
-			tier: input.Tier,
+			input: input,
 		},
 		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
 	}
@@ -59,7 +94,7 @@ func (c Client) SetTier(ctx context.Context, containerName, blobName string, inp
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -67,12 +102,21 @@ func (c Client) SetTier(ctx context.Context, containerName, blobName string, inp
 }
 
 type setTierOptions struct {
-	tier AccessTier
+	input SetTierInput
 }
 
 func (s setTierOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	headers.Append("x-ms-access-tier", string(s.tier))
+	headers.Append("x-ms-access-tier", string(s.input.Tier))
+
+	if s.input.RehydratePriority != nil {
+		headers.Append("x-ms-rehydrate-priority", string(*s.input.RehydratePriority))
+	}
+
+	if s.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *s.input.LeaseID)
+	}
+
 	return headers
 }
 
@@ -83,5 +127,14 @@ func (s setTierOptions) ToOData() *odata.Query {
 func (s setTierOptions) ToQuery() *client.QueryParams {
 	out := &client.QueryParams{}
 	out.Append("comp", "tier")
+
+	if s.input.Snapshot != nil {
+		out.Append("snapshot", *s.input.Snapshot)
+	}
+
+	if s.input.VersionID != nil {
+		out.Append("versionid", *s.input.VersionID)
+	}
+
 	return out
 }
@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SnapshotInput struct {
@@ -41,6 +42,9 @@ type SnapshotInput struct {
 	// does not match the value specified.
 	// If the values are identical, the Blob service returns status code 412 (Precondition Failed).
 	IfNoneMatch *string
+
+	// Optional - A Customer-Provided Key used to encrypt the Blob (which must match the key used to write the Blob), which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
 }
 
 type SnapshotResponse struct {
@@ -62,8 +66,8 @@ func (c Client) Snapshot(ctx context.Context, containerName, blobName string, in
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -71,12 +75,20 @@ func (c Client) Snapshot(ctx context.Context, containerName, blobName string, in
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if err = metadata.Validate(input.MetaData); err != nil {
 		err = fmt.Errorf(fmt.Sprintf("`input.MetaData` is not valid: %s.", err))
 		return
 	}
 
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
@@ -107,7 +119,7 @@ func (c Client) Snapshot(ctx context.Context, containerName, blobName string, in
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -146,6 +158,8 @@ func (s snapshotOptions) ToHeaders() *client.Headers {
 	}
 
 	headers.Merge(metadata.SetMetaDataHeaders(s.input.MetaData))
+	headers.Merge(s.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
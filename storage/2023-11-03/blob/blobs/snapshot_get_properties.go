@@ -5,11 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetSnapshotPropertiesInput struct {
@@ -19,6 +20,10 @@ type GetSnapshotPropertiesInput struct {
 
 	// The ID of the Snapshot which should be retrieved
 	SnapshotID string
+
+	// Optional - The Customer-Provided Key which was used to write the Blob, which must be specified to read a Blob
+	// written using a Customer-Provided Key
+	CustomerProvidedKey *CustomerProvidedKey
 }
 
 // GetSnapshotProperties returns all user-defined metadata, standard HTTP properties, and system properties for
@@ -29,8 +34,8 @@ func (c Client) GetSnapshotProperties(ctx context.Context, containerName, blobNa
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -38,12 +43,20 @@ func (c Client) GetSnapshotProperties(ctx context.Context, containerName, blobNa
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	if input.SnapshotID == "" {
 		err = fmt.Errorf("`input.SnapshotID` cannot be an empty string")
 		return
 	}
 
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, nil); err != nil {
+		return
+	}
+
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
 			http.StatusOK,
@@ -137,7 +150,7 @@ func (c Client) GetSnapshotProperties(ctx context.Context, containerName, blobNa
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -156,6 +169,8 @@ func (s snapshotGetPropertiesOptions) ToHeaders() *client.Headers {
 	if s.input.LeaseID != nil {
 		headers.Append("x-ms-lease-id", *s.input.LeaseID)
 	}
+	headers.Merge(s.input.CustomerProvidedKey.headers())
+
 	return headers
 }
 
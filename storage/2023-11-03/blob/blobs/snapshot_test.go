@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/jackofallops/giovanni/storage/2020-08-04/blob/containers"
@@ -144,6 +145,20 @@ func TestSnapshotLifecycle(t *testing.T) {
 		t.Fatalf("Error retrieving properties for the second snapshot: %s", err)
 	}
 
+	t.Logf("[DEBUG] Reading the Second Snapshot..")
+	getSecondSnapshotContentsInput := GetInput{
+		StartByte: pointer.To(int64(0)),
+		EndByte:   pointer.To(int64(1023)),
+		Snapshot:  &secondSnapshot.SnapshotDateTime,
+	}
+	getSecondSnapshot, err := blobClient.Get(ctx, containerName, fileName, getSecondSnapshotContentsInput)
+	if err != nil {
+		t.Fatalf("Error reading the second snapshot: %s", err)
+	}
+	if getSecondSnapshot.Contents == nil || len(*getSecondSnapshot.Contents) != 1024 {
+		t.Fatalf("Expected 1024 bytes to be read from the second snapshot")
+	}
+
 	t.Logf("[DEBUG] Deleting the Second Snapshot..")
 	deleteSnapshotInput := DeleteSnapshotInput{
 		SnapshotDateTime: secondSnapshot.SnapshotDateTime,
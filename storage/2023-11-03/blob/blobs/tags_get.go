@@ -0,0 +1,121 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/tags"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetTagsInput struct {
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+}
+
+type GetTagsResponse struct {
+	HttpResponse *http.Response
+
+	// The user-defined Index Tags assigned to this Blob, in the order returned by the service
+	Tags BlobTags
+}
+
+// BlobTags is an ordered set of Blob Index Tags, see `Get`, `Set` and `Validate`
+type BlobTags = tags.BlobTags
+
+// Tag is a single Blob Index Tag within BlobTags
+type Tag = tags.Tag
+
+// GetTags returns the user-defined Index Tags for the specified Blob.
+func (c Client) GetTags(ctx context.Context, containerName, blobName string, input GetTagsInput) (result GetTagsResponse, err error) {
+	if containerName == "" {
+		err = fmt.Errorf("`containerName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
+
+	if blobName == "" {
+		err = fmt.Errorf("`blobName` cannot be an empty string")
+		return
+	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		err = fmt.Errorf("`input.LeaseID` cannot be an empty string, if specified")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: getTagsOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			var model tags.Tags
+			err = resp.Unmarshal(&model)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+			result.Tags = model.ToBlobTags()
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type getTagsOptions struct {
+	input GetTagsInput
+}
+
+func (g getTagsOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if g.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *g.input.LeaseID)
+	}
+	return headers
+}
+
+func (g getTagsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (g getTagsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "tags")
+	return out
+}
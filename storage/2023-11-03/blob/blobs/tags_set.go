@@ -0,0 +1,117 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/tags"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type SetTagsInput struct {
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 403 is returned
+	LeaseID *string
+
+	// The Index Tags which should be assigned to this Blob, replacing any existing Tags.
+	// At most 10 Tags can be specified, Keys must be unique and between 1 and 128 characters and Values at most 256 characters.
+	Tags BlobTags
+}
+
+type SetTagsResponse struct {
+	HttpResponse *http.Response
+}
+
+// SetTags sets the user-defined Index Tags for the specified Blob, replacing any existing Tags.
+func (c Client) SetTags(ctx context.Context, containerName, blobName string, input SetTagsInput) (result SetTagsResponse, err error) {
+	if containerName == "" {
+		err = fmt.Errorf("`containerName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
+
+	if blobName == "" {
+		err = fmt.Errorf("`blobName` cannot be an empty string")
+		return
+	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		err = fmt.Errorf("`input.LeaseID` cannot be an empty string, if specified")
+		return
+	}
+
+	if err = input.Tags.Validate(); err != nil {
+		err = fmt.Errorf("`input.Tags` is not valid: %s", err)
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: setTagsOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	body := tags.FromBlobTags(input.Tags)
+	if err = req.Marshal(&body); err != nil {
+		err = fmt.Errorf("marshalling request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type setTagsOptions struct {
+	input SetTagsInput
+}
+
+func (s setTagsOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if s.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *s.input.LeaseID)
+	}
+	return headers
+}
+
+func (s setTagsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (s setTagsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "tags")
+	return out
+}
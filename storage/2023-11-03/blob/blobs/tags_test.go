@@ -0,0 +1,98 @@
+package blobs
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSetAndGetTags(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var stored string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/container/blob.txt" || r.URL.Query().Get("comp") != "tags" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			stored = strings.TrimPrefix(string(body), xml.Header)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(stored))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	var input BlobTags
+	input.Set("project", "giovanni")
+	input.Set("env", "test")
+	if _, err := blobClient.SetTags(ctx, "container", "blob.txt", SetTagsInput{Tags: input}); err != nil {
+		t.Fatalf("setting tags: %+v", err)
+	}
+
+	expected := `<Tags><TagSet><Tag><Key>project</Key><Value>giovanni</Value></Tag><Tag><Key>env</Key><Value>test</Value></Tag></TagSet></Tags>`
+	if stored != expected {
+		t.Fatalf("expected the request body to be %q but got %q", expected, stored)
+	}
+
+	result, err := blobClient.GetTags(ctx, "container", "blob.txt", GetTagsInput{})
+	if err != nil {
+		t.Fatalf("retrieving tags: %+v", err)
+	}
+	if keys := result.Tags.Keys(); len(keys) != 2 || keys[0] != "project" || keys[1] != "env" {
+		t.Fatalf("expected the tags to be returned in order but got %+v", keys)
+	}
+	if v, ok := result.Tags.Get("env"); !ok || v != "test" {
+		t.Fatalf("expected the tag `env` to be %q but got %q", "test", v)
+	}
+}
+
+func TestSetTagsValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input BlobTags
+	}{
+		{
+			Name:  "Duplicate Keys",
+			Input: BlobTags{Tag{Key: "project", Value: "a"}, Tag{Key: "project", Value: "b"}},
+		},
+		{
+			Name:  "Key Too Long",
+			Input: BlobTags{{Key: strings.Repeat("a", 129), Value: "value"}},
+		},
+		{
+			Name:  "Value Too Long",
+			Input: BlobTags{{Key: "key", Value: strings.Repeat("a", 257)}},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).SetTags(ctx, "container", "blob.txt", SetTagsInput{Tags: v.Input}); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
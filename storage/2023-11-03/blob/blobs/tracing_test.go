@@ -0,0 +1,73 @@
+package blobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/baseclient"
+)
+
+type testTracer struct {
+	names []string
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, baseclient.Span) {
+	t.names = append(t.names, name)
+	return ctx, testSpan{}
+}
+
+type testSpan struct{}
+
+func (testSpan) SetAttribute(string, interface{}) {}
+
+func (testSpan) AddEvent(string, map[string]interface{}) {}
+
+func (testSpan) RecordError(error) {}
+
+func (testSpan) End() {}
+
+func TestTracingSpanNames(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-ms-copy-source") != "" {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	blobClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	tracer := &testTracer{}
+	blobClient.Client.SetTracer(tracer)
+
+	if _, err := blobClient.PutBlockBlob(ctx, "container", "blob.txt", PutBlockBlobInput{
+		Content: pointer.To([]byte("hello world")),
+	}); err != nil {
+		t.Fatalf("putting blob: %+v", err)
+	}
+	if _, err := blobClient.Copy(ctx, "container", "copy.txt", CopyInput{
+		CopySource: server.URL + "/container/blob.txt",
+	}); err != nil {
+		t.Fatalf("copying blob: %+v", err)
+	}
+
+	expected := []string{"Blobs.PutBlockBlob", "Blobs.Copy"}
+	if len(tracer.names) != len(expected) {
+		t.Fatalf("expected the spans %+v but got %+v", expected, tracer.names)
+	}
+	for i := range expected {
+		if tracer.names[i] != expected[i] {
+			t.Fatalf("expected the spans %+v but got %+v", expected, tracer.names)
+		}
+	}
+}
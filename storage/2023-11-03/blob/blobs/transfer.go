@@ -0,0 +1,105 @@
+package blobs
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+)
+
+const (
+	// defaultTransferChunkSize is the size of each range downloaded/block uploaded when transferring a file
+	defaultTransferChunkSize = int64(4 * 1024 * 1024)
+
+	// defaultTransferParallelism is the number of ranges downloaded/blocks uploaded at once when transferring a file
+	defaultTransferParallelism = 4
+
+	// maxBlockSize is the maximum size of a single Block within a Block Blob
+	maxBlockSize = int64(4000 * 1024 * 1024)
+
+	// maxBlocksPerBlob is the maximum number of committed Blocks within a Block Blob
+	maxBlocksPerBlob = 50000
+)
+
+// transferChunk is a range of bytes within a file which is transferred as a single request
+type transferChunk struct {
+	index  int
+	offset int64
+	length int64
+}
+
+// splitIntoChunks splits `size` bytes into chunks of (at most) `chunkSize` bytes
+func splitIntoChunks(size, chunkSize int64) []transferChunk {
+	chunks := make([]transferChunk, 0)
+	for offset := int64(0); offset < size; offset += chunkSize {
+		length := chunkSize
+		if remaining := size - offset; remaining < length {
+			length = remaining
+		}
+		chunks = append(chunks, transferChunk{
+			index:  len(chunks),
+			offset: offset,
+			length: length,
+		})
+	}
+	return chunks
+}
+
+// transferParallelism returns the number of chunks which should be transferred at once for the specified input
+func transferParallelism(input, chunks int) int {
+	parallelism := input
+	if parallelism <= 0 {
+		parallelism = defaultTransferParallelism
+	}
+	if parallelism > chunks {
+		parallelism = chunks
+	}
+	return parallelism
+}
+
+// transferInParallel calls `fn` for each chunk, using at most `parallelism` goroutines. The first error returned
+// from `fn` cancels the context passed to the remaining calls, and is returned once all calls have completed.
+func transferInParallel(ctx context.Context, chunks []transferChunk, parallelism int, fn func(ctx context.Context, chunk transferChunk) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var waitGroup sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	jobs := make(chan transferChunk)
+	for i := 0; i < parallelism; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for chunk := range jobs {
+				if err := fn(ctx, chunk); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	for _, chunk := range chunks {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- chunk
+	}
+	close(jobs)
+	waitGroup.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// blockIDForChunk returns the (base64-encoded) Block ID used for the chunk at `index` when uploading a file, which is
+// deterministic so that the Blocks uploaded by a previous (interrupted) upload can be identified when resuming
+func blockIDForChunk(index int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("block-%06d", index)))
+}
@@ -0,0 +1,239 @@
+package blobs
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/jackofallops/giovanni/storage/2020-08-04/blob/containers"
+	"github.com/jackofallops/giovanni/storage/internal/testhelpers"
+)
+
+func TestSplitIntoChunks(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Size      int64
+		ChunkSize int64
+		Expected  []transferChunk
+	}{
+		{
+			Name:      "Empty",
+			Size:      0,
+			ChunkSize: 4,
+			Expected:  []transferChunk{},
+		},
+		{
+			Name:      "Smaller than a Chunk",
+			Size:      3,
+			ChunkSize: 4,
+			Expected: []transferChunk{
+				{index: 0, offset: 0, length: 3},
+			},
+		},
+		{
+			Name:      "Exact Multiple",
+			Size:      8,
+			ChunkSize: 4,
+			Expected: []transferChunk{
+				{index: 0, offset: 0, length: 4},
+				{index: 1, offset: 4, length: 4},
+			},
+		},
+		{
+			Name:      "Partial Final Chunk",
+			Size:      10,
+			ChunkSize: 4,
+			Expected: []transferChunk{
+				{index: 0, offset: 0, length: 4},
+				{index: 1, offset: 4, length: 4},
+				{index: 2, offset: 8, length: 2},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := splitIntoChunks(v.Size, v.ChunkSize)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("expected %d chunks but got %d", len(v.Expected), len(actual))
+		}
+		for i := range actual {
+			if actual[i] != v.Expected[i] {
+				t.Fatalf("expected chunk %d to be %+v but got %+v", i, v.Expected[i], actual[i])
+			}
+		}
+	}
+}
+
+func TestTransferInParallel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	chunks := splitIntoChunks(100, 1)
+
+	var calls int64
+	err := transferInParallel(ctx, chunks, 4, func(ctx context.Context, chunk transferChunk) error {
+		atomic.AddInt64(&calls, 1)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if calls != 100 {
+		t.Fatalf("expected 100 calls but got %d", calls)
+	}
+
+	calls = 0
+	err = transferInParallel(ctx, chunks, 4, func(ctx context.Context, chunk transferChunk) error {
+		atomic.AddInt64(&calls, 1)
+		if chunk.index == 10 {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "failed" {
+		t.Fatalf("expected the error from the failed chunk but got: %+v", err)
+	}
+	if calls == 100 {
+		t.Fatalf("expected the remaining chunks not to be transferred after a failure")
+	}
+}
+
+func TestBlockIDForChunk(t *testing.T) {
+	// Block IDs within a Blob must all be the same length
+	if len(blockIDForChunk(0)) != len(blockIDForChunk(maxBlocksPerBlob-1)) {
+		t.Fatalf("expected the Block IDs to be the same length but got %q and %q", blockIDForChunk(0), blockIDForChunk(maxBlocksPerBlob-1))
+	}
+	if blockIDForChunk(1) != blockIDForChunk(1) || blockIDForChunk(1) == blockIDForChunk(2) {
+		t.Fatalf("expected the Block IDs to be deterministic and unique")
+	}
+}
+
+func TestTransferFileValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if _, err := (Client{}).DownloadToFile(ctx, "container", "blob", "", DownloadToFileInput{}); err == nil {
+		t.Fatalf("expected an error for an empty `localPath` but didn't get one")
+	}
+	if _, err := (Client{}).DownloadToFile(ctx, "container", "blob", "file.txt", DownloadToFileInput{ChunkSize: -1}); err == nil {
+		t.Fatalf("expected an error for a negative `ChunkSize` but didn't get one")
+	}
+	if _, err := (Client{}).UploadFile(ctx, "container", "blob", "", UploadFileInput{}); err == nil {
+		t.Fatalf("expected an error for an empty `localPath` but didn't get one")
+	}
+	if _, err := (Client{}).UploadFile(ctx, "container", "blob", "file.txt", UploadFileInput{BlockSize: maxBlockSize + 1}); err == nil {
+		t.Fatalf("expected an error for a `BlockSize` larger than the maximum but didn't get one")
+	}
+}
+
+func TestUploadAndDownloadFile(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
+	defer cancel()
+
+	client, err := testhelpers.Build(ctx, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceGroup := fmt.Sprintf("acctestrg-%d", testhelpers.RandomInt())
+	accountName := fmt.Sprintf("acctestsa%s", testhelpers.RandomString())
+	containerName := fmt.Sprintf("cont-%d", testhelpers.RandomInt())
+	fileName := "example.bin"
+
+	testData, err := client.BuildTestResources(ctx, resourceGroup, accountName, storageaccounts.KindBlobStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.DestroyTestResources(ctx, resourceGroup, accountName)
+
+	domainSuffix, ok := client.Environment.Storage.DomainSuffix()
+	if !ok {
+		t.Fatalf("storage didn't return a domain suffix for this environment")
+	}
+	baseUri := fmt.Sprintf("https://%s.blob.%s", testData.StorageAccountName, *domainSuffix)
+
+	containersClient, err := containers.NewWithBaseUri(baseUri)
+	if err != nil {
+		t.Fatalf("building client for environment: %+v", err)
+	}
+	if err = client.PrepareWithSharedKeyAuth(containersClient.Client, testData, auth.SharedKey); err != nil {
+		t.Fatalf("adding authorizer to client: %+v", err)
+	}
+
+	_, err = containersClient.Create(ctx, containerName, containers.CreateInput{})
+	if err != nil {
+		t.Fatal(fmt.Errorf("Error creating: %s", err))
+	}
+	defer containersClient.Delete(ctx, containerName)
+
+	blobClient, err := NewWithBaseUri(baseUri)
+	if err != nil {
+		t.Fatalf("building client for environment: %+v", err)
+	}
+	if err = client.PrepareWithSharedKeyAuth(blobClient.Client, testData, auth.SharedKey); err != nil {
+		t.Fatalf("adding authorizer to client: %+v", err)
+	}
+
+	contents := make([]byte, 10*1024*1024+123)
+	if _, err = rand.Read(contents); err != nil {
+		t.Fatalf("generating contents: %+v", err)
+	}
+	directory := t.TempDir()
+	sourcePath := filepath.Join(directory, "source.bin")
+	if err = os.WriteFile(sourcePath, contents, 0644); err != nil {
+		t.Fatalf("writing %q: %+v", sourcePath, err)
+	}
+
+	t.Logf("[DEBUG] Uploading the first block, to simulate an interrupted upload..")
+	blockSize := int64(1024 * 1024)
+	if _, err = blobClient.PutBlock(ctx, containerName, fileName, PutBlockInput{
+		BlockID: blockIDForChunk(0),
+		Content: contents[:blockSize],
+	}); err != nil {
+		t.Fatalf("putting block: %+v", err)
+	}
+
+	t.Logf("[DEBUG] Resuming the upload..")
+	uploadResult, err := blobClient.UploadFile(ctx, containerName, fileName, sourcePath, UploadFileInput{
+		BlockSize:   blockSize,
+		Parallelism: 4,
+		Resume:      true,
+	})
+	if err != nil {
+		t.Fatalf("uploading file: %+v", err)
+	}
+	if uploadResult.SkippedBlocks != 1 {
+		t.Fatalf("expected 1 block to be skipped but got %d", uploadResult.SkippedBlocks)
+	}
+
+	t.Logf("[DEBUG] Downloading the file..")
+	destinationPath := filepath.Join(directory, "destination.bin")
+	downloadResult, err := blobClient.DownloadToFile(ctx, containerName, fileName, destinationPath, DownloadToFileInput{
+		ChunkSize:   blockSize,
+		Parallelism: 4,
+	})
+	if err != nil {
+		t.Fatalf("downloading file: %+v", err)
+	}
+	if downloadResult.ContentLength != int64(len(contents)) {
+		t.Fatalf("expected the Content-Length to be %d but got %d", len(contents), downloadResult.ContentLength)
+	}
+
+	downloaded, err := os.ReadFile(destinationPath)
+	if err != nil {
+		t.Fatalf("reading %q: %+v", destinationPath, err)
+	}
+	if !bytes.Equal(downloaded, contents) {
+		t.Fatalf("expected the downloaded file to match the uploaded file")
+	}
+}
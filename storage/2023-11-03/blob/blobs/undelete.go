@@ -2,12 +2,14 @@ package blobs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type UndeleteResponse struct {
@@ -15,14 +17,15 @@ type UndeleteResponse struct {
 }
 
 // Undelete restores the contents and metadata of soft deleted blob and any associated soft deleted snapshots.
+// A BlobNotSoftDeletedError (wrapping the ResponseError) is returned when the blob doesn't exist as a soft deleted blob.
 func (c Client) Undelete(ctx context.Context, containerName, blobName string) (result UndeleteResponse, err error) {
 	if containerName == "" {
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
 
-	if strings.ToLower(containerName) != containerName {
-		err = fmt.Errorf("`containerName` must be a lower-cased string")
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
 		return
 	}
 
@@ -30,6 +33,10 @@ func (c Client) Undelete(ctx context.Context, containerName, blobName string) (r
 		err = fmt.Errorf("`blobName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateBlobName(blobName); err != nil {
+		err = fmt.Errorf("`blobName` is not valid: %+v", err)
+		return
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -50,9 +57,20 @@ func (c Client) Undelete(ctx context.Context, containerName, blobName string) (r
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		// a missing Container returns a `ContainerNotFound` error instead, which is returned as-is
+		if err != nil && resp.StatusCode == http.StatusNotFound && resp.Header.Get("x-ms-error-code") == "BlobNotFound" {
+			notSoftDeletedErr := BlobNotSoftDeletedError{
+				ContainerName: containerName,
+				BlobName:      blobName,
+			}
+			errors.As(responseerror.New(resp, err), &notSoftDeletedErr.ResponseError)
+			err = fmt.Errorf("executing request: %w", notSoftDeletedErr)
+			return
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -0,0 +1,71 @@
+package blobs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+func TestUndeleteNotFound(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name                      string
+		ErrorCode                 string
+		ExpectNotSoftDeletedError bool
+	}{
+		{
+			Name:                      "Blob Not Found",
+			ErrorCode:                 "BlobNotFound",
+			ExpectNotSoftDeletedError: true,
+		},
+		{
+			Name:                      "Container Not Found",
+			ErrorCode:                 "ContainerNotFound",
+			ExpectNotSoftDeletedError: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("x-ms-error-code", v.ErrorCode)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+
+		blobClient, err := NewWithBaseUri(server.URL)
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+
+		_, err = blobClient.Undelete(ctx, "container", "blob.txt")
+		server.Close()
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		var notSoftDeletedErr BlobNotSoftDeletedError
+		if actual := errors.As(err, &notSoftDeletedErr); actual != v.ExpectNotSoftDeletedError {
+			t.Fatalf("expected errors.As(BlobNotSoftDeletedError) to be %t but got %t: %+v", v.ExpectNotSoftDeletedError, actual, err)
+		}
+
+		// the ResponseError is available regardless
+		if !responseerror.IsNotFound(err) {
+			t.Fatalf("expected IsNotFound to be true: %+v", err)
+		}
+		var responseErr responseerror.ResponseError
+		if !errors.As(err, &responseErr) {
+			t.Fatalf("expected a ResponseError but got: %+v", err)
+		}
+		if responseErr.Code != v.ErrorCode {
+			t.Fatalf("expected the Code to be %q but got %q", v.ErrorCode, responseErr.Code)
+		}
+	}
+}
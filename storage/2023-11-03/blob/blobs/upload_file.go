@@ -0,0 +1,207 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/internal/progress"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+	"github.com/jackofallops/giovanni/storage/transfermanager"
+)
+
+type UploadFileInput struct {
+	// The number of blocks which should be uploaded in parallel, defaults to 4
+	Parallelism int
+
+	// Optional - A TransferManager shared with other uploads and downloads, which limits the number of blocks
+	// uploaded at once (and the bandwidth used) across all of them, in addition to Parallelism
+	TransferManager *transfermanager.TransferManager
+
+	// The size (in bytes) of each block which should be uploaded, defaults to 4 MiB and can be at most 4000 MiB.
+	// A Block Blob can contain at most 50,000 blocks, so this must be large enough to upload the file in 50,000 blocks.
+	BlockSize int64
+
+	// Should any blocks already uploaded to the Blob by a previous (interrupted) call to UploadFile be skipped?
+	// Blocks are skipped when a block with the same Block ID and size has already been uploaded - as such when
+	// resuming the file (and BlockSize) must be the same as when the upload was started.
+	Resume bool
+
+	CacheControl       *string
+	ContentDisposition *string
+	ContentEncoding    *string
+	ContentLanguage    *string
+	ContentType        *string
+	LeaseID            *string
+	EncryptionScope    *string
+	MetaData           map[string]string
+
+	// Optional - A Customer-Provided Key used to encrypt the Blob, which cannot be specified alongside EncryptionScope
+	CustomerProvidedKey *CustomerProvidedKey
+
+	// Optional - A callback which is fired as each block is uploaded (or skipped when resuming), with the cumulative
+	// number of bytes uploaded and the size of the file. A final callback is fired once the Blob has been committed.
+	Progress func(bytesTransferred, totalBytes int64)
+}
+
+type UploadFileResponse struct {
+	HttpResponse *http.Response
+
+	ContentMD5   string
+	ETag         string
+	LastModified time.Time
+
+	// The number of blocks which were skipped since they'd already been uploaded, when resuming
+	SkippedBlocks int
+}
+
+// UploadFile is a helper method which uploads the file at `localPath` as a Block Blob, by uploading blocks of the file
+// in parallel and then committing them. When `Resume` is set the blocks which have already been uploaded (from a
+// previous call which was interrupted) are skipped.
+func (c Client) UploadFile(ctx context.Context, containerName, blobName, localPath string, input UploadFileInput) (result UploadFileResponse, err error) {
+	if localPath == "" {
+		return result, fmt.Errorf("`localPath` cannot be an empty string")
+	}
+
+	if input.Parallelism < 0 {
+		return result, fmt.Errorf("`input.Parallelism` must be greater than or equal to 0")
+	}
+
+	if input.BlockSize < 0 || input.BlockSize > maxBlockSize {
+		return result, fmt.Errorf("`input.BlockSize` must be between 0 and %d bytes", maxBlockSize)
+	}
+
+	if err = validateCustomerProvidedKey(input.CustomerProvidedKey, input.EncryptionScope); err != nil {
+		return
+	}
+
+	file, err := os.Open(localPath)
+	if err != nil {
+		return result, fmt.Errorf("opening %q: %+v", localPath, err)
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return result, fmt.Errorf("loading file info for %q: %+v", localPath, err)
+	}
+	fileSize := fileInfo.Size()
+
+	blockSize := input.BlockSize
+	if blockSize == 0 {
+		blockSize = defaultTransferChunkSize
+	}
+	chunks := splitIntoChunks(fileSize, blockSize)
+	if len(chunks) > maxBlocksPerBlob {
+		return result, fmt.Errorf("uploading %q (%d bytes) in blocks of %d bytes requires %d blocks, but a Block Blob can contain at most %d blocks - `input.BlockSize` must be increased", localPath, fileSize, blockSize, len(chunks), maxBlocksPerBlob)
+	}
+
+	uploaded := make(map[string]int64)
+	if input.Resume {
+		if uploaded, err = c.uploadedBlocks(ctx, containerName, blobName, input.LeaseID); err != nil {
+			return result, fmt.Errorf("retrieving the blocks already uploaded: %w", err)
+		}
+	}
+
+	tracker := progress.NewTracker(input.Progress, fileSize)
+	pending := make([]transferChunk, 0)
+	blockIDs := make([]BlockID, 0)
+	for _, chunk := range chunks {
+		blockID := blockIDForChunk(chunk.index)
+		blockIDs = append(blockIDs, BlockID{
+			Value: blockID,
+		})
+
+		if size, ok := uploaded[blockID]; ok && size == chunk.length {
+			result.SkippedBlocks++
+			tracker.Add(chunk.length)
+			continue
+		}
+		pending = append(pending, chunk)
+	}
+
+	err = transferInParallel(ctx, pending, transferParallelism(input.Parallelism, len(pending)), func(ctx context.Context, chunk transferChunk) error {
+		return input.TransferManager.Do(ctx, chunk.length, func(ctx context.Context) error {
+			return c.uploadChunkFromFile(ctx, containerName, blobName, file, chunk, input, tracker)
+		})
+	})
+	if err != nil {
+		return result, fmt.Errorf("uploading %q: %w", localPath, err)
+	}
+
+	resp, err := c.PutBlockList(ctx, containerName, blobName, PutBlockListInput{
+		BlockList: BlockList{
+			LatestBlockIDs: blockIDs,
+		},
+		CacheControl:        input.CacheControl,
+		ContentDisposition:  input.ContentDisposition,
+		ContentEncoding:     input.ContentEncoding,
+		ContentLanguage:     input.ContentLanguage,
+		ContentType:         input.ContentType,
+		LeaseID:             input.LeaseID,
+		EncryptionScope:     input.EncryptionScope,
+		MetaData:            input.MetaData,
+		CustomerProvidedKey: input.CustomerProvidedKey,
+	})
+	result.HttpResponse = resp.HttpResponse
+	if err != nil {
+		return result, fmt.Errorf("committing blocks: %w", err)
+	}
+	result.ContentMD5 = resp.ContentMD5
+	result.ETag = resp.ETag
+	result.LastModified = resp.LastModified
+
+	tracker.Complete()
+	return result, nil
+}
+
+// uploadedBlocks returns the size of each (committed or uncommitted) block which has already been uploaded to the Blob,
+// keyed by Block ID - which is empty when the Blob doesn't exist
+func (c Client) uploadedBlocks(ctx context.Context, containerName, blobName string, leaseID *string) (map[string]int64, error) {
+	output := make(map[string]int64)
+
+	blockList, err := c.GetBlockList(ctx, containerName, blobName, GetBlockListInput{
+		BlockListType: All,
+		LeaseID:       leaseID,
+	})
+	if err != nil {
+		if responseerror.IsNotFound(err) {
+			return output, nil
+		}
+		return nil, err
+	}
+
+	for _, block := range blockList.CommittedBlocks.Blocks {
+		output[block.Name] = block.Size
+	}
+	for _, block := range blockList.UncommittedBlocks.Blocks {
+		output[block.Name] = block.Size
+	}
+
+	return output, nil
+}
+
+func (c Client) uploadChunkFromFile(ctx context.Context, containerName, blobName string, file *os.File, chunk transferChunk, input UploadFileInput, tracker *progress.Tracker) error {
+	content := make([]byte, chunk.length)
+	if _, err := file.ReadAt(content, chunk.offset); err != nil && err != io.EOF {
+		return fmt.Errorf("reading bytes %d-%d: %+v", chunk.offset, chunk.offset+chunk.length-1, err)
+	}
+
+	_, err := c.PutBlock(ctx, containerName, blobName, PutBlockInput{
+		BlockID:             blockIDForChunk(chunk.index),
+		Content:             content,
+		LeaseID:             input.LeaseID,
+		EncryptionScope:     input.EncryptionScope,
+		ComputeContentCRC64: true,
+		CustomerProvidedKey: input.CustomerProvidedKey,
+	})
+	if err != nil {
+		return fmt.Errorf("putting block %d: %w", chunk.index, err)
+	}
+	tracker.Add(chunk.length)
+
+	return nil
+}
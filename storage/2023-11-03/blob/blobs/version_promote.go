@@ -0,0 +1,75 @@
+package blobs
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jackofallops/giovanni/storage/naming"
+)
+
+type PromoteVersionInput struct {
+	// The ID of the Version which should become the current Version of the Blob
+	VersionID string
+
+	// The ID of the Lease
+	// This must be specified if a Lease is present on the Blob, else a 412 is returned
+	LeaseID *string
+}
+
+// PromoteVersion makes a previous Version the current Version of the Blob, by copying the specified
+// Version over the base Blob and waiting for the copy to complete. The Version which was previously
+// the current Version is retained as a previous Version.
+func (c Client) PromoteVersion(ctx context.Context, containerName, blobName string, input PromoteVersionInput) error {
+	if containerName == "" {
+		return fmt.Errorf("`containerName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if blobName == "" {
+		return fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if err := naming.ValidateBlobName(blobName); err != nil {
+		return fmt.Errorf("`blobName` is not valid: %+v", err)
+	}
+
+	if input.VersionID == "" {
+		return fmt.Errorf("`input.VersionID` cannot be an empty string")
+	}
+
+	copySource, err := versionedBlobURL(c.Client.BaseUri, containerName, blobName, input.VersionID)
+	if err != nil {
+		return fmt.Errorf("building copy source for version %q: %+v", input.VersionID, err)
+	}
+
+	copyInput := CopyInput{
+		CopySource: copySource,
+		LeaseID:    input.LeaseID,
+	}
+	if err := c.CopyAndWait(ctx, containerName, blobName, copyInput); err != nil {
+		return fmt.Errorf("copying version %q over the blob: %+v", input.VersionID, err)
+	}
+
+	return nil
+}
+
+// versionedBlobURL returns the URL for the specified Version of a Blob, for use as a `x-ms-copy-source`
+func versionedBlobURL(baseUri, containerName, blobName, versionID string) (string, error) {
+	uri, err := url.Parse(baseUri)
+	if err != nil {
+		return "", fmt.Errorf("parsing base URI %q: %+v", baseUri, err)
+	}
+	// the names are set on the (unescaped) path, so that any reserved characters are escaped
+	uri.Path = fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(uri.Path, "/"), containerName, blobName)
+	uri.RawPath = ""
+
+	source := CopySource{
+		BlobURI:   uri.String(),
+		VersionID: &versionID,
+	}
+	return source.Build()
+}
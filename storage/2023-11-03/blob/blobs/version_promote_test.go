@@ -0,0 +1,64 @@
+package blobs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestVersionedBlobURL(t *testing.T) {
+	testData := []struct {
+		Name     string
+		BaseUri  string
+		BlobName string
+		Expected string
+	}{
+		{
+			Name:     "Blob within a Virtual Directory",
+			BaseUri:  "https://example.blob.core.windows.net/",
+			BlobName: "folder/blob.txt",
+			Expected: "https://example.blob.core.windows.net/container/folder/blob.txt?versionid=2024-01-01T00%3A00%3A00.0000000Z",
+		},
+		{
+			Name:     "Blob Name with Reserved Characters",
+			BaseUri:  "https://example.blob.core.windows.net",
+			BlobName: "my blob?#%.txt",
+			Expected: "https://example.blob.core.windows.net/container/my%20blob%3F%23%25.txt?versionid=2024-01-01T00%3A00%3A00.0000000Z",
+		},
+		{
+			Name:     "Base URI with a Path",
+			BaseUri:  "http://127.0.0.1:10000/devstoreaccount1",
+			BlobName: "blob.txt",
+			Expected: "http://127.0.0.1:10000/devstoreaccount1/container/blob.txt?versionid=2024-01-01T00%3A00%3A00.0000000Z",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := versionedBlobURL(v.BaseUri, "container", v.BlobName, "2024-01-01T00:00:00.0000000Z")
+		if err != nil {
+			t.Fatalf("building URL: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestVersionIDQueryParameter(t *testing.T) {
+	versionID := "2024-01-01T00:00:00.0000000Z"
+
+	if v := (getOptions{input: GetInput{VersionID: pointer.To(versionID)}}).ToQuery().Values().Get("versionid"); v != versionID {
+		t.Fatalf("expected the Get `versionid` to be %q but got %q", versionID, v)
+	}
+	if v := (deleteOptions{input: DeleteInput{VersionID: pointer.To(versionID)}}).ToQuery().Values().Get("versionid"); v != versionID {
+		t.Fatalf("expected the Delete `versionid` to be %q but got %q", versionID, v)
+	}
+	if v := (getPropertiesOptions{versionID: pointer.To(versionID)}).ToQuery().Values().Get("versionid"); v != versionID {
+		t.Fatalf("expected the GetProperties `versionid` to be %q but got %q", versionID, v)
+	}
+	if (getPropertiesOptions{}).ToQuery().Values().Has("versionid") {
+		t.Fatalf("expected the GetProperties `versionid` to be omitted when unset")
+	}
+}
@@ -7,14 +7,25 @@ import (
 type StorageContainer interface {
 	Create(ctx context.Context, containerName string, input CreateInput) (CreateResponse, error)
 	Delete(ctx context.Context, containerName string) (DeleteResponse, error)
+	Exists(ctx context.Context, containerName string) (ExistsResponse, error)
+	FindBlobsByTags(ctx context.Context, containerName string, input FindBlobsByTagsInput) (FindBlobsByTagsResponse, error)
+	FindBlobsByTagsComplete(ctx context.Context, containerName string, input FindBlobsByTagsInput) (FindBlobsByTagsCompleteResult, error)
+	NewFindBlobsByTagsIterator(containerName string, input FindBlobsByTagsInput) *FindBlobsByTagsIterator
+	GetACL(ctx context.Context, containerName string, input GetACLInput) (GetACLResponse, error)
 	GetProperties(ctx context.Context, containerName string, input GetPropertiesInput) (GetPropertiesResponse, error)
 	AcquireLease(ctx context.Context, containerName string, input AcquireLeaseInput) (AcquireLeaseResponse, error)
 	BreakLease(ctx context.Context, containerName string, input BreakLeaseInput) (BreakLeaseResponse, error)
 	ChangeLease(ctx context.Context, containerName string, input ChangeLeaseInput) (ChangeLeaseResponse, error)
 	ReleaseLease(ctx context.Context, containerName string, input ReleaseLeaseInput) (ReleaseLeaseResponse, error)
 	RenewLease(ctx context.Context, containerName string, input RenewLeaseInput) (RenewLeaseResponse, error)
+	List(ctx context.Context, input ListInput) (ListResponse, error)
+	ListComplete(ctx context.Context, input ListInput) (ListCompleteResult, error)
+	NewListIterator(input ListInput) *ListIterator
 	ListBlobs(ctx context.Context, containerName string, input ListBlobsInput) (ListBlobsResponse, error)
+	ListBlobsComplete(ctx context.Context, containerName string, input ListBlobsInput) (ListBlobsCompleteResult, error)
+	NewListBlobsIterator(containerName string, input ListBlobsInput) *ListBlobsIterator
 	GetResourceManagerResourceID(subscriptionID, resourceGroup, accountName, containerName string) string
+	SetACL(ctx context.Context, containerName string, input SetACLInput) (SetACLResponse, error)
 	SetAccessControl(ctx context.Context, containerName string, input SetAccessControlInput) (SetAccessControlResponse, error)
 	SetMetaData(ctx context.Context, containerName string, metaData SetMetaDataInput) (SetMetaDataResponse, error)
 }
@@ -3,16 +3,16 @@ package containers
 import (
 	"fmt"
 
-	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane/storage"
+	"github.com/jackofallops/giovanni/storage/baseclient"
 )
 
 // Client is the base client for Blob Storage Containers.
 type Client struct {
-	Client *storage.Client
+	Client *baseclient.Client
 }
 
 func NewWithBaseUri(baseUri string) (*Client, error) {
-	baseClient, err := storage.NewStorageClient(baseUri, componentName, apiVersion)
+	baseClient, err := baseclient.New(baseUri, componentName, apiVersion)
 	if err != nil {
 		return nil, fmt.Errorf("building base client: %+v", err)
 	}
@@ -20,3 +20,15 @@ func NewWithBaseUri(baseUri string) (*Client, error) {
 		Client: baseClient,
 	}, nil
 }
+
+// NewAnonymousWithBaseUri returns a Client which sends requests anonymously (without authorization), which can be
+// used to read from a Container with public access. Only read operations can be performed using this Client, other
+// operations return a `baseclient.AnonymousAccessError`.
+func NewAnonymousWithBaseUri(baseUri string) (*Client, error) {
+	client, err := NewWithBaseUri(baseUri)
+	if err != nil {
+		return nil, err
+	}
+	client.Client.SetAnonymous(true)
+	return client, nil
+}
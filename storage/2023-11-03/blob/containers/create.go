@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type CreateInput struct {
@@ -40,10 +42,17 @@ func (c Client) Create(ctx context.Context, containerName string, input CreateIn
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
 	if err = metadata.Validate(input.MetaData); err != nil {
 		err = fmt.Errorf("`input.MetaData` is not valid: %+v", err)
 		return
 	}
+	if err = validateAccessLevel(input.AccessLevel); err != nil {
+		return
+	}
 
 	// Retry the container creation if a conflicting container is still in the process of being deleted
 	retryFunc := func(resp *http.Response, _ *odata.OData) (bool, error) {
@@ -92,15 +101,30 @@ func (c Client) Create(ctx context.Context, containerName string, input CreateIn
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err != nil && resp.StatusCode == http.StatusConflict && resp.Header.Get("x-ms-error-code") == "ContainerAlreadyExists" {
+			err = ContainerAlreadyExistsError{
+				ContainerName: containerName,
+			}
+			return
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
+func validateAccessLevel(input AccessLevel) error {
+	switch input {
+	case Blob, Container, Private:
+		return nil
+	}
+	return fmt.Errorf("`input.AccessLevel` must be one of %q, %q or an empty string (private)", Blob, Container)
+}
+
 var _ client.Options = createOptions{}
 
 type createOptions struct {
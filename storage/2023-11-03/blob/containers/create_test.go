@@ -0,0 +1,43 @@
+package containers
+
+import "testing"
+
+func TestValidateAccessLevel(t *testing.T) {
+	testData := []struct {
+		Input         AccessLevel
+		ShouldBeValid bool
+	}{
+		{
+			Input:         Private,
+			ShouldBeValid: true,
+		},
+		{
+			Input:         Blob,
+			ShouldBeValid: true,
+		},
+		{
+			Input:         Container,
+			ShouldBeValid: true,
+		},
+		{
+			Input:         "none",
+			ShouldBeValid: false,
+		},
+		{
+			Input:         "Blob",
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		err := validateAccessLevel(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Input)
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteResponse struct {
@@ -19,6 +21,10 @@ func (c Client) Delete(ctx context.Context, containerName string) (result Delete
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
 
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
@@ -42,7 +48,7 @@ func (c Client) Delete(ctx context.Context, containerName string) (result Delete
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
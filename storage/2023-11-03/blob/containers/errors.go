@@ -0,0 +1,17 @@
+package containers
+
+import (
+	"fmt"
+)
+
+var _ error = ContainerAlreadyExistsError{}
+
+// ContainerAlreadyExistsError is returned when attempting to create a Container which already exists
+type ContainerAlreadyExistsError struct {
+	// The name of the Container which already exists
+	ContainerName string
+}
+
+func (e ContainerAlreadyExistsError) Error() string {
+	return fmt.Sprintf("the container %q already exists", e.ContainerName)
+}
@@ -0,0 +1,158 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/tags"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type FindBlobsByTagsInput struct {
+	// The Filter Expression used to find matching Blobs, for example `"Project" = 'giovanni' AND "Env" = 'test'`
+	// This is URL-encoded when the request is sent, so should be specified as-is.
+	Where string
+
+	// The value returned as `NextMarker` from a previous request, used to retrieve the next page of results
+	Marker *string
+
+	// The maximum number of Blobs to return, up to 5000
+	MaxResults *int
+}
+
+type FindBlobsByTagsResponse struct {
+	HttpResponse *http.Response
+
+	// The Blobs within the Container matching the Filter Expression
+	Blobs []FilteredBlob
+
+	// The Marker which should be used to retrieve the next page of results, if any
+	NextMarker *string
+}
+
+type FilteredBlob struct {
+	// The name of the Blob
+	Name string
+
+	// The name of the Container in which this Blob exists
+	ContainerName string
+
+	// The Index Tags on this Blob which matched the Filter Expression
+	Tags BlobTags
+}
+
+// FindBlobsByTags returns the Blobs within the specified Container whose Index Tags match the specified Filter Expression
+func (c Client) FindBlobsByTags(ctx context.Context, containerName string, input FindBlobsByTagsInput) (result FindBlobsByTagsResponse, err error) {
+	if containerName == "" {
+		return result, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
+
+	if input.Where == "" {
+		return result, fmt.Errorf("`input.Where` cannot be an empty string")
+	}
+
+	if input.MaxResults != nil && (*input.MaxResults <= 0 || *input.MaxResults > 5000) {
+		return result, fmt.Errorf("`input.MaxResults` can either be nil or between 0 and 5000")
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: findBlobsByTagsOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s", containerName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			var model filterBlobsResult
+			err = resp.Unmarshal(&model)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+			result.Blobs = model.flatten()
+			result.NextMarker = model.NextMarker
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type filterBlobsResult struct {
+	Blobs struct {
+		Blobs []filterBlobItem `xml:"Blob"`
+	} `xml:"Blobs"`
+	NextMarker *string `xml:"NextMarker,omitempty"`
+}
+
+type filterBlobItem struct {
+	Name          string    `xml:"Name"`
+	ContainerName string    `xml:"ContainerName"`
+	Tags          tags.Tags `xml:"Tags"`
+}
+
+func (f filterBlobsResult) flatten() []FilteredBlob {
+	out := make([]FilteredBlob, 0, len(f.Blobs.Blobs))
+	for _, v := range f.Blobs.Blobs {
+		out = append(out, FilteredBlob{
+			Name:          v.Name,
+			ContainerName: v.ContainerName,
+			Tags:          v.Tags.ToBlobTags(),
+		})
+	}
+	return out
+}
+
+var _ client.Options = findBlobsByTagsOptions{}
+
+type findBlobsByTagsOptions struct {
+	input FindBlobsByTagsInput
+}
+
+func (o findBlobsByTagsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o findBlobsByTagsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o findBlobsByTagsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("restype", "container")
+	out.Append("comp", "blobs")
+	out.Append("where", o.input.Where)
+	if o.input.Marker != nil {
+		out.Append("marker", *o.input.Marker)
+	}
+	if o.input.MaxResults != nil {
+		out.Append("maxresults", fmt.Sprintf("%d", *o.input.MaxResults))
+	}
+	return out
+}
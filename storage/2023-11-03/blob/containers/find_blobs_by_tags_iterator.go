@@ -0,0 +1,59 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackofallops/giovanni/storage/internal/pager"
+)
+
+// FindBlobsByTagsIterator retrieves successive pages of Blobs within a Container matching a Filter Expression,
+// following the `NextMarker` returned by the service until all of the results have been retrieved.
+type FindBlobsByTagsIterator struct {
+	pager *pager.MarkerIterator[FindBlobsByTagsResponse]
+}
+
+// NewFindBlobsByTagsIterator returns an iterator over the Blobs within the Container matching `input`.
+// The `Marker` within `input` (if specified) is used as the starting point.
+func (c Client) NewFindBlobsByTagsIterator(containerName string, input FindBlobsByTagsInput) *FindBlobsByTagsIterator {
+	return &FindBlobsByTagsIterator{
+		pager: pager.NewMarkerIterator(input.Marker, func(ctx context.Context, marker *string) (FindBlobsByTagsResponse, *string, error) {
+			input.Marker = marker
+			result, err := c.FindBlobsByTags(ctx, containerName, input)
+			return result, result.NextMarker, err
+		}),
+	}
+}
+
+// NotDone returns whether there are further pages of results to be retrieved
+func (i *FindBlobsByTagsIterator) NotDone() bool {
+	return i.pager.NotDone()
+}
+
+// Next retrieves the next page of results
+func (i *FindBlobsByTagsIterator) Next(ctx context.Context) (FindBlobsByTagsResponse, error) {
+	return i.pager.Next(ctx)
+}
+
+type FindBlobsByTagsCompleteResult struct {
+	// The Blobs within the Container matching the Filter Expression, across all pages of results
+	Blobs []FilteredBlob
+}
+
+// FindBlobsByTagsComplete retrieves all of the Blobs within the Container matching `input`,
+// following the `NextMarker` until all pages of results have been retrieved
+func (c Client) FindBlobsByTagsComplete(ctx context.Context, containerName string, input FindBlobsByTagsInput) (result FindBlobsByTagsCompleteResult, err error) {
+	iterator := c.NewFindBlobsByTagsIterator(containerName, input)
+	for iterator.NotDone() {
+		var page FindBlobsByTagsResponse
+		page, err = iterator.Next(ctx)
+		if err != nil {
+			err = fmt.Errorf("finding blobs by tags: %+v", err)
+			return
+		}
+
+		result.Blobs = append(result.Blobs, page.Blobs...)
+	}
+
+	return
+}
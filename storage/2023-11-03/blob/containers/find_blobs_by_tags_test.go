@@ -0,0 +1,140 @@
+package containers
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestFindBlobsByTagsResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example1.blob.core.windows.net/" ContainerName="container1">
+  <Where>"project" = 'giovanni'</Where>
+  <Blobs>
+    <Blob>
+      <Name>blob1.txt</Name>
+      <ContainerName>container1</ContainerName>
+      <Tags>
+        <TagSet>
+          <Tag>
+            <Key>project</Key>
+            <Value>giovanni</Value>
+          </Tag>
+        </TagSet>
+      </Tags>
+    </Blob>
+  </Blobs>
+  <NextMarker>abc123</NextMarker>
+</EnumerationResults>`
+
+	var model filterBlobsResult
+	if err := xml.Unmarshal([]byte(input), &model); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	actual := model.flatten()
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 blob but got %d", len(actual))
+	}
+	if actual[0].Name != "blob1.txt" || actual[0].ContainerName != "container1" {
+		t.Fatalf("expected the blob to be %q in %q but got %q in %q", "blob1.txt", "container1", actual[0].Name, actual[0].ContainerName)
+	}
+	if v, _ := actual[0].Tags.Get("project"); v != "giovanni" {
+		t.Fatalf("expected the tag `project` to be %q but got %q", "giovanni", v)
+	}
+	if model.NextMarker == nil || *model.NextMarker != "abc123" {
+		t.Fatalf("expected NextMarker to be %q but got %v", "abc123", model.NextMarker)
+	}
+}
+
+func TestFindBlobsByTagsRequest(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	containersClient, err := NewWithBaseUri("https://account1.blob.core.windows.net")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	where := `"project" = 'giovanni' AND "env" = 'a&b'`
+	req, err := containersClient.Client.NewRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: findBlobsByTagsOptions{
+			input: FindBlobsByTagsInput{
+				Where:      where,
+				Marker:     pointer.To("abc123"),
+				MaxResults: pointer.To(10),
+			},
+		},
+		Path: "/container1",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	query := req.URL.Query()
+	expected := map[string]string{
+		"restype":    "container",
+		"comp":       "blobs",
+		"where":      where,
+		"marker":     "abc123",
+		"maxresults": "10",
+	}
+	for k, v := range expected {
+		if actual := query.Get(k); actual != v {
+			t.Fatalf("expected the query-string parameter %q to be %q but got %q", k, v, actual)
+		}
+	}
+	if strings.ContainsAny(req.URL.RawQuery, `"' `) {
+		t.Fatalf("expected the Filter Expression to be URL-encoded but got %q", req.URL.RawQuery)
+	}
+}
+
+func TestFindBlobsByTagsValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name          string
+		ContainerName string
+		Input         FindBlobsByTagsInput
+	}{
+		{
+			Name:          "Empty Container Name",
+			ContainerName: "",
+			Input: FindBlobsByTagsInput{
+				Where: `"project" = 'giovanni'`,
+			},
+		},
+		{
+			Name:          "Empty Filter Expression",
+			ContainerName: "container1",
+			Input:         FindBlobsByTagsInput{},
+		},
+		{
+			Name:          "MaxResults Too Large",
+			ContainerName: "container1",
+			Input: FindBlobsByTagsInput{
+				Where:      `"project" = 'giovanni'`,
+				MaxResults: pointer.To(5001),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).FindBlobsByTags(ctx, v.ContainerName, v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
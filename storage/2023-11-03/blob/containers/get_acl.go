@@ -0,0 +1,109 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetACLInput struct {
+	// If specified, the operation only succeeds if the Container's lease is active and matches this ID
+	LeaseId string
+}
+
+type GetACLResponse struct {
+	HttpResponse *http.Response
+
+	// The level of public access for the Container
+	AccessLevel AccessLevel
+
+	// The Stored Access Policies for the Container
+	SignedIdentifiers []SignedIdentifier
+}
+
+// GetACL returns the public access level and the Stored Access Policies for the specified Container
+func (c Client) GetACL(ctx context.Context, containerName string, input GetACLInput) (result GetACLResponse, err error) {
+	if containerName == "" {
+		err = fmt.Errorf("`containerName` cannot be an empty string")
+		return
+	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: getAclOptions{
+			leaseId: input.LeaseId,
+		},
+		Path: fmt.Sprintf("/%s", containerName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				// If this header is not returned in the response, the container is private to the account owner.
+				result.AccessLevel = AccessLevel(resp.Header.Get("x-ms-blob-public-access"))
+			}
+
+			var model signedidentifiers.SignedIdentifiers
+			err = resp.Unmarshal(&model)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+			result.SignedIdentifiers = model.SignedIdentifiers
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+var _ client.Options = getAclOptions{}
+
+type getAclOptions struct {
+	leaseId string
+}
+
+func (o getAclOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if o.leaseId != "" {
+		headers.Append("x-ms-lease-id", o.leaseId)
+	}
+	return headers
+}
+
+func (getAclOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (getAclOptions) ToQuery() *client.QueryParams {
+	query := containerOptions{}.ToQuery()
+	query.Append("comp", "acl")
+	return query
+}
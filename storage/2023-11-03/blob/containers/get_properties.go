@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetPropertiesInput struct {
@@ -26,6 +28,10 @@ func (c Client) GetProperties(ctx context.Context, containerName string, input G
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
 
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
@@ -78,7 +84,7 @@ func (c Client) GetProperties(ctx context.Context, containerName string, input G
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
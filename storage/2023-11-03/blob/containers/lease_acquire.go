@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type AcquireLeaseInput struct {
@@ -31,8 +33,11 @@ func (c Client) AcquireLease(ctx context.Context, containerName string, input Ac
 	if containerName == "" {
 		return result, fmt.Errorf("`containerName` cannot be an empty string")
 	}
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
 	// An infinite lease duration is -1 seconds. A non-infinite lease can be between 15 and 60 seconds
-	if input.LeaseDuration != -1 && (input.LeaseDuration <= 15 || input.LeaseDuration >= 60) {
+	if input.LeaseDuration != -1 && (input.LeaseDuration < 15 || input.LeaseDuration > 60) {
 		return result, fmt.Errorf("`input.LeaseDuration` must be -1 (infinite), or between 15 and 60 seconds")
 	}
 
@@ -67,7 +72,7 @@ func (c Client) AcquireLease(ctx context.Context, containerName string, input Ac
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
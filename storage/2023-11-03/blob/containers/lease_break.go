@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 	"net/http"
 	"strconv"
 )
@@ -39,9 +41,15 @@ func (c Client) BreakLease(ctx context.Context, containerName string, input Brea
 	if containerName == "" {
 		return result, fmt.Errorf("`containerName` cannot be an empty string")
 	}
+	if err := naming.ValidateContainerName(containerName); err != nil {
+		return result, fmt.Errorf("`containerName` is not valid: %+v", err)
+	}
 	if input.LeaseID == "" {
 		return result, fmt.Errorf("`input.LeaseID` cannot be an empty string")
 	}
+	if input.BreakPeriod != nil && (*input.BreakPeriod < 0 || *input.BreakPeriod > 60) {
+		return result, fmt.Errorf("`input.BreakPeriod` must be between 0 and 60 seconds, if specified")
+	}
 
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
@@ -78,7 +86,7 @@ func (c Client) BreakLease(ctx context.Context, containerName string, input Brea
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
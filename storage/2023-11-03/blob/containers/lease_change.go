@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type ChangeLeaseInput struct {
@@ -29,6 +31,10 @@ func (c Client) ChangeLease(ctx context.Context, containerName string, input Cha
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
 	if input.ExistingLeaseID == "" {
 		err = fmt.Errorf("`input.ExistingLeaseID` cannot be an empty string")
 		return
@@ -69,7 +75,7 @@ func (c Client) ChangeLease(ctx context.Context, containerName string, input Cha
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type ReleaseLeaseInput struct {
@@ -23,6 +25,10 @@ func (c Client) ReleaseLease(ctx context.Context, containerName string, input Re
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
 	if input.LeaseId == "" {
 		err = fmt.Errorf("`input.LeaseId` cannot be an empty string")
 		return
@@ -52,7 +58,7 @@ func (c Client) ReleaseLease(ctx context.Context, containerName string, input Re
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -44,6 +44,15 @@ func TestContainerLifecycle(t *testing.T) {
 		t.Fatalf("adding authorizer to client: %+v", err)
 	}
 
+	t.Logf("[DEBUG] Checking the container doesn't exist..")
+	exists, err := containersClient.Exists(ctx, containerName)
+	if err != nil {
+		t.Fatalf("checking if the container exists: %+v", err)
+	}
+	if exists.Exists {
+		t.Fatalf("Expected the container not to exist prior to creation")
+	}
+
 	// first let's test an empty container
 	input := CreateInput{}
 	_, err = containersClient.Create(ctx, containerName, input)
@@ -51,6 +60,21 @@ func TestContainerLifecycle(t *testing.T) {
 		t.Fatal(fmt.Errorf("Error creating: %s", err))
 	}
 
+	t.Logf("[DEBUG] Checking the container exists..")
+	exists, err = containersClient.Exists(ctx, containerName)
+	if err != nil {
+		t.Fatalf("checking if the container exists: %+v", err)
+	}
+	if !exists.Exists {
+		t.Fatalf("Expected the container to exist after creation")
+	}
+
+	t.Logf("[DEBUG] Creating the container again..")
+	_, err = containersClient.Create(ctx, containerName, input)
+	if _, ok := err.(ContainerAlreadyExistsError); !ok {
+		t.Fatalf("Expected a ContainerAlreadyExistsError but got: %+v", err)
+	}
+
 	container, err := containersClient.GetProperties(ctx, containerName, GetPropertiesInput{})
 	if err != nil {
 		t.Fatalf("retrieving container: %+v", err)
@@ -97,6 +121,21 @@ func TestContainerLifecycle(t *testing.T) {
 		t.Fatalf("Expected Container Lease to be Unlocked but was: %s", container.LeaseStatus)
 	}
 
+	t.Logf("[DEBUG] Listing the containers..")
+	containerList, err := containersClient.ListComplete(ctx, ListInput{
+		Include: &[]ListInclude{ListIncludeMetaData},
+		Prefix:  &containerName,
+	})
+	if err != nil {
+		t.Fatalf("listing containers: %+v", err)
+	}
+	if len(containerList.Containers) != 1 || containerList.Containers[0].Name != containerName {
+		t.Fatalf("expected only the container %q to be listed but got %+v", containerName, containerList.Containers)
+	}
+	if containerList.Containers[0].MetaData["dont"] != "kill-my-vibe" {
+		t.Fatalf("expected the listed container to include the metadata but got %+v", containerList.Containers[0].MetaData)
+	}
+
 	// then update the ACL
 	_, err = containersClient.SetAccessControl(ctx, containerName, SetAccessControlInput{
 		AccessLevel: Blob,
@@ -123,6 +162,50 @@ func TestContainerLifecycle(t *testing.T) {
 		t.Fatalf("Expected Container Lease to be Unlocked but was: %s", container.LeaseStatus)
 	}
 
+	t.Logf("[DEBUG] Setting the Stored Access Policies..")
+	_, err = containersClient.SetACL(ctx, containerName, SetACLInput{
+		AccessLevel: Blob,
+		SignedIdentifiers: []SignedIdentifier{
+			{
+				Id: "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI=",
+				AccessPolicy: AccessPolicy{
+					Start:      "2024-01-01T00:00:00.0000000Z",
+					Expiry:     "2054-01-01T00:00:00.0000000Z",
+					Permission: "rl",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("setting the ACL: %+v", err)
+	}
+
+	// give azure some time to replicate
+	time.Sleep(2 * time.Second)
+
+	acl, err := containersClient.GetACL(ctx, containerName, GetACLInput{})
+	if err != nil {
+		t.Fatalf("retrieving the ACL: %+v", err)
+	}
+	if acl.AccessLevel != Blob {
+		t.Fatalf("Expected Access Level to be Blob but got %q", acl.AccessLevel)
+	}
+	if len(acl.SignedIdentifiers) != 1 {
+		t.Fatalf("Expected 1 Signed Identifier but got %d", len(acl.SignedIdentifiers))
+	}
+	if acl.SignedIdentifiers[0].AccessPolicy.Permission != "rl" {
+		t.Fatalf("Expected the Permission to be %q but got %q", "rl", acl.SignedIdentifiers[0].AccessPolicy.Permission)
+	}
+
+	t.Logf("[DEBUG] Round-tripping the Stored Access Policies..")
+	_, err = containersClient.SetACL(ctx, containerName, SetACLInput{
+		AccessLevel:       acl.AccessLevel,
+		SignedIdentifiers: acl.SignedIdentifiers,
+	})
+	if err != nil {
+		t.Fatalf("re-setting the ACL: %+v", err)
+	}
+
 	// acquire a lease for 30s
 	acquireLeaseInput := AcquireLeaseInput{
 		LeaseDuration: 30,
@@ -185,6 +268,15 @@ func TestContainerLifecycle(t *testing.T) {
 		t.Fatalf("Expected there to be no blobs in the container but got %d", len(listResult.Blobs.Blobs))
 	}
 
+	t.Logf("[DEBUG] Listing all blobs in the container..")
+	listCompleteResult, err := containersClient.ListBlobsComplete(ctx, containerName, listInput)
+	if err != nil {
+		t.Fatalf("listing all blobs: %+v", err)
+	}
+	if len(listCompleteResult.Blobs) != 0 {
+		t.Fatalf("Expected there to be no blobs in the container but got %d", len(listCompleteResult.Blobs))
+	}
+
 	t.Logf("[DEBUG] Deleting..")
 	if _, err = containersClient.Delete(ctx, containerName); err != nil {
 		t.Fatal(fmt.Errorf("Error deleting: %s", err))
@@ -0,0 +1,173 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ListInput struct {
+	// Include specifies additional datasets to be returned for each Container
+	Include *[]ListInclude
+
+	// Marker is the NextMarker returned from a previous List operation, to retrieve the next page of results
+	Marker *string
+
+	// MaxResults is the maximum number of Containers to return, up to 5000
+	MaxResults *int
+
+	// Prefix filters the results to Containers whose name begins with this prefix
+	Prefix *string
+}
+
+type ListResponse struct {
+	ListResult
+
+	HttpResponse *http.Response
+}
+
+type ListResult struct {
+	Marker     string          `xml:"Marker"`
+	MaxResults int             `xml:"MaxResults"`
+	NextMarker *string         `xml:"NextMarker,omitempty"`
+	Prefix     string          `xml:"Prefix"`
+	Containers []ContainerItem `xml:"Containers>Container"`
+}
+
+type ContainerItem struct {
+	Name       string                  `xml:"Name"`
+	Deleted    bool                    `xml:"Deleted,omitempty"`
+	Version    *string                 `xml:"Version,omitempty"`
+	MetaData   ContainerMetaData       `xml:"Metadata,omitempty"`
+	Properties ContainerItemProperties `xml:"Properties"`
+}
+
+type ContainerItemProperties struct {
+	DefaultEncryptionScope                *string `xml:"DefaultEncryptionScope,omitempty"`
+	DeletedTime                           *string `xml:"DeletedTime,omitempty"`
+	DenyEncryptionScopeOverride           *bool   `xml:"DenyEncryptionScopeOverride,omitempty"`
+	ETag                                  *string `xml:"Etag,omitempty"`
+	HasImmutabilityPolicy                 *bool   `xml:"HasImmutabilityPolicy,omitempty"`
+	HasLegalHold                          *bool   `xml:"HasLegalHold,omitempty"`
+	ImmutableStorageWithVersioningEnabled *bool   `xml:"ImmutableStorageWithVersioningEnabled,omitempty"`
+	LastModified                          *string `xml:"Last-Modified,omitempty"`
+	LeaseDuration                         *string `xml:"LeaseDuration,omitempty"`
+	LeaseState                            *string `xml:"LeaseState,omitempty"`
+	LeaseStatus                           *string `xml:"LeaseStatus,omitempty"`
+	PublicAccess                          *string `xml:"PublicAccess,omitempty"`
+	RemainingRetentionDays                *int    `xml:"RemainingRetentionDays,omitempty"`
+}
+
+// ContainerMetaData is the MetaData for a Container, which is returned when `ListIncludeMetaData` is included in the ListInput
+type ContainerMetaData = metadata.XMLMap
+
+// List lists the Containers within the Storage Account matching the specified query
+func (c Client) List(ctx context.Context, input ListInput) (result ListResponse, err error) {
+	if input.MaxResults != nil && (*input.MaxResults <= 0 || *input.MaxResults > 5000) {
+		err = fmt.Errorf("`input.MaxResults` can either be nil or between 0 and 5000")
+		return
+	}
+	if input.Include != nil {
+		for _, v := range *input.Include {
+			if err = validateListInclude(v); err != nil {
+				return
+			}
+		}
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: listOptions{
+			include:    input.Include,
+			marker:     input.Marker,
+			maxResults: input.MaxResults,
+			prefix:     input.Prefix,
+		},
+		Path: "/",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			err = resp.Unmarshal(&result)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+func validateListInclude(input ListInclude) error {
+	for _, v := range PossibleValuesForListInclude() {
+		if input == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("`input.Include` contains an unsupported value %q", input)
+}
+
+var _ client.Options = listOptions{}
+
+type listOptions struct {
+	include    *[]ListInclude
+	marker     *string
+	maxResults *int
+	prefix     *string
+}
+
+func (o listOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o listOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o listOptions) ToQuery() *client.QueryParams {
+	query := &client.QueryParams{}
+	query.Append("comp", "list")
+
+	if o.include != nil {
+		vals := make([]string, 0)
+		for _, v := range *o.include {
+			vals = append(vals, string(v))
+		}
+		query.Append("include", strings.Join(vals, ","))
+	}
+	if o.marker != nil {
+		query.Append("marker", *o.marker)
+	}
+	if o.maxResults != nil {
+		query.Append("maxresults", fmt.Sprintf("%d", *o.maxResults))
+	}
+	if o.prefix != nil {
+		query.Append("prefix", *o.prefix)
+	}
+	return query
+}
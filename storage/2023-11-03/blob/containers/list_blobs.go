@@ -8,6 +8,10 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/tags"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type ListBlobsInput struct {
@@ -34,16 +38,21 @@ type ListBlobsResult struct {
 }
 
 type Blobs struct {
-	Blobs      []BlobDetails `xml:"Blob"`
-	BlobPrefix *BlobPrefix   `xml:"BlobPrefix"`
+	Blobs []BlobDetails `xml:"Blob"`
+
+	// The virtual directories matching the Delimiter, when specified
+	BlobPrefixes []BlobPrefix `xml:"BlobPrefix"`
 }
 
 type BlobDetails struct {
-	Name       string                 `xml:"Name"`
-	Deleted    bool                   `xml:"Deleted,omitempty"`
-	MetaData   map[string]interface{} `map:"Metadata,omitempty"`
-	Properties *BlobProperties        `xml:"Properties,omitempty"`
-	Snapshot   *string                `xml:"Snapshot,omitempty"`
+	Name             string          `xml:"Name"`
+	Deleted          bool            `xml:"Deleted,omitempty"`
+	IsCurrentVersion *bool           `xml:"IsCurrentVersion,omitempty"`
+	MetaData         BlobMetaData    `xml:"Metadata,omitempty"`
+	Properties       *BlobProperties `xml:"Properties,omitempty"`
+	Snapshot         *string         `xml:"Snapshot,omitempty"`
+	Tags             BlobTags        `xml:"Tags,omitempty"`
+	VersionID        *string         `xml:"VersionId,omitempty"`
 }
 
 type BlobProperties struct {
@@ -72,7 +81,7 @@ type BlobProperties struct {
 	LeaseDuration          *string `xml:"LeaseDuration,omitempty"`
 	LeaseState             *string `xml:"LeaseState,omitempty"`
 	LeaseStatus            *string `xml:"LeaseStatus,omitempty"`
-	RemainingRetentionDays *string `xml:"RemainingRetentionDays,omitempty"`
+	RemainingRetentionDays *int    `xml:"RemainingRetentionDays,omitempty"`
 	ServerEncrypted        *bool   `xml:"ServerEncrypted,omitempty"`
 }
 
@@ -80,16 +89,38 @@ type BlobPrefix struct {
 	Name string `xml:"Name"`
 }
 
+// BlobMetaData is the MetaData for a Blob, which is returned when `MetaData` is included in the ListBlobsInput
+type BlobMetaData = metadata.XMLMap
+
+// BlobTags is an ordered set of Blob Index Tags, which are returned when `Tags` is included in the ListBlobsInput
+// (or for each Blob matching the Filter Expression in FindBlobsByTags)
+type BlobTags = tags.BlobTags
+
+// Tag is a single Blob Index Tag within BlobTags
+type Tag = tags.Tag
+
 // ListBlobs lists the blobs matching the specified query within the specified Container
 func (c Client) ListBlobs(ctx context.Context, containerName string, input ListBlobsInput) (result ListBlobsResponse, err error) {
 	if containerName == "" {
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
 	if input.MaxResults != nil && (*input.MaxResults <= 0 || *input.MaxResults > 5000) {
 		err = fmt.Errorf("`input.MaxResults` can either be nil or between 0 and 5000")
 		return
 	}
+	if input.Include != nil {
+		for _, v := range *input.Include {
+			if err = validateDataset(v); err != nil {
+				return
+			}
+		}
+	}
+
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
@@ -126,13 +157,22 @@ func (c Client) ListBlobs(ctx context.Context, containerName string, input ListB
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
+func validateDataset(input Dataset) error {
+	for _, v := range PossibleValuesForDataset() {
+		if input == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("`input.Include` contains an unsupported value %q", input)
+}
+
 var _ client.Options = listBlobsOptions{}
 
 type listBlobsOptions struct {
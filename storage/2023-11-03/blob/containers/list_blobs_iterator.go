@@ -0,0 +1,63 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackofallops/giovanni/storage/internal/pager"
+)
+
+// ListBlobsIterator retrieves successive pages of Blobs from a Container, following the
+// `NextMarker` returned by the service until all of the results have been retrieved.
+type ListBlobsIterator struct {
+	pager *pager.MarkerIterator[ListBlobsResponse]
+}
+
+// NewListBlobsIterator returns an iterator over the Blobs within the specified Container matching `input`.
+// The `Marker` within `input` (if specified) is used as the starting point.
+func (c Client) NewListBlobsIterator(containerName string, input ListBlobsInput) *ListBlobsIterator {
+	return &ListBlobsIterator{
+		pager: pager.NewMarkerIterator(input.Marker, func(ctx context.Context, marker *string) (ListBlobsResponse, *string, error) {
+			input.Marker = marker
+			result, err := c.ListBlobs(ctx, containerName, input)
+			return result, result.NextMarker, err
+		}),
+	}
+}
+
+// NotDone returns whether there are further pages of results to be retrieved
+func (i *ListBlobsIterator) NotDone() bool {
+	return i.pager.NotDone()
+}
+
+// Next retrieves the next page of results
+func (i *ListBlobsIterator) Next(ctx context.Context) (ListBlobsResponse, error) {
+	return i.pager.Next(ctx)
+}
+
+type ListBlobsCompleteResult struct {
+	// The Blobs matching the query, across all pages of results
+	Blobs []BlobDetails
+
+	// The virtual directories matching the Delimiter, across all pages of results
+	BlobPrefixes []BlobPrefix
+}
+
+// ListBlobsComplete retrieves all of the Blobs within the specified Container matching `input`,
+// following the `NextMarker` until all pages of results have been retrieved
+func (c Client) ListBlobsComplete(ctx context.Context, containerName string, input ListBlobsInput) (result ListBlobsCompleteResult, err error) {
+	iterator := c.NewListBlobsIterator(containerName, input)
+	for iterator.NotDone() {
+		var page ListBlobsResponse
+		page, err = iterator.Next(ctx)
+		if err != nil {
+			err = fmt.Errorf("listing blobs: %+v", err)
+			return
+		}
+
+		result.Blobs = append(result.Blobs, page.Blobs.Blobs...)
+		result.BlobPrefixes = append(result.BlobPrefixes, page.Blobs.BlobPrefixes...)
+	}
+
+	return
+}
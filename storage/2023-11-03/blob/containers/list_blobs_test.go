@@ -0,0 +1,149 @@
+package containers
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestListBlobsResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example1.blob.core.windows.net/" ContainerName="container1">
+  <Prefix>folder/</Prefix>
+  <MaxResults>2</MaxResults>
+  <Delimiter>/</Delimiter>
+  <Blobs>
+    <Blob>
+      <Name>folder/blob1.txt</Name>
+      <VersionId>2024-01-01T00:00:00.0000000Z</VersionId>
+      <IsCurrentVersion>true</IsCurrentVersion>
+      <Properties>
+        <Content-Length>12</Content-Length>
+        <BlobType>BlockBlob</BlobType>
+      </Properties>
+      <Metadata>
+        <hello>world</hello>
+        <project>giovanni</project>
+      </Metadata>
+      <Tags>
+        <TagSet>
+          <Tag>
+            <Key>env</Key>
+            <Value>test</Value>
+          </Tag>
+        </TagSet>
+      </Tags>
+    </Blob>
+    <BlobPrefix>
+      <Name>folder/nested1/</Name>
+    </BlobPrefix>
+    <BlobPrefix>
+      <Name>folder/nested2/</Name>
+    </BlobPrefix>
+  </Blobs>
+  <NextMarker>abc123</NextMarker>
+</EnumerationResults>`
+
+	var actual ListBlobsResult
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if actual.Prefix != "folder/" || actual.Delimiter != "/" || actual.MaxResults != 2 {
+		t.Fatalf("unexpected values for the query: %+v", actual)
+	}
+	if actual.NextMarker == nil || *actual.NextMarker != "abc123" {
+		t.Fatalf("expected the NextMarker to be %q but got %v", "abc123", actual.NextMarker)
+	}
+
+	if len(actual.Blobs.Blobs) != 1 {
+		t.Fatalf("expected 1 blob but got %d", len(actual.Blobs.Blobs))
+	}
+	blob := actual.Blobs.Blobs[0]
+	if blob.Name != "folder/blob1.txt" {
+		t.Fatalf("expected the blob to be named %q but got %q", "folder/blob1.txt", blob.Name)
+	}
+	if blob.VersionID == nil || *blob.VersionID != "2024-01-01T00:00:00.0000000Z" {
+		t.Fatalf("expected the Version ID to be %q but got %v", "2024-01-01T00:00:00.0000000Z", blob.VersionID)
+	}
+	if blob.IsCurrentVersion == nil || !*blob.IsCurrentVersion {
+		t.Fatalf("expected the blob to be the current version")
+	}
+	if blob.Properties == nil || blob.Properties.ContentLength == nil || *blob.Properties.ContentLength != 12 {
+		t.Fatalf("expected the Content Length to be 12 but got %+v", blob.Properties)
+	}
+	if len(blob.MetaData) != 2 || blob.MetaData["hello"] != "world" || blob.MetaData["project"] != "giovanni" {
+		t.Fatalf("unexpected metadata: %+v", blob.MetaData)
+	}
+	if v, ok := blob.Tags.Get("env"); len(blob.Tags) != 1 || !ok || v != "test" {
+		t.Fatalf("unexpected tags: %+v", blob.Tags)
+	}
+
+	if len(actual.Blobs.BlobPrefixes) != 2 {
+		t.Fatalf("expected 2 blob prefixes but got %d", len(actual.Blobs.BlobPrefixes))
+	}
+	if actual.Blobs.BlobPrefixes[1].Name != "folder/nested2/" {
+		t.Fatalf("expected the second blob prefix to be %q but got %q", "folder/nested2/", actual.Blobs.BlobPrefixes[1].Name)
+	}
+}
+
+func TestListBlobsOptions(t *testing.T) {
+	delimiter := "/"
+	prefix := "folder/"
+	include := []Dataset{MetaData, Tags, Versions}
+	query := listBlobsOptions{
+		delimiter: &delimiter,
+		include:   &include,
+		prefix:    &prefix,
+	}.ToQuery().Values()
+
+	expected := map[string]string{
+		"restype":   "container",
+		"comp":      "list",
+		"delimiter": "/",
+		"include":   "metadata,tags,versions",
+		"prefix":    "folder/",
+	}
+	if len(query) != len(expected) {
+		t.Fatalf("expected %d query parameters but got %d", len(expected), len(query))
+	}
+	for k, v := range expected {
+		if actual := query.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+}
+
+func TestListBlobsResultUnmarshalDeleted(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example1.blob.core.windows.net/" ContainerName="container1">
+  <Blobs>
+    <Blob>
+      <Name>blob1.txt</Name>
+      <Deleted>true</Deleted>
+      <Properties>
+        <DeletedTime>Mon, 01 Jan 2024 00:00:00 GMT</DeletedTime>
+        <RemainingRetentionDays>6</RemainingRetentionDays>
+      </Properties>
+    </Blob>
+  </Blobs>
+</EnumerationResults>`
+
+	var actual ListBlobsResult
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if len(actual.Blobs.Blobs) != 1 {
+		t.Fatalf("expected 1 blob but got %d", len(actual.Blobs.Blobs))
+	}
+	blob := actual.Blobs.Blobs[0]
+	if !blob.Deleted {
+		t.Fatalf("expected the blob to be deleted")
+	}
+	if blob.Properties.DeletedTime == nil || *blob.Properties.DeletedTime != "Mon, 01 Jan 2024 00:00:00 GMT" {
+		t.Fatalf("expected the DeletedTime to be %q but got %v", "Mon, 01 Jan 2024 00:00:00 GMT", blob.Properties.DeletedTime)
+	}
+	if blob.Properties.RemainingRetentionDays == nil || *blob.Properties.RemainingRetentionDays != 6 {
+		t.Fatalf("expected the RemainingRetentionDays to be 6 but got %v", blob.Properties.RemainingRetentionDays)
+	}
+}
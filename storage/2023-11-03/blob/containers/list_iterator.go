@@ -0,0 +1,59 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackofallops/giovanni/storage/internal/pager"
+)
+
+// ListIterator retrieves successive pages of Containers from a Storage Account, following the
+// `NextMarker` returned by the service until all of the results have been retrieved.
+type ListIterator struct {
+	pager *pager.MarkerIterator[ListResponse]
+}
+
+// NewListIterator returns an iterator over the Containers within the Storage Account matching `input`.
+// The `Marker` within `input` (if specified) is used as the starting point.
+func (c Client) NewListIterator(input ListInput) *ListIterator {
+	return &ListIterator{
+		pager: pager.NewMarkerIterator(input.Marker, func(ctx context.Context, marker *string) (ListResponse, *string, error) {
+			input.Marker = marker
+			result, err := c.List(ctx, input)
+			return result, result.NextMarker, err
+		}),
+	}
+}
+
+// NotDone returns whether there are further pages of results to be retrieved
+func (i *ListIterator) NotDone() bool {
+	return i.pager.NotDone()
+}
+
+// Next retrieves the next page of results
+func (i *ListIterator) Next(ctx context.Context) (ListResponse, error) {
+	return i.pager.Next(ctx)
+}
+
+type ListCompleteResult struct {
+	// The Containers matching the query, across all pages of results
+	Containers []ContainerItem
+}
+
+// ListComplete retrieves all of the Containers within the Storage Account matching `input`,
+// following the `NextMarker` until all pages of results have been retrieved
+func (c Client) ListComplete(ctx context.Context, input ListInput) (result ListCompleteResult, err error) {
+	iterator := c.NewListIterator(input)
+	for iterator.NotDone() {
+		var page ListResponse
+		page, err = iterator.Next(ctx)
+		if err != nil {
+			err = fmt.Errorf("listing containers: %+v", err)
+			return
+		}
+
+		result.Containers = append(result.Containers, page.Containers...)
+	}
+
+	return
+}
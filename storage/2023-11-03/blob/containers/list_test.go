@@ -0,0 +1,113 @@
+package containers
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestListResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example1.blob.core.windows.net/">
+  <Prefix>cont</Prefix>
+  <MaxResults>2</MaxResults>
+  <Containers>
+    <Container>
+      <Name>container1</Name>
+      <Properties>
+        <Last-Modified>Mon, 01 Jan 2024 00:00:00 GMT</Last-Modified>
+        <Etag>"0x8D0000000000001"</Etag>
+        <LeaseStatus>unlocked</LeaseStatus>
+        <LeaseState>available</LeaseState>
+        <PublicAccess>blob</PublicAccess>
+        <DefaultEncryptionScope>$account-encryption-key</DefaultEncryptionScope>
+        <DenyEncryptionScopeOverride>false</DenyEncryptionScopeOverride>
+        <HasImmutabilityPolicy>false</HasImmutabilityPolicy>
+        <HasLegalHold>false</HasLegalHold>
+      </Properties>
+      <Metadata>
+        <hello>world</hello>
+      </Metadata>
+    </Container>
+    <Container>
+      <Name>container2</Name>
+      <Deleted>true</Deleted>
+      <Version>01D60F8BB59A4652</Version>
+      <Properties>
+        <Last-Modified>Mon, 01 Jan 2024 00:00:00 GMT</Last-Modified>
+        <DeletedTime>Tue, 02 Jan 2024 00:00:00 GMT</DeletedTime>
+        <RemainingRetentionDays>6</RemainingRetentionDays>
+      </Properties>
+    </Container>
+  </Containers>
+  <NextMarker>/example1/container3</NextMarker>
+</EnumerationResults>`
+
+	var actual ListResult
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if actual.Prefix != "cont" || actual.MaxResults != 2 {
+		t.Fatalf("unexpected values for the query: %+v", actual)
+	}
+	if actual.NextMarker == nil || *actual.NextMarker != "/example1/container3" {
+		t.Fatalf("expected the NextMarker to be %q but got %v", "/example1/container3", actual.NextMarker)
+	}
+	if len(actual.Containers) != 2 {
+		t.Fatalf("expected 2 containers but got %d", len(actual.Containers))
+	}
+
+	first := actual.Containers[0]
+	if first.Name != "container1" || first.Deleted {
+		t.Fatalf("unexpected first container: %+v", first)
+	}
+	if first.Properties.PublicAccess == nil || *first.Properties.PublicAccess != "blob" {
+		t.Fatalf("expected the Public Access to be %q but got %v", "blob", first.Properties.PublicAccess)
+	}
+	if first.Properties.LeaseState == nil || *first.Properties.LeaseState != string(Available) {
+		t.Fatalf("expected the Lease State to be %q but got %v", Available, first.Properties.LeaseState)
+	}
+	if len(first.MetaData) != 1 || first.MetaData["hello"] != "world" {
+		t.Fatalf("unexpected metadata: %+v", first.MetaData)
+	}
+
+	second := actual.Containers[1]
+	if second.Name != "container2" || !second.Deleted {
+		t.Fatalf("expected the second container to be deleted: %+v", second)
+	}
+	if second.Version == nil || *second.Version != "01D60F8BB59A4652" {
+		t.Fatalf("expected the Version to be %q but got %v", "01D60F8BB59A4652", second.Version)
+	}
+	if second.Properties.RemainingRetentionDays == nil || *second.Properties.RemainingRetentionDays != 6 {
+		t.Fatalf("expected the Remaining Retention Days to be 6 but got %v", second.Properties.RemainingRetentionDays)
+	}
+}
+
+func TestListOptions(t *testing.T) {
+	marker := "/example1/container3"
+	maxResults := 10
+	prefix := "cont"
+	include := []ListInclude{ListIncludeMetaData, ListIncludeDeleted}
+	query := listOptions{
+		include:    &include,
+		marker:     &marker,
+		maxResults: &maxResults,
+		prefix:     &prefix,
+	}.ToQuery().Values()
+
+	expected := map[string]string{
+		"comp":       "list",
+		"include":    "metadata,deleted",
+		"marker":     "/example1/container3",
+		"maxresults": "10",
+		"prefix":     "cont",
+	}
+	if len(query) != len(expected) {
+		t.Fatalf("expected %d query parameters but got %d", len(expected), len(query))
+	}
+	for k, v := range expected {
+		if actual := query.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+}
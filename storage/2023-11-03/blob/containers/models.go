@@ -1,6 +1,10 @@
 package containers
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+
+	"github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+)
 
 type AccessLevel string
 
@@ -39,9 +43,41 @@ var (
 	Deleted          Dataset = "deleted"
 	MetaData         Dataset = "metadata"
 	Snapshots        Dataset = "snapshots"
+	Tags             Dataset = "tags"
 	UncommittedBlobs Dataset = "uncommittedblobs"
+	Versions         Dataset = "versions"
+)
+
+func PossibleValuesForDataset() []Dataset {
+	return []Dataset{
+		Copy,
+		Deleted,
+		MetaData,
+		Snapshots,
+		Tags,
+		UncommittedBlobs,
+		Versions,
+	}
+}
+
+// ListInclude specifies an additional dataset to be returned for each Container when listing Containers.
+// NOTE: unlike Shares, Containers don't support Snapshots - Snapshots are taken of the individual Blobs instead.
+type ListInclude string
+
+var (
+	ListIncludeDeleted  ListInclude = "deleted"
+	ListIncludeMetaData ListInclude = "metadata"
+	ListIncludeSystem   ListInclude = "system"
 )
 
+func PossibleValuesForListInclude() []ListInclude {
+	return []ListInclude{
+		ListIncludeDeleted,
+		ListIncludeMetaData,
+		ListIncludeSystem,
+	}
+}
+
 type ErrorResponse struct {
 	XMLName xml.Name `xml:"Error"`
 	Code    *string  `xml:"Code"`
@@ -74,3 +110,9 @@ var (
 	Locked   LeaseStatus = "locked"
 	Unlocked LeaseStatus = "unlocked"
 )
+
+// SignedIdentifier is a Stored Access Policy for a Container
+type SignedIdentifier = signedidentifiers.SignedIdentifier
+
+// AccessPolicy defines the validity period and permissions for a Stored Access Policy
+type AccessPolicy = signedidentifiers.AccessPolicy
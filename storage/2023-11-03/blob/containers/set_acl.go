@@ -7,6 +7,9 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetAccessControlInput struct {
@@ -20,11 +23,50 @@ type SetAccessControlResponse struct {
 
 // SetAccessControl sets the Access Control for a Container without a Lease ID
 // NOTE: The SetAccessControl operation only supports Shared Key authorization.
+// NOTE: this removes any existing Stored Access Policies for the Container, use SetACL to retain these.
 func (c Client) SetAccessControl(ctx context.Context, containerName string, input SetAccessControlInput) (result SetAccessControlResponse, err error) {
+	resp, err := c.SetACL(ctx, containerName, SetACLInput{
+		AccessLevel: input.AccessLevel,
+		LeaseId:     input.LeaseId,
+	})
+	result.HttpResponse = resp.HttpResponse
+	return
+}
+
+type SetACLInput struct {
+	// Specifies whether data in the container may be accessed publicly and the level of access
+	AccessLevel AccessLevel
+
+	// If specified, the operation only succeeds if the Container's lease is active and matches this ID
+	LeaseId string
+
+	// The Stored Access Policies for the Container, which replace any existing Stored Access Policies.
+	// Up to 5 Stored Access Policies can be specified.
+	SignedIdentifiers []SignedIdentifier
+}
+
+type SetACLResponse struct {
+	HttpResponse *http.Response
+}
+
+// SetACL sets the public access level and the Stored Access Policies for the specified Container
+// NOTE: The SetACL operation only supports Shared Key authorization.
+func (c Client) SetACL(ctx context.Context, containerName string, input SetACLInput) (result SetACLResponse, err error) {
 	if containerName == "" {
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
+	if err = validateAccessLevel(input.AccessLevel); err != nil {
+		return
+	}
+	if err = signedidentifiers.Validate(input.SignedIdentifiers); err != nil {
+		err = fmt.Errorf("`input.SignedIdentifiers` is not valid: %+v", err)
+		return
+	}
 
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
@@ -45,13 +87,21 @@ func (c Client) SetAccessControl(ctx context.Context, containerName string, inpu
 		return
 	}
 
+	body := signedidentifiers.SignedIdentifiers{
+		SignedIdentifiers: input.SignedIdentifiers,
+	}
+	if err = req.Marshal(&body); err != nil {
+		err = fmt.Errorf("marshaling request: %+v", err)
+		return
+	}
+
 	var resp *client.Response
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetMetaDataInput struct {
@@ -25,6 +27,10 @@ func (c Client) SetMetaData(ctx context.Context, containerName string, input Set
 		err = fmt.Errorf("`containerName` cannot be an empty string")
 		return
 	}
+	if err = naming.ValidateContainerName(containerName); err != nil {
+		err = fmt.Errorf("`containerName` is not valid: %+v", err)
+		return
+	}
 	if err = metadata.Validate(input.MetaData); err != nil {
 		err = fmt.Errorf("`input.MetaData` is not valid: %s", err)
 		return
@@ -55,7 +61,7 @@ func (c Client) SetMetaData(ctx context.Context, containerName string, input Set
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
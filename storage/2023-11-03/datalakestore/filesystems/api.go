@@ -0,0 +1,17 @@
+package filesystems
+
+import (
+	"context"
+)
+
+type StorageFileSystem interface {
+	Create(ctx context.Context, fileSystemName string, input CreateInput) (CreateResponse, error)
+	Delete(ctx context.Context, fileSystemName string) (DeleteResponse, error)
+	GetProperties(ctx context.Context, fileSystemName string) (GetPropertiesResponse, error)
+	SetProperties(ctx context.Context, fileSystemName string, input SetPropertiesInput) (SetPropertiesResponse, error)
+	List(ctx context.Context, input ListInput) (ListResponse, error)
+	ListComplete(ctx context.Context, input ListInput) (ListCompleteResult, error)
+	NewListIterator(input ListInput) *ListIterator
+	ListPaths(ctx context.Context, fileSystemName string, input ListPathsInput) (ListPathsResponse, error)
+	Walk(ctx context.Context, fileSystemName string, input ListPathsInput, fn WalkFunc) error
+}
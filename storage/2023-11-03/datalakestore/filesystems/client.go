@@ -3,16 +3,16 @@ package filesystems
 import (
 	"fmt"
 
-	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane/storage"
+	"github.com/jackofallops/giovanni/storage/baseclient"
 )
 
 // Client is the base client for Data Lake Store Filesystems.
 type Client struct {
-	Client *storage.Client
+	Client *baseclient.Client
 }
 
 func NewWithBaseUri(baseUri string) (*Client, error) {
-	baseClient, err := storage.NewStorageClient(baseUri, componentName, apiVersion)
+	baseClient, err := baseclient.New(baseUri, componentName, apiVersion)
 	if err != nil {
 		return nil, fmt.Errorf("building base client: %+v", err)
 	}
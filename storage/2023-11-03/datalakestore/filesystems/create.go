@@ -7,16 +7,17 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type CreateInput struct {
 	// The encryption scope to set as the default on the filesystem.
 	DefaultEncryptionScope string
 
-	// A map of base64-encoded strings to store as user-defined properties with the File System
-	// Note that items may only contain ASCII characters in the ISO-8859-1 character set.
-	// This automatically gets converted to a comma-separated list of name and
-	// value pairs before sending to the API
+	// A map of user-defined properties to store with the File System
+	// Note that keys may only contain ASCII characters in the ISO-8859-1 character set.
+	// The values are automatically base64-encoded and converted to a comma-separated
+	// list of name and value pairs before sending to the API
 	Properties map[string]string
 }
 
@@ -56,7 +57,7 @@ func (c Client) Create(ctx context.Context, fileSystemName string, input CreateI
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
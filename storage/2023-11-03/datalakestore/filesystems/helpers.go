@@ -1,21 +1,32 @@
 package filesystems
 
 import (
+	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 )
 
+// buildProperties converts the map of properties into the format expected by the `x-ms-properties` header,
+// which is a comma-separated list of key-value pairs where each value is base64-encoded
 func buildProperties(input map[string]string) string {
-	// properties has to be a comma-separated key-value pair
-	properties := make([]string, 0)
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	// sort the keys so that the header value is deterministic
+	sort.Strings(keys)
 
-	for k, v := range input {
-		properties = append(properties, fmt.Sprintf("%s=%s", k, v))
+	properties := make([]string, 0, len(keys))
+	for _, k := range keys {
+		properties = append(properties, fmt.Sprintf("%s=%s", k, base64.StdEncoding.EncodeToString([]byte(input[k]))))
 	}
 
 	return strings.Join(properties, ",")
 }
 
+// parseProperties parses the value of an `x-ms-properties` header into a map of properties,
+// decoding the base64-encoded value of each item
 func parseProperties(input string) (*map[string]string, error) {
 	properties := make(map[string]string)
 	if input == "" {
@@ -33,8 +44,11 @@ func parseProperties(input string) (*map[string]string, error) {
 		}
 
 		key := propertyRaw[0:position]
-		value := propertyRaw[position+1:]
-		properties[key] = value
+		value, err := base64.StdEncoding.DecodeString(propertyRaw[position+1:])
+		if err != nil {
+			return nil, fmt.Errorf("decoding the value for the property %q: %+v", key, err)
+		}
+		properties[key] = string(value)
 	}
 	return &properties, nil
 }
@@ -24,17 +24,15 @@ func TestParseProperties(t *testing.T) {
 			expectError: true,
 		},
 		{
-			name:  "single item",
-			input: "hello=world",
-			expected: map[string]string{
-				"hello": "world",
-			},
+			name:        "invalid base64",
+			input:       "hello=world!",
+			expectError: true,
 		},
 		{
 			name:  "single-item-base64",
 			input: "hello=aGVsbG8=",
 			expected: map[string]string{
-				"hello": "aGVsbG8=",
+				"hello": "hello",
 			},
 			expectError: false,
 		},
@@ -42,7 +40,7 @@ func TestParseProperties(t *testing.T) {
 			name:  "single-item-base64-multipleequals",
 			input: "hello=d29uZGVybGFuZA==",
 			expected: map[string]string{
-				"hello": "d29uZGVybGFuZA==",
+				"hello": "wonderland",
 			},
 			expectError: false,
 		},
@@ -51,8 +49,8 @@ func TestParseProperties(t *testing.T) {
 			input: "hello=d29uZGVybGFuZA==,private=ZXll",
 
 			expected: map[string]string{
-				"hello":   "d29uZGVybGFuZA==",
-				"private": "ZXll",
+				"hello":   "wonderland",
+				"private": "eye",
 			},
 			expectError: false,
 		},
@@ -69,8 +67,57 @@ func TestParseProperties(t *testing.T) {
 
 			t.Fatalf("[DEBUG] Didn't expect an error but got %s", err)
 		}
+		if testCase.expectError {
+			t.Fatalf("[DEBUG] Expected an error but didn't get one")
+		}
 		if !reflect.DeepEqual(testCase.expected, *actual) {
 			t.Fatalf("Expected %+v but got %+v", testCase.expected, *actual)
 		}
 	}
 }
+
+func TestBuildProperties(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    map[string]string
+		expected string
+	}{
+		{
+			name:     "no items",
+			input:    map[string]string{},
+			expected: "",
+		},
+		{
+			name: "single item",
+			input: map[string]string{
+				"hello": "wonderland",
+			},
+			expected: "hello=d29uZGVybGFuZA==",
+		},
+		{
+			name: "multiple items",
+			input: map[string]string{
+				"private": "eye",
+				"hello":   "wonderland",
+			},
+			expected: "hello=d29uZGVybGFuZA==,private=ZXll",
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test %q", testCase.name)
+
+		actual := buildProperties(testCase.input)
+		if actual != testCase.expected {
+			t.Fatalf("Expected %q but got %q", testCase.expected, actual)
+		}
+
+		parsed, err := parseProperties(actual)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", actual, err)
+		}
+		if !reflect.DeepEqual(testCase.input, *parsed) {
+			t.Fatalf("Expected the round-tripped properties to be %+v but got %+v", testCase.input, *parsed)
+		}
+	}
+}
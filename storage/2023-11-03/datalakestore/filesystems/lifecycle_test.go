@@ -11,6 +11,8 @@ import (
 	"github.com/jackofallops/giovanni/storage/internal/testhelpers"
 )
 
+var _ StorageFileSystem = Client{}
+
 func TestLifecycle(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
 	defer cancel()
@@ -46,7 +48,7 @@ func TestLifecycle(t *testing.T) {
 	t.Logf("[DEBUG] Creating an empty File System..")
 	input := CreateInput{
 		Properties: map[string]string{
-			"hello": "aGVsbG8=",
+			"hello": "hello",
 		},
 	}
 	if _, err = fileSystemsClient.Create(ctx, fileSystemName, input); err != nil {
@@ -62,15 +64,15 @@ func TestLifecycle(t *testing.T) {
 	if len(props.Properties) != 1 {
 		t.Fatalf("Expected 1 properties by default but got %d", len(props.Properties))
 	}
-	if props.Properties["hello"] != "aGVsbG8=" {
-		t.Fatalf("Expected `hello` to be `aGVsbG8=` but got %q", props.Properties["hello"])
+	if props.Properties["hello"] != "hello" {
+		t.Fatalf("Expected `hello` to be `hello` but got %q", props.Properties["hello"])
 	}
 
 	t.Logf("[DEBUG] Updating the properties..")
 	setInput := SetPropertiesInput{
 		Properties: map[string]string{
-			"hello":   "d29uZGVybGFuZA==",
-			"private": "ZXll",
+			"hello":   "wonderland",
+			"private": "eye",
 		},
 	}
 	if _, err := fileSystemsClient.SetProperties(ctx, fileSystemName, setInput); err != nil {
@@ -85,11 +87,24 @@ func TestLifecycle(t *testing.T) {
 	if len(props.Properties) != 2 {
 		t.Fatalf("Expected 2 properties by default but got %d", len(props.Properties))
 	}
-	if props.Properties["hello"] != "d29uZGVybGFuZA==" {
-		t.Fatalf("Expected `hello` to be `d29uZGVybGFuZA==` but got %q", props.Properties["hello"])
+	if props.Properties["hello"] != "wonderland" {
+		t.Fatalf("Expected `hello` to be `wonderland` but got %q", props.Properties["hello"])
+	}
+	if props.Properties["private"] != "eye" {
+		t.Fatalf("Expected `private` to be `eye` but got %q", props.Properties["private"])
+	}
+
+	t.Logf("[DEBUG] Listing the File Systems..")
+	prefix := fileSystemName
+	list, err := fileSystemsClient.ListComplete(ctx, ListInput{Prefix: &prefix})
+	if err != nil {
+		t.Fatalf("Error listing: %s", err)
+	}
+	if len(list.FileSystems) != 1 {
+		t.Fatalf("Expected 1 File System but got %d", len(list.FileSystems))
 	}
-	if props.Properties["private"] != "ZXll" {
-		t.Fatalf("Expected `private` to be `ZXll` but got %q", props.Properties["private"])
+	if list.FileSystems[0].Name != fileSystemName {
+		t.Fatalf("Expected the File System to be %q but got %q", fileSystemName, list.FileSystems[0].Name)
 	}
 
 	t.Logf("[DEBUG] Deleting File System..")
@@ -0,0 +1,122 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ListInput struct {
+	// Optional - Filters the results to only return File Systems whose name begins with the specified prefix
+	Prefix *string
+
+	// Optional - The continuation token returned from a previous List operation, used to retrieve the next page of results
+	Continuation *string
+
+	// Optional - The maximum number of File Systems to return, up to 5000
+	MaxResults *int
+}
+
+type ListResponse struct {
+	HttpResponse *http.Response
+
+	// The File Systems within this page of results
+	FileSystems []FileSystem `json:"filesystems"`
+
+	// The continuation token which should be used to retrieve the next page of results,
+	// this is empty when there are no further results
+	Continuation string `json:"-"`
+}
+
+type FileSystem struct {
+	// The name of the File System
+	Name string `json:"name"`
+
+	// The date and time the File System was last modified, in RFC1123 format
+	LastModified string `json:"lastModified"`
+
+	// The ETag of the File System
+	ETag string `json:"etag"`
+}
+
+// List lists the Data Lake Store Gen2 FileSystems within a Storage Account
+func (c Client) List(ctx context.Context, input ListInput) (result ListResponse, err error) {
+	if input.MaxResults != nil && (*input.MaxResults <= 0 || *input.MaxResults > 5000) {
+		err = fmt.Errorf("`input.MaxResults` can either be nil or between 1 and 5000")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: listOptions{
+			input: input,
+		},
+		Path: "/",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.Continuation = resp.Header.Get("x-ms-continuation")
+			}
+
+			err = resp.Unmarshal(&result)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type listOptions struct {
+	input ListInput
+}
+
+func (o listOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o listOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o listOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("resource", "account")
+	if o.input.Prefix != nil {
+		out.Append("prefix", *o.input.Prefix)
+	}
+	if o.input.Continuation != nil {
+		out.Append("continuation", *o.input.Continuation)
+	}
+	if o.input.MaxResults != nil {
+		out.Append("maxResults", strconv.Itoa(*o.input.MaxResults))
+	}
+	return out
+}
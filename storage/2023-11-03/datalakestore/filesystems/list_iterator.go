@@ -0,0 +1,73 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListIterator retrieves successive pages of File Systems from a Storage Account, following the
+// `Continuation` token returned by the service until all of the results have been retrieved.
+type ListIterator struct {
+	client Client
+	input  ListInput
+	done   bool
+}
+
+// NewListIterator returns an iterator over the File Systems within the Storage Account matching `input`.
+// The `Continuation` within `input` (if specified) is used as the starting point.
+func (c Client) NewListIterator(input ListInput) *ListIterator {
+	return &ListIterator{
+		client: c,
+		input:  input,
+	}
+}
+
+// NotDone returns whether there are further pages of results to be retrieved
+func (i *ListIterator) NotDone() bool {
+	return !i.done
+}
+
+// Next retrieves the next page of results
+func (i *ListIterator) Next(ctx context.Context) (result ListResponse, err error) {
+	if i.done {
+		err = fmt.Errorf("no more results are available")
+		return
+	}
+
+	result, err = i.client.List(ctx, i.input)
+	if err != nil {
+		return
+	}
+
+	if result.Continuation == "" {
+		i.done = true
+	} else {
+		continuation := result.Continuation
+		i.input.Continuation = &continuation
+	}
+
+	return
+}
+
+type ListCompleteResult struct {
+	// The File Systems matching the query, across all pages of results
+	FileSystems []FileSystem
+}
+
+// ListComplete retrieves all of the File Systems within the Storage Account matching `input`,
+// following the `Continuation` token until all pages of results have been retrieved
+func (c Client) ListComplete(ctx context.Context, input ListInput) (result ListCompleteResult, err error) {
+	iterator := c.NewListIterator(input)
+	for iterator.NotDone() {
+		var page ListResponse
+		page, err = iterator.Next(ctx)
+		if err != nil {
+			err = fmt.Errorf("listing file systems: %+v", err)
+			return
+		}
+
+		result.FileSystems = append(result.FileSystems, page.FileSystems...)
+	}
+
+	return
+}
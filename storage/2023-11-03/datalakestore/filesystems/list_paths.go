@@ -0,0 +1,210 @@
+package filesystems
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ListPathsInput struct {
+	// Optional - Filters the results to only return Paths within the specified Directory
+	Directory *string
+
+	// Should the Paths within each child Directory also be returned? When false only the
+	// immediate children of the File System (or Directory) are returned.
+	Recursive bool
+
+	// Optional - The continuation token returned from a previous ListPaths operation, used to retrieve the next page of results
+	Continuation *string
+
+	// Optional - The maximum number of Paths to return, up to 5000
+	MaxResults *int
+}
+
+type ListPathsResponse struct {
+	HttpResponse *http.Response
+
+	// The Paths within this page of results
+	Paths []Path `json:"paths"`
+
+	// The continuation token which should be used to retrieve the next page of results,
+	// this is empty when there are no further results
+	Continuation string `json:"-"`
+}
+
+type Path struct {
+	// The name of the Path, relative to the root of the File System
+	Name string
+
+	// Whether the Path is a Directory (rather than a File)
+	IsDirectory bool
+
+	// The size of the File in bytes, which is 0 for a Directory
+	ContentLength int64
+
+	// The owner of the Path
+	Owner string
+
+	// The owning group of the Path
+	Group string
+
+	// The POSIX access permissions for the owner, owning group and others, for example `rwxr-x---`
+	Permissions string
+
+	// The date and time the Path was last modified, in RFC1123 format
+	LastModified string
+
+	// The ETag of the Path
+	ETag string
+}
+
+// UnmarshalJSON parses a Path, where the service returns `isDirectory` and `contentLength` as strings
+func (p *Path) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name          string          `json:"name"`
+		IsDirectory   json.RawMessage `json:"isDirectory"`
+		ContentLength json.RawMessage `json:"contentLength"`
+		Owner         string          `json:"owner"`
+		Group         string          `json:"group"`
+		Permissions   string          `json:"permissions"`
+		LastModified  string          `json:"lastModified"`
+		ETag          string          `json:"etag"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	isDirectory, err := parseRawValue(raw.IsDirectory, strconv.ParseBool)
+	if err != nil {
+		return fmt.Errorf("parsing `isDirectory` for %q: %+v", raw.Name, err)
+	}
+	contentLength, err := parseRawValue(raw.ContentLength, func(v string) (int64, error) {
+		return strconv.ParseInt(v, 10, 64)
+	})
+	if err != nil {
+		return fmt.Errorf("parsing `contentLength` for %q: %+v", raw.Name, err)
+	}
+
+	*p = Path{
+		Name:          raw.Name,
+		IsDirectory:   isDirectory,
+		ContentLength: contentLength,
+		Owner:         raw.Owner,
+		Group:         raw.Group,
+		Permissions:   raw.Permissions,
+		LastModified:  raw.LastModified,
+		ETag:          raw.ETag,
+	}
+	return nil
+}
+
+// parseRawValue parses a JSON value which is either a string, or a literal (for example `true` or `42`)
+func parseRawValue[T any](input json.RawMessage, parse func(string) (T, error)) (out T, err error) {
+	if len(input) == 0 || string(input) == "null" {
+		return
+	}
+
+	value := string(input)
+	var unquoted string
+	if json.Unmarshal(input, &unquoted) == nil {
+		value = unquoted
+	}
+	if value == "" {
+		return
+	}
+	return parse(value)
+}
+
+// ListPaths lists the Paths within a Data Lake Store Gen2 File System (or a Directory within it)
+func (c Client) ListPaths(ctx context.Context, fileSystemName string, input ListPathsInput) (result ListPathsResponse, err error) {
+	if fileSystemName == "" {
+		err = fmt.Errorf("`fileSystemName` cannot be an empty string")
+		return
+	}
+
+	if input.Directory != nil && *input.Directory == "" {
+		err = fmt.Errorf("`input.Directory` should either be specified or nil, not an empty string")
+		return
+	}
+
+	if input.MaxResults != nil && (*input.MaxResults <= 0 || *input.MaxResults > 5000) {
+		err = fmt.Errorf("`input.MaxResults` can either be nil or between 1 and 5000")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: listPathsOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s", fileSystemName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.Continuation = resp.Header.Get("x-ms-continuation")
+			}
+
+			err = resp.Unmarshal(&result)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type listPathsOptions struct {
+	input ListPathsInput
+}
+
+func (o listPathsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o listPathsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o listPathsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("resource", "filesystem")
+	out.Append("recursive", strconv.FormatBool(o.input.Recursive))
+	if o.input.Directory != nil {
+		out.Append("directory", *o.input.Directory)
+	}
+	if o.input.Continuation != nil {
+		out.Append("continuation", *o.input.Continuation)
+	}
+	if o.input.MaxResults != nil {
+		out.Append("maxResults", strconv.Itoa(*o.input.MaxResults))
+	}
+	return out
+}
@@ -0,0 +1,140 @@
+package filesystems
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestListPathsResponseUnmarshal(t *testing.T) {
+	input := `{"paths":[{"contentLength":"0","etag":"0x1","group":"$superuser","isDirectory":"true","lastModified":"Mon, 01 Jan 2024 00:00:00 GMT","name":"directory","owner":"$superuser","permissions":"rwxr-x---"},{"contentLength":11,"etag":"0x2","group":"$superuser","lastModified":"Tue, 02 Jan 2024 00:00:00 GMT","name":"directory/file.txt","owner":"$superuser","permissions":"rw-r-----"}]}`
+
+	var actual ListPathsResponse
+	if err := json.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if len(actual.Paths) != 2 {
+		t.Fatalf("expected 2 paths but got %d", len(actual.Paths))
+	}
+	if !actual.Paths[0].IsDirectory || actual.Paths[0].Name != "directory" {
+		t.Fatalf("expected the first path to be the directory %q but got %+v", "directory", actual.Paths[0])
+	}
+	if actual.Paths[1].IsDirectory {
+		t.Fatalf("expected the second path to be a file since `isDirectory` is omitted for files")
+	}
+	if actual.Paths[1].ContentLength != 11 {
+		t.Fatalf("expected the second path to be 11 bytes but got %d", actual.Paths[1].ContentLength)
+	}
+	if actual.Paths[1].Permissions != "rw-r-----" || actual.Paths[1].Owner != "$superuser" || actual.Paths[1].Group != "$superuser" {
+		t.Fatalf("unexpected access control for the second path: %+v", actual.Paths[1])
+	}
+
+	if err := json.Unmarshal([]byte(`{"paths":[{"name":"file","isDirectory":"maybe"}]}`), &actual); err == nil {
+		t.Fatalf("expected an error for an invalid `isDirectory` but didn't get one")
+	}
+}
+
+func TestListPathsOptions(t *testing.T) {
+	query := listPathsOptions{input: ListPathsInput{}}.ToQuery().Values()
+	if v := query.Get("resource"); v != "filesystem" {
+		t.Fatalf("expected `resource` to be %q but got %q", "filesystem", v)
+	}
+	if v := query.Get("recursive"); v != "false" {
+		t.Fatalf("expected `recursive` to be %q but got %q", "false", v)
+	}
+	for _, k := range []string{"directory", "continuation", "maxResults"} {
+		if query.Has(k) {
+			t.Fatalf("expected %q to be omitted when unset", k)
+		}
+	}
+
+	query = listPathsOptions{input: ListPathsInput{
+		Directory:    pointer.To("directory"),
+		Recursive:    true,
+		Continuation: pointer.To("token"),
+		MaxResults:   pointer.To(10),
+	}}.ToQuery().Values()
+	expected := map[string]string{
+		"directory":    "directory",
+		"recursive":    "true",
+		"continuation": "token",
+		"maxResults":   "10",
+	}
+	for k, v := range expected {
+		if query.Get(k) != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, query.Get(k))
+		}
+	}
+}
+
+func TestWalk(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Method != http.MethodGet || r.URL.Path != "/filesystem" || query.Get("resource") != "filesystem" || query.Get("recursive") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch query.Get("continuation") {
+		case "":
+			w.Header().Set("x-ms-continuation", "token")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"paths":[{"name":"directory","isDirectory":"true","contentLength":"0"}]}`))
+		case "token":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"paths":[{"name":"directory/file.txt","contentLength":"11"},{"name":"other.txt","contentLength":"5"}]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	fileSystemsClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	directories := make([]string, 0)
+	files := make([]string, 0)
+	err = fileSystemsClient.Walk(ctx, "filesystem", ListPathsInput{Recursive: true}, func(ctx context.Context, path Path) error {
+		if path.IsDirectory {
+			directories = append(directories, path.Name)
+		} else {
+			files = append(files, path.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walking: %+v", err)
+	}
+	if len(directories) != 1 || directories[0] != "directory" {
+		t.Fatalf("expected the directories to be %q but got %q", []string{"directory"}, directories)
+	}
+	if len(files) != 2 || files[0] != "directory/file.txt" || files[1] != "other.txt" {
+		t.Fatalf("expected the files to be %q but got %q", []string{"directory/file.txt", "other.txt"}, files)
+	}
+
+	stop := errors.New("stop")
+	visited := 0
+	err = fileSystemsClient.Walk(ctx, "filesystem", ListPathsInput{Recursive: true}, func(ctx context.Context, path Path) error {
+		visited++
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("expected the error returned from the callback but got: %+v", err)
+	}
+	if visited != 1 {
+		t.Fatalf("expected the walk to stop after the first path but visited %d paths", visited)
+	}
+}
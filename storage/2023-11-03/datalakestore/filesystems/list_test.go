@@ -0,0 +1,57 @@
+package filesystems
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestListResponseUnmarshal(t *testing.T) {
+	input := `{"filesystems":[{"etag":"0x8DC1234567890AB","lastModified":"Mon, 01 Jan 2024 00:00:00 GMT","name":"first"},{"etag":"0x8DC1234567890CD","lastModified":"Tue, 02 Jan 2024 00:00:00 GMT","name":"second"}]}`
+
+	var actual ListResponse
+	if err := json.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if len(actual.FileSystems) != 2 {
+		t.Fatalf("expected 2 file systems but got %d", len(actual.FileSystems))
+	}
+	if actual.FileSystems[0].Name != "first" {
+		t.Fatalf("expected the first file system to be %q but got %q", "first", actual.FileSystems[0].Name)
+	}
+	if actual.FileSystems[1].ETag != "0x8DC1234567890CD" {
+		t.Fatalf("expected the second file system to have the etag %q but got %q", "0x8DC1234567890CD", actual.FileSystems[1].ETag)
+	}
+	if actual.FileSystems[1].LastModified != "Tue, 02 Jan 2024 00:00:00 GMT" {
+		t.Fatalf("expected the second file system to have been modified at %q but got %q", "Tue, 02 Jan 2024 00:00:00 GMT", actual.FileSystems[1].LastModified)
+	}
+}
+
+func TestListOptions(t *testing.T) {
+	query := listOptions{input: ListInput{}}.ToQuery().Values()
+	if v := query.Get("resource"); v != "account" {
+		t.Fatalf("expected `resource` to be %q but got %q", "account", v)
+	}
+	for _, k := range []string{"prefix", "continuation", "maxResults"} {
+		if query.Has(k) {
+			t.Fatalf("expected %q to be omitted when unset", k)
+		}
+	}
+
+	query = listOptions{input: ListInput{
+		Prefix:       pointer.To("acc"),
+		Continuation: pointer.To("token"),
+		MaxResults:   pointer.To(10),
+	}}.ToQuery().Values()
+	if v := query.Get("prefix"); v != "acc" {
+		t.Fatalf("expected `prefix` to be %q but got %q", "acc", v)
+	}
+	if v := query.Get("continuation"); v != "token" {
+		t.Fatalf("expected `continuation` to be %q but got %q", "token", v)
+	}
+	if v := query.Get("maxResults"); v != "10" {
+		t.Fatalf("expected `maxResults` to be %q but got %q", "10", v)
+	}
+}
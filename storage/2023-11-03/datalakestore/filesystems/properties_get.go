@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetPropertiesResponse struct {
@@ -15,10 +16,8 @@ type GetPropertiesResponse struct {
 	// The default encryption scope for the filesystem.
 	DefaultEncryptionScope string
 
-	// A map of base64-encoded strings to store as user-defined properties with the File System
-	// Note that items may only contain ASCII characters in the ISO-8859-1 character set.
-	// This automatically gets converted to a comma-separated list of name and
-	// value pairs before sending to the API
+	// A map of the user-defined properties stored with the File System
+	// The values are automatically decoded from base64 when parsing the response
 	Properties map[string]string
 
 	// Is Hierarchical Namespace Enabled?
@@ -70,7 +69,7 @@ func (c Client) GetProperties(ctx context.Context, fileSystemName string) (resul
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
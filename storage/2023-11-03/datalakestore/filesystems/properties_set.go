@@ -4,16 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetPropertiesInput struct {
-	// A map of base64-encoded strings to store as user-defined properties with the File System
-	// Note that items may only contain ASCII characters in the ISO-8859-1 character set.
-	// This automatically gets converted to a comma-separated list of name and
-	// value pairs before sending to the API
+	// A map of user-defined properties to store with the File System
+	// Note that keys may only contain ASCII characters in the ISO-8859-1 character set.
+	// The values are automatically base64-encoded and converted to a comma-separated
+	// list of name and value pairs before sending to the API
 	Properties map[string]string
 
 	// Optional - A date and time value.
@@ -27,6 +30,9 @@ type SetPropertiesInput struct {
 
 type SetPropertiesResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // SetProperties sets the Properties for a Data Lake Store Gen2 FileSystem within a Storage Account
@@ -61,9 +67,18 @@ func (c Client) SetProperties(ctx context.Context, fileSystemName string, input
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -0,0 +1,38 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+)
+
+// WalkFunc is called for each Path visited by Walk, `path.IsDirectory` can be used to distinguish
+// Directories from Files. Returning an error stops the walk, and the error is returned from Walk.
+type WalkFunc func(ctx context.Context, path Path) error
+
+// Walk lists the Paths within a Data Lake Store Gen2 File System (or a Directory within it, when
+// `input.Directory` is specified) calling `fn` for each Path - following the `Continuation` token
+// until all pages of results have been retrieved. Set `input.Recursive` to walk the entire tree.
+func (c Client) Walk(ctx context.Context, fileSystemName string, input ListPathsInput, fn WalkFunc) error {
+	if fn == nil {
+		return fmt.Errorf("`fn` cannot be nil")
+	}
+
+	for {
+		page, err := c.ListPaths(ctx, fileSystemName, input)
+		if err != nil {
+			return fmt.Errorf("listing paths: %+v", err)
+		}
+
+		for _, path := range page.Paths {
+			if err := fn(ctx, path); err != nil {
+				return err
+			}
+		}
+
+		if page.Continuation == "" {
+			return nil
+		}
+		continuation := page.Continuation
+		input.Continuation = &continuation
+	}
+}
@@ -0,0 +1,84 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetAccessControlResponse struct {
+	HttpResponse *http.Response
+
+	ETag string
+
+	// The Owner of the Path
+	Owner string
+
+	// The owning Group of the Path
+	Group string
+
+	// The POSIX access permissions for the Owner, owning Group and others, for example `rwxr-x---`
+	Permissions string
+
+	// The POSIX Access Control List for the Path
+	ACL accesscontrol.ACL
+}
+
+// GetAccessControl gets the owner, group, permissions and Access Control List for a Data Lake Store Gen2 Path
+func (c Client) GetAccessControl(ctx context.Context, fileSystemName string, path string) (result GetAccessControlResponse, err error) {
+	if fileSystemName == "" {
+		err = fmt.Errorf("`fileSystemName` cannot be an empty string")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodHead,
+		OptionsObject: getPropertyOptions{
+			action: GetPropertiesActionGetAccessControl,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				result.Owner = resp.Header.Get("x-ms-owner")
+				result.Group = resp.Header.Get("x-ms-group")
+				result.Permissions = resp.Header.Get("x-ms-permissions")
+
+				if aclRaw := resp.Header.Get("x-ms-acl"); aclRaw != "" {
+					result.ACL, err = accesscontrol.ParseACL(aclRaw)
+					if err != nil {
+						err = fmt.Errorf("parsing `x-ms-acl`: %+v", err)
+						return
+					}
+				}
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
@@ -0,0 +1,237 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type SetAccessControlRecursiveMode string
+
+const (
+	// SetAccessControlRecursiveModeModify adds or updates the specified entries, retaining any other entries
+	SetAccessControlRecursiveModeModify SetAccessControlRecursiveMode = "modify"
+
+	// SetAccessControlRecursiveModeRemove removes the specified entries, the Permissions of each entry are ignored
+	SetAccessControlRecursiveModeRemove SetAccessControlRecursiveMode = "remove"
+
+	// SetAccessControlRecursiveModeSet replaces the existing Access Control List with the specified entries
+	SetAccessControlRecursiveModeSet SetAccessControlRecursiveMode = "set"
+)
+
+func PossibleValuesForSetAccessControlRecursiveMode() []string {
+	return []string{
+		string(SetAccessControlRecursiveModeModify),
+		string(SetAccessControlRecursiveModeRemove),
+		string(SetAccessControlRecursiveModeSet),
+	}
+}
+
+type SetAccessControlRecursiveInput struct {
+	// How the ACL should be applied to the Path and each of its children
+	Mode SetAccessControlRecursiveMode
+
+	// The POSIX Access Control List entries which should be set, modified or removed
+	ACL accesscontrol.ACL
+
+	// Optional - The continuation token returned from a previous (partially completed) operation,
+	// used to resume applying the ACL from where it finished
+	Continuation *string
+
+	// Optional - The maximum number of Paths which should be updated in each request, up to 2000
+	MaxRecords *int
+
+	// Optional - Should the operation continue when the ACL couldn't be applied to a child Path?
+	// By default the operation stops at the first failure.
+	ContinueOnFailure bool
+}
+
+type SetAccessControlRecursiveFailedEntry struct {
+	// The error returned when applying the ACL to this Path
+	ErrorMessage string `json:"errorMessage"`
+
+	// The name of the Path which couldn't be updated
+	Name string `json:"name"`
+
+	// The type of the Path which couldn't be updated, either `FILE` or `DIRECTORY`
+	Type string `json:"type"`
+}
+
+type SetAccessControlRecursiveResponse struct {
+	// The HTTP Response for the final request which was made
+	HttpResponse *http.Response
+
+	// The number of Directories which were updated, across all requests
+	DirectoriesSuccessful int64
+
+	// The number of Files which were updated, across all requests
+	FilesSuccessful int64
+
+	// The number of Paths which couldn't be updated, across all requests
+	FailureCount int64
+
+	// The Paths which couldn't be updated, across all requests
+	FailedEntries []SetAccessControlRecursiveFailedEntry
+}
+
+type setAccessControlRecursivePage struct {
+	DirectoriesSuccessful int64                                  `json:"directoriesSuccessful"`
+	FilesSuccessful       int64                                  `json:"filesSuccessful"`
+	FailureCount          int64                                  `json:"failureCount"`
+	FailedEntries         []SetAccessControlRecursiveFailedEntry `json:"failedEntries"`
+}
+
+// SetAccessControlRecursive sets, modifies or removes the Access Control List for a Data Lake Store Gen2 Path
+// and all of its children, following the `x-ms-continuation` token until all Paths have been processed
+func (c Client) SetAccessControlRecursive(ctx context.Context, fileSystemName string, path string, input SetAccessControlRecursiveInput) (result SetAccessControlRecursiveResponse, err error) {
+	if fileSystemName == "" {
+		err = fmt.Errorf("`fileSystemName` cannot be an empty string")
+		return
+	}
+
+	if err = validateSetAccessControlRecursiveInput(input); err != nil {
+		return
+	}
+
+	for {
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodPatch,
+			OptionsObject: setAccessControlRecursiveOptions{
+				input: input,
+			},
+			Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+		}
+
+		var req *client.Request
+		req, err = c.Client.NewRequest(ctx, opts)
+		if err != nil {
+			err = fmt.Errorf("building request: %+v", err)
+			return
+		}
+
+		var page setAccessControlRecursivePage
+		var continuation string
+		var resp *client.Response
+		resp, err = req.Execute(ctx)
+		if resp != nil && resp.Response != nil {
+			result.HttpResponse = resp.Response
+
+			if err == nil {
+				continuation = resp.Header.Get("x-ms-continuation")
+
+				err = resp.Unmarshal(&page)
+				if err != nil {
+					err = fmt.Errorf("unmarshalling response: %+v", err)
+					return
+				}
+			}
+		}
+		if err != nil {
+			err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+			return
+		}
+
+		result.DirectoriesSuccessful += page.DirectoriesSuccessful
+		result.FilesSuccessful += page.FilesSuccessful
+		result.FailureCount += page.FailureCount
+		result.FailedEntries = append(result.FailedEntries, page.FailedEntries...)
+
+		if continuation == "" {
+			break
+		}
+		input.Continuation = &continuation
+	}
+
+	return
+}
+
+func validateSetAccessControlRecursiveInput(input SetAccessControlRecursiveInput) error {
+	validMode := false
+	for _, v := range PossibleValuesForSetAccessControlRecursiveMode() {
+		if string(input.Mode) == v {
+			validMode = true
+			break
+		}
+	}
+	if !validMode {
+		return fmt.Errorf("`input.Mode` must be one of %s but got %q", strings.Join(PossibleValuesForSetAccessControlRecursiveMode(), ", "), input.Mode)
+	}
+
+	if len(input.ACL.Entries) == 0 {
+		return fmt.Errorf("`input.ACL` must contain at least one entry")
+	}
+	// the permissions aren't sent when removing entries, so only need to be valid when setting or modifying
+	if input.Mode != SetAccessControlRecursiveModeRemove {
+		if err := input.ACL.Validate(); err != nil {
+			return fmt.Errorf("validating `input.ACL`: %+v", err)
+		}
+	}
+
+	if input.MaxRecords != nil && (*input.MaxRecords <= 0 || *input.MaxRecords > 2000) {
+		return fmt.Errorf("`input.MaxRecords` can either be nil or between 1 and 2000")
+	}
+
+	return nil
+}
+
+type setAccessControlRecursiveOptions struct {
+	input SetAccessControlRecursiveInput
+}
+
+func (s setAccessControlRecursiveOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-acl", buildRecursiveACL(s.input.Mode, s.input.ACL))
+	return headers
+}
+
+// buildRecursiveACL returns the value for the `x-ms-acl` header, when removing entries
+// these are identified by `[default:]{type}:{id}` and so the permissions are omitted
+func buildRecursiveACL(mode SetAccessControlRecursiveMode, acl accesscontrol.ACL) string {
+	if mode != SetAccessControlRecursiveModeRemove {
+		return acl.String()
+	}
+
+	entries := make([]string, 0, len(acl.Entries))
+	for _, v := range acl.Entries {
+		entry := fmt.Sprintf("%s:", v.TagType)
+		if v.TagQualifier != nil {
+			entry += v.TagQualifier.String()
+		}
+		if v.IsDefault {
+			entry = fmt.Sprintf("default:%s", entry)
+		}
+		entries = append(entries, entry)
+	}
+	return strings.Join(entries, ",")
+}
+
+func (s setAccessControlRecursiveOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (s setAccessControlRecursiveOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("action", "setAccessControlRecursive")
+	out.Append("mode", string(s.input.Mode))
+	if s.input.Continuation != nil {
+		out.Append("continuation", *s.input.Continuation)
+	}
+	if s.input.MaxRecords != nil {
+		out.Append("maxRecords", strconv.Itoa(*s.input.MaxRecords))
+	}
+	if s.input.ContinueOnFailure {
+		out.Append("forceFlag", "true")
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+package paths
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+)
+
+func TestValidateSetAccessControlRecursiveInput(t *testing.T) {
+	qualifier := uuid.MustParse("00000000-0000-0000-0000-000000000000")
+	withPermissions := accesscontrol.ACL{
+		Entries: []accesscontrol.ACE{
+			{TagType: accesscontrol.TagTypeUser, TagQualifier: &qualifier, Permissions: "r-x"},
+		},
+	}
+	withoutPermissions := accesscontrol.ACL{
+		Entries: []accesscontrol.ACE{
+			{TagType: accesscontrol.TagTypeUser, TagQualifier: &qualifier},
+		},
+	}
+
+	testData := []struct {
+		Name          string
+		Input         SetAccessControlRecursiveInput
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Invalid Mode",
+			Input:         SetAccessControlRecursiveInput{Mode: "replace", ACL: withPermissions},
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "No Entries",
+			Input:         SetAccessControlRecursiveInput{Mode: SetAccessControlRecursiveModeSet},
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Set",
+			Input:         SetAccessControlRecursiveInput{Mode: SetAccessControlRecursiveModeSet, ACL: withPermissions},
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Modify Without Permissions",
+			Input:         SetAccessControlRecursiveInput{Mode: SetAccessControlRecursiveModeModify, ACL: withoutPermissions},
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Remove Without Permissions",
+			Input:         SetAccessControlRecursiveInput{Mode: SetAccessControlRecursiveModeRemove, ACL: withoutPermissions},
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Invalid Max Records",
+			Input:         SetAccessControlRecursiveInput{Mode: SetAccessControlRecursiveModeSet, ACL: withPermissions, MaxRecords: pointer.To(2001)},
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateSetAccessControlRecursiveInput(v.Input)
+		isValid := err == nil
+		if v.ShouldBeValid != isValid {
+			t.Fatalf("Expected %t but got %t (%+v)", v.ShouldBeValid, isValid, err)
+		}
+	}
+}
+
+func TestBuildRecursiveACL(t *testing.T) {
+	acl, err := accesscontrol.ParseACL("user:00000000-0000-0000-0000-000000000000:r-x,default:group:11111111-1111-1111-1111-111111111111:r--,mask::r-x")
+	if err != nil {
+		t.Fatalf("parsing ACL: %+v", err)
+	}
+
+	if actual := buildRecursiveACL(SetAccessControlRecursiveModeModify, acl); actual != acl.String() {
+		t.Fatalf("expected %q but got %q", acl.String(), actual)
+	}
+
+	expected := "user:00000000-0000-0000-0000-000000000000,default:group:11111111-1111-1111-1111-111111111111,mask:"
+	if actual := buildRecursiveACL(SetAccessControlRecursiveModeRemove, acl); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
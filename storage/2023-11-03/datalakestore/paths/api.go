@@ -0,0 +1,23 @@
+package paths
+
+import (
+	"context"
+	"io"
+)
+
+type StoragePath interface {
+	Create(ctx context.Context, fileSystemName string, path string, input CreateInput) (CreateResponse, error)
+	Delete(ctx context.Context, fileSystemName string, path string, input DeleteInput) (DeleteResponse, error)
+	DeleteRecursive(ctx context.Context, fileSystemName string, path string, input DeleteRecursiveInput) (DeleteRecursiveResponse, error)
+	Append(ctx context.Context, fileSystemName string, path string, input AppendInput) (AppendResponse, error)
+	Flush(ctx context.Context, fileSystemName string, path string, input FlushInput) (FlushResponse, error)
+	UploadFromReader(ctx context.Context, fileSystemName string, path string, reader io.Reader, input UploadFromReaderInput) (FlushResponse, error)
+	Rename(ctx context.Context, fileSystemName string, path string, input RenameInput) (RenameResponse, error)
+	Exists(ctx context.Context, fileSystemName string, path string) (ExistsResponse, error)
+	GetProperties(ctx context.Context, fileSystemName string, path string, input GetPropertiesInput) (GetPropertiesResponse, error)
+	GetStatus(ctx context.Context, fileSystemName string, path string) (GetPropertiesResponse, error)
+	Read(ctx context.Context, fileSystemName string, path string, input ReadInput) (ReadResponse, error)
+	GetAccessControl(ctx context.Context, fileSystemName string, path string) (GetAccessControlResponse, error)
+	SetAccessControlRecursive(ctx context.Context, fileSystemName string, path string, input SetAccessControlRecursiveInput) (SetAccessControlRecursiveResponse, error)
+	SetAccessControl(ctx context.Context, fileSystemName string, path string, input SetAccessControlInput) (SetPropertiesResponse, error)
+}
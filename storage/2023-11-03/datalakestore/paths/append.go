@@ -0,0 +1,166 @@
+package paths
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/checksum"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+// maxAppendSize is the maximum number of bytes which can be appended to a Path in a single request
+const maxAppendSize int64 = 4000 * 1024 * 1024
+
+type AppendInput struct {
+	// The offset within the File at which the Content should be appended, this must be equal to
+	// the length of the File (including any previously appended but unflushed data).
+	Position int64
+
+	// The data which should be appended to the File, which can be at most 4000MB
+	Content []byte
+
+	// Optional - The base64-encoded MD5 hash of the Content, used to verify the integrity of the data during transport
+	ContentMD5 *string
+
+	// Optional - The base64-encoded CRC64 checksum of the Content, used to verify the integrity of the data
+	// during transport. This cannot be specified alongside ContentMD5.
+	ContentCRC64 *string
+
+	// Should the CRC64 checksum of the Content be computed and sent as the `x-ms-content-crc64` header?
+	// The service then rejects the data if it was corrupted during transport.
+	// This cannot be specified alongside ContentMD5 or ContentCRC64.
+	ComputeContentCRC64 bool
+
+	// Optional - The Lease ID of the File, which must be specified when the File has an active lease
+	LeaseID *string
+}
+
+type AppendResponse struct {
+	HttpResponse *http.Response
+
+	// The base64-encoded CRC64 checksum of the Content, either as computed when `ComputeContentCRC64`
+	// is set, or as returned by the service
+	ContentCRC64 string
+}
+
+// Append uploads data to be appended to a File within a Data Lake Store Gen2 File System.
+// The data isn't committed to the File until Flush is called.
+func (c Client) Append(ctx context.Context, fileSystemName string, path string, input AppendInput) (result AppendResponse, err error) {
+
+	if fileSystemName == "" {
+		return result, fmt.Errorf("`fileSystemName` cannot be an empty string")
+	}
+
+	if path == "" {
+		return result, fmt.Errorf("`path` cannot be an empty string")
+	}
+
+	if input.Position < 0 {
+		return result, fmt.Errorf("`input.Position` must be greater or equal to 0")
+	}
+
+	if len(input.Content) == 0 {
+		return result, fmt.Errorf("`input.Content` cannot be empty")
+	}
+
+	if int64(len(input.Content)) > maxAppendSize {
+		return result, fmt.Errorf("`input.Content` can be at most %d bytes but got %d", maxAppendSize, len(input.Content))
+	}
+
+	checksums := 0
+	for _, v := range []bool{input.ContentMD5 != nil, input.ContentCRC64 != nil, input.ComputeContentCRC64} {
+		if v {
+			checksums++
+		}
+	}
+	if checksums > 1 {
+		return result, fmt.Errorf("at most one of `input.ContentMD5`, `input.ContentCRC64` and `input.ComputeContentCRC64` can be specified")
+	}
+
+	if input.ComputeContentCRC64 {
+		input.ContentCRC64 = pointer.To(checksum.CRC64(input.Content))
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/octet-stream",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod: http.MethodPatch,
+		OptionsObject: appendOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(input.Content))
+	req.ContentLength = int64(len(input.Content))
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ContentCRC64 = resp.Header.Get("x-ms-content-crc64")
+			}
+			if input.ContentCRC64 != nil {
+				result.ContentCRC64 = *input.ContentCRC64
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type appendOptions struct {
+	input AppendInput
+}
+
+func (a appendOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("Content-Length", strconv.Itoa(len(a.input.Content)))
+
+	if a.input.ContentMD5 != nil {
+		headers.Append("Content-MD5", *a.input.ContentMD5)
+	}
+
+	if a.input.ContentCRC64 != nil {
+		headers.Append("x-ms-content-crc64", *a.input.ContentCRC64)
+	}
+
+	if a.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *a.input.LeaseID)
+	}
+
+	return headers
+}
+
+func (a appendOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (a appendOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("action", "append")
+	out.Append("position", strconv.FormatInt(a.input.Position, 10))
+	return out
+}
@@ -0,0 +1,162 @@
+package paths
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/internal/checksum"
+)
+
+func TestAppendOptions(t *testing.T) {
+	options := appendOptions{input: AppendInput{
+		Position:   1024,
+		Content:    []byte("hello world"),
+		ContentMD5: pointer.To("XrY7u+Ae7tCTyyK7j1rNww=="),
+	}}
+
+	query := options.ToQuery().Values()
+	if v := query.Get("action"); v != "append" {
+		t.Fatalf("expected `action` to be %q but got %q", "append", v)
+	}
+	if v := query.Get("position"); v != "1024" {
+		t.Fatalf("expected `position` to be %q but got %q", "1024", v)
+	}
+
+	headers := options.ToHeaders().Headers()
+	if v := headers.Get("Content-Length"); v != "11" {
+		t.Fatalf("expected `Content-Length` to be %q but got %q", "11", v)
+	}
+	if v := headers.Get("Content-MD5"); v != "XrY7u+Ae7tCTyyK7j1rNww==" {
+		t.Fatalf("expected `Content-MD5` to be %q but got %q", "XrY7u+Ae7tCTyyK7j1rNww==", v)
+	}
+}
+
+func TestAppendOptionsContentCRC64(t *testing.T) {
+	options := appendOptions{input: AppendInput{
+		Position:     0,
+		Content:      []byte("hello world"),
+		ContentCRC64: pointer.To(checksum.CRC64([]byte("hello world"))),
+	}}
+
+	headers := options.ToHeaders().Headers()
+	if v := headers.Get("x-ms-content-crc64"); v != checksum.CRC64([]byte("hello world")) {
+		t.Fatalf("expected `x-ms-content-crc64` to be %q but got %q", checksum.CRC64([]byte("hello world")), v)
+	}
+	if v := headers.Get("Content-MD5"); v != "" {
+		t.Fatalf("expected `Content-MD5` to be omitted but got %q", v)
+	}
+}
+
+func TestAppendMultipleChecksums(t *testing.T) {
+	input := AppendInput{
+		Content:             []byte("hello world"),
+		ContentMD5:          pointer.To("XrY7u+Ae7tCTyyK7j1rNww=="),
+		ComputeContentCRC64: true,
+	}
+	if _, err := (Client{}).Append(context.Background(), "filesystem", "file.txt", input); err == nil {
+		t.Fatalf("expected an error when both `ContentMD5` and `ComputeContentCRC64` are specified")
+	}
+}
+
+func TestFlushOptions(t *testing.T) {
+	query := flushOptions{input: FlushInput{Position: 11}}.ToQuery().Values()
+	if v := query.Get("action"); v != "flush" {
+		t.Fatalf("expected `action` to be %q but got %q", "flush", v)
+	}
+	if v := query.Get("position"); v != "11" {
+		t.Fatalf("expected `position` to be %q but got %q", "11", v)
+	}
+	if query.Has("close") {
+		t.Fatalf("expected `close` to be omitted when false")
+	}
+
+	options := flushOptions{input: FlushInput{
+		Position:    11,
+		Close:       true,
+		ContentType: pointer.To("text/plain"),
+	}}
+	query = options.ToQuery().Values()
+	if v := query.Get("close"); v != "true" {
+		t.Fatalf("expected `close` to be %q but got %q", "true", v)
+	}
+	headers := options.ToHeaders().Headers()
+	if v := headers.Get("x-ms-content-type"); v != "text/plain" {
+		t.Fatalf("expected `x-ms-content-type` to be %q but got %q", "text/plain", v)
+	}
+	if v := headers.Get("If-Match"); v != "" {
+		t.Fatalf("expected `If-Match` to be omitted but got %q", v)
+	}
+
+	options.input.IfMatch = pointer.To("\"0x8D000000000000\"")
+	headers = options.ToHeaders().Headers()
+	if v := headers.Get("If-Match"); v != "\"0x8D000000000000\"" {
+		t.Fatalf("expected `If-Match` to be %q but got %q", "\"0x8D000000000000\"", v)
+	}
+}
+
+func TestFlushResponse(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name                 string
+		LastModified         string
+		ExpectedLastModified time.Time
+		ShouldError          bool
+	}{
+		{
+			Name:                 "Last Modified",
+			LastModified:         "Tue, 02 Jan 2024 03:04:05 GMT",
+			ExpectedLastModified: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			Name: "No Last Modified",
+		},
+		{
+			Name:         "Invalid Last Modified",
+			LastModified: "yesterday",
+			ShouldError:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", "0x1")
+			if v.LastModified != "" {
+				w.Header().Set("Last-Modified", v.LastModified)
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		pathsClient, err := NewWithBaseUri(server.URL)
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+
+		result, err := pathsClient.Flush(ctx, "filesystem", "file.txt", FlushInput{
+			Position: 5,
+		})
+		server.Close()
+		if v.ShouldError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("flushing: %+v", err)
+		}
+		if result.ETag != "0x1" {
+			t.Fatalf("expected the ETag to be %q but got %q", "0x1", result.ETag)
+		}
+		if !result.LastModified.Equal(v.ExpectedLastModified) {
+			t.Fatalf("expected the LastModified to be %s but got %s", v.ExpectedLastModified, result.LastModified)
+		}
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PathResource string
@@ -14,12 +18,23 @@ type PathResource string
 const PathResourceFile PathResource = "file"
 const PathResourceDirectory PathResource = "directory"
 
+func PossibleValuesForPathResource() []string {
+	return []string{
+		string(PathResourceDirectory),
+		string(PathResourceFile),
+	}
+}
+
 type CreateInput struct {
+	// The type of Path which should be created, either a File or a Directory
 	Resource PathResource
 }
 
 type CreateResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // Create creates a Data Lake Store Gen2 Path within a Storage Account
@@ -29,6 +44,14 @@ func (c Client) Create(ctx context.Context, fileSystemName string, path string,
 		return result, fmt.Errorf("`fileSystemName` cannot be an empty string")
 	}
 
+	if path == "" {
+		return result, fmt.Errorf("`path` cannot be an empty string")
+	}
+
+	if input.Resource != PathResourceFile && input.Resource != PathResourceDirectory {
+		return result, fmt.Errorf("`input.Resource` must be one of %s but got %q", strings.Join(PossibleValuesForPathResource(), ", "), input.Resource)
+	}
+
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
@@ -52,9 +75,18 @@ func (c Client) Create(ctx context.Context, fileSystemName string, path string,
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return result, err
 	}
 
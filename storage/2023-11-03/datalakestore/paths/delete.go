@@ -4,29 +4,63 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
+type DeleteInput struct {
+	// Optional - Should the contents of a Directory be deleted along with the Directory?
+	// This is only valid for Directories, when false (or omitted) the Directory must be empty.
+	Recursive *bool
+
+	// Optional - Should a recursive Delete be paginated? When true the service checks the permissions of each child
+	// Path across multiple requests, returning a continuation token until the Directory has been deleted - rather than
+	// within a single request, which can time out for large Directories.
+	Paginated bool
+
+	// Optional - The continuation token returned from a previous (paginated) Delete, used to continue deleting the Directory
+	Continuation *string
+}
+
 type DeleteResponse struct {
 	HttpResponse *http.Response
-}
 
-// Delete deletes a Data Lake Store Gen2 FileSystem within a Storage Account
-func (c Client) Delete(ctx context.Context, fileSystemName string, path string) (result DeleteResponse, err error) {
+	// The continuation token returned when a paginated Delete hasn't completed, which must be specified
+	// in a subsequent Delete to continue deleting the Directory
+	Continuation string
+}
 
+// Delete deletes a Data Lake Store Gen2 Path within a Storage Account File System
+func (c Client) Delete(ctx context.Context, fileSystemName string, path string, input DeleteInput) (result DeleteResponse, err error) {
 	if fileSystemName == "" {
 		return result, fmt.Errorf("`fileSystemName` cannot be an empty string")
 	}
 
+	if path == "" {
+		return result, fmt.Errorf("`path` cannot be an empty string")
+	}
+
+	if input.Paginated && (input.Recursive == nil || !*input.Recursive) {
+		return result, fmt.Errorf("`input.Paginated` can only be specified when `input.Recursive` is true")
+	}
+
+	if input.Continuation != nil && *input.Continuation == "" {
+		return result, fmt.Errorf("`input.Continuation` should either be specified or nil, not an empty string")
+	}
+
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
 			http.StatusOK,
 		},
-		HttpMethod:    http.MethodDelete,
-		OptionsObject: nil,
-		Path:          fmt.Sprintf("/%s/%s", fileSystemName, path),
+		HttpMethod: http.MethodDelete,
+		OptionsObject: deleteOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
 	}
 	req, err := c.Client.NewRequest(ctx, opts)
 	if err != nil {
@@ -38,11 +72,43 @@ func (c Client) Delete(ctx context.Context, fileSystemName string, path string)
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.Continuation = resp.Header.Get("x-ms-continuation")
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
+
+type deleteOptions struct {
+	input DeleteInput
+}
+
+func (d deleteOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (d deleteOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (d deleteOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	if d.input.Recursive != nil {
+		out.Append("recursive", strconv.FormatBool(*d.input.Recursive))
+	}
+	if d.input.Paginated {
+		out.Append("paginated", "true")
+	}
+	if d.input.Continuation != nil {
+		out.Append("continuation", *d.input.Continuation)
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+type DeleteRecursiveInput struct {
+	// Optional - A callback which is fired once each (paginated) Delete request has completed, with the number
+	// of requests completed so far. The service doesn't return the number of Paths deleted by each request.
+	Progress func(requestsCompleted int)
+}
+
+type DeleteRecursiveResponse struct {
+	// The HTTP Response for the final request which was made
+	HttpResponse *http.Response
+
+	// The number of Delete requests which were made to delete the Directory
+	RequestsCompleted int
+}
+
+// DeleteRecursive deletes a Data Lake Store Gen2 Directory (and all of its children) within a Storage Account
+// File System, using a paginated Delete and following the `x-ms-continuation` token until the Directory has been
+// deleted. The context is checked between each request, so that a cancelled deletion can be continued later.
+func (c Client) DeleteRecursive(ctx context.Context, fileSystemName string, path string, input DeleteRecursiveInput) (result DeleteRecursiveResponse, err error) {
+	deleteInput := DeleteInput{
+		Recursive: pointer.To(true),
+		Paginated: true,
+	}
+
+	for {
+		var resp DeleteResponse
+		resp, err = c.Delete(ctx, fileSystemName, path, deleteInput)
+		result.HttpResponse = resp.HttpResponse
+		if err != nil {
+			if result.RequestsCompleted > 0 {
+				err = fmt.Errorf("deleting %q after %d requests: %w", path, result.RequestsCompleted, err)
+			}
+			return
+		}
+
+		result.RequestsCompleted++
+		if input.Progress != nil {
+			input.Progress(result.RequestsCompleted)
+		}
+
+		if resp.Continuation == "" {
+			break
+		}
+
+		if err = ctx.Err(); err != nil {
+			err = fmt.Errorf("deleting %q after %d requests: %w", path, result.RequestsCompleted, err)
+			return
+		}
+		deleteInput.Continuation = pointer.To(resp.Continuation)
+	}
+
+	return
+}
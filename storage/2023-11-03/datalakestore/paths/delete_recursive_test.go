@@ -0,0 +1,129 @@
+package paths
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestDeleteRecursive(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	continuations := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Method != http.MethodDelete || r.URL.Path != "/filesystem/directory" || query.Get("recursive") != "true" || query.Get("paginated") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		continuation := query.Get("continuation")
+		continuations = append(continuations, continuation)
+		if len(continuations) < 3 {
+			w.Header().Set("x-ms-continuation", fmt.Sprintf("token%d", len(continuations)))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	pathsClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	progress := make([]int, 0)
+	result, err := pathsClient.DeleteRecursive(ctx, "filesystem", "directory", DeleteRecursiveInput{
+		Progress: func(requestsCompleted int) {
+			progress = append(progress, requestsCompleted)
+		},
+	})
+	if err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	if result.RequestsCompleted != 3 {
+		t.Fatalf("expected 3 requests to be completed but got %d", result.RequestsCompleted)
+	}
+
+	expected := []string{"", "token1", "token2"}
+	if len(continuations) != len(expected) {
+		t.Fatalf("expected the continuations %q but got %q", expected, continuations)
+	}
+	for i, v := range expected {
+		if continuations[i] != v {
+			t.Fatalf("expected continuation %d to be %q but got %q", i, v, continuations[i])
+		}
+		if progress[i] != i+1 {
+			t.Fatalf("expected progress %d to be %d but got %d", i, i+1, progress[i])
+		}
+	}
+}
+
+func TestDeleteRecursiveCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("x-ms-continuation", "token")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	pathsClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	_, err = pathsClient.DeleteRecursive(ctx, "filesystem", "directory", DeleteRecursiveInput{
+		Progress: func(requestsCompleted int) {
+			if requestsCompleted == 2 {
+				cancel()
+			}
+		},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the deletion to be cancelled but got: %+v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests to be made before the deletion was cancelled but got %d", requests)
+	}
+}
+
+func TestDeleteValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input DeleteInput
+	}{
+		{
+			Name: "Paginated without Recursive",
+			Input: DeleteInput{
+				Paginated: true,
+			},
+		},
+		{
+			Name: "Empty Continuation",
+			Input: DeleteInput{
+				Continuation: pointer.To(""),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).Delete(ctx, "filesystem", "directory", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
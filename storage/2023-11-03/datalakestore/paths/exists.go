@@ -0,0 +1,66 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ExistsResponse struct {
+	HttpResponse *http.Response
+
+	// Whether the Path exists
+	Exists bool
+}
+
+// Exists determines whether the specified Path exists, returning `false` rather than an error
+// when the Path is not found
+func (c Client) Exists(ctx context.Context, fileSystemName string, path string) (result ExistsResponse, err error) {
+	if fileSystemName == "" {
+		err = fmt.Errorf("`fileSystemName` cannot be an empty string")
+		return
+	}
+	if path == "" {
+		err = fmt.Errorf("`path` cannot be an empty string")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodHead,
+		OptionsObject: getPropertyOptions{
+			action: GetPropertiesActionGetStatus,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if resp.StatusCode == http.StatusNotFound {
+			err = nil
+			return
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	result.Exists = true
+	return
+}
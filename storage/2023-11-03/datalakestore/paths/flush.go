@@ -0,0 +1,165 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type FlushInput struct {
+	// The length of the File once all of the previously appended data has been flushed,
+	// this must be equal to the position plus the length of the data appended so far.
+	Position int64
+
+	// Optional - Should the File be closed? This raises a change notification signalling
+	// that this is the final flush for the File.
+	Close bool
+
+	// Optional - Should any uncommitted data beyond the Position be retained?
+	// By default uncommitted data is deleted once the flush has completed.
+	RetainUncommittedData bool
+
+	// Optional - The MIME content type of the File
+	ContentType *string
+
+	// Optional - The content encoding of the File
+	ContentEncoding *string
+
+	// Optional - The content language of the File
+	ContentLanguage *string
+
+	// Optional - The content disposition of the File
+	ContentDisposition *string
+
+	// Optional - The cache control value of the File
+	CacheControl *string
+
+	// Optional - The base64-encoded MD5 hash of the complete File, which is stored with the File
+	ContentMD5 *string
+
+	// Optional - The Lease ID of the File, which must be specified when the File has an active lease
+	LeaseID *string
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
+}
+
+type FlushResponse struct {
+	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
+}
+
+// Flush commits the previously appended data to a File within a Data Lake Store Gen2 File System
+func (c Client) Flush(ctx context.Context, fileSystemName string, path string, input FlushInput) (result FlushResponse, err error) {
+
+	if fileSystemName == "" {
+		return result, fmt.Errorf("`fileSystemName` cannot be an empty string")
+	}
+
+	if path == "" {
+		return result, fmt.Errorf("`path` cannot be an empty string")
+	}
+
+	if input.Position < 0 {
+		return result, fmt.Errorf("`input.Position` must be greater or equal to 0")
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/octet-stream",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPatch,
+		OptionsObject: flushOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			result.ETag = resp.Header.Get("ETag")
+			if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type flushOptions struct {
+	input FlushInput
+}
+
+func (f flushOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("Content-Length", "0")
+
+	if f.input.ContentType != nil {
+		headers.Append("x-ms-content-type", *f.input.ContentType)
+	}
+	if f.input.ContentEncoding != nil {
+		headers.Append("x-ms-content-encoding", *f.input.ContentEncoding)
+	}
+	if f.input.ContentLanguage != nil {
+		headers.Append("x-ms-content-language", *f.input.ContentLanguage)
+	}
+	if f.input.ContentDisposition != nil {
+		headers.Append("x-ms-content-disposition", *f.input.ContentDisposition)
+	}
+	if f.input.CacheControl != nil {
+		headers.Append("x-ms-cache-control", *f.input.CacheControl)
+	}
+	if f.input.ContentMD5 != nil {
+		headers.Append("x-ms-content-md5", *f.input.ContentMD5)
+	}
+	if f.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *f.input.LeaseID)
+	}
+
+	headers.Merge(accessconditions.SetIntoHeaders(f.input.AccessConditions))
+
+	return headers
+}
+
+func (f flushOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (f flushOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("action", "flush")
+	out.Append("position", strconv.FormatInt(f.input.Position, 10))
+	if f.input.Close {
+		out.Append("close", "true")
+	}
+	if f.input.RetainUncommittedData {
+		out.Append("retainUncommittedData", "true")
+	}
+	return out
+}
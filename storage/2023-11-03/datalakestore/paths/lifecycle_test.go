@@ -3,15 +3,20 @@ package paths
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/jackofallops/giovanni/storage/2020-08-04/datalakestore/filesystems"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
 	"github.com/jackofallops/giovanni/storage/internal/testhelpers"
 )
 
+var _ StoragePath = Client{}
+
 func TestLifecycle(t *testing.T) {
 	const defaultACLString = "user::rwx,group::r-x,other::---"
 
@@ -85,8 +90,12 @@ func TestLifecycle(t *testing.T) {
 	}
 
 	newACL := "user::rwx,group::r-x,other::r-x,default:user::rwx,default:group::r-x,default:other::---"
+	parsedACL, err := accesscontrol.ParseACL(newACL)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error parsing ACL: %s", err))
+	}
 	accessControlInput := SetAccessControlInput{
-		ACL: &newACL,
+		ACL: &parsedACL,
 	}
 	t.Logf("[DEBUG] Setting Access Control for folder 'test' ..")
 	if _, err = pathsClient.SetAccessControl(ctx, fileSystemName, path, accessControlInput); err != nil {
@@ -102,8 +111,79 @@ func TestLifecycle(t *testing.T) {
 		t.Fatal(fmt.Errorf("expected new ACL %q, got %q", newACL, props.ACL))
 	}
 
+	t.Logf("[DEBUG] Getting Access Control for folder 'test' ..")
+	accessControl, err := pathsClient.GetAccessControl(ctx, fileSystemName, path)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error getting Access Control: %s", err))
+	}
+	if accessControl.ACL.String() != newACL {
+		t.Fatal(fmt.Errorf("expected parsed ACL %q, got %q", newACL, accessControl.ACL.String()))
+	}
+	if accessControl.Permissions != "rwxr-xr-x" {
+		t.Fatal(fmt.Errorf("expected permissions %q, got %q", "rwxr-xr-x", accessControl.Permissions))
+	}
+
+	t.Logf("[DEBUG] Creating file 'test/source' ..")
+	if _, err = pathsClient.Create(ctx, fileSystemName, "test/source", CreateInput{Resource: PathResourceFile}); err != nil {
+		t.Fatal(fmt.Errorf("error creating file: %s", err))
+	}
+
+	t.Logf("[DEBUG] Renaming file 'test/source' to 'test/destination' ..")
+	renameInput := RenameInput{
+		Source:              fmt.Sprintf("/%s/test/source", fileSystemName),
+		PreserveDestination: true,
+	}
+	if _, err = pathsClient.Rename(ctx, fileSystemName, "test/destination", renameInput); err != nil {
+		t.Fatal(fmt.Errorf("error renaming file: %s", err))
+	}
+
+	t.Logf("[DEBUG] Getting properties for file 'test/destination' ..")
+	if _, err = pathsClient.GetProperties(ctx, fileSystemName, "test/destination", GetPropertiesInput{Action: GetPropertiesActionGetStatus}); err != nil {
+		t.Fatal(fmt.Errorf("error getting properties for renamed file: %s", err))
+	}
+
+	t.Logf("[DEBUG] Checking file 'test/source' no longer exists ..")
+	exists, err := pathsClient.Exists(ctx, fileSystemName, "test/source")
+	if err != nil {
+		t.Fatal(fmt.Errorf("error checking if the source exists: %s", err))
+	}
+	if exists.Exists {
+		t.Fatal(fmt.Errorf("expected the source not to exist after renaming"))
+	}
+
+	t.Logf("[DEBUG] Uploading file 'test/uploaded' ..")
+	contents := strings.Repeat("abcdefghij", 1024)
+	uploadInput := UploadFromReaderInput{
+		ChunkSize:   4096,
+		ContentType: pointer.To("text/plain"),
+	}
+	if _, err = pathsClient.UploadFromReader(ctx, fileSystemName, "test/uploaded", strings.NewReader(contents), uploadInput); err != nil {
+		t.Fatal(fmt.Errorf("error uploading file: %s", err))
+	}
+
+	t.Logf("[DEBUG] Setting Access Control recursively for folder 'test' ..")
+	recursiveInput := SetAccessControlRecursiveInput{
+		Mode: SetAccessControlRecursiveModeModify,
+		ACL: accesscontrol.ACL{
+			Entries: []accesscontrol.ACE{
+				{TagType: accesscontrol.TagTypeOther, Permissions: "r--"},
+			},
+		},
+	}
+	recursiveResult, err := pathsClient.SetAccessControlRecursive(ctx, fileSystemName, path, recursiveInput)
+	if err != nil {
+		t.Fatal(fmt.Errorf("error setting Access Control recursively: %s", err))
+	}
+	if recursiveResult.FailureCount != 0 {
+		t.Fatal(fmt.Errorf("expected no failures setting Access Control recursively but got %d", recursiveResult.FailureCount))
+	}
+	if recursiveResult.FilesSuccessful != 2 {
+		t.Fatal(fmt.Errorf("expected 2 files to be updated but got %d", recursiveResult.FilesSuccessful))
+	}
+
 	t.Logf("[DEBUG] Deleting path 'test' ..")
-	if _, err = pathsClient.Delete(ctx, fileSystemName, path); err != nil {
+	recursive := true
+	if _, err = pathsClient.Delete(ctx, fileSystemName, path, DeleteInput{Recursive: &recursive}); err != nil {
 		t.Fatal(fmt.Errorf("error deleting path: %s", err))
 	}
 
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetPropertiesResponse struct {
@@ -15,15 +18,22 @@ type GetPropertiesResponse struct {
 
 	ETag         string
 	LastModified time.Time
-	// ResourceType is only returned for GetPropertiesActionGetStatus requests
+	// ResourceType is returned unless the Action is GetPropertiesActionGetAccessControl
 	ResourceType PathResource
 	Owner        string
 	Group        string
+	// The POSIX access permissions for the Owner, owning Group and others, for example `rwxr-x---`
+	Permissions string
 	// ACL is only returned for GetPropertiesActionGetAccessControl requests
 	ACL string
+
+	// The size of the File in bytes, which is only returned when no Action is specified
+	ContentLength int64
 }
 
 type GetPropertiesInput struct {
+	// Optional - The subset of properties which should be returned, when not specified all of the
+	// system properties (including the size of the File) are returned
 	Action GetPropertiesAction
 }
 
@@ -34,6 +44,13 @@ const (
 	GetPropertiesActionGetAccessControl GetPropertiesAction = "getAccessControl"
 )
 
+func PossibleValuesForGetPropertiesAction() []string {
+	return []string{
+		string(GetPropertiesActionGetAccessControl),
+		string(GetPropertiesActionGetStatus),
+	}
+}
+
 // GetProperties gets the properties for a Data Lake Store Gen2 Path in a FileSystem within a Storage Account
 func (c Client) GetProperties(ctx context.Context, fileSystemName string, path string, input GetPropertiesInput) (result GetPropertiesResponse, err error) {
 	if fileSystemName == "" {
@@ -41,6 +58,11 @@ func (c Client) GetProperties(ctx context.Context, fileSystemName string, path s
 		return
 	}
 
+	if input.Action != "" && input.Action != GetPropertiesActionGetStatus && input.Action != GetPropertiesActionGetAccessControl {
+		err = fmt.Errorf("`input.Action` must be one of %s but got %q", strings.Join(PossibleValuesForGetPropertiesAction(), ", "), input.Action)
+		return
+	}
+
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
@@ -80,18 +102,36 @@ func (c Client) GetProperties(ctx context.Context, fileSystemName string, path s
 
 				result.Owner = resp.Header.Get("x-ms-owner")
 				result.Group = resp.Header.Get("x-ms-group")
+				result.Permissions = resp.Header.Get("x-ms-permissions")
 				result.ACL = resp.Header.Get("x-ms-acl")
+
+				if v := resp.Header.Get("Content-Length"); v != "" && input.Action == "" {
+					i, innerErr := strconv.ParseInt(v, 10, 64)
+					if innerErr != nil {
+						err = fmt.Errorf("parsing `Content-Length` header value %q: %+v", v, innerErr)
+						return
+					}
+					result.ContentLength = i
+				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return result, err
 	}
 
 	return
 }
 
+// GetStatus gets the system properties for a Data Lake Store Gen2 Path, including whether the Path is a File
+// or a Directory, without the Access Control List
+func (c Client) GetStatus(ctx context.Context, fileSystemName string, path string) (GetPropertiesResponse, error) {
+	return c.GetProperties(ctx, fileSystemName, path, GetPropertiesInput{
+		Action: GetPropertiesActionGetStatus,
+	})
+}
+
 type getPropertyOptions struct {
 	action GetPropertiesAction
 }
@@ -106,6 +146,8 @@ func (g getPropertyOptions) ToOData() *odata.Query {
 
 func (g getPropertyOptions) ToQuery() *client.QueryParams {
 	out := &client.QueryParams{}
-	out.Append("action", string(g.action))
+	if g.action != "" {
+		out.Append("action", string(g.action))
+	}
 	return out
 }
@@ -4,15 +4,29 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetAccessControlInput struct {
+	// Optional - The Owner of the Path
 	Owner *string
+
+	// Optional - The owning Group of the Path
 	Group *string
-	ACL   *string
+
+	// Optional - The POSIX Access Control List which should be assigned to the Path.
+	// This cannot be specified alongside Permissions.
+	ACL *accesscontrol.ACL
+
+	// Optional - The POSIX access permissions for the Owner, owning Group and others, for example `rwxr-x---`.
+	// This cannot be specified alongside ACL.
+	Permissions *string
 
 	// Optional - A date and time value.
 	// Specify this header to perform the operation only if the resource has been modified since the specified date and time.
@@ -25,6 +39,9 @@ type SetAccessControlInput struct {
 
 type SetPropertiesResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // SetAccessControl sets the access control properties for a Data Lake Store Gen2 Path within a Storage Account File System
@@ -34,6 +51,18 @@ func (c Client) SetAccessControl(ctx context.Context, fileSystemName string, pat
 		return
 	}
 
+	if input.ACL != nil && input.Permissions != nil {
+		err = fmt.Errorf("at most one of `input.ACL` and `input.Permissions` can be specified")
+		return
+	}
+
+	if input.ACL != nil {
+		if err = input.ACL.Validate(); err != nil {
+			err = fmt.Errorf("validating `input.ACL`: %+v", err)
+			return
+		}
+	}
+
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
@@ -57,9 +86,18 @@ func (c Client) SetAccessControl(ctx context.Context, fileSystemName string, pat
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return result, err
 	}
 
@@ -74,7 +112,11 @@ func (s setPropertyOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
 
 	if s.input.ACL != nil {
-		headers.Append("x-ms-acl", *s.input.ACL)
+		headers.Append("x-ms-acl", s.input.ACL.String())
+	}
+
+	if s.input.Permissions != nil {
+		headers.Append("x-ms-permissions", *s.input.Permissions)
 	}
 
 	if s.input.Owner != nil {
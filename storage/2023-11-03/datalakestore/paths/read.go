@@ -0,0 +1,154 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/accessconditions"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ReadInput struct {
+	// The (inclusive) range of bytes which should be read, both must be specified or neither
+	StartByte *int64
+	EndByte   *int64
+
+	// Optional - The Lease ID of the File, which must be specified when the File has an active lease
+	LeaseID *string
+
+	// Optional - The conditions which must be met for the operation to be performed
+	accessconditions.AccessConditions
+}
+
+type ReadResponse struct {
+	HttpResponse *http.Response
+
+	// Body streams the contents of the File (or the requested range), this must be closed by the caller.
+	Body io.ReadCloser
+
+	// The number of bytes present in the response body
+	ContentLength int64
+
+	// The range of bytes returned, for example `bytes 0-1023/2048`, when a range was requested
+	ContentRange string
+
+	// The content type specified for the File
+	ContentType string
+
+	ETag         string
+	LastModified string
+
+	// The type of the Path, either a File or a Directory
+	ResourceType PathResource
+}
+
+// Read reads the contents of a File (or a range of bytes from within it) within a Data Lake Store Gen2 File System,
+// without buffering the response in memory - the returned Body must be closed by the caller.
+func (c Client) Read(ctx context.Context, fileSystemName string, path string, input ReadInput) (result ReadResponse, err error) {
+	if fileSystemName == "" {
+		return result, fmt.Errorf("`fileSystemName` cannot be an empty string")
+	}
+
+	if path == "" {
+		return result, fmt.Errorf("`path` cannot be an empty string")
+	}
+
+	if (input.StartByte != nil && input.EndByte == nil) || (input.StartByte == nil && input.EndByte != nil) {
+		return result, fmt.Errorf("`input.StartByte` and `input.EndByte` must both be specified, or both be nil")
+	}
+
+	if input.StartByte != nil && input.EndByte != nil {
+		if *input.StartByte < 0 || *input.EndByte < *input.StartByte {
+			return result, fmt.Errorf("`input.EndByte` must be greater than or equal to `input.StartByte`, which must be greater than or equal to 0")
+		}
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		return result, fmt.Errorf("`input.LeaseID` should either be specified or nil, not an empty string")
+	}
+
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+			http.StatusPartialContent,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: readOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			// the response body is intentionally not read here, instead it's handed to the caller to stream
+			result.Body = resp.Body
+
+			if resp.Header != nil {
+				result.ContentRange = resp.Header.Get("Content-Range")
+				result.ContentType = resp.Header.Get("Content-Type")
+				result.ETag = resp.Header.Get("ETag")
+				result.LastModified = resp.Header.Get("Last-Modified")
+				result.ResourceType = PathResource(resp.Header.Get("x-ms-resource-type"))
+
+				if v := resp.Header.Get("Content-Length"); v != "" {
+					i, innerErr := strconv.ParseInt(v, 10, 64)
+					if innerErr != nil {
+						resp.Body.Close()
+						result.Body = nil
+						err = fmt.Errorf("parsing `Content-Length` header value %q: %+v", v, innerErr)
+						return
+					}
+					result.ContentLength = i
+				}
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type readOptions struct {
+	input ReadInput
+}
+
+func (r readOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if r.input.StartByte != nil && r.input.EndByte != nil {
+		headers.Append("Range", fmt.Sprintf("bytes=%d-%d", *r.input.StartByte, *r.input.EndByte))
+	}
+	if r.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *r.input.LeaseID)
+	}
+
+	headers.Merge(accessconditions.SetIntoHeaders(r.input.AccessConditions))
+
+	return headers
+}
+
+func (r readOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (r readOptions) ToQuery() *client.QueryParams {
+	return nil
+}
@@ -0,0 +1,164 @@
+package paths
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestRead(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/filesystem/file.txt" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if v := r.Header.Get("Range"); v != "bytes=6-10" {
+			t.Errorf("expected the `Range` header to be %q but got %q", "bytes=6-10", v)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Range", "bytes 6-10/11")
+		w.Header().Set("ETag", "0x1")
+		w.Header().Set("x-ms-resource-type", "file")
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write([]byte("world"))
+	}))
+	defer server.Close()
+
+	pathsClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := pathsClient.Read(ctx, "filesystem", "file.txt", ReadInput{
+		StartByte: pointer.To(int64(6)),
+		EndByte:   pointer.To(int64(10)),
+	})
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	defer result.Body.Close()
+
+	contents, err := io.ReadAll(result.Body)
+	if err != nil {
+		t.Fatalf("reading body: %+v", err)
+	}
+	if string(contents) != "world" {
+		t.Fatalf("expected the contents to be %q but got %q", "world", string(contents))
+	}
+	if result.ContentLength != 5 {
+		t.Fatalf("expected the ContentLength to be 5 but got %d", result.ContentLength)
+	}
+	if result.ContentRange != "bytes 6-10/11" {
+		t.Fatalf("expected the ContentRange to be %q but got %q", "bytes 6-10/11", result.ContentRange)
+	}
+	if result.ResourceType != PathResourceFile {
+		t.Fatalf("expected the ResourceType to be %q but got %q", PathResourceFile, result.ResourceType)
+	}
+}
+
+func TestReadValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input ReadInput
+	}{
+		{
+			Name: "Only StartByte",
+			Input: ReadInput{
+				StartByte: pointer.To(int64(0)),
+			},
+		},
+		{
+			Name: "EndByte before StartByte",
+			Input: ReadInput{
+				StartByte: pointer.To(int64(10)),
+				EndByte:   pointer.To(int64(5)),
+			},
+		},
+		{
+			Name: "Empty LeaseID",
+			Input: ReadInput{
+				LeaseID: pointer.To(""),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).Read(ctx, "filesystem", "file.txt", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestGetProperties(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch action := r.URL.Query().Get("action"); action {
+		case "":
+			w.Header().Set("Content-Length", "11")
+			w.Header().Set("x-ms-permissions", "rwxr-x---")
+		case "getStatus":
+		default:
+			t.Errorf("unexpected action %q", action)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("ETag", "0x1")
+		w.Header().Set("x-ms-owner", "$superuser")
+		w.Header().Set("x-ms-resource-type", "file")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	pathsClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	props, err := pathsClient.GetProperties(ctx, "filesystem", "file.txt", GetPropertiesInput{})
+	if err != nil {
+		t.Fatalf("getting properties: %+v", err)
+	}
+	if props.ContentLength != 11 {
+		t.Fatalf("expected the ContentLength to be 11 but got %d", props.ContentLength)
+	}
+	if props.Permissions != "rwxr-x---" {
+		t.Fatalf("expected the Permissions to be %q but got %q", "rwxr-x---", props.Permissions)
+	}
+	if props.Owner != "$superuser" || props.ETag != "0x1" {
+		t.Fatalf("unexpected properties: %+v", props)
+	}
+
+	status, err := pathsClient.GetStatus(ctx, "filesystem", "file.txt")
+	if err != nil {
+		t.Fatalf("getting status: %+v", err)
+	}
+	if status.ResourceType != PathResourceFile {
+		t.Fatalf("expected the ResourceType to be %q but got %q", PathResourceFile, status.ResourceType)
+	}
+
+	if _, err := pathsClient.GetProperties(ctx, "filesystem", "file.txt", GetPropertiesInput{Action: "getEverything"}); err == nil {
+		t.Fatalf("expected an error for an unknown action but didn't get one")
+	}
+}
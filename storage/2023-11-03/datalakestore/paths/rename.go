@@ -0,0 +1,132 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type RenameInput struct {
+	// The Path which should be renamed, in the format `/{fileSystemName}/{path}`.
+	// The source must be within the same File System as the destination.
+	Source string
+
+	// Optional - Should the rename fail if the destination Path already exists?
+	// When true the `If-None-Match: *` header is sent, otherwise an existing destination is overwritten.
+	PreserveDestination bool
+
+	// Optional - The Lease ID of the Source Path, which must be specified when the Source has an active lease
+	SourceLeaseID *string
+
+	// Optional - The Lease ID of the destination Path, which must be specified when the destination has an active lease
+	LeaseID *string
+}
+
+type RenameResponse struct {
+	HttpResponse *http.Response
+}
+
+// Rename renames (moves) the Source Path to the specified Path, within a Data Lake Store Gen2 File System
+func (c Client) Rename(ctx context.Context, fileSystemName string, path string, input RenameInput) (result RenameResponse, err error) {
+
+	if fileSystemName == "" {
+		return result, fmt.Errorf("`fileSystemName` cannot be an empty string")
+	}
+
+	if path == "" {
+		return result, fmt.Errorf("`path` cannot be an empty string")
+	}
+
+	if err = validateRenameSource(fileSystemName, input.Source); err != nil {
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: renameOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+// validateRenameSource ensures the source is in the format `/{fileSystemName}/{path}` and that it's
+// within the same File System as the destination, since a Path can't be renamed across File Systems
+func validateRenameSource(fileSystemName, source string) error {
+	if source == "" {
+		return fmt.Errorf("`input.Source` cannot be an empty string")
+	}
+
+	segments := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return fmt.Errorf("`input.Source` must be in the format `/{fileSystemName}/{path}` but got %q", source)
+	}
+
+	if segments[0] != fileSystemName {
+		return fmt.Errorf("`input.Source` must be within the File System %q but was within the File System %q", fileSystemName, segments[0])
+	}
+
+	return nil
+}
+
+type renameOptions struct {
+	input RenameInput
+}
+
+func (r renameOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+
+	// the source needs to be URL-encoded, and always starts with a slash
+	source := (&url.URL{Path: fmt.Sprintf("/%s", strings.TrimPrefix(r.input.Source, "/"))}).EscapedPath()
+	headers.Append("x-ms-rename-source", source)
+
+	if r.input.PreserveDestination {
+		headers.Append("If-None-Match", "*")
+	}
+
+	if r.input.SourceLeaseID != nil {
+		headers.Append("x-ms-source-lease-id", *r.input.SourceLeaseID)
+	}
+
+	if r.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *r.input.LeaseID)
+	}
+
+	return headers
+}
+
+func (r renameOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (r renameOptions) ToQuery() *client.QueryParams {
+	return nil
+}
@@ -0,0 +1,74 @@
+package paths
+
+import (
+	"testing"
+)
+
+func TestValidateRenameSource(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         string
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Empty",
+			Input:         "",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "File System Only",
+			Input:         "/myfilesystem",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "File System With Trailing Slash",
+			Input:         "/myfilesystem/",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Different File System",
+			Input:         "/otherfilesystem/source",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Same File System",
+			Input:         "/myfilesystem/source",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Same File System Without Leading Slash",
+			Input:         "myfilesystem/source",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Nested Path",
+			Input:         "/myfilesystem/some/nested/source",
+			ShouldBeValid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateRenameSource("myfilesystem", v.Input)
+		isValid := err == nil
+		if v.ShouldBeValid != isValid {
+			t.Fatalf("Expected %t but got %t (%+v)", v.ShouldBeValid, isValid, err)
+		}
+	}
+}
+
+func TestRenameOptionsHeaders(t *testing.T) {
+	headers := renameOptions{input: RenameInput{Source: "myfilesystem/some dir/source"}}.ToHeaders().Headers()
+	if v := headers.Get("x-ms-rename-source"); v != "/myfilesystem/some%20dir/source" {
+		t.Fatalf("expected `x-ms-rename-source` to be %q but got %q", "/myfilesystem/some%20dir/source", v)
+	}
+	if v := headers.Get("If-None-Match"); v != "" {
+		t.Fatalf("expected `If-None-Match` to be omitted but got %q", v)
+	}
+
+	headers = renameOptions{input: RenameInput{Source: "/myfilesystem/source", PreserveDestination: true}}.ToHeaders().Headers()
+	if v := headers.Get("If-None-Match"); v != "*" {
+		t.Fatalf("expected `If-None-Match` to be %q but got %q", "*", v)
+	}
+}
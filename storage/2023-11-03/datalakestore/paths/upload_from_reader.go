@@ -0,0 +1,124 @@
+package paths
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/jackofallops/giovanni/storage/internal/progress"
+	"github.com/jackofallops/giovanni/storage/transfermanager"
+)
+
+// defaultUploadChunkSize is the number of bytes appended per request when no ChunkSize is specified
+const defaultUploadChunkSize = 4 * 1024 * 1024
+
+type UploadFromReaderInput struct {
+	// Optional - The number of bytes which should be appended in each request, defaults to 4MB
+	// and can be at most 4000MB.
+	ChunkSize int
+
+	// Optional - The MIME content type of the File
+	ContentType *string
+
+	// Optional - The content encoding of the File
+	ContentEncoding *string
+
+	// Optional - The content language of the File
+	ContentLanguage *string
+
+	// Optional - The content disposition of the File
+	ContentDisposition *string
+
+	// Optional - The cache control value of the File
+	CacheControl *string
+
+	// Optional - A TransferManager shared with other uploads and downloads, which limits the number of chunks
+	// appended at once (and the bandwidth used) across all of them
+	TransferManager *transfermanager.TransferManager
+
+	// Optional - Should the CRC64 checksum of each chunk be computed and sent to the service, so that
+	// any chunk which was corrupted during transport is rejected?
+	ComputeContentCRC64 bool
+
+	// Optional - A callback which is fired as the contents of the reader are uploaded, with the cumulative
+	// number of bytes read. Since the length of the reader isn't known in advance the total number of
+	// bytes is reported as -1, until the final callback which is fired once the File has been flushed.
+	Progress func(bytesTransferred, totalBytes int64)
+}
+
+// UploadFromReader is a helper method which creates (or overwrites) the specified File, then reads the
+// contents of `reader` in chunks, appending each chunk sequentially before flushing and closing the File
+func (c Client) UploadFromReader(ctx context.Context, fileSystemName string, path string, reader io.Reader, input UploadFromReaderInput) (result FlushResponse, err error) {
+	if reader == nil {
+		return result, fmt.Errorf("`reader` cannot be nil")
+	}
+
+	chunkSize := input.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultUploadChunkSize
+	}
+	if chunkSize < 0 || int64(chunkSize) > maxAppendSize {
+		return result, fmt.Errorf("`input.ChunkSize` must be between 1 and %d but got %d", maxAppendSize, input.ChunkSize)
+	}
+
+	if _, err = c.Create(ctx, fileSystemName, path, CreateInput{Resource: PathResourceFile}); err != nil {
+		return result, fmt.Errorf("creating file: %+v", err)
+	}
+
+	tracker := progress.NewTracker(input.Progress, -1)
+	reader = progress.NewReader(reader, tracker)
+
+	position := int64(0)
+	buffer := make([]byte, chunkSize)
+	for {
+		n, readErr := io.ReadFull(reader, buffer)
+		if readErr != nil && !errors.Is(readErr, io.EOF) && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return result, fmt.Errorf("reading chunk at position %d: %+v", position, readErr)
+		}
+
+		if n > 0 {
+			log.Printf("[DEBUG] Appending %d bytes at position %d", n, position)
+			appendInput := AppendInput{
+				Position:            position,
+				Content:             buffer[:n],
+				ComputeContentCRC64: input.ComputeContentCRC64,
+			}
+			var appendResult AppendResponse
+			err = input.TransferManager.Do(ctx, int64(n), func(ctx context.Context) (err error) {
+				appendResult, err = c.Append(ctx, fileSystemName, path, appendInput)
+				return err
+			})
+			if err != nil {
+				return result, fmt.Errorf("appending chunk at position %d: %+v", position, err)
+			}
+			if appendResult.ContentCRC64 != "" {
+				log.Printf("[DEBUG] Appended chunk at position %d with CRC64 %q", position, appendResult.ContentCRC64)
+			}
+			position += int64(n)
+		}
+
+		// a short read means the reader has been exhausted
+		if readErr != nil {
+			break
+		}
+	}
+
+	flushInput := FlushInput{
+		Position:           position,
+		Close:              true,
+		ContentType:        input.ContentType,
+		ContentEncoding:    input.ContentEncoding,
+		ContentLanguage:    input.ContentLanguage,
+		ContentDisposition: input.ContentDisposition,
+		CacheControl:       input.CacheControl,
+	}
+	result, err = c.Flush(ctx, fileSystemName, path, flushInput)
+	if err != nil {
+		return result, fmt.Errorf("flushing file: %+v", err)
+	}
+	tracker.Complete()
+
+	return
+}
@@ -8,6 +8,12 @@ type StorageDirectory interface {
 	Delete(ctx context.Context, shareName, path string) (resp DeleteResponse, err error)
 	GetMetaData(ctx context.Context, shareName, path string) (resp GetMetaDataResponse, err error)
 	SetMetaData(ctx context.Context, shareName, path string, input SetMetaDataInput) (resp SetMetaDataResponse, err error)
+	SetProperties(ctx context.Context, shareName, path string, input SetPropertiesInput) (resp SetPropertiesResponse, err error)
 	Create(ctx context.Context, shareName, path string, input CreateDirectoryInput) (resp CreateDirectoryResponse, err error)
+	CreateRecursive(ctx context.Context, shareName, path string, input CreateDirectoryInput) (resp CreateDirectoryResponse, err error)
 	Get(ctx context.Context, shareName, path string) (resp GetResponse, err error)
+	GetProperties(ctx context.Context, shareName, path string) (resp GetResponse, err error)
+	List(ctx context.Context, shareName, path string, input ListInput) (resp ListResponse, err error)
+	ListComplete(ctx context.Context, shareName, path string, input ListInput) (resp ListCompleteResult, err error)
+	NewListIterator(shareName, path string, input ListInput) *ListIterator
 }
@@ -2,14 +2,18 @@ package directories
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/nfsproperties"
+	"github.com/jackofallops/giovanni/storage/internal/smbproperties"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type CreateDirectoryInput struct {
@@ -25,6 +29,25 @@ type CreateDirectoryInput struct {
 
 	// MetaData is a mapping of key value pairs which should be assigned to this directory
 	MetaData map[string]string
+
+	// An optional SDDL permission which should be assigned to this directory, when neither this nor
+	// FilePermissionKey are specified the permission is inherited from the parent directory.
+	// This maps to the `x-ms-file-permission` field and cannot be specified alongside FilePermissionKey.
+	FilePermission *string
+
+	// An optional key for a permission created using the Share's CreatePermission method, which must
+	// be used for permissions larger than 8KiB. This maps to the `x-ms-file-permission-key` field
+	// and cannot be specified alongside FilePermission.
+	FilePermissionKey *string
+
+	// An optional pipe-separated list of attributes which should be assigned to this directory, e.g. `ReadOnly|Hidden`
+	// If omitted, this'll be set to `None`. This maps to the `x-ms-file-attributes` field.
+	FileAttributes *string
+
+	// Optional - The POSIX properties which should be assigned to this directory within an NFS Share. When specified
+	// the SMB-only headers (`x-ms-file-permission`, `x-ms-file-permission-key` and `x-ms-file-attributes`) aren't
+	// sent, and so FilePermission, FilePermissionKey and FileAttributes cannot be specified.
+	NFSProperties *NFSProperties
 }
 
 type CreateDirectoryResponse struct {
@@ -32,6 +55,7 @@ type CreateDirectoryResponse struct {
 }
 
 // Create creates a new directory under the specified share or parent directory.
+// A ShareNotFoundError is returned if the Share doesn't exist.
 func (c Client) Create(ctx context.Context, shareName, path string, input CreateDirectoryInput) (result CreateDirectoryResponse, err error) {
 
 	if shareName == "" {
@@ -39,8 +63,8 @@ func (c Client) Create(ctx context.Context, shareName, path string, input Create
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -54,6 +78,22 @@ func (c Client) Create(ctx context.Context, shareName, path string, input Create
 		return
 	}
 
+	if err = input.smbProperties().Validate(smbproperties.CreateDefaults); err != nil {
+		err = fmt.Errorf("`input` is not valid: %s", err)
+		return
+	}
+
+	if input.NFSProperties != nil {
+		if err = input.smbProperties().ValidateForNFS(); err != nil {
+			err = fmt.Errorf("`input` is not valid: %s", err)
+			return
+		}
+		if err = input.nfsProperties().Validate(); err != nil {
+			err = fmt.Errorf("`input.NFSProperties` is not valid: %s", err)
+			return
+		}
+	}
+
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
@@ -76,15 +116,44 @@ func (c Client) Create(ctx context.Context, shareName, path string, input Create
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err != nil && resp.StatusCode == http.StatusNotFound && resp.Header.Get("x-ms-error-code") == "ShareNotFound" {
+			shareNotFoundErr := ShareNotFoundError{
+				ShareName: shareName,
+			}
+			errors.As(responseerror.New(resp, err), &shareNotFoundErr.ResponseError)
+			err = fmt.Errorf("executing request: %w", shareNotFoundErr)
+			return
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
+func (i CreateDirectoryInput) smbProperties() smbproperties.Properties {
+	return smbproperties.Properties{
+		FilePermission:    i.FilePermission,
+		FilePermissionKey: i.FilePermissionKey,
+		FileAttributes:    i.FileAttributes,
+		CreationTime:      i.CreatedAt,
+		LastWriteTime:     i.LastModified,
+	}
+}
+
+func (i CreateDirectoryInput) nfsProperties() nfsproperties.Properties {
+	return nfsproperties.Properties{
+		Owner:         i.NFSProperties.Owner,
+		Group:         i.NFSProperties.Group,
+		FileMode:      i.NFSProperties.FileMode,
+		CreationTime:  i.CreatedAt,
+		LastWriteTime: i.LastModified,
+	}
+}
+
 type CreateOptions struct {
 	input CreateDirectoryInput
 }
@@ -96,18 +165,12 @@ func (c CreateOptions) ToHeaders() *client.Headers {
 		headers.Merge(metadata.SetMetaDataHeaders(c.input.MetaData))
 	}
 
-	var coalesceDate = func(input *time.Time, defaultVal string) string {
-		if input == nil {
-			return defaultVal
-		}
-		return input.Format(time.RFC1123)
-	}
-
 	// ... Yes I know these say File not Directory, I didn't design the API.
-	headers.Append("x-ms-file-permission", "inherit") // TODO: expose this in future
-	headers.Append("x-ms-file-attributes", "None")    // TODO: expose this in future
-	headers.Append("x-ms-file-creation-time", coalesceDate(c.input.CreatedAt, "now"))
-	headers.Append("x-ms-file-last-write-time", coalesceDate(c.input.LastModified, "now"))
+	if c.input.NFSProperties != nil {
+		headers.Merge(c.input.nfsProperties().Headers())
+	} else {
+		headers.Merge(c.input.smbProperties().Headers(smbproperties.CreateDefaults))
+	}
 
 	return headers
 }
@@ -0,0 +1,71 @@
+package directories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+// CreateRecursive creates the specified directory, first creating any parent directories which don't exist.
+// The input is only used for the specified directory, any parent directories which are created use the default
+// properties (and inherit their permission from their parent). A directory which already exists is treated as
+// having been created, including the specified directory - in which case its properties are left unchanged.
+// A ShareNotFoundError is returned if the Share doesn't exist.
+func (c Client) CreateRecursive(ctx context.Context, shareName, path string, input CreateDirectoryInput) (result CreateDirectoryResponse, err error) {
+	if shareName == "" {
+		err = fmt.Errorf("`shareName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if path == "" {
+		err = fmt.Errorf("`path` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateDirectoryPath(path); err != nil {
+		err = fmt.Errorf("`path` is not valid: %+v", err)
+		return
+	}
+
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		current := strings.Join(segments[:i+1], "/")
+
+		currentInput := CreateDirectoryInput{}
+		if i == len(segments)-1 {
+			currentInput = input
+		}
+
+		result, err = c.Create(ctx, shareName, current, currentInput)
+		if err != nil {
+			if isDirectoryAlreadyExists(err) {
+				err = nil
+				continue
+			}
+			var shareNotFound ShareNotFoundError
+			if errors.As(err, &shareNotFound) {
+				return
+			}
+			err = fmt.Errorf("creating directory %q: %+v", current, err)
+			return
+		}
+	}
+
+	return
+}
+
+// isDirectoryAlreadyExists returns whether `err` is the 409 Conflict returned when the directory already exists,
+// rather than (for example) when a File exists with the same name
+func isDirectoryAlreadyExists(err error) bool {
+	var responseErr responseerror.ResponseError
+	return errors.As(err, &responseErr) && responseerror.IsConflict(err) && responseErr.Code == "ResourceAlreadyExists"
+}
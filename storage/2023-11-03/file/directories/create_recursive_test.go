@@ -0,0 +1,101 @@
+package directories
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+func TestCreateRecursive(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var lock sync.Mutex
+	existing := map[string]bool{
+		"parent": true,
+	}
+	created := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if r.Method != http.MethodPut || r.URL.Query().Get("restype") != "directory" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		shareName, directory, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if shareName != "share" {
+			w.Header().Set("x-ms-error-code", "ShareNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if parent := path.Dir(directory); parent != "." && !existing[parent] {
+			w.Header().Set("x-ms-error-code", "ParentNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if existing[directory] {
+			w.Header().Set("x-ms-error-code", "ResourceAlreadyExists")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		existing[directory] = true
+		created = append(created, directory)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	directoriesClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	authorizer, err := auth.NewSharedKeyAuthorizer("account", "a2V5", auth.SharedKey)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+	directoriesClient.Client.SetAuthorizer(authorizer)
+
+	if _, err := directoriesClient.CreateRecursive(ctx, "share", "parent/child/grandchild", CreateDirectoryInput{}); err != nil {
+		t.Fatalf("creating directories: %+v", err)
+	}
+	if strings.Join(created, ",") != "parent/child,parent/child/grandchild" {
+		t.Fatalf("expected `parent/child` and `parent/child/grandchild` to be created but got %v", created)
+	}
+
+	t.Logf("[DEBUG] Creating directories which already exist..")
+	if _, err := directoriesClient.CreateRecursive(ctx, "share", "parent/child", CreateDirectoryInput{}); err != nil {
+		t.Fatalf("creating existing directories: %+v", err)
+	}
+
+	t.Logf("[DEBUG] Creating directories within a share which doesn't exist..")
+	_, err = directoriesClient.CreateRecursive(ctx, "missing", "parent/child", CreateDirectoryInput{})
+	var shareNotFound ShareNotFoundError
+	if !errors.As(err, &shareNotFound) || shareNotFound.ShareName != "missing" {
+		t.Fatalf("expected a ShareNotFoundError but got: %+v", err)
+	}
+	if !responseerror.IsNotFound(err) {
+		t.Fatalf("expected the ShareNotFoundError to wrap the ResponseError but got: %+v", err)
+	}
+}
+
+func TestCreateRecursiveValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, input := range []string{"", "/parent", "parent//child", "parent/../child", "parent/ch*ld"} {
+		t.Logf("[DEBUG] Testing %q..", input)
+
+		if _, err := (Client{}).CreateRecursive(ctx, "share", input, CreateDirectoryInput{}); err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", input)
+		}
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteResponse struct {
@@ -27,8 +29,8 @@ func (c Client) Delete(ctx context.Context, shareName, path string) (result Dele
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -84,7 +86,7 @@ func (c Client) Delete(ctx context.Context, shareName, path string) (result Dele
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -0,0 +1,27 @@
+package directories
+
+import (
+	"fmt"
+
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+var _ error = ShareNotFoundError{}
+
+// ShareNotFoundError is returned when attempting to create a Directory within a Share which doesn't exist, and
+// wraps the ResponseError for the 404 response
+type ShareNotFoundError struct {
+	// The name of the Share which doesn't exist
+	ShareName string
+
+	ResponseError responseerror.ResponseError
+}
+
+func (e ShareNotFoundError) Error() string {
+	return fmt.Sprintf("the share %q was not found", e.ShareName)
+}
+
+// Unwrap returns the ResponseError for the 404 response
+func (e ShareNotFoundError) Unwrap() error {
+	return e.ResponseError
+}
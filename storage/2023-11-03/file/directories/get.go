@@ -8,6 +8,10 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/nfsproperties"
+	"github.com/jackofallops/giovanni/storage/internal/smbproperties"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetResponse struct {
@@ -19,6 +23,32 @@ type GetResponse struct {
 	// The value of this header is set to true if the directory metadata is completely
 	// encrypted using the specified algorithm. Otherwise, the value is set to false.
 	DirectoryMetaDataEncrypted bool
+
+	// The ETag of the directory
+	ETag string
+
+	// The date/time that the directory was last modified
+	LastModified string
+
+	// The SMB properties of the directory
+	FileAttributes    string
+	FileCreationTime  string
+	FileLastWriteTime string
+	FileChangeTime    string
+	FilePermissionKey string
+	FileID            string
+	FileParentID      string
+
+	// The POSIX properties of the directory, which are only returned for an NFS Share
+	Owner    string
+	Group    string
+	FileMode string
+}
+
+// GetProperties returns all system properties (including the SMB properties) for the specified directory,
+// this is equivalent to Get.
+func (c Client) GetProperties(ctx context.Context, shareName, path string) (GetResponse, error) {
+	return c.Get(ctx, shareName, path)
 }
 
 // Get returns all system properties for the specified directory,
@@ -29,8 +59,8 @@ func (c Client) Get(ctx context.Context, shareName, path string) (result GetResp
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -63,12 +93,28 @@ func (c Client) Get(ctx context.Context, shareName, path string) (result GetResp
 		if err == nil {
 			if resp.Header != nil {
 				result.DirectoryMetaDataEncrypted = strings.EqualFold(resp.Header.Get("x-ms-server-encrypted"), "true")
+				result.ETag = resp.Header.Get("ETag")
+				result.LastModified = resp.Header.Get("Last-Modified")
 				result.MetaData = metadata.ParseFromHeaders(resp.Header)
+
+				smb := smbproperties.ParseFromHeaders(resp.Header)
+				result.FileAttributes = smb.FileAttributes
+				result.FileCreationTime = smb.FileCreationTime
+				result.FileLastWriteTime = smb.FileLastWriteTime
+				result.FileChangeTime = smb.FileChangeTime
+				result.FilePermissionKey = smb.FilePermissionKey
+				result.FileID = smb.FileID
+				result.FileParentID = smb.FileParentID
+
+				nfs := nfsproperties.ParseFromHeaders(resp.Header)
+				result.Owner = nfs.Owner
+				result.Group = nfs.Group
+				result.FileMode = nfs.FileMode
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -0,0 +1,55 @@
+package directories
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+)
+
+func TestGetPropertiesParsesHeaders(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/share/dir" || r.URL.Query().Get("restype") != "directory" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("ETag", "\"0x8D\"")
+		w.Header().Set("Last-Modified", "Tue, 02 Jan 2024 03:04:05 GMT")
+		w.Header().Set("x-ms-file-attributes", "Directory")
+		w.Header().Set("x-ms-file-change-time", "2024-01-02T03:04:05.1234567Z")
+		w.Header().Set("x-ms-file-parent-id", "0")
+		w.Header().Set("x-ms-server-encrypted", "true")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	directoriesClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	authorizer, err := auth.NewSharedKeyAuthorizer("account", "a2V5", auth.SharedKey)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+	directoriesClient.Client.SetAuthorizer(authorizer)
+
+	props, err := directoriesClient.GetProperties(ctx, "share", "dir")
+	if err != nil {
+		t.Fatalf("retrieving properties: %+v", err)
+	}
+	if props.ETag != "\"0x8D\"" || props.LastModified != "Tue, 02 Jan 2024 03:04:05 GMT" {
+		t.Fatalf("unexpected ETag/LastModified: %+v", props)
+	}
+	if props.FileAttributes != "Directory" || props.FileChangeTime != "2024-01-02T03:04:05.1234567Z" || props.FileParentID != "0" {
+		t.Fatalf("unexpected SMB properties: %+v", props)
+	}
+	if !props.DirectoryMetaDataEncrypted {
+		t.Fatalf("expected the directory to be encrypted")
+	}
+}
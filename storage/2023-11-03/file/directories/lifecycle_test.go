@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/jackofallops/giovanni/storage/2020-08-04/file/shares"
@@ -111,6 +112,32 @@ func TestDirectoriesLifeCycle(t *testing.T) {
 		t.Fatalf("Expected the metadata `panda` to be `pops` but got %q", retrievedMetaData.MetaData["panda"])
 	}
 
+	log.Printf("[DEBUG] Setting Properties")
+	if _, err := directoriesClient.SetProperties(ctx, shareName, "hello/there", SetPropertiesInput{
+		FilePermission: pointer.To("O:SYG:SYD:(A;;FA;;;SY)(A;;FA;;;BA)"),
+		FileAttributes: pointer.To("Hidden"),
+	}); err != nil {
+		t.Fatalf("Error setting the properties: %s", err)
+	}
+
+	log.Printf("[DEBUG] Listing Top Level")
+	listResult, err := directoriesClient.ListComplete(ctx, shareName, "hello", ListInput{
+		MaxResults:          pointer.To(1),
+		IncludeExtendedInfo: true,
+	})
+	if err != nil {
+		t.Fatalf("Error listing Top Level Directory: %s", err)
+	}
+	if len(listResult.Directories) != 1 || listResult.Directories[0].Name != "there" {
+		t.Fatalf("Expected the Top Level Directory to contain the Inner Directory but got %+v", listResult.Directories)
+	}
+	if len(listResult.Files) != 0 {
+		t.Fatalf("Expected the Top Level Directory to contain no Files but got %d", len(listResult.Files))
+	}
+	if listResult.Directories[0].FileID == nil {
+		t.Fatalf("Expected the File ID to be returned for the Inner Directory")
+	}
+
 	t.Logf("[DEBUG] Deleting Inner..")
 	if _, err := directoriesClient.Delete(ctx, shareName, "hello/there"); err != nil {
 		t.Fatalf("Error deleting Inner Directory: %s", err)
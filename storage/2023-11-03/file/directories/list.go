@@ -0,0 +1,181 @@
+package directories
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ListInput struct {
+	// An optional prefix, only Directories and Files whose names begin with this prefix are returned
+	Prefix *string
+
+	// An optional maximum number of Directories and Files to return, up to 5000
+	MaxResults *int
+
+	// An optional marker, returned as `NextMarker` from a previous List, from which the listing should continue
+	Marker *string
+
+	// Should the extended information (the File ID, Timestamps, ETag, Attributes and Permission Key) be returned
+	// for each Directory and File? This sends the `x-ms-file-extended-info` header.
+	IncludeExtendedInfo bool
+}
+
+type ListResponse struct {
+	ListResult
+
+	HttpResponse *http.Response
+}
+
+type ListResult struct {
+	DirectoryPath string  `xml:"DirectoryPath,attr"`
+	DirectoryID   *string `xml:"DirectoryId,omitempty"`
+	Marker        string  `xml:"Marker"`
+	MaxResults    int     `xml:"MaxResults"`
+	NextMarker    *string `xml:"NextMarker,omitempty"`
+	Prefix        string  `xml:"Prefix"`
+
+	// The Directories within this Directory
+	Directories []DirectoryEntry `xml:"Entries>Directory"`
+
+	// The Files within this Directory
+	Files []FileEntry `xml:"Entries>File"`
+}
+
+type DirectoryEntry struct {
+	Name string `xml:"Name"`
+
+	// The following fields are only returned when IncludeExtendedInfo is set
+	FileID        *string          `xml:"FileId,omitempty"`
+	Attributes    *string          `xml:"Attributes,omitempty"`
+	PermissionKey *string          `xml:"PermissionKey,omitempty"`
+	Properties    *EntryProperties `xml:"Properties,omitempty"`
+}
+
+type FileEntry struct {
+	Name string `xml:"Name"`
+
+	// The following fields are only returned when IncludeExtendedInfo is set
+	FileID        *string          `xml:"FileId,omitempty"`
+	Attributes    *string          `xml:"Attributes,omitempty"`
+	PermissionKey *string          `xml:"PermissionKey,omitempty"`
+	Properties    *EntryProperties `xml:"Properties,omitempty"`
+}
+
+type EntryProperties struct {
+	// The size of the File in bytes, this is always returned for Files
+	ContentLength *int64 `xml:"Content-Length,omitempty"`
+
+	// The following fields are only returned when IncludeExtendedInfo is set
+	ChangeTime     *string `xml:"ChangeTime,omitempty"`
+	CreationTime   *string `xml:"CreationTime,omitempty"`
+	ETag           *string `xml:"Etag,omitempty"`
+	LastAccessTime *string `xml:"LastAccessTime,omitempty"`
+	LastModified   *string `xml:"Last-Modified,omitempty"`
+	LastWriteTime  *string `xml:"LastWriteTime,omitempty"`
+}
+
+// List returns the Directories and Files within the specified Directory, when `path` is empty the
+// contents of the root of the Share are returned
+func (c Client) List(ctx context.Context, shareName, path string, input ListInput) (result ListResponse, err error) {
+	if shareName == "" {
+		err = fmt.Errorf("`shareName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if input.MaxResults != nil && (*input.MaxResults <= 0 || *input.MaxResults > 5000) {
+		err = fmt.Errorf("`input.MaxResults` can either be nil or between 1 and 5000")
+		return
+	}
+
+	if path != "" {
+		path = fmt.Sprintf("/%s", path)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: listOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s%s", shareName, path),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			err = resp.Unmarshal(&result)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+var _ client.Options = listOptions{}
+
+type listOptions struct {
+	input ListInput
+}
+
+func (o listOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if o.input.IncludeExtendedInfo {
+		headers.Append("x-ms-file-extended-info", "true")
+	}
+	return headers
+}
+
+func (o listOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o listOptions) ToQuery() *client.QueryParams {
+	query := directoriesOptions{}.ToQuery()
+	query.Append("comp", "list")
+
+	if o.input.Prefix != nil {
+		query.Append("prefix", *o.input.Prefix)
+	}
+	if o.input.MaxResults != nil {
+		query.Append("maxresults", strconv.Itoa(*o.input.MaxResults))
+	}
+	if o.input.Marker != nil {
+		query.Append("marker", *o.input.Marker)
+	}
+	if o.input.IncludeExtendedInfo {
+		query.Append("include", "Timestamps,ETag,Attributes,PermissionKey")
+	}
+
+	return query
+}
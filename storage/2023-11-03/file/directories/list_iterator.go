@@ -0,0 +1,81 @@
+package directories
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListIterator retrieves successive pages of Directories and Files from a Directory, following the
+// `NextMarker` returned by the service until all of the results have been retrieved.
+type ListIterator struct {
+	client    Client
+	shareName string
+	path      string
+	input     ListInput
+	done      bool
+}
+
+// NewListIterator returns an iterator over the contents of the specified Directory matching `input`.
+// The `Marker` within `input` (if specified) is used as the starting point.
+func (c Client) NewListIterator(shareName, path string, input ListInput) *ListIterator {
+	return &ListIterator{
+		client:    c,
+		shareName: shareName,
+		path:      path,
+		input:     input,
+	}
+}
+
+// NotDone returns whether there are further pages of results to be retrieved
+func (i *ListIterator) NotDone() bool {
+	return !i.done
+}
+
+// Next retrieves the next page of results
+func (i *ListIterator) Next(ctx context.Context) (result ListResponse, err error) {
+	if i.done {
+		err = fmt.Errorf("no more results are available")
+		return
+	}
+
+	result, err = i.client.List(ctx, i.shareName, i.path, i.input)
+	if err != nil {
+		return
+	}
+
+	if result.NextMarker == nil || *result.NextMarker == "" {
+		i.done = true
+	} else {
+		marker := *result.NextMarker
+		i.input.Marker = &marker
+	}
+
+	return
+}
+
+type ListCompleteResult struct {
+	// The Directories within the Directory, across all pages of results
+	Directories []DirectoryEntry
+
+	// The Files within the Directory, across all pages of results
+	Files []FileEntry
+}
+
+// ListComplete retrieves all of the Directories and Files within the specified Directory matching `input`,
+// following the `NextMarker` until all pages of results have been retrieved
+func (c Client) ListComplete(ctx context.Context, shareName, path string, input ListInput) (result ListCompleteResult, err error) {
+	iterator := c.NewListIterator(shareName, path, input)
+	for iterator.NotDone() {
+		var page ListResponse
+		page, err = iterator.Next(ctx)
+		if err != nil {
+			err = fmt.Errorf("listing directory: %+v", err)
+			return
+		}
+
+		result.Directories = append(result.Directories, page.Directories...)
+		result.Files = append(result.Files, page.Files...)
+	}
+
+	return
+}
@@ -0,0 +1,120 @@
+package directories
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestListResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://account1.file.core.windows.net/" ShareName="share1" DirectoryPath="hello">
+  <Marker />
+  <Prefix>th</Prefix>
+  <MaxResults>2</MaxResults>
+  <DirectoryId>13835128424026341376</DirectoryId>
+  <Entries>
+    <File>
+      <FileId>11529285414812647424</FileId>
+      <Name>there.txt</Name>
+      <Properties>
+        <Content-Length>1024</Content-Length>
+        <CreationTime>2024-01-01T00:00:00.0000000Z</CreationTime>
+        <LastAccessTime>2024-01-02T00:00:00.0000000Z</LastAccessTime>
+        <LastWriteTime>2024-01-03T00:00:00.0000000Z</LastWriteTime>
+        <ChangeTime>2024-01-04T00:00:00.0000000Z</ChangeTime>
+        <Last-Modified>Thu, 04 Jan 2024 00:00:00 GMT</Last-Modified>
+        <Etag>"0x8DC0C1E1B7E7B70"</Etag>
+      </Properties>
+      <Attributes>Archive</Attributes>
+      <PermissionKey>4066528134148476695*1</PermissionKey>
+    </File>
+    <Directory>
+      <FileId>16140971433240035328</FileId>
+      <Name>there</Name>
+      <Properties>
+        <CreationTime>2024-01-01T00:00:00.0000000Z</CreationTime>
+      </Properties>
+      <Attributes>Directory</Attributes>
+    </Directory>
+  </Entries>
+  <NextMarker>2!100!MDAwMDA1IXRoZXJlITAwMDAyOCE5OTk5LTEyLTMxVDIzOjU5OjU5Ljk5OTk5OTlaIQ--</NextMarker>
+</EnumerationResults>`
+
+	var actual ListResult
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if actual.DirectoryPath != "hello" {
+		t.Fatalf("expected the DirectoryPath to be %q but got %q", "hello", actual.DirectoryPath)
+	}
+	if actual.NextMarker == nil || *actual.NextMarker == "" {
+		t.Fatalf("expected a NextMarker but didn't get one")
+	}
+
+	if len(actual.Files) != 1 {
+		t.Fatalf("expected 1 file but got %d", len(actual.Files))
+	}
+	file := actual.Files[0]
+	if file.Name != "there.txt" {
+		t.Fatalf("expected the file name to be %q but got %q", "there.txt", file.Name)
+	}
+	if file.Properties == nil || file.Properties.ContentLength == nil || *file.Properties.ContentLength != 1024 {
+		t.Fatalf("expected the file to have a Content Length of 1024")
+	}
+	if file.FileID == nil || *file.FileID != "11529285414812647424" {
+		t.Fatalf("expected the file to have the File ID %q", "11529285414812647424")
+	}
+	if file.Properties.LastWriteTime == nil || *file.Properties.LastWriteTime != "2024-01-03T00:00:00.0000000Z" {
+		t.Fatalf("expected the file to have a LastWriteTime of %q", "2024-01-03T00:00:00.0000000Z")
+	}
+
+	if len(actual.Directories) != 1 {
+		t.Fatalf("expected 1 directory but got %d", len(actual.Directories))
+	}
+	if actual.Directories[0].Name != "there" {
+		t.Fatalf("expected the directory name to be %q but got %q", "there", actual.Directories[0].Name)
+	}
+	if actual.Directories[0].Properties.ContentLength != nil {
+		t.Fatalf("expected the directory not to have a Content Length")
+	}
+}
+
+func TestListOptions(t *testing.T) {
+	options := listOptions{
+		input: ListInput{
+			Prefix:              pointer.To("th"),
+			MaxResults:          pointer.To(2),
+			Marker:              pointer.To("abc"),
+			IncludeExtendedInfo: true,
+		},
+	}
+
+	query := options.ToQuery().Values()
+	expected := map[string]string{
+		"restype":    "directory",
+		"comp":       "list",
+		"prefix":     "th",
+		"maxresults": "2",
+		"marker":     "abc",
+		"include":    "Timestamps,ETag,Attributes,PermissionKey",
+	}
+	for k, v := range expected {
+		if actual := query.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+	if v := options.ToHeaders().Headers().Get("x-ms-file-extended-info"); v != "true" {
+		t.Fatalf("expected `x-ms-file-extended-info` to be %q but got %q", "true", v)
+	}
+
+	query = listOptions{}.ToQuery().Values()
+	if query.Has("include") {
+		t.Fatalf("expected `include` to be omitted when extended info isn't requested")
+	}
+	if v := (listOptions{}).ToHeaders().Headers().Get("x-ms-file-extended-info"); v != "" {
+		t.Fatalf("expected `x-ms-file-extended-info` to be omitted but got %q", v)
+	}
+}
@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetMetaDataResponse struct {
@@ -24,8 +25,8 @@ func (c Client) GetMetaData(ctx context.Context, shareName, path string) (result
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -62,7 +63,7 @@ func (c Client) GetMetaData(ctx context.Context, shareName, path string) (result
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetMetaDataResponse struct {
@@ -27,8 +28,8 @@ func (c Client) SetMetaData(ctx context.Context, shareName, path string, input S
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -66,7 +67,7 @@ func (c Client) SetMetaData(ctx context.Context, shareName, path string, input S
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
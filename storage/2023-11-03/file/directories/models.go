@@ -7,3 +7,16 @@ type ErrorResponse struct {
 	Code    *string  `xml:"Code"`
 	Message *string  `xml:"Message"`
 }
+
+// NFSProperties are the POSIX properties which can be assigned to Files and Directories within an NFS Share,
+// which are sent instead of the SMB properties (permission and attributes) that NFS Shares don't support
+type NFSProperties struct {
+	// Optional - The numeric user identifier (UID) of the owner
+	Owner *string
+
+	// Optional - The numeric group identifier (GID) of the owning group
+	Group *string
+
+	// Optional - The mode, in either 4-digit octal (e.g. `0644`) or symbolic (e.g. `rw-r--r--`) notation
+	FileMode *string
+}
@@ -0,0 +1,166 @@
+package directories
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/nfsproperties"
+	"github.com/jackofallops/giovanni/storage/internal/smbproperties"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type SetPropertiesInput struct {
+	// The time at which this directory was created at - if omitted, the existing value is preserved
+	// This maps to the `x-ms-file-creation-time` field.
+	CreatedAt *time.Time
+
+	// The time at which this directory was last modified - if omitted, the existing value is preserved
+	// This maps to the `x-ms-file-last-write-time` field.
+	LastModified *time.Time
+
+	// An optional SDDL permission which should be assigned to this directory, when neither this nor
+	// FilePermissionKey are specified the existing permission is preserved.
+	// This maps to the `x-ms-file-permission` field and cannot be specified alongside FilePermissionKey.
+	FilePermission *string
+
+	// An optional key for a permission created using the Share's CreatePermission method, which must
+	// be used for permissions larger than 8KiB. This maps to the `x-ms-file-permission-key` field
+	// and cannot be specified alongside FilePermission.
+	FilePermissionKey *string
+
+	// An optional pipe-separated list of attributes which should be assigned to this directory, e.g. `ReadOnly|Hidden`
+	// If omitted, the existing attributes are preserved. This maps to the `x-ms-file-attributes` field.
+	FileAttributes *string
+
+	// Optional - The POSIX properties which should be assigned to this directory within an NFS Share. When specified
+	// the SMB-only headers (`x-ms-file-permission`, `x-ms-file-permission-key` and `x-ms-file-attributes`) aren't
+	// sent, and so FilePermission, FilePermissionKey and FileAttributes cannot be specified.
+	NFSProperties *NFSProperties
+}
+
+type SetPropertiesResponse struct {
+	HttpResponse *http.Response
+
+	// The key of the permission assigned to this directory
+	FilePermissionKey string
+}
+
+// SetProperties sets the SMB properties (permission, attributes and timestamps) on the specified directory,
+// or for a directory within an NFS Share the POSIX properties (owner, group and mode) and timestamps
+func (c Client) SetProperties(ctx context.Context, shareName, path string, input SetPropertiesInput) (result SetPropertiesResponse, err error) {
+	if shareName == "" {
+		err = fmt.Errorf("`shareName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if path == "" {
+		err = fmt.Errorf("`path` cannot be an empty string")
+		return
+	}
+
+	if err = input.smbProperties().Validate(smbproperties.UpdateDefaults); err != nil {
+		err = fmt.Errorf("`input` is not valid: %s", err)
+		return
+	}
+
+	if input.NFSProperties != nil {
+		if err = input.smbProperties().ValidateForNFS(); err != nil {
+			err = fmt.Errorf("`input` is not valid: %s", err)
+			return
+		}
+		if err = input.nfsProperties().Validate(); err != nil {
+			err = fmt.Errorf("`input.NFSProperties` is not valid: %s", err)
+			return
+		}
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: setPropertiesOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", shareName, path),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			result.FilePermissionKey = resp.Header.Get("x-ms-file-permission-key")
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+func (i SetPropertiesInput) smbProperties() smbproperties.Properties {
+	return smbproperties.Properties{
+		FilePermission:    i.FilePermission,
+		FilePermissionKey: i.FilePermissionKey,
+		FileAttributes:    i.FileAttributes,
+		CreationTime:      i.CreatedAt,
+		LastWriteTime:     i.LastModified,
+	}
+}
+
+func (i SetPropertiesInput) nfsProperties() nfsproperties.Properties {
+	return nfsproperties.Properties{
+		Owner:         i.NFSProperties.Owner,
+		Group:         i.NFSProperties.Group,
+		FileMode:      i.NFSProperties.FileMode,
+		CreationTime:  i.CreatedAt,
+		LastWriteTime: i.LastModified,
+	}
+}
+
+var _ client.Options = setPropertiesOptions{}
+
+type setPropertiesOptions struct {
+	input SetPropertiesInput
+}
+
+func (s setPropertiesOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if s.input.NFSProperties != nil {
+		headers.Merge(s.input.nfsProperties().Headers())
+	} else {
+		headers.Merge(s.input.smbProperties().Headers(smbproperties.UpdateDefaults))
+	}
+	return headers
+}
+
+func (s setPropertiesOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (s setPropertiesOptions) ToQuery() *client.QueryParams {
+	query := directoriesOptions{}.ToQuery()
+	query.Append("comp", "properties")
+	return query
+}
@@ -2,6 +2,7 @@ package files
 
 import (
 	"context"
+	"io"
 	"os"
 )
 
@@ -11,14 +12,18 @@ type StorageFile interface {
 	ClearByteRange(ctx context.Context, shareName string, path string, fileName string, input ClearByteRangeInput) (ClearByteRangeResponse, error)
 	SetProperties(ctx context.Context, shareName string, path string, fileName string, input SetPropertiesInput) (SetPropertiesResponse, error)
 	PutFile(ctx context.Context, shareName string, path string, fileName string, file *os.File, parallelism int) error
+	PutFileWithInput(ctx context.Context, shareName string, path string, fileName string, file *os.File, input PutFileInput) error
 	Copy(ctx context.Context, shareName, path, fileName string, input CopyInput) (CopyResponse, error)
 	SetMetaData(ctx context.Context, shareName string, path string, fileName string, input SetMetaDataInput) (SetMetaDataResponse, error)
 	GetMetaData(ctx context.Context, shareName string, path string, fileName string) (GetMetaDataResponse, error)
 	AbortCopy(ctx context.Context, shareName string, path string, fileName string, input CopyAbortInput) (CopyAbortResponse, error)
 	GetFile(ctx context.Context, shareName string, path string, fileName string, input GetFileInput) (GetFileResponse, error)
-	ListRanges(ctx context.Context, shareName, path, fileName string) (ListRangesResponse, error)
+	DownloadToFile(ctx context.Context, shareName, path, fileName, localPath string, input DownloadToFileInput) (DownloadToFileResponse, error)
+	DownloadToWriter(ctx context.Context, shareName, path, fileName string, w io.WriterAt, input DownloadToFileInput) (DownloadToFileResponse, error)
+	ListRanges(ctx context.Context, shareName, path, fileName string, input ListRangesInput) (ListRangesResponse, error)
 	GetProperties(ctx context.Context, shareName string, path string, fileName string) (GetResponse, error)
 	Delete(ctx context.Context, shareName string, path string, fileName string) (DeleteResponse, error)
 	Create(ctx context.Context, shareName string, path string, fileName string, input CreateInput) (CreateResponse, error)
 	CopyAndWait(ctx context.Context, shareName, path, fileName string, input CopyInput) (CopyResponse, error)
+	WaitForCopy(ctx context.Context, shareName, path, fileName string) (GetResponse, error)
 }
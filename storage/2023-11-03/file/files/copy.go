@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"net/url"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type CopyInput struct {
@@ -19,6 +23,8 @@ type CopyInput struct {
 	// the same storage account or another storage account, then you must authenticate the source file or blob using a
 	// shared access signature. If the source is a public blob, no authentication is required to perform the copy
 	// operation. A file in a share snapshot can also be specified as a copy source.
+	//
+	// This value is sent as-is, as such any SAS token included within the query string is passed through untouched.
 	CopySource string
 
 	MetaData map[string]string
@@ -32,6 +38,9 @@ type CopyResponse struct {
 
 	// Either `success` or `pending`
 	CopySuccess string
+
+	ETag         string
+	LastModified time.Time
 }
 
 // Copy copies a blob or file to a destination file within the storage account asynchronously.
@@ -42,8 +51,8 @@ func (c Client) Copy(ctx context.Context, shareName, path, fileName string, inpu
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -52,8 +61,7 @@ func (c Client) Copy(ctx context.Context, shareName, path, fileName string, inpu
 		return
 	}
 
-	if input.CopySource == "" {
-		err = fmt.Errorf("`input.CopySource` cannot be an empty string")
+	if err = validateCopySource(input.CopySource); err != nil {
 		return
 	}
 
@@ -93,17 +101,38 @@ func (c Client) Copy(ctx context.Context, shareName, path, fileName string, inpu
 			if resp.Header != nil {
 				result.CopyID = resp.Header.Get("x-ms-copy-id")
 				result.CopySuccess = resp.Header.Get("x-ms-copy-status")
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
+func validateCopySource(input string) error {
+	if input == "" {
+		return fmt.Errorf("`input.CopySource` cannot be an empty string")
+	}
+	if len(input) > 2048 {
+		return fmt.Errorf("`input.CopySource` can be at most 2KB in length but got %d characters", len(input))
+	}
+	uri, err := url.Parse(input)
+	if err != nil {
+		return fmt.Errorf("`input.CopySource` must be a valid URL: %+v", err)
+	}
+	if uri.Scheme == "" || uri.Host == "" {
+		return fmt.Errorf("`input.CopySource` must be an absolute URL to a File or Blob but got %q", input)
+	}
+	return nil
+}
+
 type CopyOptions struct {
 	input CopyInput
 }
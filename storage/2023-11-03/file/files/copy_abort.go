@@ -4,14 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type CopyAbortInput struct {
-	copyID string
+	// The Copy ID which should be aborted, as returned from Copy
+	CopyID string
 }
 
 type CopyAbortResponse struct {
@@ -26,8 +28,8 @@ func (c Client) AbortCopy(ctx context.Context, shareName, path, fileName string,
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -36,8 +38,8 @@ func (c Client) AbortCopy(ctx context.Context, shareName, path, fileName string,
 		return
 	}
 
-	if input.copyID == "" {
-		err = fmt.Errorf("`copyID` cannot be an empty string")
+	if input.CopyID == "" {
+		err = fmt.Errorf("`input.CopyID` cannot be an empty string")
 		return
 	}
 
@@ -52,7 +54,7 @@ func (c Client) AbortCopy(ctx context.Context, shareName, path, fileName string,
 		},
 		HttpMethod: http.MethodPut,
 		OptionsObject: CopyAbortOptions{
-			copyId: input.copyID,
+			copyId: input.CopyID,
 		},
 		Path: fmt.Sprintf("/%s/%s%s", shareName, path, fileName),
 	}
@@ -69,7 +71,7 @@ func (c Client) AbortCopy(ctx context.Context, shareName, path, fileName string,
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
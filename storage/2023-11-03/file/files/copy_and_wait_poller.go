@@ -25,6 +25,9 @@ type copyAndWaitPoller struct {
 	shareName string
 	path      string
 	fileName  string
+
+	// latest contains the File Properties retrieved during the most recent poll
+	latest *GetResponse
 }
 
 func (p *copyAndWaitPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
@@ -32,17 +35,42 @@ func (p *copyAndWaitPoller) Poll(ctx context.Context) (*pollers.PollResult, erro
 	if err != nil {
 		return nil, fmt.Errorf("retrieving copy (shareName: %s path: %s fileName: %s) : %+v", p.shareName, p.path, p.fileName, err)
 	}
+	p.latest = &props
 
-	if strings.EqualFold(props.CopyStatus, "success") {
-		return &pollers.PollResult{
-			Status:       pollers.PollingStatusSucceeded,
-			PollInterval: 10 * time.Second,
-		}, nil
+	done, err := copyCompleted(p.shareName, p.path, p.fileName, props)
+	if err != nil {
+		return nil, err
 	}
 
-	// Processing
+	status := pollers.PollingStatusInProgress
+	if done {
+		status = pollers.PollingStatusSucceeded
+	}
 	return &pollers.PollResult{
-		Status:       pollers.PollingStatusInProgress,
+		Status:       status,
 		PollInterval: 10 * time.Second,
 	}, nil
 }
+
+// copyCompleted returns whether the pending copy operation (where this File is the destination) has succeeded, or an
+// error when the copy has failed or was aborted
+func copyCompleted(shareName, path, fileName string, props GetResponse) (bool, error) {
+	if strings.EqualFold(props.CopyStatus, "success") {
+		return true, nil
+	}
+
+	if strings.EqualFold(props.CopyStatus, "failed") {
+		return false, pollers.PollingFailedError{
+			Message: fmt.Sprintf("copy %q (shareName: %s path: %s fileName: %s) failed: %s", props.CopyID, shareName, path, fileName, props.CopyStatusDescription),
+		}
+	}
+
+	if strings.EqualFold(props.CopyStatus, "aborted") {
+		return false, pollers.PollingCancelledError{
+			Message: fmt.Sprintf("copy %q (shareName: %s path: %s fileName: %s) was aborted: %s", props.CopyID, shareName, path, fileName, props.CopyStatusDescription),
+		}
+	}
+
+	// Processing
+	return false, nil
+}
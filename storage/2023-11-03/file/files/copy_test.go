@@ -0,0 +1,62 @@
+package files
+
+import "testing"
+
+func TestValidateCopySource(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         string
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Empty",
+			Input:         "",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Relative Path",
+			Input:         "share1/file.txt",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "File URL",
+			Input:         "https://account1.file.core.windows.net/share1/dir/file.txt",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Blob URL with a SAS Token",
+			Input:         "https://account1.blob.core.windows.net/container1/blob.txt?sv=2023-11-03&sr=b&sp=r&se=2024-01-01T00%3A00%3A00Z&sig=abc%2Bdef%3D",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Too Long",
+			Input:         "https://account1.blob.core.windows.net/container1/" + string(make([]byte, 2048)),
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateCopySource(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Input)
+		}
+	}
+}
+
+func TestCopyOptionsPassesSASThroughUntouched(t *testing.T) {
+	source := "https://account1.blob.core.windows.net/container1/blob.txt?sv=2023-11-03&sr=b&sp=r&se=2024-01-01T00%3A00%3A00Z&sig=abc%2Bdef%3D"
+	headers := CopyOptions{
+		input: CopyInput{
+			CopySource: source,
+		},
+	}.ToHeaders().Headers()
+
+	if v := headers.Get("x-ms-copy-source"); v != source {
+		t.Fatalf("expected `x-ms-copy-source` to be %q but got %q", source, v)
+	}
+}
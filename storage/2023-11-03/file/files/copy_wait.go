@@ -3,26 +3,52 @@ package files
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/jackofallops/giovanni/storage/internal/poll"
 )
 
 // CopyAndWait is a convenience method which doesn't exist in the API, which copies the file and then waits for the copy to complete
 func (c Client) CopyAndWait(ctx context.Context, shareName, path, fileName string, input CopyInput) (result CopyResponse, err error) {
-	fileCopy, e := c.Copy(ctx, shareName, path, fileName, input)
+	result, err = c.Copy(ctx, shareName, path, fileName, input)
 	if err != nil {
-		result.HttpResponse = fileCopy.HttpResponse
-		err = fmt.Errorf("copying: %s", e)
+		err = fmt.Errorf("copying: %s", err)
 		return
 	}
 
-	result.CopyID = fileCopy.CopyID
+	// copies within the same storage account can complete synchronously
+	if strings.EqualFold(result.CopySuccess, "success") {
+		return
+	}
 
-	pollerType := NewCopyAndWaitPoller(&c, shareName, path, fileName)
-	poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
-	if err = poller.PollUntilDone(ctx); err != nil {
-		return result, fmt.Errorf("waiting for file to copy: %+v", err)
+	props, err := c.WaitForCopy(ctx, shareName, path, fileName)
+	if err != nil {
+		return
+	}
+	result.CopySuccess = props.CopyStatus
+
+	return
+}
+
+// WaitForCopy is a convenience method which doesn't exist in the API, which polls the properties of the specified file
+// until the pending copy operation either succeeds, fails or is aborted - returning the final properties of the file
+func (c Client) WaitForCopy(ctx context.Context, shareName, path, fileName string) (result GetResponse, err error) {
+	latest, err := poll.Until(ctx, 10*time.Second, func(ctx context.Context) (*GetResponse, bool, error) {
+		props, err := c.GetProperties(ctx, shareName, path, fileName)
+		if err != nil {
+			return nil, false, fmt.Errorf("retrieving copy (shareName: %s path: %s fileName: %s) : %+v", shareName, path, fileName, err)
+		}
+
+		done, err := copyCompleted(shareName, path, fileName, props)
+		return &props, done, err
+	})
+	if latest != nil {
+		result = *latest
+	}
+	if err != nil {
+		err = fmt.Errorf("waiting for file to copy: %w", err)
+		return
 	}
 
 	return
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/nfsproperties"
+	"github.com/jackofallops/giovanni/storage/internal/smbproperties"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type CreateInput struct {
@@ -48,10 +52,32 @@ type CreateInput struct {
 
 	// MetaData is a mapping of key value pairs which should be assigned to this file
 	MetaData map[string]string
+
+	// An optional SDDL permission which should be assigned to this file, when neither this nor
+	// FilePermissionKey are specified the permission is inherited from the parent directory.
+	// This maps to the `x-ms-file-permission` field and cannot be specified alongside FilePermissionKey.
+	FilePermission *string
+
+	// An optional key for a permission created using the Share's CreatePermission method, which must
+	// be used for permissions larger than 8KiB. This maps to the `x-ms-file-permission-key` field
+	// and cannot be specified alongside FilePermission.
+	FilePermissionKey *string
+
+	// An optional pipe-separated list of attributes which should be assigned to this file, e.g. `ReadOnly|Hidden`
+	// If omitted, this'll be set to `None`. This maps to the `x-ms-file-attributes` field.
+	FileAttributes *string
+
+	// Optional - The POSIX properties which should be assigned to this file within an NFS Share. When specified
+	// the SMB-only headers (`x-ms-file-permission`, `x-ms-file-permission-key` and `x-ms-file-attributes`) aren't
+	// sent, and so FilePermission, FilePermissionKey and FileAttributes cannot be specified.
+	NFSProperties *NFSProperties
 }
 
 type CreateResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // Create creates a new file or replaces a file.
@@ -61,8 +87,8 @@ func (c Client) Create(ctx context.Context, shareName, path, fileName string, in
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -76,6 +102,22 @@ func (c Client) Create(ctx context.Context, shareName, path, fileName string, in
 		return
 	}
 
+	if err = input.smbProperties().Validate(smbproperties.CreateDefaults); err != nil {
+		err = fmt.Errorf("`input` is not valid: %s", err)
+		return
+	}
+
+	if input.NFSProperties != nil {
+		if err = input.smbProperties().ValidateForNFS(); err != nil {
+			err = fmt.Errorf("`input` is not valid: %s", err)
+			return
+		}
+		if err = input.nfsProperties().Validate(); err != nil {
+			err = fmt.Errorf("`input.NFSProperties` is not valid: %s", err)
+			return
+		}
+	}
+
 	if path != "" {
 		path = fmt.Sprintf("%s/", path)
 	}
@@ -102,15 +144,44 @@ func (c Client) Create(ctx context.Context, shareName, path, fileName string, in
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
+func (i CreateInput) smbProperties() smbproperties.Properties {
+	return smbproperties.Properties{
+		FilePermission:    i.FilePermission,
+		FilePermissionKey: i.FilePermissionKey,
+		FileAttributes:    i.FileAttributes,
+		CreationTime:      i.CreatedAt,
+		LastWriteTime:     i.LastModified,
+	}
+}
+
+func (i CreateInput) nfsProperties() nfsproperties.Properties {
+	return nfsproperties.Properties{
+		Owner:         i.NFSProperties.Owner,
+		Group:         i.NFSProperties.Group,
+		FileMode:      i.NFSProperties.FileMode,
+		CreationTime:  i.CreatedAt,
+		LastWriteTime: i.LastModified,
+	}
+}
+
 type CreateOptions struct {
 	input CreateInput
 }
@@ -118,14 +189,6 @@ type CreateOptions struct {
 func (c CreateOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
 
-	var coalesceDate = func(input *time.Time, defaultVal string) string {
-		if input == nil {
-			return defaultVal
-		}
-
-		return input.Format(time.RFC1123)
-	}
-
 	if len(c.input.MetaData) > 0 {
 		headers.Merge(metadata.SetMetaDataHeaders(c.input.MetaData))
 	}
@@ -133,10 +196,11 @@ func (c CreateOptions) ToHeaders() *client.Headers {
 	headers.Append("x-ms-content-length", strconv.Itoa(int(c.input.ContentLength)))
 	headers.Append("x-ms-type", "file")
 
-	headers.Append("x-ms-file-permission", "inherit") // TODO: expose this in future
-	headers.Append("x-ms-file-attributes", "None")    // TODO: expose this in future
-	headers.Append("x-ms-file-creation-time", coalesceDate(c.input.CreatedAt, "now"))
-	headers.Append("x-ms-file-last-write-time", coalesceDate(c.input.LastModified, "now"))
+	if c.input.NFSProperties != nil {
+		headers.Merge(c.input.nfsProperties().Headers())
+	} else {
+		headers.Merge(c.input.smbProperties().Headers(smbproperties.CreateDefaults))
+	}
 
 	if c.input.ContentDisposition != nil {
 		headers.Append("x-ms-content-disposition", *c.input.ContentDisposition)
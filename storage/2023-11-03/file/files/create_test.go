@@ -0,0 +1,129 @@
+package files
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestCreateOptionsNFS(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    CreateInput
+		Expected map[string]string
+	}{
+		{
+			Name:  "SMB",
+			Input: CreateInput{},
+			Expected: map[string]string{
+				"x-ms-file-permission":      "inherit",
+				"x-ms-file-attributes":      "None",
+				"x-ms-file-creation-time":   "now",
+				"x-ms-file-last-write-time": "now",
+				"x-ms-owner":                "",
+				"x-ms-mode":                 "",
+			},
+		},
+		{
+			Name: "NFS",
+			Input: CreateInput{
+				NFSProperties: &NFSProperties{
+					Owner:    pointer.To("1000"),
+					Group:    pointer.To("1000"),
+					FileMode: pointer.To("0644"),
+				},
+			},
+			Expected: map[string]string{
+				"x-ms-file-permission":      "",
+				"x-ms-file-attributes":      "",
+				"x-ms-file-creation-time":   "",
+				"x-ms-file-last-write-time": "",
+				"x-ms-owner":                "1000",
+				"x-ms-group":                "1000",
+				"x-ms-mode":                 "0644",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		headers := CreateOptions{input: v.Input}.ToHeaders().Headers()
+		for k, expected := range v.Expected {
+			if actual := headers.Get(k); actual != expected {
+				t.Fatalf("expected %q to be %q but got %q", k, expected, actual)
+			}
+		}
+	}
+}
+
+func TestCreateNFSValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input CreateInput
+	}{
+		{
+			Name: "NFS with a Permission",
+			Input: CreateInput{
+				FilePermission: pointer.To("O:SYG:SYD:(A;;FA;;;SY)"),
+				NFSProperties:  &NFSProperties{},
+			},
+		},
+		{
+			Name: "NFS with a Permission Key",
+			Input: CreateInput{
+				FilePermissionKey: pointer.To("4066528134148476695*1"),
+				NFSProperties:     &NFSProperties{},
+			},
+		},
+		{
+			Name: "NFS with Attributes",
+			Input: CreateInput{
+				FileAttributes: pointer.To("ReadOnly"),
+				NFSProperties:  &NFSProperties{},
+			},
+		},
+		{
+			Name: "Invalid Mode",
+			Input: CreateInput{
+				NFSProperties: &NFSProperties{
+					FileMode: pointer.To("rwx"),
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).Create(ctx, "share", "dir", "file.txt", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestSetPropertiesOptionsPreservesOmittedProperties(t *testing.T) {
+	headers := SetPropertiesOptions{
+		input: SetPropertiesInput{
+			ContentType: pointer.To("text/plain"),
+		},
+	}.ToHeaders().Headers()
+
+	expected := map[string]string{
+		"x-ms-content-type":         "text/plain",
+		"x-ms-file-permission":      "preserve",
+		"x-ms-file-attributes":      "preserve",
+		"x-ms-file-creation-time":   "preserve",
+		"x-ms-file-last-write-time": "preserve",
+	}
+	for k, v := range expected {
+		if actual := headers.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package files
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jackofallops/giovanni/storage/internal/progress"
+	"github.com/jackofallops/giovanni/storage/transfermanager"
+)
+
+const (
+	// defaultDownloadChunkSize is the size of each range retrieved when downloading a File, which is also the
+	// maximum size of a range which can be retrieved using GetByteRange
+	defaultDownloadChunkSize = int64(4 * 1024 * 1024)
+
+	// defaultDownloadParallelism is the number of ranges retrieved at once when downloading a File
+	defaultDownloadParallelism = 4
+)
+
+type DownloadToFileInput struct {
+	// The number of ranges which should be downloaded in parallel, defaults to 4
+	Parallelism int
+
+	// Optional - A TransferManager shared with other uploads and downloads, which limits the number of ranges
+	// downloaded at once (and the bandwidth used) across all of them, in addition to Parallelism
+	TransferManager *transfermanager.TransferManager
+
+	// The size (in bytes) of each range which should be downloaded, defaults to (and can be at most) 4 MiB
+	ChunkSize int64
+
+	// Optional - A callback which is fired as each range is downloaded, with the cumulative number of bytes
+	// downloaded and the size of the File. A final callback is fired once the File has been downloaded.
+	Progress func(bytesTransferred, totalBytes int64)
+}
+
+type DownloadToFileResponse struct {
+	HttpResponse *http.Response
+
+	// The number of bytes written to the destination, which is the size of the File
+	ContentLength int64
+
+	// The ETag of the File which was downloaded
+	ETag string
+}
+
+// DownloadToFile is a helper method which downloads a File to the file at `localPath` by retrieving ranges of the
+// File in parallel. The local file is created (or truncated) and pre-allocated to the size of the File - once
+// downloaded the size of the local file is verified against the `Content-Length` of the File.
+func (c Client) DownloadToFile(ctx context.Context, shareName, path, fileName, localPath string, input DownloadToFileInput) (result DownloadToFileResponse, err error) {
+	if localPath == "" {
+		return result, fmt.Errorf("`localPath` cannot be an empty string")
+	}
+
+	if err = input.validate(); err != nil {
+		return result, err
+	}
+
+	result, err = c.downloadProperties(ctx, shareName, path, fileName)
+	if err != nil {
+		return result, err
+	}
+
+	file, err := os.OpenFile(localPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return result, fmt.Errorf("opening %q: %+v", localPath, err)
+	}
+	defer file.Close()
+
+	if err = file.Truncate(result.ContentLength); err != nil {
+		return result, fmt.Errorf("allocating %d bytes for %q: %+v", result.ContentLength, localPath, err)
+	}
+
+	result, err = c.downloadToWriter(ctx, shareName, path, fileName, file, input, result)
+	if err != nil {
+		return result, err
+	}
+
+	if err = file.Sync(); err != nil {
+		return result, fmt.Errorf("flushing %q: %+v", localPath, err)
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return result, fmt.Errorf("loading file info for %q: %+v", localPath, err)
+	}
+	if fileInfo.Size() != result.ContentLength {
+		return result, fmt.Errorf("expected %q to be %d bytes (the `Content-Length` of the File) but got %d bytes", localPath, result.ContentLength, fileInfo.Size())
+	}
+
+	return result, nil
+}
+
+// DownloadToWriter is a helper method which downloads a File to `w` by retrieving ranges of the File in parallel,
+// writing each range at its offset within `w` as it's retrieved. Once downloaded the total number of bytes written
+// is verified against the `Content-Length` of the File.
+func (c Client) DownloadToWriter(ctx context.Context, shareName, path, fileName string, w io.WriterAt, input DownloadToFileInput) (result DownloadToFileResponse, err error) {
+	if w == nil {
+		return result, fmt.Errorf("`w` cannot be nil")
+	}
+
+	if err = input.validate(); err != nil {
+		return result, err
+	}
+
+	result, err = c.downloadProperties(ctx, shareName, path, fileName)
+	if err != nil {
+		return result, err
+	}
+
+	return c.downloadToWriter(ctx, shareName, path, fileName, w, input, result)
+}
+
+// downloadProperties retrieves the size and ETag of the File being downloaded
+func (c Client) downloadProperties(ctx context.Context, shareName, path, fileName string) (result DownloadToFileResponse, err error) {
+	properties, err := c.GetProperties(ctx, shareName, path, fileName)
+	result.HttpResponse = properties.HttpResponse
+	if err != nil {
+		return result, fmt.Errorf("retrieving properties: %w", err)
+	}
+	if properties.ContentLength == nil {
+		return result, fmt.Errorf("retrieving properties: `Content-Length` was nil")
+	}
+	result.ContentLength = *properties.ContentLength
+	result.ETag = properties.ETag
+	return result, nil
+}
+
+// downloadToWriter downloads the ranges of the File to `w`, where `result` contains the size of the File
+func (c Client) downloadToWriter(ctx context.Context, shareName, path, fileName string, w io.WriterAt, input DownloadToFileInput, result DownloadToFileResponse) (DownloadToFileResponse, error) {
+	length := result.ContentLength
+
+	chunkSize := input.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultDownloadChunkSize
+	}
+	ranges := splitIntoDownloadRanges(length, chunkSize)
+	tracker := progress.NewTracker(input.Progress, length)
+
+	parallelism := input.Parallelism
+	if parallelism == 0 {
+		parallelism = defaultDownloadParallelism
+	}
+	if parallelism > len(ranges) {
+		parallelism = len(ranges)
+	}
+
+	var written atomic.Int64
+	err := downloadRangesInParallel(ctx, ranges, parallelism, func(ctx context.Context, r downloadRange) error {
+		var n int64
+		err := input.TransferManager.Do(ctx, r.length, func(ctx context.Context) (err error) {
+			n, err = c.downloadRangeToWriter(ctx, shareName, path, fileName, w, r)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		written.Add(n)
+		tracker.Add(n)
+		return nil
+	})
+	if err != nil {
+		return result, fmt.Errorf("downloading %q: %w", fileName, err)
+	}
+
+	if total := written.Load(); total != length {
+		return result, fmt.Errorf("expected %d bytes (the `Content-Length` of the File) to be downloaded but got %d bytes", length, total)
+	}
+
+	tracker.Complete()
+	return result, nil
+}
+
+func (input DownloadToFileInput) validate() error {
+	if input.Parallelism < 0 {
+		return fmt.Errorf("`input.Parallelism` must be greater than or equal to 0")
+	}
+
+	if input.ChunkSize < 0 || input.ChunkSize > defaultDownloadChunkSize {
+		return fmt.Errorf("`input.ChunkSize` must be between 0 and %d", defaultDownloadChunkSize)
+	}
+
+	return nil
+}
+
+func (c Client) downloadRangeToWriter(ctx context.Context, shareName, path, fileName string, w io.WriterAt, r downloadRange) (int64, error) {
+	resp, err := c.GetByteRange(ctx, shareName, path, fileName, GetByteRangeInput{
+		StartBytes: r.offset,
+		EndBytes:   r.offset + r.length,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("retrieving bytes %d-%d: %w", r.offset, r.offset+r.length-1, err)
+	}
+
+	contents := *resp.Contents
+	if int64(len(contents)) != r.length {
+		return 0, fmt.Errorf("expected %d bytes for the range %d-%d but got %d bytes", r.length, r.offset, r.offset+r.length-1, len(contents))
+	}
+
+	written, err := w.WriteAt(contents, r.offset)
+	if err != nil {
+		return 0, fmt.Errorf("writing bytes %d-%d: %+v", r.offset, r.offset+r.length-1, err)
+	}
+
+	return int64(written), nil
+}
+
+// downloadRange is a range of bytes within a File which is retrieved as a single request
+type downloadRange struct {
+	offset int64
+	length int64
+}
+
+// splitIntoDownloadRanges splits `size` bytes into ranges of (at most) `chunkSize` bytes, where the final range
+// may be shorter - no ranges are returned when `size` is 0
+func splitIntoDownloadRanges(size, chunkSize int64) []downloadRange {
+	ranges := make([]downloadRange, 0)
+	for offset := int64(0); offset < size; offset += chunkSize {
+		length := chunkSize
+		if remaining := size - offset; remaining < length {
+			length = remaining
+		}
+		ranges = append(ranges, downloadRange{
+			offset: offset,
+			length: length,
+		})
+	}
+	return ranges
+}
+
+// downloadRangesInParallel calls `fn` for each range, using at most `parallelism` goroutines. The first error returned
+// from `fn` cancels the context passed to the remaining calls, and is returned once all calls have completed.
+func downloadRangesInParallel(ctx context.Context, ranges []downloadRange, parallelism int, fn func(ctx context.Context, r downloadRange) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var waitGroup sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	jobs := make(chan downloadRange)
+	for i := 0; i < parallelism; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for r := range jobs {
+				if err := fn(ctx, r); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	for _, r := range ranges {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- r
+	}
+	close(jobs)
+	waitGroup.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,339 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/jackofallops/giovanni/storage/transfermanager"
+)
+
+func newDownloadTestServer(t *testing.T, contents []byte) (*httptest.Server, *[]string) {
+	var lock sync.Mutex
+	ranges := make([]string, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/share/dir/file.bin" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodHead:
+			w.Header().Set("Content-Length", strconv.Itoa(len(contents)))
+			w.Header().Set("ETag", "\"0x8D\"")
+			w.WriteHeader(http.StatusOK)
+
+		case http.MethodGet:
+			v := r.Header.Get("x-ms-range")
+			lock.Lock()
+			ranges = append(ranges, v)
+			lock.Unlock()
+
+			var start, end int
+			if _, err := fmt.Sscanf(v, "bytes=%d-%d", &start, &end); err != nil || end >= len(contents) {
+				t.Errorf("unexpected `x-ms-range` header %q", v)
+				w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(contents)))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(contents[start : end+1])
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	return server, &ranges
+}
+
+func newDownloadTestClient(t *testing.T, baseUri string) *Client {
+	filesClient, err := NewWithBaseUri(baseUri)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	authorizer, err := auth.NewSharedKeyAuthorizer("account", "a2V5", auth.SharedKey)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+	filesClient.Client.SetAuthorizer(authorizer)
+	return filesClient
+}
+
+func TestDownloadToFile(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name           string
+		Size           int
+		ChunkSize      int64
+		ExpectedRanges int
+	}{
+		{
+			Name:           "Empty File",
+			Size:           0,
+			ChunkSize:      4,
+			ExpectedRanges: 0,
+		},
+		{
+			Name:           "Single Range",
+			Size:           3,
+			ChunkSize:      4,
+			ExpectedRanges: 1,
+		},
+		{
+			Name:           "Exact Ranges",
+			Size:           16,
+			ChunkSize:      4,
+			ExpectedRanges: 4,
+		},
+		{
+			Name:           "Short Final Range",
+			Size:           18,
+			ChunkSize:      4,
+			ExpectedRanges: 5,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		contents := make([]byte, v.Size)
+		for i := range contents {
+			contents[i] = byte('a' + i%26)
+		}
+
+		server, ranges := newDownloadTestServer(t, contents)
+		filesClient := newDownloadTestClient(t, server.URL)
+
+		localPath := filepath.Join(t.TempDir(), "file.bin")
+		var lastProgress int64 = -1
+		result, err := filesClient.DownloadToFile(ctx, "share", "dir", "file.bin", localPath, DownloadToFileInput{
+			ChunkSize:   v.ChunkSize,
+			Parallelism: 3,
+			Progress: func(bytesTransferred, totalBytes int64) {
+				lastProgress = bytesTransferred
+			},
+		})
+		server.Close()
+		if err != nil {
+			t.Fatalf("downloading: %+v", err)
+		}
+
+		actual, err := os.ReadFile(localPath)
+		if err != nil {
+			t.Fatalf("reading %q: %+v", localPath, err)
+		}
+		if !bytes.Equal(actual, contents) {
+			t.Fatalf("expected the file to contain %q but got %q", string(contents), string(actual))
+		}
+		if result.ContentLength != int64(v.Size) {
+			t.Fatalf("expected the ContentLength to be %d but got %d", v.Size, result.ContentLength)
+		}
+		if result.ETag != "\"0x8D\"" {
+			t.Fatalf("expected the ETag to be %q but got %q", "\"0x8D\"", result.ETag)
+		}
+		if len(*ranges) != v.ExpectedRanges {
+			t.Fatalf("expected %d ranges to be requested but got %d: %+v", v.ExpectedRanges, len(*ranges), *ranges)
+		}
+		if lastProgress != int64(v.Size) {
+			t.Fatalf("expected the final progress callback to report %d bytes but got %d", v.Size, lastProgress)
+		}
+	}
+}
+
+func TestDownloadToFilePreAllocates(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// the ranges can't be retrieved, so the size of the local file is only from the pre-allocation
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.Header().Set("Content-Length", "1024")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	filesClient := newDownloadTestClient(t, server.URL)
+	localPath := filepath.Join(t.TempDir(), "file.bin")
+	if _, err := filesClient.DownloadToFile(ctx, "share", "dir", "file.bin", localPath, DownloadToFileInput{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	fileInfo, err := os.Stat(localPath)
+	if err != nil {
+		t.Fatalf("loading file info for %q: %+v", localPath, err)
+	}
+	if fileInfo.Size() != 1024 {
+		t.Fatalf("expected the file to be pre-allocated to 1024 bytes but got %d bytes", fileInfo.Size())
+	}
+}
+
+func TestDownloadToWriterWithTransferManager(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	contents := []byte("abcdefghijklmnopqrstuvwxyz012345")
+	var inFlight, maxInFlight atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodHead:
+			w.Header().Set("Content-Length", strconv.Itoa(len(contents)))
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				previous := maxInFlight.Load()
+				if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			var start, end int
+			fmt.Sscanf(r.Header.Get("x-ms-range"), "bytes=%d-%d", &start, &end)
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(contents[start : end+1])
+		}
+	}))
+	defer server.Close()
+
+	filesClient := newDownloadTestClient(t, server.URL)
+	manager, err := transfermanager.New(transfermanager.Options{
+		MaxConcurrency: 2,
+	})
+	if err != nil {
+		t.Fatalf("building TransferManager: %+v", err)
+	}
+
+	// two downloads, each with a parallelism of 4, sharing a budget of 2 requests
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			buffer := make([]byte, len(contents))
+			_, err := filesClient.DownloadToWriter(ctx, "share", "dir", "file.bin", &sliceWriterAt{b: buffer}, DownloadToFileInput{
+				ChunkSize:       4,
+				Parallelism:     4,
+				TransferManager: manager,
+			})
+			if err != nil {
+				t.Errorf("downloading: %+v", err)
+				return
+			}
+			if !bytes.Equal(buffer, contents) {
+				t.Errorf("expected %q but got %q", string(contents), string(buffer))
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	if v := maxInFlight.Load(); v > 2 {
+		t.Fatalf("expected at most 2 ranges to be downloaded at once but got %d", v)
+	}
+}
+
+func TestDownloadToWriterShortRange(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodHead:
+			w.Header().Set("Content-Length", "8")
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			// the service returns fewer bytes than were requested, for example if the File was truncated
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write([]byte("ab"))
+		}
+	}))
+	defer server.Close()
+
+	filesClient := newDownloadTestClient(t, server.URL)
+
+	buffer := make([]byte, 8)
+	_, err := filesClient.DownloadToWriter(ctx, "share", "dir", "file.bin", &sliceWriterAt{b: buffer}, DownloadToFileInput{
+		ChunkSize: 4,
+	})
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestDownloadToFileValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name      string
+		LocalPath string
+		Input     DownloadToFileInput
+	}{
+		{
+			Name:      "No Local Path",
+			LocalPath: "",
+		},
+		{
+			Name:      "Negative Parallelism",
+			LocalPath: "file.bin",
+			Input: DownloadToFileInput{
+				Parallelism: -1,
+			},
+		},
+		{
+			Name:      "Negative Chunk Size",
+			LocalPath: "file.bin",
+			Input: DownloadToFileInput{
+				ChunkSize: -1,
+			},
+		},
+		{
+			Name:      "Chunk Size Too Large",
+			LocalPath: "file.bin",
+			Input: DownloadToFileInput{
+				ChunkSize: defaultDownloadChunkSize + 1,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).DownloadToFile(ctx, "share", "dir", "file.bin", v.LocalPath, v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+// sliceWriterAt is an io.WriterAt backed by a fixed-size slice
+type sliceWriterAt struct {
+	lock sync.Mutex
+	b    []byte
+}
+
+func (s *sliceWriterAt) WriteAt(p []byte, off int64) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if off+int64(len(p)) > int64(len(s.b)) {
+		return 0, fmt.Errorf("writing %d bytes at offset %d exceeds the buffer", len(p), off)
+	}
+	return copy(s.b[off:], p), nil
+}
@@ -162,6 +162,53 @@ func TestFilesLifeCycle(t *testing.T) {
 		t.Fatalf("Expected `second` to be `thing` but got %q", retrievedMetaData.MetaData["second"])
 	}
 
+	t.Logf("[DEBUG] Putting a Byte Range with a Content-MD5..")
+	content := []byte("hello world")
+	putResult, err := filesClient.PutByteRange(ctx, shareName, "", fileName, PutByteRangeInput{
+		StartBytes:        0,
+		EndBytes:          int64(len(content)),
+		Content:           content,
+		ComputeContentMD5: true,
+	})
+	if err != nil {
+		t.Fatalf("Error putting Byte Range: %s", err)
+	}
+	if putResult.ContentMD5 == "" {
+		t.Fatalf("Expected a Content-MD5 to be returned for the Byte Range but didn't get one")
+	}
+
+	t.Logf("[DEBUG] Retrieving the Byte Range..")
+	getResult, err := filesClient.GetByteRange(ctx, shareName, "", fileName, GetByteRangeInput{
+		StartBytes: 0,
+		EndBytes:   int64(len(content)),
+	})
+	if err != nil {
+		t.Fatalf("Error retrieving Byte Range: %s", err)
+	}
+	if getResult.Contents == nil || string(*getResult.Contents) != string(content) {
+		t.Fatalf("Expected the Byte Range to contain %q", string(content))
+	}
+
+	t.Logf("[DEBUG] Listing the Ranges..")
+	ranges, err := filesClient.ListRanges(ctx, shareName, "", fileName, ListRangesInput{})
+	if err != nil {
+		t.Fatalf("Error listing Ranges: %s", err)
+	}
+	if ranges.FileContentLength != updatedSize {
+		t.Fatalf("Expected the File Content Length to be %d but got %d", updatedSize, ranges.FileContentLength)
+	}
+	if len(ranges.Ranges) != 1 || ranges.Ranges[0].Start != 0 {
+		t.Fatalf("Expected a single Range starting at 0 but got %+v", ranges.Ranges)
+	}
+
+	t.Logf("[DEBUG] Clearing the Byte Range..")
+	if _, err := filesClient.ClearByteRange(ctx, shareName, "", fileName, ClearByteRangeInput{
+		StartBytes: 0,
+		EndBytes:   512,
+	}); err != nil {
+		t.Fatalf("Error clearing Byte Range: %s", err)
+	}
+
 	t.Logf("[DEBUG] Deleting Top Level File..")
 	if _, err := filesClient.Delete(ctx, shareName, "", fileName); err != nil {
 		t.Fatalf("Error deleting Top-Level File: %s", err)
@@ -9,6 +9,10 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/nfsproperties"
+	"github.com/jackofallops/giovanni/storage/internal/smbproperties"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetResponse struct {
@@ -27,7 +31,23 @@ type GetResponse struct {
 	CopyProgress          string
 	CopyStatusDescription string
 	CopyCompletionTime    string
+	ETag                  string
 	Encrypted             bool
+	LastModified          string
+
+	// The SMB properties of the File
+	FileAttributes    string
+	FileCreationTime  string
+	FileLastWriteTime string
+	FileChangeTime    string
+	FilePermissionKey string
+	FileID            string
+	FileParentID      string
+
+	// The POSIX properties of the File, which are only returned for an NFS Share
+	Owner    string
+	Group    string
+	FileMode string
 
 	MetaData map[string]string
 }
@@ -39,8 +59,8 @@ func (c Client) GetProperties(ctx context.Context, shareName, path, fileName str
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -88,9 +108,25 @@ func (c Client) GetProperties(ctx context.Context, shareName, path, fileName str
 				result.CopySource = resp.Header.Get("x-ms-copy-source")
 				result.CopyStatus = resp.Header.Get("x-ms-copy-status")
 				result.CopyStatusDescription = resp.Header.Get("x-ms-copy-status-description")
+				result.ETag = resp.Header.Get("ETag")
 				result.Encrypted = strings.EqualFold(resp.Header.Get("x-ms-server-encrypted"), "true")
+				result.LastModified = resp.Header.Get("Last-Modified")
 				result.MetaData = metadata.ParseFromHeaders(resp.Header)
 
+				smb := smbproperties.ParseFromHeaders(resp.Header)
+				result.FileAttributes = smb.FileAttributes
+				result.FileCreationTime = smb.FileCreationTime
+				result.FileLastWriteTime = smb.FileLastWriteTime
+				result.FileChangeTime = smb.FileChangeTime
+				result.FilePermissionKey = smb.FilePermissionKey
+				result.FileID = smb.FileID
+				result.FileParentID = smb.FileParentID
+
+				nfs := nfsproperties.ParseFromHeaders(resp.Header)
+				result.Owner = nfs.Owner
+				result.Group = nfs.Group
+				result.FileMode = nfs.FileMode
+
 				contentLengthRaw := resp.Header.Get("Content-Length")
 				if contentLengthRaw != "" {
 					var contentLength int
@@ -106,7 +142,7 @@ func (c Client) GetProperties(ctx context.Context, shareName, path, fileName str
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
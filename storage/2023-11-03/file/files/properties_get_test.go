@@ -0,0 +1,62 @@
+package files
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+)
+
+func TestGetPropertiesParsesHeaders(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead || r.URL.Path != "/share/dir/file.txt" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Length", "1024")
+		w.Header().Set("ETag", "\"0x8D\"")
+		w.Header().Set("Last-Modified", "Tue, 02 Jan 2024 03:04:05 GMT")
+		w.Header().Set("x-ms-file-attributes", "ReadOnly|Archive")
+		w.Header().Set("x-ms-file-creation-time", "2024-01-02T03:04:05.1234567Z")
+		w.Header().Set("x-ms-file-id", "13835128424026341376")
+		w.Header().Set("x-ms-meta-hello", "world")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	filesClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	authorizer, err := auth.NewSharedKeyAuthorizer("account", "a2V5", auth.SharedKey)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+	filesClient.Client.SetAuthorizer(authorizer)
+
+	props, err := filesClient.GetProperties(ctx, "share", "dir", "file.txt")
+	if err != nil {
+		t.Fatalf("retrieving properties: %+v", err)
+	}
+	if props.ContentLength == nil || *props.ContentLength != 1024 {
+		t.Fatalf("expected the ContentLength to be 1024 but got %+v", props.ContentLength)
+	}
+	if props.ETag != "\"0x8D\"" {
+		t.Fatalf("expected the ETag to be %q but got %q", "\"0x8D\"", props.ETag)
+	}
+	if props.LastModified != "Tue, 02 Jan 2024 03:04:05 GMT" {
+		t.Fatalf("expected the LastModified to be %q but got %q", "Tue, 02 Jan 2024 03:04:05 GMT", props.LastModified)
+	}
+	if props.FileAttributes != "ReadOnly|Archive" || props.FileCreationTime != "2024-01-02T03:04:05.1234567Z" || props.FileID != "13835128424026341376" {
+		t.Fatalf("unexpected SMB properties: %+v", props)
+	}
+	if props.MetaData["hello"] != "world" {
+		t.Fatalf("expected the MetaData to contain `hello` but got %+v", props.MetaData)
+	}
+}
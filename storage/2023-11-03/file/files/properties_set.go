@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/internal/nfsproperties"
+	"github.com/jackofallops/giovanni/storage/internal/smbproperties"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetPropertiesInput struct {
@@ -55,20 +59,42 @@ type SetPropertiesInput struct {
 	// unless it is explicitly set on the file again.
 	ContentType *string
 
-	// The time at which this file was created at - if omitted, this'll be set to "now"
+	// The time at which this file was created at - if omitted, the existing value is preserved
 	// This maps to the `x-ms-file-creation-time` field.
 	CreatedAt *time.Time
 
-	// The time at which this file was last modified - if omitted, this'll be set to "now"
+	// The time at which this file was last modified - if omitted, the existing value is preserved
 	// This maps to the `x-ms-file-last-write-time` field.
 	LastModified *time.Time
 
 	// MetaData is a mapping of key value pairs which should be assigned to this file
 	MetaData map[string]string
+
+	// An optional SDDL permission which should be assigned to this file, when neither this nor
+	// FilePermissionKey are specified the existing permission is preserved.
+	// This maps to the `x-ms-file-permission` field and cannot be specified alongside FilePermissionKey.
+	FilePermission *string
+
+	// An optional key for a permission created using the Share's CreatePermission method, which must
+	// be used for permissions larger than 8KiB. This maps to the `x-ms-file-permission-key` field
+	// and cannot be specified alongside FilePermission.
+	FilePermissionKey *string
+
+	// An optional pipe-separated list of attributes which should be assigned to this file, e.g. `ReadOnly|Hidden`
+	// If omitted, the existing attributes are preserved. This maps to the `x-ms-file-attributes` field.
+	FileAttributes *string
+
+	// Optional - The POSIX properties which should be assigned to this file within an NFS Share. When specified
+	// the SMB-only headers (`x-ms-file-permission`, `x-ms-file-permission-key` and `x-ms-file-attributes`) aren't
+	// sent, and so FilePermission, FilePermissionKey and FileAttributes cannot be specified.
+	NFSProperties *NFSProperties
 }
 
 type SetPropertiesResponse struct {
 	HttpResponse *http.Response
+
+	ETag         string
+	LastModified time.Time
 }
 
 // SetProperties sets the specified properties on the specified File
@@ -78,8 +104,8 @@ func (c Client) SetProperties(ctx context.Context, shareName, path, fileName str
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -88,6 +114,22 @@ func (c Client) SetProperties(ctx context.Context, shareName, path, fileName str
 		return
 	}
 
+	if err = input.smbProperties().Validate(smbproperties.UpdateDefaults); err != nil {
+		err = fmt.Errorf("`input` is not valid: %s", err)
+		return
+	}
+
+	if input.NFSProperties != nil {
+		if err = input.smbProperties().ValidateForNFS(); err != nil {
+			err = fmt.Errorf("`input` is not valid: %s", err)
+			return
+		}
+		if err = input.nfsProperties().Validate(); err != nil {
+			err = fmt.Errorf("`input.NFSProperties` is not valid: %s", err)
+			return
+		}
+	}
+
 	if path != "" {
 		path = fmt.Sprintf("%s/", path)
 	}
@@ -101,7 +143,7 @@ func (c Client) SetProperties(ctx context.Context, shareName, path, fileName str
 		OptionsObject: SetPropertiesOptions{
 			input: input,
 		},
-		Path: fmt.Sprintf("%s/%s%s", shareName, path, fileName),
+		Path: fmt.Sprintf("/%s/%s%s", shareName, path, fileName),
 	}
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -114,36 +156,58 @@ func (c Client) SetProperties(ctx context.Context, shareName, path, fileName str
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+				if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
+func (i SetPropertiesInput) smbProperties() smbproperties.Properties {
+	return smbproperties.Properties{
+		FilePermission:    i.FilePermission,
+		FilePermissionKey: i.FilePermissionKey,
+		FileAttributes:    i.FileAttributes,
+		CreationTime:      i.CreatedAt,
+		LastWriteTime:     i.LastModified,
+	}
+}
+
+func (i SetPropertiesInput) nfsProperties() nfsproperties.Properties {
+	return nfsproperties.Properties{
+		Owner:         i.NFSProperties.Owner,
+		Group:         i.NFSProperties.Group,
+		FileMode:      i.NFSProperties.FileMode,
+		CreationTime:  i.CreatedAt,
+		LastWriteTime: i.LastModified,
+	}
+}
+
 type SetPropertiesOptions struct {
 	input SetPropertiesInput
 }
 
 func (s SetPropertiesOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	var coalesceDate = func(input *time.Time, defaultVal string) string {
-		if input == nil {
-			return defaultVal
-		}
-
-		return input.Format(time.RFC1123)
-	}
-
 	headers.Append("x-ms-type", "file")
 
 	headers.Append("x-ms-content-length", strconv.Itoa(int(s.input.ContentLength)))
-	headers.Append("x-ms-file-permission", "inherit") // TODO: expose this in future
-	headers.Append("x-ms-file-attributes", "None")    // TODO: expose this in future
-	headers.Append("x-ms-file-creation-time", coalesceDate(s.input.CreatedAt, "now"))
-	headers.Append("x-ms-file-last-write-time", coalesceDate(s.input.LastModified, "now"))
+	if s.input.NFSProperties != nil {
+		headers.Merge(s.input.nfsProperties().Headers())
+	} else {
+		headers.Merge(s.input.smbProperties().Headers(smbproperties.UpdateDefaults))
+	}
 
 	if s.input.ContentControl != nil {
 		headers.Append("x-ms-cache-control", *s.input.ContentControl)
@@ -6,10 +6,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"io"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetByteRangeInput struct {
@@ -30,8 +31,8 @@ func (c Client) GetByteRange(ctx context.Context, shareName, path, fileName stri
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -45,16 +46,12 @@ func (c Client) GetByteRange(ctx context.Context, shareName, path, fileName stri
 		return
 	}
 
-	if input.EndBytes <= 0 {
-		err = fmt.Errorf("`input.EndBytes` must be greater than 0")
+	if input.EndBytes <= input.StartBytes {
+		err = fmt.Errorf("`input.EndBytes` must be greater than `input.StartBytes`")
 		return
 	}
 
 	expectedBytes := input.EndBytes - input.StartBytes
-	if expectedBytes < (4 * 1024) {
-		err = fmt.Errorf("requested Byte Range must be at least 4KB")
-		return
-	}
 	if expectedBytes > (4 * 1024 * 1024) {
 		err = fmt.Errorf("requested Byte Range must be at most 4MB")
 		return
@@ -103,7 +100,7 @@ func (c Client) GetByteRange(ctx context.Context, shareName, path, fileName stri
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -8,10 +8,21 @@ import (
 	"net/http"
 	"runtime"
 	"sync"
+
+	"github.com/jackofallops/giovanni/storage/internal/progress"
+	"github.com/jackofallops/giovanni/storage/transfermanager"
 )
 
 type GetFileInput struct {
 	Parallelism int
+
+	// Optional - A TransferManager shared with other uploads and downloads, which limits the number of chunks
+	// downloaded at once (and the bandwidth used) across all of them, in addition to Parallelism
+	TransferManager *transfermanager.TransferManager
+
+	// Optional - A callback which is fired as each chunk is downloaded, with the cumulative number of bytes
+	// downloaded and the size of the file. A final callback is fired once the file has been downloaded.
+	Progress func(bytesTransferred, totalBytes int64)
 }
 
 type GetFileResponse struct {
@@ -45,6 +56,7 @@ func (c Client) GetFile(ctx context.Context, shareName, path, fileName string, i
 
 	// then split that up into chunks and retrieve it into the 'results' set
 	chunks := int(math.Ceil(float64(length) / float64(chunkSize)))
+	tracker := progress.NewTracker(input.Progress, length)
 	workerCount := input.Parallelism * runtime.NumCPU()
 	if workerCount > chunks {
 		workerCount = chunks
@@ -64,9 +76,18 @@ func (c Client) GetFile(ctx context.Context, shareName, path, fileName string, i
 				thisChunk: i,
 				chunkSize: chunkSize,
 				fileSize:  length,
+				tracker:   tracker,
 			}
 
-			result, err := c.downloadFileChunk(ctx, shareName, path, fileName, dfci)
+			chunkLength := chunkSize
+			if remaining := length - int64(i)*chunkSize; remaining < chunkLength {
+				chunkLength = remaining
+			}
+			var result *downloadFileChunkResult
+			err := input.TransferManager.Do(ctx, chunkLength, func(ctx context.Context) (err error) {
+				result, err = c.downloadFileChunk(ctx, shareName, path, fileName, dfci)
+				return err
+			})
 			if err != nil {
 				errors <- err
 				waitGroup.Done()
@@ -97,6 +118,7 @@ func (c Client) GetFile(ctx context.Context, shareName, path, fileName string, i
 		result.OutputBytes = &[]byte{}
 	}
 	*result.OutputBytes = output
+	tracker.Complete()
 	return
 }
 
@@ -104,6 +126,7 @@ type downloadFileChunkInput struct {
 	thisChunk int
 	chunkSize int64
 	fileSize  int64
+	tracker   *progress.Tracker
 }
 
 type downloadFileChunkResult struct {
@@ -130,6 +153,7 @@ func (c Client) downloadFileChunk(ctx context.Context, shareName, path, fileName
 	if err != nil {
 		return nil, fmt.Errorf("error putting bytes: %s", err)
 	}
+	input.tracker.Add(endBytes - startBytes)
 
 	output := downloadFileChunkResult{
 		startBytes: startBytes,
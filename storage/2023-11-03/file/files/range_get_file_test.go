@@ -91,10 +91,20 @@ func testGetFile(t *testing.T, fileName string, contentType string) {
 	}
 
 	t.Logf("[DEBUG] Downloading file..")
-	resp, err := filesClient.GetFile(ctx, shareName, "", fileName, GetFileInput{Parallelism: 4})
+	var downloaded int64
+	getInput := GetFileInput{
+		Parallelism: 4,
+		Progress: func(bytesTransferred, totalBytes int64) {
+			downloaded = bytesTransferred
+		},
+	}
+	resp, err := filesClient.GetFile(ctx, shareName, "", fileName, getInput)
 	if err != nil {
 		t.Fatalf("Error downloading file: %s", err)
 	}
+	if downloaded != info.Size() {
+		t.Fatalf("Expected the progress to report %d bytes downloaded but got %d", info.Size(), downloaded)
+	}
 
 	t.Logf("[DEBUG] Asserting the files are the same size..")
 	expectedBytes := make([]byte, info.Size())
@@ -7,10 +7,15 @@ import (
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/checksum"
+	"github.com/jackofallops/giovanni/storage/internal/lastmodified"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PutByteRangeInput struct {
@@ -20,10 +25,24 @@ type PutByteRangeInput struct {
 	// Content is the File Contents for the specified range
 	// which can be at most 4MB
 	Content []byte
+
+	// The base64-encoded MD5 hash of the Content, used to verify the integrity of the range during transport.
+	// This cannot be specified alongside ComputeContentMD5.
+	ContentMD5 *string
+
+	// Should the MD5 hash of the Content be computed and sent as the `Content-MD5` header?
+	// This cannot be specified alongside ContentMD5.
+	ComputeContentMD5 bool
 }
 
 type PutRangeResponse struct {
 	HttpResponse *http.Response
+
+	// The base64-encoded MD5 hash of the range, as computed by the service
+	ContentMD5 string
+
+	ETag         string
+	LastModified time.Time
 }
 
 // PutByteRange puts the specified Byte Range in the specified File.
@@ -33,8 +52,8 @@ func (c Client) PutByteRange(ctx context.Context, shareName, path, fileName stri
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -47,15 +66,15 @@ func (c Client) PutByteRange(ctx context.Context, shareName, path, fileName stri
 		err = fmt.Errorf("`input.StartBytes` must be greater or equal to 0")
 		return
 	}
-	if input.EndBytes <= 0 {
-		err = fmt.Errorf("`input.EndBytes` must be greater than 0")
+	if input.EndBytes <= input.StartBytes {
+		err = fmt.Errorf("`input.EndBytes` must be greater than `input.StartBytes`")
 		return
 	}
 
 	expectedBytes := input.EndBytes - input.StartBytes
 	actualBytes := len(input.Content)
 	if expectedBytes != int64(actualBytes) {
-		err = fmt.Errorf(fmt.Sprintf("The specified byte-range (%d) didn't match the content size (%d).", expectedBytes, actualBytes))
+		err = fmt.Errorf("the specified byte-range (%d) didn't match the content size (%d)", expectedBytes, actualBytes)
 		return
 	}
 
@@ -64,6 +83,15 @@ func (c Client) PutByteRange(ctx context.Context, shareName, path, fileName stri
 		return
 	}
 
+	if input.ContentMD5 != nil && input.ComputeContentMD5 {
+		err = fmt.Errorf("at most one of `input.ContentMD5` and `input.ComputeContentMD5` can be specified")
+		return
+	}
+
+	if input.ComputeContentMD5 {
+		input.ContentMD5 = pointer.To(checksum.MD5(input.Content))
+	}
+
 	if path != "" {
 		path = fmt.Sprintf("%s/", path)
 	}
@@ -92,9 +120,17 @@ func (c Client) PutByteRange(ctx context.Context, shareName, path, fileName stri
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			result.ContentMD5 = resp.Header.Get("Content-MD5")
+			result.ETag = resp.Header.Get("ETag")
+			if result.LastModified, err = lastmodified.Parse(resp.Header); err != nil {
+				return
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -110,6 +146,9 @@ func (p PutRangeOptions) ToHeaders() *client.Headers {
 	headers.Append("x-ms-write", "update")
 	headers.Append("x-ms-range", fmt.Sprintf("bytes=%d-%d", p.input.StartBytes, p.input.EndBytes-1))
 	headers.Append("Content-Length", strconv.Itoa(len(p.input.Content)))
+	if p.input.ContentMD5 != nil {
+		headers.Append("Content-MD5", *p.input.ContentMD5)
+	}
 	return headers
 }
 
@@ -8,10 +8,34 @@ import (
 	"math"
 	"os"
 	"sync"
+
+	"github.com/jackofallops/giovanni/storage/internal/progress"
+	"github.com/jackofallops/giovanni/storage/transfermanager"
 )
 
+type PutFileInput struct {
+	// The number of chunks which should be uploaded in parallel
+	Parallelism int
+
+	// Optional - A TransferManager shared with other uploads and downloads, which limits the number of chunks
+	// uploaded at once (and the bandwidth used) across all of them, in addition to Parallelism
+	TransferManager *transfermanager.TransferManager
+
+	// Optional - A callback which is fired as each chunk is uploaded, with the cumulative number of bytes
+	// uploaded and the size of the file. A final callback is fired once the file has been uploaded.
+	Progress func(bytesTransferred, totalBytes int64)
+}
+
 // PutFile is a helper method which takes a file, and automatically chunks it up, rather than having to do this yourself
 func (c Client) PutFile(ctx context.Context, shareName, path, fileName string, file *os.File, parallelism int) error {
+	return c.PutFileWithInput(ctx, shareName, path, fileName, file, PutFileInput{
+		Parallelism: parallelism,
+	})
+}
+
+// PutFileWithInput uploads the file in chunks in the same manner as PutFile, allowing a TransferManager and
+// a Progress callback to be specified
+func (c Client) PutFileWithInput(ctx context.Context, shareName, path, fileName string, file *os.File, input PutFileInput) error {
 	fileInfo, err := file.Stat()
 	if err != nil {
 		return fmt.Errorf("error loading file info: %s", err)
@@ -26,8 +50,9 @@ func (c Client) PutFile(ctx context.Context, shareName, path, fileName string, f
 		chunkSize = int(fileSize)
 	}
 	chunks := int(math.Ceil(float64(fileSize) / float64(chunkSize*1.0)))
+	tracker := progress.NewTracker(input.Progress, fileSize)
 
-	workerCount := parallelism
+	workerCount := input.Parallelism
 	if workerCount > chunks {
 		workerCount = chunks
 	}
@@ -47,9 +72,17 @@ func (c Client) PutFile(ctx context.Context, shareName, path, fileName string, f
 					thisChunk: i,
 					chunkSize: chunkSize,
 					fileSize:  fileSize,
+					tracker:   tracker,
 				}
 
-				_, err := c.uploadChunk(ctx, shareName, path, fileName, uci, file)
+				length := int64(chunkSize)
+				if remaining := fileSize - int64(i*chunkSize); remaining < length {
+					length = remaining
+				}
+				err := input.TransferManager.Do(ctx, length, func(ctx context.Context) error {
+					_, err := c.uploadChunk(ctx, shareName, path, fileName, uci, file)
+					return err
+				})
 				if err != nil {
 					errors <- err
 				}
@@ -68,6 +101,7 @@ func (c Client) PutFile(ctx context.Context, shareName, path, fileName string, f
 	if len(errors) > 0 {
 		return fmt.Errorf("uploading file: %s", <-errors)
 	}
+	tracker.Complete()
 
 	return nil
 }
@@ -76,6 +110,7 @@ type uploadChunkInput struct {
 	thisChunk int
 	chunkSize int
 	fileSize  int64
+	tracker   *progress.Tracker
 }
 
 func (c Client) uploadChunk(ctx context.Context, shareName, path, fileName string, input uploadChunkInput, file *os.File) (result PutRangeResponse, err error) {
@@ -107,6 +142,7 @@ func (c Client) uploadChunk(ctx context.Context, shareName, path, fileName strin
 	if err != nil {
 		return result, fmt.Errorf("putting bytes: %s", err)
 	}
+	input.tracker.Add(int64(bytesToRead))
 
 	return
 }
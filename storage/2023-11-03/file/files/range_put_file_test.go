@@ -1,9 +1,15 @@
 package files
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -100,3 +106,57 @@ func testPutFile(t *testing.T, fileName string, contentType string) {
 		t.Fatalf("Error deleting Top-Level File: %s", err)
 	}
 }
+
+func TestPutFileWithInputProgress(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Query().Get("comp") != "range" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests.Add(1)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	// 4MB chunks, so this is uploaded as 2 chunks
+	contents := bytes.Repeat([]byte("a"), 5*1024*1024)
+	fileName := filepath.Join(t.TempDir(), "file.bin")
+	if err := os.WriteFile(fileName, contents, 0600); err != nil {
+		t.Fatalf("writing file: %+v", err)
+	}
+	file, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("opening file: %+v", err)
+	}
+	defer file.Close()
+
+	var lock sync.Mutex
+	var lastTransferred, lastTotal int64
+	filesClient := newDownloadTestClient(t, server.URL)
+	input := PutFileInput{
+		Parallelism: 2,
+		Progress: func(bytesTransferred, totalBytes int64) {
+			lock.Lock()
+			defer lock.Unlock()
+			if bytesTransferred < lastTransferred {
+				t.Errorf("expected the progress to increase but got %d after %d", bytesTransferred, lastTransferred)
+			}
+			lastTransferred = bytesTransferred
+			lastTotal = totalBytes
+		},
+	}
+	if err := filesClient.PutFileWithInput(ctx, "share", "dir", "file.bin", file, input); err != nil {
+		t.Fatalf("uploading file: %+v", err)
+	}
+
+	if v := requests.Load(); v != 2 {
+		t.Fatalf("expected 2 chunks to be uploaded but got %d", v)
+	}
+	if lastTransferred != int64(len(contents)) || lastTotal != int64(len(contents)) {
+		t.Fatalf("expected the final progress to be %d of %d but got %d of %d", len(contents), len(contents), lastTransferred, lastTotal)
+	}
+}
@@ -0,0 +1,125 @@
+package files
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestPutRangeOptionsHeaders(t *testing.T) {
+	headers := PutRangeOptions{
+		input: PutByteRangeInput{
+			StartBytes: 512,
+			EndBytes:   1024,
+			Content:    make([]byte, 512),
+		},
+	}.ToHeaders().Headers()
+
+	if v := headers.Get("x-ms-range"); v != "bytes=512-1023" {
+		t.Fatalf("expected `x-ms-range` to be %q but got %q", "bytes=512-1023", v)
+	}
+	if v := headers.Get("x-ms-write"); v != "update" {
+		t.Fatalf("expected `x-ms-write` to be %q but got %q", "update", v)
+	}
+	if v := headers.Get("Content-MD5"); v != "" {
+		t.Fatalf("expected `Content-MD5` to be omitted when unset but got %q", v)
+	}
+
+	headers = PutRangeOptions{
+		input: PutByteRangeInput{
+			StartBytes: 0,
+			EndBytes:   5,
+			Content:    []byte("hello"),
+			ContentMD5: pointer.To("XUFAKrxLKna5cZ2REBfFkg=="),
+		},
+	}.ToHeaders().Headers()
+	if v := headers.Get("Content-MD5"); v != "XUFAKrxLKna5cZ2REBfFkg==" {
+		t.Fatalf("expected `Content-MD5` to be %q but got %q", "XUFAKrxLKna5cZ2REBfFkg==", v)
+	}
+}
+
+func TestPutByteRangeValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input PutByteRangeInput
+	}{
+		{
+			Name: "End Before Start",
+			Input: PutByteRangeInput{
+				StartBytes: 10,
+				EndBytes:   5,
+			},
+		},
+		{
+			Name: "Content Size Mismatch",
+			Input: PutByteRangeInput{
+				StartBytes: 0,
+				EndBytes:   10,
+				Content:    []byte("hello"),
+			},
+		},
+		{
+			Name: "Larger than 4MiB",
+			Input: PutByteRangeInput{
+				StartBytes: 0,
+				EndBytes:   4*1024*1024 + 1,
+				Content:    make([]byte, 4*1024*1024+1),
+			},
+		},
+		{
+			Name: "Both ContentMD5 and ComputeContentMD5",
+			Input: PutByteRangeInput{
+				StartBytes:        0,
+				EndBytes:          5,
+				Content:           []byte("hello"),
+				ContentMD5:        pointer.To("XUFAKrxLKna5cZ2REBfFkg=="),
+				ComputeContentMD5: true,
+			},
+		},
+	}
+
+	c := Client{}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := c.PutByteRange(ctx, "share", "", "file.txt", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestPutByteRangeResponse(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-MD5", "XUFAKrxLKna5cZ2REBfFkg==")
+		w.Header().Set("ETag", "\"0x8D\"")
+		w.Header().Set("Last-Modified", "Tue, 02 Jan 2024 03:04:05 GMT")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	filesClient := newDownloadTestClient(t, server.URL)
+	result, err := filesClient.PutByteRange(ctx, "share", "dir", "file.txt", PutByteRangeInput{
+		StartBytes: 0,
+		EndBytes:   5,
+		Content:    []byte("hello"),
+	})
+	if err != nil {
+		t.Fatalf("putting range: %+v", err)
+	}
+	if result.ETag != "\"0x8D\"" {
+		t.Fatalf("expected the ETag to be %q but got %q", "\"0x8D\"", result.ETag)
+	}
+	if expected := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC); !result.LastModified.Equal(expected) {
+		t.Fatalf("expected the LastModified to be %s but got %s", expected, result.LastModified)
+	}
+}
@@ -4,42 +4,73 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"strconv"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
+type ListRangesInput struct {
+	// An optional Share Snapshot from which the Ranges should be listed
+	ShareSnapshot *string
+
+	// An optional previous Share Snapshot, when specified only the Ranges which have changed
+	// since this Snapshot are returned - with Ranges which were cleared returned as ClearRanges
+	PreviousShareSnapshot *string
+
+	// An optional byte range within which the Ranges should be listed, both of which must be specified
+	StartBytes *int64
+	EndBytes   *int64
+}
+
 type ListRangesResponse struct {
 	HttpResponse *http.Response
 
+	// The size of the File in bytes
+	FileContentLength int64
+
+	// The Ranges within the File which contain data
 	Ranges []Range `xml:"Range"`
+
+	// The Ranges which have been cleared since the PreviousShareSnapshot, only populated when
+	// PreviousShareSnapshot is specified
+	ClearRanges []Range `xml:"ClearRange"`
 }
 
 type Range struct {
-	Start string `xml:"Start"`
-	End   string `xml:"End"`
+	// The offset of the first byte within this Range
+	Start int64 `xml:"Start"`
+
+	// The offset of the last byte within this Range (inclusive)
+	End int64 `xml:"End"`
 }
 
 // ListRanges returns the list of valid ranges for the specified File.
-func (c Client) ListRanges(ctx context.Context, shareName, path, fileName string) (result ListRangesResponse, err error) {
+func (c Client) ListRanges(ctx context.Context, shareName, path, fileName string, input ListRangesInput) (result ListRangesResponse, err error) {
 	if shareName == "" {
 		err = fmt.Errorf("`shareName` cannot be an empty string")
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
-	if path == "" {
-		err = fmt.Errorf("`path` cannot be an empty string")
+	if fileName == "" {
+		err = fmt.Errorf("`fileName` cannot be an empty string")
 		return
 	}
 
-	if fileName == "" {
-		err = fmt.Errorf("`fileName` cannot be an empty string")
+	if (input.StartBytes == nil) != (input.EndBytes == nil) {
+		err = fmt.Errorf("`input.StartBytes` and `input.EndBytes` must either both be specified or both be omitted")
+		return
+	}
+
+	if input.StartBytes != nil && (*input.StartBytes < 0 || *input.EndBytes <= *input.StartBytes) {
+		err = fmt.Errorf("`input.StartBytes` must be greater or equal to 0 and `input.EndBytes` must be greater than `input.StartBytes`")
 		return
 	}
 
@@ -52,9 +83,11 @@ func (c Client) ListRanges(ctx context.Context, shareName, path, fileName string
 		ExpectedStatusCodes: []int{
 			http.StatusOK,
 		},
-		HttpMethod:    http.MethodGet,
-		OptionsObject: ListRangeOptions{},
-		Path:          fmt.Sprintf("/%s/%s%s", shareName, path, fileName),
+		HttpMethod: http.MethodGet,
+		OptionsObject: ListRangeOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s%s", shareName, path, fileName),
 	}
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -69,6 +102,15 @@ func (c Client) ListRanges(ctx context.Context, shareName, path, fileName string
 		result.HttpResponse = resp.Response
 
 		if err == nil {
+			if v := resp.Header.Get("x-ms-content-length"); v != "" {
+				contentLength, parseErr := strconv.ParseInt(v, 10, 64)
+				if parseErr != nil {
+					err = fmt.Errorf("parsing `x-ms-content-length` header value %q: %+v", v, parseErr)
+					return
+				}
+				result.FileContentLength = contentLength
+			}
+
 			err = resp.Unmarshal(&result)
 			if err != nil {
 				err = fmt.Errorf("unmarshalling response: %+v", err)
@@ -77,17 +119,23 @@ func (c Client) ListRanges(ctx context.Context, shareName, path, fileName string
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
-type ListRangeOptions struct{}
+type ListRangeOptions struct {
+	input ListRangesInput
+}
 
 func (l ListRangeOptions) ToHeaders() *client.Headers {
-	return nil
+	headers := &client.Headers{}
+	if l.input.StartBytes != nil && l.input.EndBytes != nil {
+		headers.Append("x-ms-range", fmt.Sprintf("bytes=%d-%d", *l.input.StartBytes, *l.input.EndBytes-1))
+	}
+	return headers
 }
 
 func (l ListRangeOptions) ToOData() *odata.Query {
@@ -97,5 +145,11 @@ func (l ListRangeOptions) ToOData() *odata.Query {
 func (l ListRangeOptions) ToQuery() *client.QueryParams {
 	out := &client.QueryParams{}
 	out.Append("comp", "rangelist")
+	if l.input.ShareSnapshot != nil {
+		out.Append("sharesnapshot", *l.input.ShareSnapshot)
+	}
+	if l.input.PreviousShareSnapshot != nil {
+		out.Append("prevsharesnapshot", *l.input.PreviousShareSnapshot)
+	}
 	return out
 }
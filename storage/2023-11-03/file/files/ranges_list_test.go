@@ -0,0 +1,83 @@
+package files
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestListRangesResponseUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<Ranges>
+  <Range>
+    <Start>0</Start>
+    <End>511</End>
+  </Range>
+  <ClearRange>
+    <Start>512</Start>
+    <End>1023</End>
+  </ClearRange>
+  <Range>
+    <Start>5368709120</Start>
+    <End>5368709631</End>
+  </Range>
+</Ranges>`
+
+	var actual ListRangesResponse
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	expectedRanges := []Range{
+		{Start: 0, End: 511},
+		{Start: 5368709120, End: 5368709631},
+	}
+	if len(actual.Ranges) != len(expectedRanges) {
+		t.Fatalf("expected %d ranges but got %d", len(expectedRanges), len(actual.Ranges))
+	}
+	for i, v := range expectedRanges {
+		if actual.Ranges[i] != v {
+			t.Fatalf("expected range %d to be %+v but got %+v", i, v, actual.Ranges[i])
+		}
+	}
+
+	if len(actual.ClearRanges) != 1 {
+		t.Fatalf("expected 1 clear range but got %d", len(actual.ClearRanges))
+	}
+	if expected := (Range{Start: 512, End: 1023}); actual.ClearRanges[0] != expected {
+		t.Fatalf("expected the clear range to be %+v but got %+v", expected, actual.ClearRanges[0])
+	}
+}
+
+func TestListRangeOptions(t *testing.T) {
+	options := ListRangeOptions{
+		input: ListRangesInput{
+			ShareSnapshot:         pointer.To("2024-01-02T00:00:00.0000000Z"),
+			PreviousShareSnapshot: pointer.To("2024-01-01T00:00:00.0000000Z"),
+			StartBytes:            pointer.To(int64(0)),
+			EndBytes:              pointer.To(int64(1024)),
+		},
+	}
+
+	query := options.ToQuery().Values()
+	if v := query.Get("comp"); v != "rangelist" {
+		t.Fatalf("expected `comp` to be %q but got %q", "rangelist", v)
+	}
+	if v := query.Get("sharesnapshot"); v != "2024-01-02T00:00:00.0000000Z" {
+		t.Fatalf("expected `sharesnapshot` to be %q but got %q", "2024-01-02T00:00:00.0000000Z", v)
+	}
+	if v := query.Get("prevsharesnapshot"); v != "2024-01-01T00:00:00.0000000Z" {
+		t.Fatalf("expected `prevsharesnapshot` to be %q but got %q", "2024-01-01T00:00:00.0000000Z", v)
+	}
+
+	headers := options.ToHeaders().Headers()
+	if v := headers.Get("x-ms-range"); v != "bytes=0-1023" {
+		t.Fatalf("expected `x-ms-range` to be %q but got %q", "bytes=0-1023", v)
+	}
+
+	query = ListRangeOptions{}.ToQuery().Values()
+	if query.Has("prevsharesnapshot") || query.Has("sharesnapshot") {
+		t.Fatalf("expected the snapshot parameters to be omitted when unset")
+	}
+}
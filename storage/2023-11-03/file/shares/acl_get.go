@@ -4,26 +4,29 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetACLResult struct {
 	HttpResponse *http.Response
 
-	SignedIdentifiers []SignedIdentifier `xml:"SignedIdentifier"`
+	// The Stored Access Policies for the Share
+	SignedIdentifiers []SignedIdentifier
 }
 
-// GetACL get the Access Control List for the specified Storage Share
+// GetACL returns the Stored Access Policies for the specified Storage Share
 func (c Client) GetACL(ctx context.Context, shareName string) (result GetACLResult, err error) {
 	if shareName == "" {
 		err = fmt.Errorf("`shareName` cannot be an empty string")
 		return
 	}
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -33,7 +36,7 @@ func (c Client) GetACL(ctx context.Context, shareName string) (result GetACLResu
 			http.StatusOK,
 		},
 		HttpMethod:    http.MethodGet,
-		OptionsObject: getAclOptions{},
+		OptionsObject: aclOptions{},
 		Path:          fmt.Sprintf("/%s", shareName),
 	}
 
@@ -49,33 +52,34 @@ func (c Client) GetACL(ctx context.Context, shareName string) (result GetACLResu
 		result.HttpResponse = resp.Response
 
 		if err == nil {
-			err = resp.Unmarshal(&result)
+			var model signedidentifiers.SignedIdentifiers
+			err = resp.Unmarshal(&model)
 			if err != nil {
 				err = fmt.Errorf("unmarshalling response: %+v", err)
 				return
 			}
+			result.SignedIdentifiers = model.SignedIdentifiers
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
-type getAclOptions struct {
-}
+type aclOptions struct{}
 
-func (g getAclOptions) ToHeaders() *client.Headers {
+func (a aclOptions) ToHeaders() *client.Headers {
 	return nil
 }
 
-func (g getAclOptions) ToOData() *odata.Query {
+func (a aclOptions) ToOData() *odata.Query {
 	return nil
 }
 
-func (g getAclOptions) ToQuery() *client.QueryParams {
+func (a aclOptions) ToQuery() *client.QueryParams {
 	out := &client.QueryParams{}
 	out.Append("restype", "share")
 	out.Append("comp", "acl")
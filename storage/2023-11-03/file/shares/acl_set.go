@@ -1,17 +1,14 @@
 package shares
 
 import (
-	"bytes"
 	"context"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"net/http"
-	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
-	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetAclResponse struct {
@@ -19,19 +16,25 @@ type SetAclResponse struct {
 }
 
 type SetAclInput struct {
-	SignedIdentifiers []SignedIdentifier `xml:"SignedIdentifier"`
-	XMLName           xml.Name           `xml:"SignedIdentifiers"`
+	// The Stored Access Policies for the Share, which replace any existing Stored Access Policies.
+	// Up to 5 Stored Access Policies can be specified.
+	SignedIdentifiers []SignedIdentifier
 }
 
-// SetACL sets the specified Access Control List on the specified Storage Share
+// SetACL sets the Stored Access Policies for the specified Storage Share
 func (c Client) SetACL(ctx context.Context, shareName string, input SetAclInput) (result SetAclResponse, err error) {
 	if shareName == "" {
 		err = fmt.Errorf("`shareName` cannot be an empty string")
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if err = signedidentifiers.Validate(input.SignedIdentifiers); err != nil {
+		err = fmt.Errorf("`input.SignedIdentifiers` is not valid: %+v", err)
 		return
 	}
 
@@ -41,7 +44,7 @@ func (c Client) SetACL(ctx context.Context, shareName string, input SetAclInput)
 			http.StatusOK,
 		},
 		HttpMethod:    http.MethodPut,
-		OptionsObject: setAclOptions{},
+		OptionsObject: aclOptions{},
 		Path:          fmt.Sprintf("/%s", shareName),
 	}
 
@@ -51,16 +54,13 @@ func (c Client) SetACL(ctx context.Context, shareName string, input SetAclInput)
 		return
 	}
 
-	b, err := xml.Marshal(&input)
-	if err != nil {
-		err = fmt.Errorf("marshalling input: %+v", err)
+	body := signedidentifiers.SignedIdentifiers{
+		SignedIdentifiers: input.SignedIdentifiers,
+	}
+	if err = req.Marshal(&body); err != nil {
+		err = fmt.Errorf("marshaling request: %+v", err)
 		return
 	}
-	withHeader := xml.Header + string(b)
-	bytesWithHeader := []byte(withHeader)
-	req.ContentLength = int64(len(bytesWithHeader))
-	req.Header.Set("Content-Length", strconv.Itoa(len(bytesWithHeader)))
-	req.Body = io.NopCloser(bytes.NewReader(bytesWithHeader))
 
 	var resp *client.Response
 	resp, err = req.Execute(ctx)
@@ -68,28 +68,9 @@ func (c Client) SetACL(ctx context.Context, shareName string, input SetAclInput)
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
-
-type setAclOptions struct {
-	SignedIdentifiers []SignedIdentifier `xml:"SignedIdentifier"`
-}
-
-func (s setAclOptions) ToHeaders() *client.Headers {
-	return nil
-}
-
-func (s setAclOptions) ToOData() *odata.Query {
-	return nil
-}
-
-func (s setAclOptions) ToQuery() *client.QueryParams {
-	out := &client.QueryParams{}
-	out.Append("restype", "share")
-	out.Append("comp", "acl")
-	return out
-}
@@ -0,0 +1,94 @@
+package shares
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestACLRoundTrip(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var lock sync.Mutex
+	var stored []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		query := r.URL.Query()
+		if r.URL.Path != "/share" || query.Get("restype") != "share" || query.Get("comp") != "acl" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			stored, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusOK)
+			w.Write(stored)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sharesClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	input := []SignedIdentifier{
+		{
+			Id: "full",
+			AccessPolicy: AccessPolicy{
+				Start:      "2024-01-02T03:04:05.0000000Z",
+				Expiry:     "2024-02-02T03:04:05.0000000Z",
+				Permission: "rwdl",
+			},
+		},
+		{
+			Id: "permission-only",
+			AccessPolicy: AccessPolicy{
+				Permission: "r",
+			},
+		},
+	}
+	if _, err := sharesClient.SetACL(ctx, "share", SetAclInput{SignedIdentifiers: input}); err != nil {
+		t.Fatalf("setting ACL: %+v", err)
+	}
+	if strings.Count(string(stored), "<Start>") != 1 || strings.Count(string(stored), "<Expiry>") != 1 {
+		t.Fatalf("expected the empty fields to be omitted but got %q", string(stored))
+	}
+
+	result, err := sharesClient.GetACL(ctx, "share")
+	if err != nil {
+		t.Fatalf("retrieving ACL: %+v", err)
+	}
+	if !reflect.DeepEqual(input, result.SignedIdentifiers) {
+		t.Fatalf("expected %+v but got %+v", input, result.SignedIdentifiers)
+	}
+}
+
+func TestSetACLValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	input := SetAclInput{
+		SignedIdentifiers: []SignedIdentifier{
+			{Id: "duplicate"},
+			{Id: "duplicate"},
+		},
+	}
+	if _, err := (Client{}).SetACL(ctx, "share", input); err == nil {
+		t.Fatalf("expected an error for duplicate IDs but didn't get one")
+	}
+}
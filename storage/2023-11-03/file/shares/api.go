@@ -5,11 +5,17 @@ import (
 )
 
 type StorageShare interface {
+	AcquireLease(ctx context.Context, shareName string, input AcquireLeaseInput) (AcquireLeaseResponse, error)
+	BreakLease(ctx context.Context, shareName string, input BreakLeaseInput) (BreakLeaseResponse, error)
+	ChangeLease(ctx context.Context, shareName string, input ChangeLeaseInput) (ChangeLeaseResponse, error)
+	ReleaseLease(ctx context.Context, shareName string, input ReleaseLeaseInput) (ReleaseLeaseResponse, error)
+	RenewLease(ctx context.Context, shareName string, input RenewLeaseInput) (RenewLeaseResponse, error)
 	SetACL(ctx context.Context, shareName string, input SetAclInput) (SetAclResponse, error)
 	GetSnapshot(ctx context.Context, shareName string, input GetSnapshotPropertiesInput) (GetSnapshotPropertiesResponse, error)
 	GetStats(ctx context.Context, shareName string) (GetStatsResponse, error)
 	GetACL(ctx context.Context, shareName string) (GetACLResult, error)
 	SetMetaData(ctx context.Context, shareName string, input SetMetaDataInput) (SetMetaDataResponse, error)
+	Exists(ctx context.Context, shareName string) (ExistsResponse, error)
 	GetMetaData(ctx context.Context, shareName string) (GetMetaDataResponse, error)
 	SetProperties(ctx context.Context, shareName string, properties ShareProperties) (SetPropertiesResponse, error)
 	DeleteSnapshot(ctx context.Context, accountName string, shareName string, shareSnapshot string) (DeleteSnapshotResponse, error)
@@ -18,4 +24,11 @@ type StorageShare interface {
 	GetProperties(ctx context.Context, shareName string) (GetPropertiesResult, error)
 	Delete(ctx context.Context, shareName string, input DeleteInput) (DeleteResponse, error)
 	Create(ctx context.Context, shareName string, input CreateInput) (CreateResponse, error)
+	CreatePermission(ctx context.Context, shareName string, input CreatePermissionInput) (CreatePermissionResponse, error)
+	List(ctx context.Context, input ListInput) (ListResponse, error)
+	ListComplete(ctx context.Context, input ListInput) (ListCompleteResult, error)
+	NewListIterator(input ListInput) *ListIterator
+	GetPermission(ctx context.Context, shareName, filePermissionKey string) (GetPermissionResponse, error)
+	GetServiceProperties(ctx context.Context) (GetStorageServicePropertiesResponse, error)
+	SetServiceProperties(ctx context.Context, input SetStorageServicePropertiesInput) (SetStorageServicePropertiesResponse, error)
 }
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type AccessTier string
@@ -27,7 +29,7 @@ const (
 
 type CreateInput struct {
 	// Specifies the maximum size of the share, in gigabytes.
-	// Must be greater than 0, and less than or equal to 5TB (5120).
+	// Must be greater than 0, and less than or equal to 100TB (102400).
 	QuotaInGB int
 
 	// Specifies the enabled protocols on the share. If not specified, the default is SMB.
@@ -37,6 +39,10 @@ type CreateInput struct {
 
 	// Specifies the access tier of the share.
 	AccessTier *AccessTier
+
+	// Optional - Specifies how the root user is mapped when accessing the share, which can only be specified
+	// when EnabledProtocol is NFS.
+	RootSquash *RootSquash
 }
 
 type CreateResponse struct {
@@ -44,6 +50,7 @@ type CreateResponse struct {
 }
 
 // Create creates the specified Storage Share within the specified Storage Account
+// A ShareAlreadyExistsError is returned if a Share with this name already exists.
 func (c Client) Create(ctx context.Context, shareName string, input CreateInput) (result CreateResponse, err error) {
 
 	if shareName == "" {
@@ -51,13 +58,12 @@ func (c Client) Create(ctx context.Context, shareName string, input CreateInput)
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
-	if input.QuotaInGB <= 0 || input.QuotaInGB > 102400 {
-		err = fmt.Errorf("`input.QuotaInGB` must be greater than 0, and less than/equal to 100TB (102400 GB)")
+	if err = validateQuotaInGB("input.QuotaInGB", input.QuotaInGB); err != nil {
 		return
 	}
 
@@ -66,6 +72,29 @@ func (c Client) Create(ctx context.Context, shareName string, input CreateInput)
 		return
 	}
 
+	switch input.EnabledProtocol {
+	case "", SMB, NFS:
+	default:
+		err = fmt.Errorf("`input.EnabledProtocol` must be one of %q or %q but got %q", SMB, NFS, input.EnabledProtocol)
+		return
+	}
+
+	if input.AccessTier != nil {
+		if err = validateAccessTier("input.AccessTier", *input.AccessTier); err != nil {
+			return
+		}
+	}
+
+	if input.RootSquash != nil {
+		if input.EnabledProtocol != NFS {
+			err = fmt.Errorf("`input.RootSquash` can only be specified when `input.EnabledProtocol` is %q", NFS)
+			return
+		}
+		if err = validateRootSquash("input.RootSquash", *input.RootSquash); err != nil {
+			return
+		}
+	}
+
 	// Retry the share creation if a conflicting share is still in the process of being deleted
 	retryFunc := func(resp *http.Response, _ *odata.OData) (bool, error) {
 		if resp != nil {
@@ -112,9 +141,16 @@ func (c Client) Create(ctx context.Context, shareName string, input CreateInput)
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err != nil && resp.StatusCode == http.StatusConflict && resp.Header.Get("x-ms-error-code") == "ShareAlreadyExists" {
+			err = ShareAlreadyExistsError{
+				ShareName: shareName,
+			}
+			return
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -142,6 +178,10 @@ func (c CreateOptions) ToHeaders() *client.Headers {
 		headers.Append("x-ms-access-tier", string(*c.input.AccessTier))
 	}
 
+	if c.input.RootSquash != nil {
+		headers.Append("x-ms-root-squash", string(*c.input.RootSquash))
+	}
+
 	headers.Append("x-ms-share-quota", strconv.Itoa(c.input.QuotaInGB))
 
 	return headers
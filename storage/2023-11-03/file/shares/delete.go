@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteResponse struct {
@@ -15,7 +16,16 @@ type DeleteResponse struct {
 }
 
 type DeleteInput struct {
+	// Should any Snapshots of this Share also be deleted? A Share which has Snapshots
+	// can only be deleted when this is set.
 	DeleteSnapshots bool
+
+	// Should any leased Snapshots of this Share also be deleted? This implies DeleteSnapshots.
+	DeleteLeasedSnapshots bool
+
+	// Snapshot is an optional Snapshot of this Share which should be deleted - rather than the Share itself.
+	// This is the Date Time returned from CreateSnapshot, and cannot be combined with DeleteSnapshots.
+	Snapshot *string
 }
 
 // Delete deletes the specified Storage Share from within a Storage Account
@@ -25,11 +35,22 @@ func (c Client) Delete(ctx context.Context, shareName string, input DeleteInput)
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
+	if input.Snapshot != nil {
+		if *input.Snapshot == "" {
+			err = fmt.Errorf("`input.Snapshot` cannot be an empty string")
+			return
+		}
+		if input.DeleteSnapshots || input.DeleteLeasedSnapshots {
+			err = fmt.Errorf("`input.DeleteSnapshots` and `input.DeleteLeasedSnapshots` cannot be specified when deleting a Snapshot")
+			return
+		}
+	}
+
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
@@ -37,7 +58,9 @@ func (c Client) Delete(ctx context.Context, shareName string, input DeleteInput)
 		},
 		HttpMethod: http.MethodDelete,
 		OptionsObject: DeleteOptions{
-			deleteSnapshots: input.DeleteSnapshots,
+			deleteSnapshots:       input.DeleteSnapshots,
+			deleteLeasedSnapshots: input.DeleteLeasedSnapshots,
+			snapshot:              input.Snapshot,
 		},
 		Path: fmt.Sprintf("/%s", shareName),
 	}
@@ -53,7 +76,7 @@ func (c Client) Delete(ctx context.Context, shareName string, input DeleteInput)
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -61,12 +84,16 @@ func (c Client) Delete(ctx context.Context, shareName string, input DeleteInput)
 }
 
 type DeleteOptions struct {
-	deleteSnapshots bool
+	deleteSnapshots       bool
+	deleteLeasedSnapshots bool
+	snapshot              *string
 }
 
 func (d DeleteOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	if d.deleteSnapshots {
+	if d.deleteLeasedSnapshots {
+		headers.Append("x-ms-delete-snapshots", "include-leased")
+	} else if d.deleteSnapshots {
 		headers.Append("x-ms-delete-snapshots", "include")
 	}
 	return headers
@@ -79,5 +106,8 @@ func (d DeleteOptions) ToOData() *odata.Query {
 func (d DeleteOptions) ToQuery() *client.QueryParams {
 	out := &client.QueryParams{}
 	out.Append("restype", "share")
+	if d.snapshot != nil {
+		out.Append("sharesnapshot", *d.snapshot)
+	}
 	return out
 }
@@ -0,0 +1,104 @@
+package shares
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestDeleteOptions(t *testing.T) {
+	testData := []struct {
+		Name                  string
+		Input                 DeleteInput
+		ExpectedDeleteHeader  string
+		ExpectedSnapshotQuery string
+	}{
+		{
+			Name:  "Share",
+			Input: DeleteInput{},
+		},
+		{
+			Name: "Share and Snapshots",
+			Input: DeleteInput{
+				DeleteSnapshots: true,
+			},
+			ExpectedDeleteHeader: "include",
+		},
+		{
+			Name: "Share and Leased Snapshots",
+			Input: DeleteInput{
+				DeleteSnapshots:       true,
+				DeleteLeasedSnapshots: true,
+			},
+			ExpectedDeleteHeader: "include-leased",
+		},
+		{
+			Name: "Snapshot",
+			Input: DeleteInput{
+				Snapshot: pointer.To("2024-01-02T03:04:05.0000000Z"),
+			},
+			ExpectedSnapshotQuery: "2024-01-02T03:04:05.0000000Z",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		options := DeleteOptions{
+			deleteSnapshots:       v.Input.DeleteSnapshots,
+			deleteLeasedSnapshots: v.Input.DeleteLeasedSnapshots,
+			snapshot:              v.Input.Snapshot,
+		}
+
+		headers := options.ToHeaders().Headers()
+		if actual := headers.Get("x-ms-delete-snapshots"); actual != v.ExpectedDeleteHeader {
+			t.Fatalf("expected `x-ms-delete-snapshots` to be %q but got %q", v.ExpectedDeleteHeader, actual)
+		}
+
+		query := options.ToQuery().Values()
+		if actual := query.Get("restype"); actual != "share" {
+			t.Fatalf("expected `restype` to be %q but got %q", "share", actual)
+		}
+		if actual := query.Get("sharesnapshot"); actual != v.ExpectedSnapshotQuery {
+			t.Fatalf("expected `sharesnapshot` to be %q but got %q", v.ExpectedSnapshotQuery, actual)
+		}
+	}
+}
+
+func TestDeleteSnapshotValidation(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input DeleteInput
+	}{
+		{
+			Name: "Empty Snapshot",
+			Input: DeleteInput{
+				Snapshot: pointer.To(""),
+			},
+		},
+		{
+			Name: "Snapshot and Delete Snapshots",
+			Input: DeleteInput{
+				DeleteSnapshots: true,
+				Snapshot:        pointer.To("2024-01-02T03:04:05.0000000Z"),
+			},
+		},
+		{
+			Name: "Snapshot and Delete Leased Snapshots",
+			Input: DeleteInput{
+				DeleteLeasedSnapshots: true,
+				Snapshot:              pointer.To("2024-01-02T03:04:05.0000000Z"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		// validation happens before the request is built, so a Client isn't required
+		if _, err := (Client{}).Delete(context.TODO(), "share", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
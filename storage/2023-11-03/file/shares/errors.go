@@ -0,0 +1,17 @@
+package shares
+
+import (
+	"fmt"
+)
+
+var _ error = ShareAlreadyExistsError{}
+
+// ShareAlreadyExistsError is returned when attempting to create a Share which already exists
+type ShareAlreadyExistsError struct {
+	// The name of the Share which already exists
+	ShareName string
+}
+
+func (e ShareAlreadyExistsError) Error() string {
+	return fmt.Sprintf("the share %q already exists", e.ShareName)
+}
@@ -0,0 +1,115 @@
+package shares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type AcquireLeaseInput struct {
+	// Specifies the duration of the lease, in seconds, or negative one (-1) for a lease that never expires.
+	// A non-infinite lease can be between 15 and 60 seconds
+	LeaseDuration int
+
+	// The Proposed new ID for the Lease
+	ProposedLeaseID *string
+}
+
+type AcquireLeaseResponse struct {
+	HttpResponse *http.Response
+
+	LeaseID string
+}
+
+// AcquireLease establishes and manages a lock on a Share for delete operations.
+func (c Client) AcquireLease(ctx context.Context, shareName string, input AcquireLeaseInput) (result AcquireLeaseResponse, err error) {
+	if shareName == "" {
+		err = fmt.Errorf("`shareName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if input.ProposedLeaseID != nil && *input.ProposedLeaseID == "" {
+		err = fmt.Errorf("`input.ProposedLeaseID` cannot be an empty string, if specified")
+		return
+	}
+
+	// An infinite lease duration is -1 seconds. A non-infinite lease can be between 15 and 60 seconds
+	if input.LeaseDuration != -1 && (input.LeaseDuration < 15 || input.LeaseDuration > 60) {
+		err = fmt.Errorf("`input.LeaseDuration` must be -1 (infinite), or between 15 and 60 seconds")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: acquireLeaseOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s", shareName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.LeaseID = resp.Header.Get("x-ms-lease-id")
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type acquireLeaseOptions struct {
+	input AcquireLeaseInput
+}
+
+func (a acquireLeaseOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+
+	headers.Append("x-ms-lease-action", "acquire")
+	headers.Append("x-ms-lease-duration", strconv.Itoa(a.input.LeaseDuration))
+
+	if a.input.ProposedLeaseID != nil {
+		headers.Append("x-ms-proposed-lease-id", *a.input.ProposedLeaseID)
+	}
+
+	return headers
+}
+
+func (a acquireLeaseOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (a acquireLeaseOptions) ToQuery() *client.QueryParams {
+	out := sharesOptions{}.ToQuery()
+	out.Append("comp", "lease")
+	return out
+}
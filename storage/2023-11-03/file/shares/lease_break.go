@@ -0,0 +1,132 @@
+package shares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type BreakLeaseInput struct {
+	//  For a break operation, proposed duration the lease should continue
+	//  before it is broken, in seconds, between 0 and 60.
+	//  This break period is only used if it is shorter than the time remaining on the lease.
+	//  If longer, the time remaining on the lease is used.
+	//  A new lease will not be available before the break period has expired,
+	//  but the lease may be held for longer than the break period.
+	//  If this header does not appear with a break operation, a fixed-duration lease breaks
+	//  after the remaining lease period elapses, and an infinite lease breaks immediately.
+	BreakPeriod *int
+
+	// The ID of the Lease, which is optional when breaking the Lease on a Share
+	LeaseID *string
+}
+
+type BreakLeaseResponse struct {
+	HttpResponse *http.Response
+
+	// Approximate time remaining in the lease period, in seconds.
+	// If the break is immediate, 0 is returned.
+	LeaseTime int
+}
+
+// BreakLease breaks an existing lock on a Share.
+func (c Client) BreakLease(ctx context.Context, shareName string, input BreakLeaseInput) (result BreakLeaseResponse, err error) {
+	if shareName == "" {
+		err = fmt.Errorf("`shareName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if input.LeaseID != nil && *input.LeaseID == "" {
+		err = fmt.Errorf("`input.LeaseID` cannot be an empty string, if specified")
+		return
+	}
+
+	if input.BreakPeriod != nil && (*input.BreakPeriod < 0 || *input.BreakPeriod > 60) {
+		err = fmt.Errorf("`input.BreakPeriod` must be between 0 and 60 seconds, if specified")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: breakLeaseOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s", shareName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				if v := resp.Header.Get("x-ms-lease-time"); v != "" {
+					i, innerErr := strconv.Atoi(v)
+					if innerErr != nil {
+						err = fmt.Errorf("parsing `x-ms-lease-time` header value %q: %+v", v, innerErr)
+						return
+					}
+					result.LeaseTime = i
+				}
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type breakLeaseOptions struct {
+	input BreakLeaseInput
+}
+
+func (b breakLeaseOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+
+	headers.Append("x-ms-lease-action", "break")
+
+	if b.input.LeaseID != nil {
+		headers.Append("x-ms-lease-id", *b.input.LeaseID)
+	}
+
+	if b.input.BreakPeriod != nil {
+		headers.Append("x-ms-lease-break-period", strconv.Itoa(*b.input.BreakPeriod))
+	}
+
+	return headers
+}
+
+func (b breakLeaseOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (b breakLeaseOptions) ToQuery() *client.QueryParams {
+	out := sharesOptions{}.ToQuery()
+	out.Append("comp", "lease")
+	return out
+}
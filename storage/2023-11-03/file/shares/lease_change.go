@@ -0,0 +1,106 @@
+package shares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ChangeLeaseInput struct {
+	ExistingLeaseID string
+	ProposedLeaseID string
+}
+
+type ChangeLeaseResponse struct {
+	HttpResponse *http.Response
+
+	LeaseID string
+}
+
+// ChangeLease changes the lock on a Share from one Lease ID to another Lease ID.
+func (c Client) ChangeLease(ctx context.Context, shareName string, input ChangeLeaseInput) (result ChangeLeaseResponse, err error) {
+	if shareName == "" {
+		err = fmt.Errorf("`shareName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if input.ExistingLeaseID == "" {
+		err = fmt.Errorf("`input.ExistingLeaseID` cannot be an empty string")
+		return
+	}
+
+	if input.ProposedLeaseID == "" {
+		err = fmt.Errorf("`input.ProposedLeaseID` cannot be an empty string")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: changeLeaseOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s", shareName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.LeaseID = resp.Header.Get("x-ms-lease-id")
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type changeLeaseOptions struct {
+	input ChangeLeaseInput
+}
+
+func (c changeLeaseOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+
+	headers.Append("x-ms-lease-action", "change")
+	headers.Append("x-ms-lease-id", c.input.ExistingLeaseID)
+	headers.Append("x-ms-proposed-lease-id", c.input.ProposedLeaseID)
+
+	return headers
+}
+
+func (c changeLeaseOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (c changeLeaseOptions) ToQuery() *client.QueryParams {
+	out := sharesOptions{}.ToQuery()
+	out.Append("comp", "lease")
+	return out
+}
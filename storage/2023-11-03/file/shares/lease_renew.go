@@ -0,0 +1,91 @@
+package shares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type RenewLeaseInput struct {
+	LeaseID string
+}
+
+type RenewLeaseResponse struct {
+	HttpResponse *http.Response
+}
+
+// RenewLease renews a lock on a Share based on the Lease ID.
+func (c Client) RenewLease(ctx context.Context, shareName string, input RenewLeaseInput) (result RenewLeaseResponse, err error) {
+	if shareName == "" {
+		err = fmt.Errorf("`shareName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if input.LeaseID == "" {
+		err = fmt.Errorf("`input.LeaseID` cannot be an empty string")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: renewLeaseOptions{
+			leaseID: input.LeaseID,
+		},
+		Path: fmt.Sprintf("/%s", shareName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type renewLeaseOptions struct {
+	leaseID string
+}
+
+func (r renewLeaseOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+
+	headers.Append("x-ms-lease-action", "renew")
+	headers.Append("x-ms-lease-id", r.leaseID)
+
+	return headers
+}
+
+func (r renewLeaseOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (r renewLeaseOptions) ToQuery() *client.QueryParams {
+	out := sharesOptions{}.ToQuery()
+	out.Append("comp", "lease")
+	return out
+}
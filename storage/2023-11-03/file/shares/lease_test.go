@@ -0,0 +1,125 @@
+package shares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestLeaseValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input func(ctx context.Context, c Client) error
+	}{
+		{
+			Name: "Acquire with an Invalid Share Name",
+			Input: func(ctx context.Context, c Client) error {
+				_, err := c.AcquireLease(ctx, "Share", AcquireLeaseInput{LeaseDuration: -1})
+				return err
+			},
+		},
+		{
+			Name: "Acquire with a Duration which is too Short",
+			Input: func(ctx context.Context, c Client) error {
+				_, err := c.AcquireLease(ctx, "share", AcquireLeaseInput{LeaseDuration: 14})
+				return err
+			},
+		},
+		{
+			Name: "Acquire with a Duration which is too Long",
+			Input: func(ctx context.Context, c Client) error {
+				_, err := c.AcquireLease(ctx, "share", AcquireLeaseInput{LeaseDuration: 61})
+				return err
+			},
+		},
+		{
+			Name: "Acquire with an Empty Proposed Lease ID",
+			Input: func(ctx context.Context, c Client) error {
+				_, err := c.AcquireLease(ctx, "share", AcquireLeaseInput{LeaseDuration: -1, ProposedLeaseID: pointer.To("")})
+				return err
+			},
+		},
+		{
+			Name: "Renew without a Lease ID",
+			Input: func(ctx context.Context, c Client) error {
+				_, err := c.RenewLease(ctx, "share", RenewLeaseInput{})
+				return err
+			},
+		},
+		{
+			Name: "Change without a Proposed Lease ID",
+			Input: func(ctx context.Context, c Client) error {
+				_, err := c.ChangeLease(ctx, "share", ChangeLeaseInput{ExistingLeaseID: "abc123"})
+				return err
+			},
+		},
+		{
+			Name: "Release without a Lease ID",
+			Input: func(ctx context.Context, c Client) error {
+				_, err := c.ReleaseLease(ctx, "share", ReleaseLeaseInput{})
+				return err
+			},
+		},
+		{
+			Name: "Break with a Break Period which is too Long",
+			Input: func(ctx context.Context, c Client) error {
+				_, err := c.BreakLease(ctx, "share", BreakLeaseInput{BreakPeriod: pointer.To(61)})
+				return err
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if err := v.Input(ctx, Client{}); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestBreakLease(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Method != http.MethodPut || query.Get("restype") != "share" || query.Get("comp") != "lease" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get("x-ms-lease-action") != "break" || r.Header.Get("x-ms-lease-break-period") != "10" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := r.Header["X-Ms-Lease-Id"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("x-ms-lease-time", "10")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sharesClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := sharesClient.BreakLease(ctx, "share", BreakLeaseInput{
+		BreakPeriod: pointer.To(10),
+	})
+	if err != nil {
+		t.Fatalf("breaking lease: %+v", err)
+	}
+	if result.LeaseTime != 10 {
+		t.Fatalf("expected the LeaseTime to be 10 but got %d", result.LeaseTime)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/jackofallops/giovanni/storage/internal/testhelpers"
@@ -51,13 +52,28 @@ func TestSharesLifecycle(t *testing.T) {
 		t.Fatalf("Error creating fileshare: %s", err)
 	}
 
+	t.Logf("[DEBUG] Checking the share exists..")
+	exists, err := sharesClient.Exists(ctx, shareName)
+	if err != nil {
+		t.Fatalf("checking if the share exists: %+v", err)
+	}
+	if !exists.Exists {
+		t.Fatalf("Expected the share to exist")
+	}
+
+	if _, err = sharesClient.Create(ctx, shareName, input); err == nil {
+		t.Fatalf("Expected an error when creating the fileshare again but didn't get one")
+	} else if _, ok := err.(ShareAlreadyExistsError); !ok {
+		t.Fatalf("Expected a ShareAlreadyExistsError when creating the fileshare again but got: %+v", err)
+	}
+
 	snapshot, err := sharesClient.CreateSnapshot(ctx, shareName, CreateSnapshotInput{})
 	if err != nil {
 		t.Fatalf("Error taking snapshot: %s", err)
 	}
 	t.Logf("Snapshot Date Time: %s", snapshot.SnapshotDateTime)
 
-	snapshotDetails, err := sharesClient.GetSnapshot(ctx, shareName, GetSnapshotPropertiesInput{snapshotShare: snapshot.SnapshotDateTime})
+	snapshotDetails, err := sharesClient.GetSnapshot(ctx, shareName, GetSnapshotPropertiesInput{Snapshot: snapshot.SnapshotDateTime})
 	if err != nil {
 		t.Fatalf("Error retrieving snapshot: %s", err)
 	}
@@ -175,6 +191,64 @@ func TestSharesLifecycle(t *testing.T) {
 		t.Fatalf("Expected 2 identifiers but got %d", len(acls.SignedIdentifiers))
 	}
 
+	permission, err := sharesClient.CreatePermission(ctx, shareName, CreatePermissionInput{
+		Permission: "O:SYG:SYD:(A;;FA;;;SY)(A;;FA;;;BA)",
+	})
+	if err != nil {
+		t.Fatalf("Error creating permission: %s", err)
+	}
+	if permission.FilePermissionKey == "" {
+		t.Fatalf("Expected a permission key to be returned but didn't get one")
+	}
+
+	retrievedPermission, err := sharesClient.GetPermission(ctx, shareName, permission.FilePermissionKey)
+	if err != nil {
+		t.Fatalf("Error retrieving permission: %s", err)
+	}
+	if retrievedPermission.Permission == "" {
+		t.Fatalf("Expected the permission to be returned but it was empty")
+	}
+
+	t.Logf("[DEBUG] Setting the Service Properties..")
+	_, err = sharesClient.SetServiceProperties(ctx, SetStorageServicePropertiesInput{
+		Properties: StorageServiceProperties{
+			Cors: &Cors{
+				CorsRule: []CorsRule{
+					{
+						AllowedOrigins:  "https://example.com",
+						AllowedMethods:  "GET,PUT",
+						AllowedHeaders:  "x-ms-meta-*",
+						ExposedHeaders:  "x-ms-meta-*",
+						MaxAgeInSeconds: 60,
+					},
+				},
+			},
+			ShareDeleteRetentionPolicy: &RetentionPolicy{
+				Enabled: true,
+				Days:    7,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error setting the service properties: %s", err)
+	}
+
+	serviceProperties, err := sharesClient.GetServiceProperties(ctx)
+	if err != nil {
+		t.Fatalf("Error retrieving the service properties: %s", err)
+	}
+	if serviceProperties.Cors == nil || len(serviceProperties.Cors.CorsRule) != 1 {
+		t.Fatalf("Expected 1 CORS Rule but got %+v", serviceProperties.Cors)
+	}
+	if serviceProperties.ShareDeleteRetentionPolicy == nil || serviceProperties.ShareDeleteRetentionPolicy.Days != 7 {
+		t.Fatalf("Expected the Share Delete Retention Policy to be 7 days but got %+v", serviceProperties.ShareDeleteRetentionPolicy)
+	}
+
+	t.Logf("[DEBUG] Round-tripping the Service Properties..")
+	if _, err = sharesClient.SetServiceProperties(ctx, SetStorageServicePropertiesInput{Properties: serviceProperties.StorageServiceProperties}); err != nil {
+		t.Fatalf("Error re-setting the service properties: %s", err)
+	}
+
 	_, err = sharesClient.Delete(ctx, shareName, DeleteInput{DeleteSnapshots: false})
 	if err != nil {
 		t.Fatalf("Error deleting Share: %s", err)
@@ -223,14 +297,31 @@ func TestSharesLifecycleLargeQuota(t *testing.T) {
 	}
 	t.Logf("Snapshot Date Time: %s", snapshot.SnapshotDateTime)
 
-	snapshotDetails, err := sharesClient.GetSnapshot(ctx, shareName, GetSnapshotPropertiesInput{snapshotShare: snapshot.SnapshotDateTime})
+	shares, err := sharesClient.ListComplete(ctx, ListInput{
+		Include: &[]ListInclude{ListIncludeSnapshots},
+		Prefix:  &shareName,
+	})
+	if err != nil {
+		t.Fatalf("Error listing shares: %s", err)
+	}
+	foundSnapshot := false
+	for _, share := range shares.Shares {
+		if share.Name == shareName && share.Snapshot != nil && *share.Snapshot == snapshot.SnapshotDateTime {
+			foundSnapshot = true
+		}
+	}
+	if !foundSnapshot {
+		t.Fatalf("Expected the snapshot %q to be listed but got %+v", snapshot.SnapshotDateTime, shares.Shares)
+	}
+
+	snapshotDetails, err := sharesClient.GetSnapshot(ctx, shareName, GetSnapshotPropertiesInput{Snapshot: snapshot.SnapshotDateTime})
 	if err != nil {
 		t.Fatalf("Error retrieving snapshot: %s", err)
 	}
 
 	t.Logf("MetaData: %s", snapshotDetails.MetaData)
 
-	_, err = sharesClient.DeleteSnapshot(ctx, accountName, shareName, snapshot.SnapshotDateTime)
+	_, err = sharesClient.Delete(ctx, shareName, DeleteInput{Snapshot: pointer.To(snapshot.SnapshotDateTime)})
 	if err != nil {
 		t.Fatalf("Error deleting snapshot: %s", err)
 	}
@@ -366,6 +457,7 @@ func TestSharesLifecycleNFSProtocol(t *testing.T) {
 	input := CreateInput{
 		QuotaInGB:       1000,
 		EnabledProtocol: NFS,
+		RootSquash:      pointer.To(RootSquashRootSquash),
 	}
 	_, err = sharesClient.Create(ctx, shareName, input)
 	if err != nil {
@@ -379,6 +471,24 @@ func TestSharesLifecycleNFSProtocol(t *testing.T) {
 	if share.EnabledProtocol != NFS {
 		t.Fatalf(`Expected enabled protocol to be "NFS" but got: %q`, share.EnabledProtocol)
 	}
+	if share.RootSquash == nil || *share.RootSquash != RootSquashRootSquash {
+		t.Fatalf("Expected RootSquash to be %q but got: %+v", RootSquashRootSquash, share.RootSquash)
+	}
+
+	_, err = sharesClient.SetProperties(ctx, shareName, ShareProperties{
+		RootSquash: pointer.To(AllSquash),
+	})
+	if err != nil {
+		t.Fatalf("Error updating share properties: %s", err)
+	}
+
+	share, err = sharesClient.GetProperties(ctx, shareName)
+	if err != nil {
+		t.Fatalf("Error retrieving share: %s", err)
+	}
+	if share.RootSquash == nil || *share.RootSquash != AllSquash {
+		t.Fatalf("Expected RootSquash to be %q but got: %+v", AllSquash, share.RootSquash)
+	}
 
 	_, err = sharesClient.Delete(ctx, shareName, DeleteInput{DeleteSnapshots: false})
 	if err != nil {
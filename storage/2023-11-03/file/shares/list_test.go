@@ -0,0 +1,121 @@
+package shares
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestListResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example1.file.core.windows.net/">
+  <Prefix>share</Prefix>
+  <MaxResults>3</MaxResults>
+  <Shares>
+    <Share>
+      <Name>share1</Name>
+      <Snapshot>2024-01-02T03:04:05.0000000Z</Snapshot>
+      <Properties>
+        <Last-Modified>Mon, 01 Jan 2024 00:00:00 GMT</Last-Modified>
+        <Etag>"0x8D0000000000001"</Etag>
+        <Quota>5</Quota>
+      </Properties>
+    </Share>
+    <Share>
+      <Name>share1</Name>
+      <Properties>
+        <Last-Modified>Mon, 01 Jan 2024 00:00:00 GMT</Last-Modified>
+        <Etag>"0x8D0000000000002"</Etag>
+        <Quota>5</Quota>
+        <AccessTier>Cool</AccessTier>
+        <EnabledProtocols>SMB</EnabledProtocols>
+        <LeaseStatus>unlocked</LeaseStatus>
+        <LeaseState>available</LeaseState>
+      </Properties>
+      <Metadata>
+        <hello>world</hello>
+      </Metadata>
+    </Share>
+    <Share>
+      <Name>share2</Name>
+      <Deleted>true</Deleted>
+      <Version>01D60F8BB59A4652</Version>
+      <Properties>
+        <DeletedTime>Tue, 02 Jan 2024 00:00:00 GMT</DeletedTime>
+        <RemainingRetentionDays>6</RemainingRetentionDays>
+      </Properties>
+    </Share>
+  </Shares>
+  <NextMarker>/example1/share3</NextMarker>
+</EnumerationResults>`
+
+	var actual ListResult
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if actual.Prefix != "share" || actual.MaxResults != 3 {
+		t.Fatalf("unexpected values for the query: %+v", actual)
+	}
+	if actual.NextMarker == nil || *actual.NextMarker != "/example1/share3" {
+		t.Fatalf("expected the NextMarker to be %q but got %v", "/example1/share3", actual.NextMarker)
+	}
+	if len(actual.Shares) != 3 {
+		t.Fatalf("expected 3 shares but got %d", len(actual.Shares))
+	}
+
+	snapshot := actual.Shares[0]
+	if snapshot.Snapshot == nil || *snapshot.Snapshot != "2024-01-02T03:04:05.0000000Z" {
+		t.Fatalf("expected the Snapshot to be %q but got %v", "2024-01-02T03:04:05.0000000Z", snapshot.Snapshot)
+	}
+
+	share := actual.Shares[1]
+	if share.Snapshot != nil {
+		t.Fatalf("expected the share not to be a snapshot but got %q", *share.Snapshot)
+	}
+	if share.Properties.QuotaInGB == nil || *share.Properties.QuotaInGB != 5 {
+		t.Fatalf("expected the Quota to be 5 but got %v", share.Properties.QuotaInGB)
+	}
+	if share.Properties.AccessTier == nil || *share.Properties.AccessTier != string(CoolAccessTier) {
+		t.Fatalf("expected the Access Tier to be %q but got %v", CoolAccessTier, share.Properties.AccessTier)
+	}
+	if len(share.MetaData) != 1 || share.MetaData["hello"] != "world" {
+		t.Fatalf("unexpected metadata: %+v", share.MetaData)
+	}
+
+	deleted := actual.Shares[2]
+	if !deleted.Deleted || deleted.Version == nil || *deleted.Version != "01D60F8BB59A4652" {
+		t.Fatalf("expected the third share to be deleted: %+v", deleted)
+	}
+	if deleted.Properties.RemainingRetentionDays == nil || *deleted.Properties.RemainingRetentionDays != 6 {
+		t.Fatalf("expected the Remaining Retention Days to be 6 but got %v", deleted.Properties.RemainingRetentionDays)
+	}
+}
+
+func TestListOptions(t *testing.T) {
+	marker := "/example1/share3"
+	maxResults := 10
+	prefix := "share"
+	include := []ListInclude{ListIncludeSnapshots, ListIncludeMetaData, ListIncludeDeleted}
+	query := listOptions{
+		include:    &include,
+		marker:     &marker,
+		maxResults: &maxResults,
+		prefix:     &prefix,
+	}.ToQuery().Values()
+
+	expected := map[string]string{
+		"comp":       "list",
+		"include":    "snapshots,metadata,deleted",
+		"marker":     "/example1/share3",
+		"maxresults": "10",
+		"prefix":     "share",
+	}
+	if len(query) != len(expected) {
+		t.Fatalf("expected %d query parameters but got %d", len(expected), len(query))
+	}
+	for k, v := range expected {
+		if actual := query.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+}
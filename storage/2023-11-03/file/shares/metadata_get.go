@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetMetaDataResponse struct {
@@ -22,8 +23,8 @@ func (c Client) GetMetaData(ctx context.Context, shareName string) (result GetMe
 	if shareName == "" {
 		return result, fmt.Errorf("`shareName` cannot be an empty string")
 	}
-	if strings.ToLower(shareName) != shareName {
-		return result, fmt.Errorf("`shareName` must be a lower-cased string")
+	if err := naming.ValidateShareName(shareName); err != nil {
+		return result, fmt.Errorf("`shareName` is not valid: %+v", err)
 	}
 
 	opts := client.RequestOptions{
@@ -54,7 +55,7 @@ func (c Client) GetMetaData(ctx context.Context, shareName string) (result GetMe
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -1,17 +1,16 @@
 package shares
 
-import "encoding/xml"
+import (
+	"encoding/xml"
 
-type SignedIdentifier struct {
-	Id           string       `xml:"Id"`
-	AccessPolicy AccessPolicy `xml:"AccessPolicy"`
-}
+	"github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+)
 
-type AccessPolicy struct {
-	Start      string `xml:"Start"`
-	Expiry     string `xml:"Expiry"`
-	Permission string `xml:"Permission"`
-}
+// SignedIdentifier is a Stored Access Policy for a Share
+type SignedIdentifier = signedidentifiers.SignedIdentifier
+
+// AccessPolicy defines the validity period and permissions for a Stored Access Policy
+type AccessPolicy = signedidentifiers.AccessPolicy
 
 type ShareProtocol string
 
@@ -23,8 +22,85 @@ const (
 	NFS ShareProtocol = "NFS"
 )
 
+// RootSquash specifies how the root user (and group) are mapped when accessing an NFS Share
+type RootSquash string
+
+const (
+	// NoRootSquash indicates the root user retains root permissions
+	NoRootSquash RootSquash = "NoRootSquash"
+
+	// RootSquashRootSquash indicates the root user is mapped to the anonymous (nobody) user
+	RootSquashRootSquash RootSquash = "RootSquash"
+
+	// AllSquash indicates all users are mapped to the anonymous (nobody) user
+	AllSquash RootSquash = "AllSquash"
+)
+
 type ErrorResponse struct {
 	XMLName xml.Name `xml:"Error"`
 	Code    *string  `xml:"Code"`
 	Message *string  `xml:"Message"`
 }
+
+// StorageServiceProperties are the properties of the File Service within a Storage Account. Unlike the Blob and
+// Queue Services, the File Service doesn't support Logging or a Default Service Version.
+type StorageServiceProperties struct {
+	HourMetrics                *MetricsConfig    `xml:"HourMetrics,omitempty"`
+	MinuteMetrics              *MetricsConfig    `xml:"MinuteMetrics,omitempty"`
+	Cors                       *Cors             `xml:"Cors,omitempty"`
+	ShareDeleteRetentionPolicy *RetentionPolicy  `xml:"ShareDeleteRetentionPolicy,omitempty"`
+	ProtocolSettings           *ProtocolSettings `xml:"ProtocolSettings,omitempty"`
+}
+
+type MetricsConfig struct {
+	Version string `xml:"Version"`
+	Enabled bool   `xml:"Enabled"`
+
+	// Element IncludeAPIs is only expected when Metrics is enabled
+	IncludeAPIs *bool `xml:"IncludeAPIs,omitempty"`
+
+	RetentionPolicy RetentionPolicy `xml:"RetentionPolicy"`
+}
+
+type RetentionPolicy struct {
+	Enabled bool `xml:"Enabled"`
+	Days    int  `xml:"Days,omitempty"`
+}
+
+type Cors struct {
+	CorsRule []CorsRule `xml:"CorsRule"`
+}
+
+// CorsRule is a CORS rule for the File Service, where each list is a comma-separated string
+type CorsRule struct {
+	AllowedOrigins  string `xml:"AllowedOrigins"`
+	AllowedMethods  string `xml:"AllowedMethods"`
+	MaxAgeInSeconds int    `xml:"MaxAgeInSeconds"`
+	ExposedHeaders  string `xml:"ExposedHeaders"`
+	AllowedHeaders  string `xml:"AllowedHeaders"`
+}
+
+type ProtocolSettings struct {
+	SMB *SMBSettings `xml:"SMB,omitempty"`
+}
+
+type SMBSettings struct {
+	Multichannel *SMBMultichannel `xml:"Multichannel,omitempty"`
+
+	// The SMB Protocol Versions which are permitted, separated by a semi-colon - for example `SMB3.0;SMB3.1.1`
+	Versions *string `xml:"Versions,omitempty"`
+
+	// The Authentication Methods which are permitted, separated by a semi-colon - for example `NTLMv2;Kerberos`
+	AuthenticationMethods *string `xml:"AuthenticationMethods,omitempty"`
+
+	// The Kerberos Ticket Encryption algorithms which are permitted, separated by a semi-colon - for example `RC4-HMAC;AES-256`
+	KerberosTicketEncryption *string `xml:"KerberosTicketEncryption,omitempty"`
+
+	// The SMB Channel Encryption algorithms which are permitted, separated by a semi-colon - for example `AES-128-CCM;AES-256-GCM`
+	ChannelEncryption *string `xml:"ChannelEncryption,omitempty"`
+}
+
+type SMBMultichannel struct {
+	// Is SMB Multichannel enabled? This is only supported for Premium File Storage Accounts
+	Enabled bool `xml:"Enabled"`
+}
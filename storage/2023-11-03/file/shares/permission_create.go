@@ -0,0 +1,111 @@
+package shares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type CreatePermissionInput struct {
+	// The SDDL permission which should be stored at the Share level, e.g. `O:SYG:SYD:(A;;FA;;;SY)`
+	Permission string
+}
+
+type CreatePermissionResponse struct {
+	HttpResponse *http.Response
+
+	// The key for this permission, which can be specified as the `FilePermissionKey` when
+	// creating or updating Files and Directories within this Share
+	FilePermissionKey string
+}
+
+type sharePermission struct {
+	Permission string `json:"permission"`
+}
+
+// CreatePermission creates an SDDL permission at the Share level, returning a key which can be used
+// to reference this permission when creating or updating Files and Directories within this Share
+func (c Client) CreatePermission(ctx context.Context, shareName string, input CreatePermissionInput) (result CreatePermissionResponse, err error) {
+	if shareName == "" {
+		err = fmt.Errorf("`shareName` cannot be an empty string")
+		return
+	}
+
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
+		return
+	}
+
+	if input.Permission == "" {
+		err = fmt.Errorf("`input.Permission` cannot be an empty string")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: permissionOptions{},
+		Path:          fmt.Sprintf("/%s", shareName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	err = req.Marshal(&sharePermission{Permission: input.Permission})
+	if err != nil {
+		err = fmt.Errorf("marshalling request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			result.FilePermissionKey = resp.Header.Get("x-ms-file-permission-key")
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+var _ client.Options = permissionOptions{}
+
+type permissionOptions struct {
+	permissionKey *string
+}
+
+func (p permissionOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if p.permissionKey != nil {
+		headers.Append("x-ms-file-permission-key", *p.permissionKey)
+	}
+	return headers
+}
+
+func (p permissionOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (p permissionOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("restype", "share")
+	out.Append("comp", "filepermission")
+	return out
+}
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetPropertiesResult struct {
@@ -18,6 +19,9 @@ type GetPropertiesResult struct {
 	QuotaInGB       int
 	EnabledProtocol ShareProtocol
 	AccessTier      *AccessTier
+
+	// The root squash setting of the share, which is only returned for an NFS share
+	RootSquash *RootSquash
 }
 
 // GetProperties returns the properties about the specified Storage Share
@@ -27,8 +31,8 @@ func (c Client) GetProperties(ctx context.Context, shareName string) (result Get
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
@@ -55,35 +59,45 @@ func (c Client) GetProperties(ctx context.Context, shareName string) (result Get
 
 		if err == nil {
 			if resp.Header != nil {
-				result.MetaData = metadata.ParseFromHeaders(resp.Header)
-
-				quotaRaw := resp.Header.Get("x-ms-share-quota")
-				if quotaRaw != "" {
-					quota, e := strconv.Atoi(quotaRaw)
-					if e != nil {
-						err = fmt.Errorf("error converting %q to an integer: %s", quotaRaw, err)
-						return
-					}
-					result.QuotaInGB = quota
-				}
-
-				protocol := SMB
-				if protocolRaw := resp.Header.Get("x-ms-enabled-protocols"); protocolRaw != "" {
-					protocol = ShareProtocol(protocolRaw)
-				}
-
-				if accessTierRaw := resp.Header.Get("x-ms-access-tier"); accessTierRaw != "" {
-					tier := AccessTier(accessTierRaw)
-					result.AccessTier = &tier
+				if err = result.parseHeaders(resp.Header); err != nil {
+					return
 				}
-				result.EnabledProtocol = protocol
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
+
+func (r *GetPropertiesResult) parseHeaders(header http.Header) error {
+	r.MetaData = metadata.ParseFromHeaders(header)
+
+	if quotaRaw := header.Get("x-ms-share-quota"); quotaRaw != "" {
+		quota, err := strconv.Atoi(quotaRaw)
+		if err != nil {
+			return fmt.Errorf("error converting %q to an integer: %s", quotaRaw, err)
+		}
+		r.QuotaInGB = quota
+	}
+
+	r.EnabledProtocol = SMB
+	if protocolRaw := header.Get("x-ms-enabled-protocols"); protocolRaw != "" {
+		r.EnabledProtocol = ShareProtocol(protocolRaw)
+	}
+
+	if accessTierRaw := header.Get("x-ms-access-tier"); accessTierRaw != "" {
+		tier := AccessTier(accessTierRaw)
+		r.AccessTier = &tier
+	}
+
+	if rootSquashRaw := header.Get("x-ms-root-squash"); rootSquashRaw != "" {
+		rootSquash := RootSquash(rootSquashRaw)
+		r.RootSquash = &rootSquash
+	}
+
+	return nil
+}
@@ -5,34 +5,55 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type ShareProperties struct {
-	QuotaInGb  *int
+	// The new maximum size of the share, in gigabytes, between 1 and 102400 (100TB)
+	QuotaInGb *int
+
+	// The new access tier of the share
 	AccessTier *AccessTier
+
+	// The new root squash setting of the share, which can only be specified for an NFS share
+	RootSquash *RootSquash
 }
 
 type SetPropertiesResponse struct {
 	HttpResponse *http.Response
 }
 
-// SetProperties lets you update the Quota for the specified Storage Share
+// SetProperties lets you update the Quota, Access Tier and Root Squash setting for the specified Storage Share
 func (c Client) SetProperties(ctx context.Context, shareName string, properties ShareProperties) (result SetPropertiesResponse, err error) {
 
 	if shareName == "" {
 		return result, fmt.Errorf("`shareName` cannot be an empty string")
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		return result, fmt.Errorf("`shareName` must be a lower-cased string")
+	if err := naming.ValidateShareName(shareName); err != nil {
+		return result, fmt.Errorf("`shareName` is not valid: %+v", err)
+	}
+
+	if properties.QuotaInGb != nil {
+		if err = validateQuotaInGB("properties.QuotaInGb", *properties.QuotaInGb); err != nil {
+			return
+		}
 	}
 
-	if newQuotaGB := properties.QuotaInGb; newQuotaGB != nil && (*newQuotaGB <= 0 || *newQuotaGB > 102400) {
-		return result, fmt.Errorf("`newQuotaGB` must be greater than 0, and less than/equal to 100TB (102400 GB)")
+	if properties.AccessTier != nil {
+		if err = validateAccessTier("properties.AccessTier", *properties.AccessTier); err != nil {
+			return
+		}
+	}
+
+	if properties.RootSquash != nil {
+		if err = validateRootSquash("properties.RootSquash", *properties.RootSquash); err != nil {
+			return
+		}
 	}
 
 	opts := client.RequestOptions{
@@ -59,7 +80,7 @@ func (c Client) SetProperties(ctx context.Context, shareName string, properties
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -79,6 +100,10 @@ func (s SetPropertiesOptions) ToHeaders() *client.Headers {
 	if s.input.AccessTier != nil {
 		headers.Append("x-ms-access-tier", string(*s.input.AccessTier))
 	}
+
+	if s.input.RootSquash != nil {
+		headers.Append("x-ms-root-squash", string(*s.input.RootSquash))
+	}
 	return headers
 }
 
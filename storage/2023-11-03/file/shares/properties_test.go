@@ -0,0 +1,182 @@
+package shares
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestCreateOptionsRootSquash(t *testing.T) {
+	testData := []struct {
+		Name               string
+		Input              CreateInput
+		ExpectedProtocol   string
+		ExpectedRootSquash string
+	}{
+		{
+			Name:             "Default",
+			Input:            CreateInput{},
+			ExpectedProtocol: "SMB",
+		},
+		{
+			Name: "NFS",
+			Input: CreateInput{
+				EnabledProtocol: NFS,
+			},
+			ExpectedProtocol: "NFS",
+		},
+		{
+			Name: "NFS with Root Squash",
+			Input: CreateInput{
+				EnabledProtocol: NFS,
+				RootSquash:      pointer.To(AllSquash),
+			},
+			ExpectedProtocol:   "NFS",
+			ExpectedRootSquash: "AllSquash",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		headers := CreateOptions{input: v.Input}.ToHeaders().Headers()
+		if actual := headers.Get("x-ms-enabled-protocols"); actual != v.ExpectedProtocol {
+			t.Fatalf("expected `x-ms-enabled-protocols` to be %q but got %q", v.ExpectedProtocol, actual)
+		}
+		if actual := headers.Get("x-ms-root-squash"); actual != v.ExpectedRootSquash {
+			t.Fatalf("expected `x-ms-root-squash` to be %q but got %q", v.ExpectedRootSquash, actual)
+		}
+	}
+}
+
+func TestCreateValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input CreateInput
+	}{
+		{
+			Name: "Invalid Protocol",
+			Input: CreateInput{
+				QuotaInGB:       1,
+				EnabledProtocol: ShareProtocol("CIFS"),
+			},
+		},
+		{
+			Name: "Invalid Access Tier",
+			Input: CreateInput{
+				QuotaInGB:  1,
+				AccessTier: pointer.To(AccessTier("Archive")),
+			},
+		},
+		{
+			Name: "Root Squash with SMB",
+			Input: CreateInput{
+				QuotaInGB:       1,
+				EnabledProtocol: SMB,
+				RootSquash:      pointer.To(NoRootSquash),
+			},
+		},
+		{
+			Name: "Root Squash with the Default Protocol",
+			Input: CreateInput{
+				QuotaInGB:  1,
+				RootSquash: pointer.To(NoRootSquash),
+			},
+		},
+		{
+			Name: "Invalid Root Squash",
+			Input: CreateInput{
+				QuotaInGB:       1,
+				EnabledProtocol: NFS,
+				RootSquash:      pointer.To(RootSquash("SomeSquash")),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).Create(ctx, "share", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestSetPropertiesOptions(t *testing.T) {
+	headers := SetPropertiesOptions{
+		input: ShareProperties{
+			AccessTier: pointer.To(HotAccessTier),
+			RootSquash: pointer.To(NoRootSquash),
+		},
+	}.ToHeaders().Headers()
+
+	if actual := headers.Get("x-ms-access-tier"); actual != "Hot" {
+		t.Fatalf("expected `x-ms-access-tier` to be %q but got %q", "Hot", actual)
+	}
+	if actual := headers.Get("x-ms-root-squash"); actual != "NoRootSquash" {
+		t.Fatalf("expected `x-ms-root-squash` to be %q but got %q", "NoRootSquash", actual)
+	}
+	if actual := headers.Get("x-ms-share-quota"); actual != "" {
+		t.Fatalf("expected `x-ms-share-quota` to be omitted but got %q", actual)
+	}
+}
+
+func TestSetPropertiesValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input ShareProperties
+	}{
+		{
+			Name: "Invalid Access Tier",
+			Input: ShareProperties{
+				AccessTier: pointer.To(AccessTier("Archive")),
+			},
+		},
+		{
+			Name: "Invalid Root Squash",
+			Input: ShareProperties{
+				RootSquash: pointer.To(RootSquash("SomeSquash")),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).SetProperties(ctx, "share", v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestGetPropertiesParseHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-ms-share-quota", "100")
+	header.Set("x-ms-enabled-protocols", "NFS")
+	header.Set("x-ms-access-tier", "Premium")
+	header.Set("x-ms-root-squash", "RootSquash")
+
+	var result GetPropertiesResult
+	if err := result.parseHeaders(header); err != nil {
+		t.Fatalf("parsing headers: %+v", err)
+	}
+
+	if result.EnabledProtocol != NFS {
+		t.Fatalf("expected EnabledProtocol to be %q but got %q", NFS, result.EnabledProtocol)
+	}
+	if result.AccessTier == nil || *result.AccessTier != PremiumAccessTier {
+		t.Fatalf("expected AccessTier to be %q but got %+v", PremiumAccessTier, result.AccessTier)
+	}
+	if result.RootSquash == nil || *result.RootSquash != RootSquashRootSquash {
+		t.Fatalf("expected RootSquash to be %q but got %+v", RootSquashRootSquash, result.RootSquash)
+	}
+}
@@ -0,0 +1,63 @@
+package shares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type SetStorageServicePropertiesInput struct {
+	Properties StorageServiceProperties
+}
+
+type SetStorageServicePropertiesResponse struct {
+	HttpResponse *http.Response
+}
+
+// SetServiceProperties sets the properties of the File Service, including the Metrics, CORS and Protocol Settings.
+// Any properties which aren't specified are left unchanged.
+func (c Client) SetServiceProperties(ctx context.Context, input SetStorageServicePropertiesInput) (result SetStorageServicePropertiesResponse, err error) {
+	if input.Properties.Cors != nil && len(input.Properties.Cors.CorsRule) > 5 {
+		return result, fmt.Errorf("`input.Properties.Cors` can contain at most 5 CORS Rules")
+	}
+
+	if v := input.Properties.ShareDeleteRetentionPolicy; v != nil && v.Enabled && (v.Days < 1 || v.Days > 365) {
+		return result, fmt.Errorf("`input.Properties.ShareDeleteRetentionPolicy.Days` must be between 1 and 365 when enabled")
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: servicePropertiesOptions{},
+		Path:          "/",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	if err = req.Marshal(&input.Properties); err != nil {
+		err = fmt.Errorf("marshalling request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
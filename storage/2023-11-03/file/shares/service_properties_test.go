@@ -0,0 +1,123 @@
+package shares
+
+import (
+	"context"
+	"encoding/xml"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestServicePropertiesRoundTrip(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<StorageServiceProperties>
+  <HourMetrics>
+    <Version>1.0</Version>
+    <Enabled>true</Enabled>
+    <IncludeAPIs>true</IncludeAPIs>
+    <RetentionPolicy>
+      <Enabled>true</Enabled>
+      <Days>7</Days>
+    </RetentionPolicy>
+  </HourMetrics>
+  <MinuteMetrics>
+    <Version>1.0</Version>
+    <Enabled>false</Enabled>
+    <RetentionPolicy>
+      <Enabled>false</Enabled>
+    </RetentionPolicy>
+  </MinuteMetrics>
+  <Cors>
+    <CorsRule>
+      <AllowedOrigins>https://example.com</AllowedOrigins>
+      <AllowedMethods>GET,PUT</AllowedMethods>
+      <MaxAgeInSeconds>500</MaxAgeInSeconds>
+      <ExposedHeaders>x-ms-meta-*</ExposedHeaders>
+      <AllowedHeaders>x-ms-meta-abc</AllowedHeaders>
+    </CorsRule>
+  </Cors>
+  <ShareDeleteRetentionPolicy>
+    <Enabled>true</Enabled>
+    <Days>14</Days>
+  </ShareDeleteRetentionPolicy>
+  <ProtocolSettings>
+    <SMB>
+      <Multichannel>
+        <Enabled>true</Enabled>
+      </Multichannel>
+      <Versions>SMB3.0;SMB3.1.1</Versions>
+      <AuthenticationMethods>NTLMv2;Kerberos</AuthenticationMethods>
+      <KerberosTicketEncryption>AES-256</KerberosTicketEncryption>
+      <ChannelEncryption>AES-256-GCM</ChannelEncryption>
+    </SMB>
+  </ProtocolSettings>
+</StorageServiceProperties>`
+
+	var first StorageServiceProperties
+	if err := xml.Unmarshal([]byte(input), &first); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if first.HourMetrics == nil || first.HourMetrics.IncludeAPIs == nil || !*first.HourMetrics.IncludeAPIs {
+		t.Fatalf("expected the Hour Metrics configuration to be parsed but got %+v", first.HourMetrics)
+	}
+	if first.Cors == nil || len(first.Cors.CorsRule) != 1 || first.Cors.CorsRule[0].AllowedMethods != "GET,PUT" {
+		t.Fatalf("expected the CORS Rules to be parsed but got %+v", first.Cors)
+	}
+	if first.ShareDeleteRetentionPolicy == nil || first.ShareDeleteRetentionPolicy.Days != 14 {
+		t.Fatalf("expected the Share Delete Retention Policy to be parsed but got %+v", first.ShareDeleteRetentionPolicy)
+	}
+	smb := first.ProtocolSettings.SMB
+	if smb == nil || smb.Multichannel == nil || !smb.Multichannel.Enabled || smb.Versions == nil || *smb.Versions != "SMB3.0;SMB3.1.1" {
+		t.Fatalf("expected the SMB Protocol Settings to be parsed but got %+v", smb)
+	}
+
+	marshalled, err := xml.Marshal(first)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	var second StorageServiceProperties
+	if err := xml.Unmarshal(marshalled, &second); err != nil {
+		t.Fatalf("unmarshalling the marshalled properties: %+v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("expected the properties to round-trip but got:\n\n%+v\n\nand\n\n%+v", first, second)
+	}
+}
+
+func TestSetServicePropertiesValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name  string
+		Input StorageServiceProperties
+	}{
+		{
+			Name: "Too Many CORS Rules",
+			Input: StorageServiceProperties{
+				Cors: &Cors{
+					CorsRule: make([]CorsRule, 6),
+				},
+			},
+		},
+		{
+			Name: "Share Delete Retention Policy Too Long",
+			Input: StorageServiceProperties{
+				ShareDeleteRetentionPolicy: &RetentionPolicy{
+					Enabled: true,
+					Days:    366,
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, err := (Client{}).SetServiceProperties(ctx, SetStorageServicePropertiesInput{Properties: v.Input}); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
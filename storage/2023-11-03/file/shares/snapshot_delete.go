@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteSnapshotResponse struct {
@@ -21,8 +22,8 @@ func (c Client) DeleteSnapshot(ctx context.Context, accountName, shareName strin
 		return result, fmt.Errorf("`shareName` cannot be an empty string")
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		return result, fmt.Errorf("`shareName` must be a lower-cased string")
+	if err := naming.ValidateShareName(shareName); err != nil {
+		return result, fmt.Errorf("`shareName` is not valid: %+v", err)
 	}
 
 	if shareSnapshot == "" {
@@ -53,7 +54,7 @@ func (c Client) DeleteSnapshot(ctx context.Context, accountName, shareName strin
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
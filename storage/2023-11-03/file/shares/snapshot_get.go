@@ -4,37 +4,36 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
-	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
-type GetSnapshotPropertiesResponse struct {
-	HttpResponse *http.Response
-
-	MetaData map[string]string
-}
+// GetSnapshotPropertiesResponse contains the same properties as GetPropertiesResult
+type GetSnapshotPropertiesResponse = GetPropertiesResult
 
 type GetSnapshotPropertiesInput struct {
-	snapshotShare string
+	// The Snapshot of the Share to retrieve, this is the Date Time returned from CreateSnapshot
+	// (or from ListShares when Snapshots are included.)
+	Snapshot string
 }
 
-// GetSnapshot gets information about the specified Snapshot of the specified Storage Share
+// GetSnapshot gets the properties of the specified Snapshot of the specified Storage Share
 func (c Client) GetSnapshot(ctx context.Context, shareName string, input GetSnapshotPropertiesInput) (result GetSnapshotPropertiesResponse, err error) {
 	if shareName == "" {
 		err = fmt.Errorf("`shareName` cannot be an empty string")
 		return
 	}
 
-	if strings.ToLower(shareName) != shareName {
-		err = fmt.Errorf("`shareName` must be a lower-cased string")
+	if err = naming.ValidateShareName(shareName); err != nil {
+		err = fmt.Errorf("`shareName` is not valid: %+v", err)
 		return
 	}
 
-	if input.snapshotShare == "" {
-		err = fmt.Errorf("`snapshotShare` cannot be an empty string")
+	if input.Snapshot == "" {
+		err = fmt.Errorf("`input.Snapshot` cannot be an empty string")
 		return
 	}
 
@@ -45,7 +44,7 @@ func (c Client) GetSnapshot(ctx context.Context, shareName string, input GetSnap
 		},
 		HttpMethod: http.MethodGet,
 		OptionsObject: snapShotGetOptions{
-			snapshotShare: input.snapshotShare,
+			snapshot: input.Snapshot,
 		},
 		Path: fmt.Sprintf("/%s", shareName),
 	}
@@ -63,12 +62,14 @@ func (c Client) GetSnapshot(ctx context.Context, shareName string, input GetSnap
 
 		if err == nil {
 			if resp.Header != nil {
-				result.MetaData = metadata.ParseFromHeaders(resp.Header)
+				if err = result.parseHeaders(resp.Header); err != nil {
+					return
+				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -76,7 +77,7 @@ func (c Client) GetSnapshot(ctx context.Context, shareName string, input GetSnap
 }
 
 type snapShotGetOptions struct {
-	snapshotShare string
+	snapshot string
 }
 
 func (s snapShotGetOptions) ToHeaders() *client.Headers {
@@ -90,6 +91,6 @@ func (s snapShotGetOptions) ToOData() *odata.Query {
 func (s snapShotGetOptions) ToQuery() *client.QueryParams {
 	out := &client.QueryParams{}
 	out.Append("restype", "share")
-	out.Append("snapshot", s.snapshotShare)
+	out.Append("sharesnapshot", s.snapshot)
 	return out
 }
@@ -0,0 +1,36 @@
+package shares
+
+import (
+	"fmt"
+)
+
+const (
+	// minimumQuotaInGB is the smallest quota which can be assigned to a Share (1GiB)
+	minimumQuotaInGB = 1
+
+	// maximumQuotaInGB is the largest quota which can be assigned to a Share (100TiB)
+	maximumQuotaInGB = 102400
+)
+
+func validateAccessTier(field string, input AccessTier) error {
+	switch input {
+	case TransactionOptimizedAccessTier, HotAccessTier, CoolAccessTier, PremiumAccessTier:
+		return nil
+	}
+	return fmt.Errorf("`%s` must be one of %q, %q, %q or %q but got %q", field, TransactionOptimizedAccessTier, HotAccessTier, CoolAccessTier, PremiumAccessTier, input)
+}
+
+func validateRootSquash(field string, input RootSquash) error {
+	switch input {
+	case NoRootSquash, RootSquashRootSquash, AllSquash:
+		return nil
+	}
+	return fmt.Errorf("`%s` must be one of %q, %q or %q but got %q", field, NoRootSquash, RootSquashRootSquash, AllSquash, input)
+}
+
+func validateQuotaInGB(field string, input int) error {
+	if input < minimumQuotaInGB || input > maximumQuotaInGB {
+		return fmt.Errorf("`%s` must be between %d and %d GB (100TB) but got %d", field, minimumQuotaInGB, maximumQuotaInGB, input)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package shares
+
+import "testing"
+
+func TestValidateQuotaInGB(t *testing.T) {
+	testData := []struct {
+		Input         int
+		ShouldBeValid bool
+	}{
+		{
+			Input:         -1,
+			ShouldBeValid: false,
+		},
+		{
+			Input:         0,
+			ShouldBeValid: false,
+		},
+		{
+			Input:         1,
+			ShouldBeValid: true,
+		},
+		{
+			Input:         5120,
+			ShouldBeValid: true,
+		},
+		{
+			Input:         102400,
+			ShouldBeValid: true,
+		},
+		{
+			Input:         102401,
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %d..", v.Input)
+
+		err := validateQuotaInGB("input.QuotaInGB", v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %d to be valid but got: %+v", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %d to be invalid but it was valid", v.Input)
+		}
+	}
+}
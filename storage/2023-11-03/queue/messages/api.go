@@ -5,6 +5,7 @@ import (
 )
 
 type StorageQueueMessage interface {
+	Clear(ctx context.Context, queueName string) (ClearResponse, error)
 	Delete(ctx context.Context, queueName string, messageID string, input DeleteInput) (DeleteResponse, error)
 	Peek(ctx context.Context, queueName string, input PeekInput) (QueueMessagesListResponse, error)
 	Put(ctx context.Context, queueName string, input PutInput) (QueueMessagesListResponse, error)
@@ -0,0 +1,68 @@
+package messages
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ClearResponse struct {
+	HttpResponse *http.Response
+}
+
+// Clear deletes all of the messages from the specified queue
+func (c Client) Clear(ctx context.Context, queueName string) (result ClearResponse, err error) {
+	if queueName == "" {
+		return result, fmt.Errorf("`queueName` cannot be an empty string")
+	}
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+		},
+		HttpMethod:    http.MethodDelete,
+		OptionsObject: clearOptions{},
+		Path:          fmt.Sprintf("/%s/messages", queueName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type clearOptions struct{}
+
+func (c clearOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (c clearOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (c clearOptions) ToQuery() *client.QueryParams {
+	return nil
+}
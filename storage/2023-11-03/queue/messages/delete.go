@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteResponse struct {
@@ -25,8 +26,8 @@ func (c Client) Delete(ctx context.Context, queueName, messageID string, input D
 		return result, fmt.Errorf("`queueName` cannot be an empty string")
 	}
 
-	if strings.ToLower(queueName) != queueName {
-		return result, fmt.Errorf("`queueName` must be a lower-cased string")
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
 	}
 
 	if messageID == "" {
@@ -61,7 +62,7 @@ func (c Client) Delete(ctx context.Context, queueName, messageID string, input D
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
-	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetInput struct {
@@ -27,17 +27,15 @@ func (c Client) Get(ctx context.Context, queueName string, input GetInput) (resu
 	if queueName == "" {
 		return result, fmt.Errorf("`queueName` cannot be an empty string")
 	}
-	if strings.ToLower(queueName) != queueName {
-		return result, fmt.Errorf("`queueName` must be a lower-cased string")
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
 	}
 	if input.NumberOfMessages < 1 || input.NumberOfMessages > 32 {
 		return result, fmt.Errorf("`input.NumberOfMessages` must be between 1 and 32")
 	}
 	if input.VisibilityTimeout != nil {
-		t := *input.VisibilityTimeout
-		maxTime := (time.Hour * 24 * 7).Seconds()
-		if t < 1 || t < int(maxTime) {
-			return result, fmt.Errorf("`input.VisibilityTimeout` must be larger than or equal to 1 second, and cannot be larger than 7 days")
+		if err = validateVisibilityTimeout(*input.VisibilityTimeout, 1); err != nil {
+			return
 		}
 	}
 
@@ -74,7 +72,7 @@ func (c Client) Get(ctx context.Context, queueName string, input GetInput) (resu
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
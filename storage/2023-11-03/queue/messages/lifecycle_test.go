@@ -72,7 +72,7 @@ func TestLifeCycle(t *testing.T) {
 	messageId := (*putResp.QueueMessages)[0].MessageId
 	popReceipt := (*putResp.QueueMessages)[0].PopReceipt
 
-	_, err = messagesClient.Update(ctx, queueName, messageId, UpdateInput{
+	updateResp, err := messagesClient.Update(ctx, queueName, messageId, UpdateInput{
 		PopReceipt:        popReceipt,
 		Message:           "Updated message",
 		VisibilityTimeout: 65,
@@ -80,6 +80,12 @@ func TestLifeCycle(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error updating: %s", err)
 	}
+	if updateResp.PopReceipt == "" {
+		t.Fatalf("Expected a new Pop Receipt to be returned when updating the message")
+	}
+	if updateResp.TimeNextVisible.IsZero() {
+		t.Fatalf("Expected the Time Next Visible to be returned when updating the message")
+	}
 
 	for i := 0; i < 5; i++ {
 		input := PutInput{
@@ -106,11 +112,32 @@ func TestLifeCycle(t *testing.T) {
 	}
 
 	for _, v := range *retrievedMessages.QueueMessages {
-		t.Logf("Message: %q", v.MessageId)
+		t.Logf("Message: %q (Dequeue Count %d)", v.MessageId, v.DequeueCount)
+		if v.DequeueCount != 1 {
+			t.Fatalf("Expected the Dequeue Count to be 1 but got %d", v.DequeueCount)
+		}
 
 		_, err = messagesClient.Delete(ctx, queueName, v.MessageId, DeleteInput{PopReceipt: v.PopReceipt})
 		if err != nil {
 			t.Fatalf("Error deleting message from queue: %s", err)
 		}
 	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := messagesClient.Put(ctx, queueName, PutInput{Message: fmt.Sprintf("Cleared message %d", i)}); err != nil {
+			t.Fatalf("Error putting message %d in queue: %s", i, err)
+		}
+	}
+
+	if _, err := messagesClient.Clear(ctx, queueName); err != nil {
+		t.Fatalf("Error clearing messages: %s", err)
+	}
+
+	peakedMessages, err = messagesClient.Peek(ctx, queueName, PeekInput{NumberOfMessages: 32})
+	if err != nil {
+		t.Fatalf("Error peaking messages: %s", err)
+	}
+	if peakedMessages.QueueMessages != nil && len(*peakedMessages.QueueMessages) != 0 {
+		t.Fatalf("Expected no messages after clearing the queue but got %d", len(*peakedMessages.QueueMessages))
+	}
 }
@@ -1,7 +1,10 @@
 package messages
 
 import (
+	"encoding/xml"
+	"fmt"
 	"net/http"
+	"time"
 )
 
 type QueueMessage struct {
@@ -15,9 +18,74 @@ type QueueMessagesListResponse struct {
 }
 
 type QueueMessageResponse struct {
+	// The ID of this Message
+	MessageId string
+
+	// The contents of this Message, which is not returned when putting a Message
+	MessageText string
+
+	// The time at which this Message was added to the Queue
+	InsertionTime time.Time
+
+	// The time at which this Message will expire and be deleted from the Queue
+	ExpirationTime time.Time
+
+	// The Pop Receipt required to Delete or Update this Message, which is not returned when peeking Messages
+	PopReceipt string
+
+	// The time at which this Message will become visible in the Queue, which is not returned when peeking Messages
+	TimeNextVisible time.Time
+
+	// The number of times this Message has been retrieved, which is not returned when putting a Message
+	DequeueCount int
+}
+
+type queueMessageResponse struct {
 	MessageId       string `xml:"MessageId"`
+	MessageText     string `xml:"MessageText"`
 	InsertionTime   string `xml:"InsertionTime"`
 	ExpirationTime  string `xml:"ExpirationTime"`
 	PopReceipt      string `xml:"PopReceipt"`
 	TimeNextVisible string `xml:"TimeNextVisible"`
+	DequeueCount    int    `xml:"DequeueCount"`
+}
+
+func (r *QueueMessageResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var model queueMessageResponse
+	if err := d.DecodeElement(&model, &start); err != nil {
+		return err
+	}
+
+	insertionTime, err := parseTime(model.InsertionTime)
+	if err != nil {
+		return fmt.Errorf("parsing `InsertionTime`: %+v", err)
+	}
+	expirationTime, err := parseTime(model.ExpirationTime)
+	if err != nil {
+		return fmt.Errorf("parsing `ExpirationTime`: %+v", err)
+	}
+	timeNextVisible, err := parseTime(model.TimeNextVisible)
+	if err != nil {
+		return fmt.Errorf("parsing `TimeNextVisible`: %+v", err)
+	}
+
+	*r = QueueMessageResponse{
+		MessageId:       model.MessageId,
+		MessageText:     model.MessageText,
+		InsertionTime:   insertionTime,
+		ExpirationTime:  expirationTime,
+		PopReceipt:      model.PopReceipt,
+		TimeNextVisible: timeNextVisible,
+		DequeueCount:    model.DequeueCount,
+	}
+	return nil
+}
+
+// parseTime parses the RFC 1123 formatted times returned by the Queue Service, returning
+// the zero value when the time was not returned
+func parseTime(input string) (time.Time, error) {
+	if input == "" {
+		return time.Time{}, nil
+	}
+	return http.ParseTime(input)
 }
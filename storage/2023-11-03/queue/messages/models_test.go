@@ -0,0 +1,69 @@
+package messages
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestQueueMessagesListResponseUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<QueueMessagesList>
+  <QueueMessage>
+    <MessageId>00000000-0000-0000-0000-000000000001</MessageId>
+    <InsertionTime>Mon, 01 Jan 2024 00:00:00 GMT</InsertionTime>
+    <ExpirationTime>Mon, 08 Jan 2024 00:00:00 GMT</ExpirationTime>
+    <PopReceipt>AgAAAAMAAAAAAAAA</PopReceipt>
+    <TimeNextVisible>Mon, 01 Jan 2024 00:00:30 GMT</TimeNextVisible>
+    <DequeueCount>2</DequeueCount>
+    <MessageText>hello world</MessageText>
+  </QueueMessage>
+  <QueueMessage>
+    <MessageId>00000000-0000-0000-0000-000000000002</MessageId>
+    <InsertionTime>Mon, 01 Jan 2024 00:00:00 GMT</InsertionTime>
+    <ExpirationTime>Mon, 08 Jan 2024 00:00:00 GMT</ExpirationTime>
+    <DequeueCount>0</DequeueCount>
+    <MessageText>peeked</MessageText>
+  </QueueMessage>
+</QueueMessagesList>`
+
+	var actual QueueMessagesListResponse
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if actual.QueueMessages == nil || len(*actual.QueueMessages) != 2 {
+		t.Fatalf("expected 2 messages but got %+v", actual.QueueMessages)
+	}
+
+	first := (*actual.QueueMessages)[0]
+	expected := QueueMessageResponse{
+		MessageId:       "00000000-0000-0000-0000-000000000001",
+		MessageText:     "hello world",
+		InsertionTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExpirationTime:  time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+		PopReceipt:      "AgAAAAMAAAAAAAAA",
+		TimeNextVisible: time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC),
+		DequeueCount:    2,
+	}
+	if first != expected {
+		t.Fatalf("expected %+v but got %+v", expected, first)
+	}
+
+	second := (*actual.QueueMessages)[1]
+	if !second.TimeNextVisible.IsZero() {
+		t.Fatalf("expected TimeNextVisible to be the zero value when omitted but got %s", second.TimeNextVisible)
+	}
+	if second.PopReceipt != "" {
+		t.Fatalf("expected PopReceipt to be empty when omitted but got %q", second.PopReceipt)
+	}
+}
+
+func TestQueueMessagesListResponseUnmarshalInvalidTime(t *testing.T) {
+	input := `<QueueMessagesList><QueueMessage><MessageId>abc</MessageId><InsertionTime>yesterday</InsertionTime></QueueMessage></QueueMessagesList>`
+
+	var actual QueueMessagesListResponse
+	if err := xml.Unmarshal([]byte(input), &actual); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PeekInput struct {
@@ -24,8 +25,8 @@ func (c Client) Peek(ctx context.Context, queueName string, input PeekInput) (re
 		return result, fmt.Errorf("`queueName` cannot be an empty string")
 	}
 
-	if strings.ToLower(queueName) != queueName {
-		return result, fmt.Errorf("`queueName` must be a lower-cased string")
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
 	}
 
 	if input.NumberOfMessages < 1 || input.NumberOfMessages > 32 {
@@ -64,7 +65,7 @@ func (c Client) Peek(ctx context.Context, queueName string, input PeekInput) (re
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -8,10 +8,11 @@ import (
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type PutInput struct {
@@ -38,8 +39,22 @@ func (c Client) Put(ctx context.Context, queueName string, input PutInput) (resu
 		return result, fmt.Errorf("`queueName` cannot be an empty string")
 	}
 
-	if strings.ToLower(queueName) != queueName {
-		return result, fmt.Errorf("`queueName` must be a lower-cased string")
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
+	}
+	if err = validateMessage(input.Message); err != nil {
+		return
+	}
+	if input.MessageTtl != nil && *input.MessageTtl != -1 && *input.MessageTtl < 1 {
+		return result, fmt.Errorf("`input.MessageTtl` must either be -1 or larger than or equal to 1 second")
+	}
+	if input.VisibilityTimeout != nil {
+		if err = validateVisibilityTimeout(*input.VisibilityTimeout, 0); err != nil {
+			return
+		}
+		if input.MessageTtl != nil && *input.MessageTtl != -1 && *input.VisibilityTimeout >= *input.MessageTtl {
+			return result, fmt.Errorf("`input.VisibilityTimeout` must be smaller than `input.MessageTtl`")
+		}
 	}
 
 	opts := client.RequestOptions{
@@ -86,7 +101,7 @@ func (c Client) Put(ctx context.Context, queueName string, input PutInput) (resu
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -8,10 +8,12 @@ import (
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type UpdateInput struct {
@@ -31,6 +33,12 @@ type UpdateInput struct {
 
 type UpdateResponse struct {
 	HttpResponse *http.Response
+
+	// The new Pop Receipt for this Message, which must be used for subsequent operations on this Message
+	PopReceipt string
+
+	// The time at which this Message will become visible in the Queue
+	TimeNextVisible time.Time
 }
 
 // Update updates an existing message based on it's Pop Receipt
@@ -39,12 +47,21 @@ func (c Client) Update(ctx context.Context, queueName string, messageID string,
 	if queueName == "" {
 		return result, fmt.Errorf("`queueName` cannot be an empty string")
 	}
-	if strings.ToLower(queueName) != queueName {
-		return result, fmt.Errorf("`queueName` must be a lower-cased string")
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
+	}
+	if messageID == "" {
+		return result, fmt.Errorf("`messageID` cannot be an empty string")
 	}
 	if input.PopReceipt == "" {
 		return result, fmt.Errorf("`input.PopReceipt` cannot be an empty string")
 	}
+	if err = validateVisibilityTimeout(input.VisibilityTimeout, 0); err != nil {
+		return
+	}
+	if err = validateMessage(input.Message); err != nil {
+		return
+	}
 
 	opts := client.RequestOptions{
 		ContentType: "application/xml; charset=utf-8",
@@ -80,9 +97,20 @@ func (c Client) Update(ctx context.Context, queueName string, messageID string,
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.PopReceipt = resp.Header.Get("x-ms-popreceipt")
+				result.TimeNextVisible, err = parseTime(resp.Header.Get("x-ms-time-next-visible"))
+				if err != nil {
+					err = fmt.Errorf("parsing `x-ms-time-next-visible`: %+v", err)
+					return
+				}
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -0,0 +1,26 @@
+package messages
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxVisibilityTimeoutInSeconds is the maximum visibility timeout for a Message, which is 7 days
+var maxVisibilityTimeoutInSeconds = int((time.Hour * 24 * 7).Seconds())
+
+// maxMessageSizeInBytes is the maximum size of a (encoded) Message, which is 64KiB
+const maxMessageSizeInBytes = 64 * 1024
+
+func validateVisibilityTimeout(input, minimum int) error {
+	if input < minimum || input > maxVisibilityTimeoutInSeconds {
+		return fmt.Errorf("`input.VisibilityTimeout` must be larger than or equal to %d second(s), and cannot be larger than 7 days", minimum)
+	}
+	return nil
+}
+
+func validateMessage(input string) error {
+	if len(input) > maxMessageSizeInBytes {
+		return fmt.Errorf("`input.Message` can be at most %d bytes but got %d", maxMessageSizeInBytes, len(input))
+	}
+	return nil
+}
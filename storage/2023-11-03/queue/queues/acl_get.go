@@ -0,0 +1,86 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetACLResponse struct {
+	HttpResponse *http.Response
+
+	// The Stored Access Policies for the Queue
+	SignedIdentifiers []SignedIdentifier
+}
+
+// GetACL returns the Stored Access Policies for the specified Queue
+func (c Client) GetACL(ctx context.Context, queueName string) (result GetACLResponse, err error) {
+
+	if queueName == "" {
+		return result, fmt.Errorf("`queueName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: aclOptions{},
+		Path:          fmt.Sprintf("/%s", queueName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			var model signedidentifiers.SignedIdentifiers
+			err = resp.Unmarshal(&model)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+			result.SignedIdentifiers = model.SignedIdentifiers
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type aclOptions struct{}
+
+func (a aclOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (a aclOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (a aclOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "acl")
+	return out
+}
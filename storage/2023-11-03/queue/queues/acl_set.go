@@ -0,0 +1,74 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type SetACLInput struct {
+	// The Stored Access Policies for the Queue, which replace any existing Stored Access Policies.
+	// Up to 5 Stored Access Policies can be specified.
+	SignedIdentifiers []SignedIdentifier
+}
+
+type SetACLResponse struct {
+	HttpResponse *http.Response
+}
+
+// SetACL sets the Stored Access Policies for the specified Queue
+func (c Client) SetACL(ctx context.Context, queueName string, input SetACLInput) (result SetACLResponse, err error) {
+
+	if queueName == "" {
+		return result, fmt.Errorf("`queueName` cannot be an empty string")
+	}
+
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
+	}
+
+	if err := signedidentifiers.Validate(input.SignedIdentifiers); err != nil {
+		return result, fmt.Errorf("`input.SignedIdentifiers` is not valid: %+v", err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: aclOptions{},
+		Path:          fmt.Sprintf("/%s", queueName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	body := signedidentifiers.SignedIdentifiers{
+		SignedIdentifiers: input.SignedIdentifiers,
+	}
+	if err = req.Marshal(&body); err != nil {
+		err = fmt.Errorf("marshaling request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
@@ -6,10 +6,16 @@ import (
 
 type StorageQueue interface {
 	Delete(ctx context.Context, queueName string) (DeleteResponse, error)
+	GetACL(ctx context.Context, queueName string) (GetACLResponse, error)
+	SetACL(ctx context.Context, queueName string, input SetACLInput) (SetACLResponse, error)
+	Exists(ctx context.Context, queueName string) (ExistsResponse, error)
 	GetMetaData(ctx context.Context, queueName string) (GetMetaDataResponse, error)
 	SetMetaData(ctx context.Context, queueName string, input SetMetaDataInput) (SetMetaDataResponse, error)
 	Create(ctx context.Context, queueName string, input CreateInput) (CreateResponse, error)
+	ListQueues(ctx context.Context, input ListQueuesInput) (ListQueuesResponse, error)
+	ListQueuesComplete(ctx context.Context, input ListQueuesInput) ([]QueueDetails, error)
 	GetResourceManagerResourceID(subscriptionID, resourceGroup, accountName, queueName string) string
 	SetServiceProperties(ctx context.Context, input SetStorageServicePropertiesInput) (SetStorageServicePropertiesResponse, error)
 	GetServiceProperties(ctx context.Context) (GetStorageServicePropertiesResponse, error)
+	GetServiceStats(ctx context.Context) (GetStorageServiceStatsResponse, error)
 }
@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type CreateInput struct {
@@ -19,15 +20,17 @@ type CreateResponse struct {
 	HttpResponse *http.Response
 }
 
-// Create creates the specified Queue within the specified Storage Account
+// Create creates the specified Queue within the specified Storage Account.
+// If the Queue already exists with identical MetaData this is a no-op, however if the existing Queue has different
+// MetaData a 409 Conflict is returned.
 func (c Client) Create(ctx context.Context, queueName string, input CreateInput) (result CreateResponse, err error) {
 
 	if queueName == "" {
 		return result, fmt.Errorf("`queueName` cannot be an empty string")
 	}
 
-	if strings.ToLower(queueName) != queueName {
-		return result, fmt.Errorf("`queueName` must be a lower-cased string")
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
 	}
 
 	if err := metadata.Validate(input.MetaData); err != nil {
@@ -38,6 +41,9 @@ func (c Client) Create(ctx context.Context, queueName string, input CreateInput)
 		ContentType: "application/xml; charset=utf-8",
 		ExpectedStatusCodes: []int{
 			http.StatusCreated,
+
+			// returned when the Queue already exists with identical MetaData
+			http.StatusNoContent,
 		},
 		HttpMethod: http.MethodPut,
 		OptionsObject: createOptions{
@@ -58,7 +64,7 @@ func (c Client) Create(ctx context.Context, queueName string, input CreateInput)
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -0,0 +1,37 @@
+package queues
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+func TestCreateReturnsConflictForDifferentMetaData(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("x-ms-error-code", "QueueAlreadyExists")
+		w.WriteHeader(http.StatusConflict)
+	}))
+	defer server.Close()
+
+	queuesClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	_, err = queuesClient.Create(ctx, "queue1", CreateInput{MetaData: map[string]string{"Hello": "world"}})
+	if !responseerror.IsConflict(err) {
+		t.Fatalf("expected a 409 Conflict but got: %+v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected a single request to be sent but got %d", requests)
+	}
+}
@@ -0,0 +1,65 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ExistsResponse struct {
+	HttpResponse *http.Response
+
+	// Whether the Queue exists
+	Exists bool
+}
+
+// Exists determines whether the specified Queue exists, returning `false` rather than an error
+// when the Queue is not found
+func (c Client) Exists(ctx context.Context, queueName string) (result ExistsResponse, err error) {
+	if queueName == "" {
+		err = fmt.Errorf("`queueName` cannot be an empty string")
+		return
+	}
+	if err = naming.ValidateQueueName(queueName); err != nil {
+		err = fmt.Errorf("`queueName` is not valid: %+v", err)
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodHead,
+		OptionsObject: getMetaDataOptions{},
+		Path:          fmt.Sprintf("/%s", queueName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if resp.StatusCode == http.StatusNotFound {
+			err = nil
+			return
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	result.Exists = true
+	return
+}
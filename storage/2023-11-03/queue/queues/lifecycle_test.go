@@ -46,12 +46,45 @@ func TestQueuesLifecycle(t *testing.T) {
 		t.Fatalf("adding authorizer to client: %+v", err)
 	}
 
+	t.Logf("[DEBUG] Checking the queue doesn't exist..")
+	exists, err := queuesClient.Exists(ctx, queueName)
+	if err != nil {
+		t.Fatalf("checking if the queue exists: %+v", err)
+	}
+	if exists.Exists {
+		t.Fatalf("Expected the queue not to exist")
+	}
+
 	// first let's test an empty container
 	_, err = queuesClient.Create(ctx, queueName, CreateInput{MetaData: map[string]string{}})
 	if err != nil {
 		t.Fatal(fmt.Errorf("error creating: %s", err))
 	}
 
+	t.Logf("[DEBUG] Checking the queue exists..")
+	exists, err = queuesClient.Exists(ctx, queueName)
+	if err != nil {
+		t.Fatalf("checking if the queue exists: %+v", err)
+	}
+	if !exists.Exists {
+		t.Fatalf("Expected the queue to exist")
+	}
+
+	// creating it again with identical metadata should be a no-op
+	_, err = queuesClient.Create(ctx, queueName, CreateInput{MetaData: map[string]string{}})
+	if err != nil {
+		t.Fatal(fmt.Errorf("error re-creating: %s", err))
+	}
+
+	// then let's find it when listing the queues..
+	queues, err := queuesClient.ListQueuesComplete(ctx, ListQueuesInput{Prefix: &queueName, IncludeMetaData: true})
+	if err != nil {
+		t.Fatalf("Error listing Queues: %s", err)
+	}
+	if len(queues) != 1 || queues[0].Name != queueName {
+		t.Fatalf("Expected the Queue %q to be listed but got: %+v", queueName, queues)
+	}
+
 	// then let's retrieve it to ensure there's no metadata..
 	resp, err := queuesClient.GetMetaData(ctx, queueName)
 	if err != nil {
@@ -60,6 +93,9 @@ func TestQueuesLifecycle(t *testing.T) {
 	if len(resp.MetaData) != 0 {
 		t.Fatalf("Expected no MetaData but got: %s", err)
 	}
+	if resp.ApproximateMessageCount != 0 {
+		t.Fatalf("Expected no Messages in the Queue but got %d", resp.ApproximateMessageCount)
+	}
 
 	// then let's add some..
 	updatedMetaData := map[string]string{
@@ -100,6 +136,34 @@ func TestQueuesLifecycle(t *testing.T) {
 		t.Fatalf("Expected no MetaData but got: %s", err)
 	}
 
+	// then set some Stored Access Policies..
+	_, err = queuesClient.SetACL(ctx, queueName, SetACLInput{
+		SignedIdentifiers: []SignedIdentifier{
+			{
+				Id: "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI=",
+				AccessPolicy: AccessPolicy{
+					Start:      "2024-01-01T00:00:00.0000000Z",
+					Expiry:     "2054-01-01T00:00:00.0000000Z",
+					Permission: "raup",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error setting ACL: %s", err)
+	}
+
+	acl, err := queuesClient.GetACL(ctx, queueName)
+	if err != nil {
+		t.Fatalf("Error retrieving ACL: %s", err)
+	}
+	if len(acl.SignedIdentifiers) != 1 {
+		t.Fatalf("Expected 1 Signed Identifier but got %d", len(acl.SignedIdentifiers))
+	}
+	if acl.SignedIdentifiers[0].AccessPolicy.Permission != "raup" {
+		t.Fatalf("Expected the Permission to be `raup` but got: %s", acl.SignedIdentifiers[0].AccessPolicy.Permission)
+	}
+
 	// set some properties
 	props := StorageServiceProperties{
 		Logging: &LoggingConfig{
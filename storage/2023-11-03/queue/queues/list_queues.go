@@ -0,0 +1,152 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type ListQueuesInput struct {
+	// Only Queues whose name begins with this Prefix will be returned
+	Prefix *string
+
+	// The value returned as `NextMarker` from a previous request, used to retrieve the next page of results
+	Marker *string
+
+	// The maximum number of Queues to return, up to 5000
+	MaxResults *int
+
+	// Whether the MetaData for each Queue should be returned
+	IncludeMetaData bool
+}
+
+type ListQueuesResponse struct {
+	HttpResponse *http.Response
+
+	// The Queues within this page of results
+	Queues []QueueDetails
+
+	// The Marker which should be used to retrieve the next page of results, if any
+	NextMarker *string
+}
+
+type QueueDetails struct {
+	// The name of the Queue
+	Name string `xml:"Name"`
+
+	// The MetaData for the Queue, which is only returned when `IncludeMetaData` is set
+	MetaData metadata.XMLMap `xml:"Metadata,omitempty"`
+}
+
+// ListQueues lists the Queues within the Storage Account
+func (c Client) ListQueues(ctx context.Context, input ListQueuesInput) (result ListQueuesResponse, err error) {
+	if input.MaxResults != nil && (*input.MaxResults <= 0 || *input.MaxResults > 5000) {
+		return result, fmt.Errorf("`input.MaxResults` can either be nil or between 0 and 5000")
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: listQueuesOptions{
+			input: input,
+		},
+		Path: "/",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			var model listQueuesResult
+			err = resp.Unmarshal(&model)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+			result.Queues = model.Queues.Queues
+			result.NextMarker = model.NextMarker
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type listQueuesResult struct {
+	Queues struct {
+		Queues []QueueDetails `xml:"Queue"`
+	} `xml:"Queues"`
+	NextMarker *string `xml:"NextMarker,omitempty"`
+}
+
+// ListQueuesComplete lists all of the Queues within the Storage Account, following the `NextMarker`
+// until all pages of results have been retrieved
+func (c Client) ListQueuesComplete(ctx context.Context, input ListQueuesInput) (result []QueueDetails, err error) {
+	result = make([]QueueDetails, 0)
+	for {
+		var page ListQueuesResponse
+		page, err = c.ListQueues(ctx, input)
+		if err != nil {
+			err = fmt.Errorf("listing queues: %+v", err)
+			return
+		}
+		result = append(result, page.Queues...)
+
+		if page.NextMarker == nil || *page.NextMarker == "" {
+			break
+		}
+		marker := *page.NextMarker
+		input.Marker = &marker
+	}
+
+	return
+}
+
+type listQueuesOptions struct {
+	input ListQueuesInput
+}
+
+func (o listQueuesOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o listQueuesOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o listQueuesOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "list")
+	if o.input.Prefix != nil {
+		out.Append("prefix", *o.input.Prefix)
+	}
+	if o.input.Marker != nil {
+		out.Append("marker", *o.input.Marker)
+	}
+	if o.input.MaxResults != nil {
+		out.Append("maxresults", fmt.Sprintf("%d", *o.input.MaxResults))
+	}
+	if o.input.IncludeMetaData {
+		out.Append("include", "metadata")
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package queues
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestListQueuesResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example1.queue.core.windows.net/">
+  <Prefix>queue</Prefix>
+  <MaxResults>2</MaxResults>
+  <Queues>
+    <Queue>
+      <Name>queue1</Name>
+      <Metadata>
+        <hello>world</hello>
+      </Metadata>
+    </Queue>
+    <Queue>
+      <Name>queue2</Name>
+    </Queue>
+  </Queues>
+  <NextMarker>/example1/queue3</NextMarker>
+</EnumerationResults>`
+
+	var actual listQueuesResult
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if len(actual.Queues.Queues) != 2 {
+		t.Fatalf("expected 2 queues but got %d", len(actual.Queues.Queues))
+	}
+	if actual.Queues.Queues[0].Name != "queue1" || actual.Queues.Queues[0].MetaData["hello"] != "world" {
+		t.Fatalf("unexpected value for the first queue: %+v", actual.Queues.Queues[0])
+	}
+	if actual.Queues.Queues[1].Name != "queue2" || len(actual.Queues.Queues[1].MetaData) != 0 {
+		t.Fatalf("unexpected value for the second queue: %+v", actual.Queues.Queues[1])
+	}
+	if actual.NextMarker == nil || *actual.NextMarker != "/example1/queue3" {
+		t.Fatalf("expected the NextMarker to be %q but got %v", "/example1/queue3", actual.NextMarker)
+	}
+}
@@ -4,28 +4,34 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
+	"strconv"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/internal/metadata"
+	"github.com/jackofallops/giovanni/storage/naming"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetMetaDataResponse struct {
 	HttpResponse *http.Response
 
 	MetaData map[string]string
+
+	// The approximate number of Messages in the Queue. This is an upper bound, since it may
+	// include Messages which have since been deleted.
+	ApproximateMessageCount int
 }
 
-// GetMetaData returns the metadata for this Queue
+// GetMetaData returns the metadata and the approximate number of messages for this Queue
 func (c Client) GetMetaData(ctx context.Context, queueName string) (result GetMetaDataResponse, err error) {
 
 	if queueName == "" {
 		return result, fmt.Errorf("`queueName` cannot be an empty string")
 	}
 
-	if strings.ToLower(queueName) != queueName {
-		return result, fmt.Errorf("`queueName` must be a lower-cased string")
+	if err := naming.ValidateQueueName(queueName); err != nil {
+		return result, fmt.Errorf("`queueName` is not valid: %+v", err)
 	}
 
 	opts := client.RequestOptions{
@@ -52,20 +58,23 @@ func (c Client) GetMetaData(ctx context.Context, queueName string) (result GetMe
 		if err == nil {
 			if resp.Header != nil {
 				result.MetaData = metadata.ParseFromHeaders(resp.Header)
+
+				if v := resp.Header.Get("x-ms-approximate-messages-count"); v != "" {
+					i, innerErr := strconv.Atoi(v)
+					if innerErr != nil {
+						err = fmt.Errorf("parsing `x-ms-approximate-messages-count` header value %q: %+v", v, innerErr)
+						return
+					}
+					result.ApproximateMessageCount = i
+				}
 			}
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
-	if result.HttpResponse != nil {
-		if result.HttpResponse.Header != nil {
-			result.MetaData = metadata.ParseFromHeaders(result.HttpResponse.Header)
-		}
-	}
-
 	return
 }
 
@@ -1,5 +1,7 @@
 package queues
 
+import "github.com/jackofallops/giovanni/storage/internal/signedidentifiers"
+
 type StorageServiceProperties struct {
 	Logging       *LoggingConfig `xml:"Logging,omitempty"`
 	HourMetrics   *MetricsConfig `xml:"HourMetrics,omitempty"`
@@ -40,3 +42,43 @@ type CorsRule struct {
 	ExposedHeaders  string `xml:"ExposedHeaders"`
 	MaxAgeInSeconds int    `xml:"MaxAgeInSeconds"`
 }
+
+// StorageServiceStats are the statistics for the replication of the Queue Service to the secondary location
+type StorageServiceStats struct {
+	GeoReplication *GeoReplication `xml:"GeoReplication,omitempty"`
+}
+
+type GeoReplication struct {
+	Status GeoReplicationStatus `xml:"Status"`
+
+	// All primary writes preceding this value (in RFC1123 format) are guaranteed to be available for read operations
+	// at the secondary location. This is empty when the Status is `bootstrap` or `unavailable`.
+	LastSyncTime string `xml:"LastSyncTime,omitempty"`
+}
+
+type GeoReplicationStatus string
+
+const (
+	// GeoReplicationStatusLive indicates that the secondary location is active and operational.
+	GeoReplicationStatusLive GeoReplicationStatus = "live"
+
+	// GeoReplicationStatusBootstrap indicates the initial synchronization from the primary location to the secondary location is in progress.
+	GeoReplicationStatusBootstrap GeoReplicationStatus = "bootstrap"
+
+	// GeoReplicationStatusUnavailable indicates that the secondary location is temporarily unavailable.
+	GeoReplicationStatusUnavailable GeoReplicationStatus = "unavailable"
+)
+
+func PossibleValuesForGeoReplicationStatus() []GeoReplicationStatus {
+	return []GeoReplicationStatus{
+		GeoReplicationStatusLive,
+		GeoReplicationStatusBootstrap,
+		GeoReplicationStatusUnavailable,
+	}
+}
+
+// SignedIdentifier is a Stored Access Policy for a Queue
+type SignedIdentifier = signedidentifiers.SignedIdentifier
+
+// AccessPolicy defines the validity period and permissions for a Stored Access Policy
+type AccessPolicy = signedidentifiers.AccessPolicy
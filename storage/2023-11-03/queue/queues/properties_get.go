@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetStorageServicePropertiesResponse struct {
@@ -47,7 +48,7 @@ func (c Client) GetServiceProperties(ctx context.Context) (result GetStorageServ
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
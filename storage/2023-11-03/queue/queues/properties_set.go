@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type SetStorageServicePropertiesResponse struct {
@@ -55,7 +56,7 @@ func (c Client) SetServiceProperties(ctx context.Context, input SetStorageServic
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -0,0 +1,79 @@
+package queues
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestServicePropertiesRoundTrip(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<StorageServiceProperties>
+  <Logging>
+    <Version>1.0</Version>
+    <Delete>true</Delete>
+    <Read>false</Read>
+    <Write>true</Write>
+    <RetentionPolicy>
+      <Enabled>true</Enabled>
+      <Days>7</Days>
+    </RetentionPolicy>
+  </Logging>
+  <HourMetrics>
+    <Version>1.0</Version>
+    <Enabled>true</Enabled>
+    <IncludeAPIs>false</IncludeAPIs>
+    <RetentionPolicy>
+      <Enabled>true</Enabled>
+      <Days>7</Days>
+    </RetentionPolicy>
+  </HourMetrics>
+  <MinuteMetrics>
+    <Version>1.0</Version>
+    <Enabled>false</Enabled>
+    <RetentionPolicy>
+      <Enabled>false</Enabled>
+    </RetentionPolicy>
+  </MinuteMetrics>
+  <Cors>
+    <CorsRule>
+      <AllowedOrigins>https://example.com</AllowedOrigins>
+      <AllowedMethods>GET,PUT</AllowedMethods>
+      <MaxAgeInSeconds>500</MaxAgeInSeconds>
+      <ExposedHeaders>x-ms-meta-*</ExposedHeaders>
+      <AllowedHeaders>x-ms-meta-abc</AllowedHeaders>
+    </CorsRule>
+  </Cors>
+</StorageServiceProperties>`
+
+	var first StorageServiceProperties
+	if err := xml.Unmarshal([]byte(input), &first); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if first.Logging == nil || !first.Logging.Delete || first.Logging.RetentionPolicy.Days != 7 {
+		t.Fatalf("expected the Logging configuration to be parsed but got %+v", first.Logging)
+	}
+	if first.HourMetrics == nil || first.HourMetrics.IncludeAPIs == nil || *first.HourMetrics.IncludeAPIs {
+		t.Fatalf("expected the Hour Metrics configuration to be parsed but got %+v", first.HourMetrics)
+	}
+	if first.MinuteMetrics == nil || first.MinuteMetrics.IncludeAPIs != nil {
+		t.Fatalf("expected the Minute Metrics configuration to be parsed but got %+v", first.MinuteMetrics)
+	}
+	if first.Cors == nil || len(first.Cors.CorsRule) != 1 || first.Cors.CorsRule[0].MaxAgeInSeconds != 500 {
+		t.Fatalf("expected the CORS Rules to be parsed but got %+v", first.Cors)
+	}
+
+	marshalled, err := xml.Marshal(first)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	var second StorageServiceProperties
+	if err := xml.Unmarshal(marshalled, &second); err != nil {
+		t.Fatalf("unmarshalling the marshalled properties: %+v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("expected the properties to round-trip but got:\n\n%+v\n\nand\n\n%+v", first, second)
+	}
+}
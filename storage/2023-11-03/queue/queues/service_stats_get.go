@@ -0,0 +1,75 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type GetStorageServiceStatsResponse struct {
+	StorageServiceStats
+	HttpResponse *http.Response
+}
+
+// GetServiceStats gets the statistics related to the replication of the Queue Service, which is only available for
+// Storage Accounts with read-access geo-redundant replication - the request is sent to the secondary endpoint,
+// which is derived from the base URI (e.g. `account1-secondary.queue.core.windows.net`)
+func (c Client) GetServiceStats(ctx context.Context) (result GetStorageServiceStatsResponse, err error) {
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: getStorageServiceStatsOptions{},
+		Path:          "/",
+	}
+
+	req, err := c.Client.NewSecondaryRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			err = resp.Unmarshal(&result)
+			if err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type getStorageServiceStatsOptions struct{}
+
+func (g getStorageServiceStatsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (g getStorageServiceStatsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (g getStorageServiceStatsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "stats")
+	out.Append("restype", "service")
+	return out
+}
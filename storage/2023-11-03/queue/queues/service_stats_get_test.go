@@ -0,0 +1,32 @@
+package queues
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestServiceStatsUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<StorageServiceStats>
+  <GeoReplication>
+    <Status>live</Status>
+    <LastSyncTime>Mon, 01 Jan 2024 00:00:00 GMT</LastSyncTime>
+  </GeoReplication>
+</StorageServiceStats>`
+
+	var actual StorageServiceStats
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+	if actual.GeoReplication == nil {
+		t.Fatalf("expected GeoReplication to be populated but it was nil")
+	}
+
+	expected := GeoReplication{
+		Status:       GeoReplicationStatusLive,
+		LastSyncTime: "Mon, 01 Jan 2024 00:00:00 GMT",
+	}
+	if *actual.GeoReplication != expected {
+		t.Fatalf("expected %+v but got %+v", expected, *actual.GeoReplication)
+	}
+}
@@ -0,0 +1,236 @@
+package sas
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// AccountServices specifies the Storage Services which an Account Shared Access Signature grants access to
+type AccountServices struct {
+	Blob  bool
+	File  bool
+	Queue bool
+	Table bool
+}
+
+// String returns the services in the order required by the Storage Service
+func (s AccountServices) String() string {
+	var sb strings.Builder
+	if s.Blob {
+		sb.WriteString("b")
+	}
+	if s.File {
+		sb.WriteString("f")
+	}
+	if s.Queue {
+		sb.WriteString("q")
+	}
+	if s.Table {
+		sb.WriteString("t")
+	}
+	return sb.String()
+}
+
+// AccountResourceTypes specifies the types of resources which an Account Shared Access Signature grants access to
+type AccountResourceTypes struct {
+	// Service grants access to the Service-level APIs (for example Get/Set Service Properties or List Containers)
+	Service bool
+
+	// Container grants access to the Container-level APIs (for example Create/Delete Container, Queue, Table or Share)
+	Container bool
+
+	// Object grants access to the Object-level APIs (for example Put Blob, Put Message or Create File)
+	Object bool
+}
+
+// String returns the resource types in the order required by the Storage Service
+func (r AccountResourceTypes) String() string {
+	var sb strings.Builder
+	if r.Service {
+		sb.WriteString("s")
+	}
+	if r.Container {
+		sb.WriteString("c")
+	}
+	if r.Object {
+		sb.WriteString("o")
+	}
+	return sb.String()
+}
+
+// AccountPermissions specifies the operations which an Account Shared Access Signature grants
+type AccountPermissions struct {
+	Read                  bool
+	Write                 bool
+	Delete                bool
+	DeleteVersion         bool
+	PermanentDelete       bool
+	List                  bool
+	Add                   bool
+	Create                bool
+	Update                bool
+	Process               bool
+	Tag                   bool
+	Filter                bool
+	SetImmutabilityPolicy bool
+}
+
+// String returns the permissions in the order required by the Storage Service
+func (p AccountPermissions) String() string {
+	var sb strings.Builder
+	if p.Read {
+		sb.WriteString("r")
+	}
+	if p.Write {
+		sb.WriteString("w")
+	}
+	if p.Delete {
+		sb.WriteString("d")
+	}
+	if p.DeleteVersion {
+		sb.WriteString("x")
+	}
+	if p.PermanentDelete {
+		sb.WriteString("y")
+	}
+	if p.List {
+		sb.WriteString("l")
+	}
+	if p.Add {
+		sb.WriteString("a")
+	}
+	if p.Create {
+		sb.WriteString("c")
+	}
+	if p.Update {
+		sb.WriteString("u")
+	}
+	if p.Process {
+		sb.WriteString("p")
+	}
+	if p.Tag {
+		sb.WriteString("t")
+	}
+	if p.Filter {
+		sb.WriteString("f")
+	}
+	if p.SetImmutabilityPolicy {
+		sb.WriteString("i")
+	}
+	return sb.String()
+}
+
+type AccountSASInput struct {
+	// The Storage Services which the Shared Access Signature grants access to
+	Services AccountServices
+
+	// The types of resources which the Shared Access Signature grants access to
+	ResourceTypes AccountResourceTypes
+
+	// The permissions granted by this Shared Access Signature
+	Permissions AccountPermissions
+
+	// The time at which this Shared Access Signature becomes valid
+	StartTime *time.Time
+
+	// The time at which this Shared Access Signature becomes invalid
+	ExpiryTime *time.Time
+
+	// The IP Address (or range of IP Addresses) from which requests will be accepted
+	IPRange *IPRange
+
+	// The protocol(s) permitted for requests made using this Shared Access Signature
+	Protocol Protocol
+
+	// The Encryption Scope which should be used to encrypt the request contents
+	EncryptionScope *string
+}
+
+// BuildAccountSAS returns the encoded query string for an Account Shared Access Signature, which can grant
+// access to the Blob, File, Queue and Table Services, signed using the Access Key for the Storage Account
+func BuildAccountSAS(accountName, accountKey string, input AccountSASInput) (string, error) {
+	if accountName == "" {
+		return "", fmt.Errorf("`accountName` cannot be an empty string")
+	}
+	if accountKey == "" {
+		return "", fmt.Errorf("`accountKey` cannot be an empty string")
+	}
+	if err := input.validate(); err != nil {
+		return "", err
+	}
+
+	signature, err := computeSignature(accountKey, input.stringToSign(accountName))
+	if err != nil {
+		return "", fmt.Errorf("computing signature: %+v", err)
+	}
+
+	values := input.queryValues()
+	values.Set("sig", signature)
+	return values.Encode(), nil
+}
+
+func (input AccountSASInput) validate() error {
+	if input.Services.String() == "" {
+		return fmt.Errorf("`input.Services` must specify at least one service")
+	}
+	if input.ResourceTypes.String() == "" {
+		return fmt.Errorf("`input.ResourceTypes` must specify at least one resource type")
+	}
+	if input.Permissions.String() == "" {
+		return fmt.Errorf("`input.Permissions` must grant at least one permission")
+	}
+	if input.ExpiryTime == nil || input.ExpiryTime.IsZero() {
+		return fmt.Errorf("`input.ExpiryTime` must be specified")
+	}
+	if input.StartTime != nil && !input.ExpiryTime.After(*input.StartTime) {
+		return fmt.Errorf("`input.ExpiryTime` must be after `input.StartTime`")
+	}
+
+	if err := validateIPRange(input.IPRange); err != nil {
+		return err
+	}
+	return validateProtocol(input.Protocol)
+}
+
+func (input AccountSASInput) ipRange() string {
+	if input.IPRange == nil {
+		return ""
+	}
+	return input.IPRange.String()
+}
+
+// stringToSign returns the string-to-sign for an Account SAS
+// https://learn.microsoft.com/en-us/rest/api/storageservices/create-account-sas#version-2020-12-06-and-later
+func (input AccountSASInput) stringToSign(accountName string) string {
+	return strings.Join([]string{
+		accountName,
+		input.Permissions.String(),
+		input.Services.String(),
+		input.ResourceTypes.String(),
+		formatTime(input.StartTime),
+		formatTime(input.ExpiryTime),
+		input.ipRange(),
+		string(input.Protocol),
+		signedVersion,
+		valueOrEmpty(input.EncryptionScope),
+
+		// the string-to-sign for an Account SAS must end with a newline
+		"",
+	}, "\n")
+}
+
+func (input AccountSASInput) queryValues() url.Values {
+	values := url.Values{}
+	values.Set("sv", signedVersion)
+	values.Set("ss", input.Services.String())
+	values.Set("srt", input.ResourceTypes.String())
+	values.Set("sp", input.Permissions.String())
+	setIfNotEmpty(values, "st", formatTime(input.StartTime))
+	values.Set("se", formatTime(input.ExpiryTime))
+	setIfNotEmpty(values, "sip", input.ipRange())
+	setIfNotEmpty(values, "spr", string(input.Protocol))
+	setIfNotEmpty(values, "ses", valueOrEmpty(input.EncryptionScope))
+	return values
+}
@@ -0,0 +1,190 @@
+package sas
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestBuildAccountSAS(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    AccountSASInput
+		Expected map[string]string
+	}{
+		{
+			Name: "Read and List Blobs",
+			Input: AccountSASInput{
+				Services: AccountServices{Blob: true},
+				ResourceTypes: AccountResourceTypes{
+					Service:   true,
+					Container: true,
+					Object:    true,
+				},
+				Permissions: AccountPermissions{
+					Read: true,
+					List: true,
+				},
+				ExpiryTime: pointer.To(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"ss":  "b",
+				"srt": "sco",
+				"sp":  "rl",
+				"se":  "2024-01-02T03:04:05Z",
+				"sig": "QYCtag4S/LssOBmpE6uMAKkXln2FG6AwLw1DrLfPzgE=",
+			},
+		},
+		{
+			Name: "All Services and Permissions, an IP Range, HTTPS only and an Encryption Scope",
+			Input: AccountSASInput{
+				Services: AccountServices{
+					Blob:  true,
+					File:  true,
+					Queue: true,
+					Table: true,
+				},
+				ResourceTypes: AccountResourceTypes{Object: true},
+				Permissions: AccountPermissions{
+					Read:                  true,
+					Write:                 true,
+					Delete:                true,
+					DeleteVersion:         true,
+					PermanentDelete:       true,
+					List:                  true,
+					Add:                   true,
+					Create:                true,
+					Update:                true,
+					Process:               true,
+					Tag:                   true,
+					Filter:                true,
+					SetImmutabilityPolicy: true,
+				},
+				StartTime:  pointer.To(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				ExpiryTime: pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				IPRange: &IPRange{
+					Start: "10.0.0.1",
+					End:   "10.0.0.255",
+				},
+				Protocol:        HTTPSOnly,
+				EncryptionScope: pointer.To("scope1"),
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"ss":  "bfqt",
+				"srt": "o",
+				"sp":  "rwdxylacuptfi",
+				"st":  "2024-01-01T00:00:00Z",
+				"se":  "2024-01-02T00:00:00Z",
+				"sip": "10.0.0.1-10.0.0.255",
+				"spr": "https",
+				"ses": "scope1",
+				"sig": "J4dM2LDtihGUAiJ/1UYwggrRAlRlensSGivqWpjJSEo=",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := BuildAccountSAS(testAccountName, testAccountKey, v.Input)
+		if err != nil {
+			t.Fatalf("building SAS: %+v", err)
+		}
+
+		values, err := url.ParseQuery(actual)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", actual, err)
+		}
+		if len(values) != len(v.Expected) {
+			t.Fatalf("expected %d query parameters but got %d: %q", len(v.Expected), len(values), actual)
+		}
+		for key, expected := range v.Expected {
+			if value := values.Get(key); value != expected {
+				t.Fatalf("expected %q to be %q but got %q", key, expected, value)
+			}
+		}
+	}
+}
+
+func TestBuildAccountSASValidation(t *testing.T) {
+	expiry := pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	testData := []struct {
+		Name  string
+		Input AccountSASInput
+	}{
+		{
+			Name: "No Services",
+			Input: AccountSASInput{
+				ResourceTypes: AccountResourceTypes{Object: true},
+				Permissions:   AccountPermissions{Read: true},
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "No Resource Types",
+			Input: AccountSASInput{
+				Services:    AccountServices{Blob: true},
+				Permissions: AccountPermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "No Permissions",
+			Input: AccountSASInput{
+				Services:      AccountServices{Blob: true},
+				ResourceTypes: AccountResourceTypes{Object: true},
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "No Expiry",
+			Input: AccountSASInput{
+				Services:      AccountServices{Blob: true},
+				ResourceTypes: AccountResourceTypes{Object: true},
+				Permissions:   AccountPermissions{Read: true},
+			},
+		},
+		{
+			Name: "Expiry before Start",
+			Input: AccountSASInput{
+				Services:      AccountServices{Blob: true},
+				ResourceTypes: AccountResourceTypes{Object: true},
+				Permissions:   AccountPermissions{Read: true},
+				StartTime:     pointer.To(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "Invalid Protocol",
+			Input: AccountSASInput{
+				Services:      AccountServices{Blob: true},
+				ResourceTypes: AccountResourceTypes{Object: true},
+				Permissions:   AccountPermissions{Read: true},
+				ExpiryTime:    expiry,
+				Protocol:      "ftp",
+			},
+		},
+		{
+			Name: "Empty IP Range",
+			Input: AccountSASInput{
+				Services:      AccountServices{Blob: true},
+				ResourceTypes: AccountResourceTypes{Object: true},
+				Permissions:   AccountPermissions{Read: true},
+				ExpiryTime:    expiry,
+				IPRange:       &IPRange{},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if _, err := BuildAccountSAS(testAccountName, testAccountKey, v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
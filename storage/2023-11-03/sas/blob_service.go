@@ -0,0 +1,257 @@
+package sas
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/naming"
+)
+
+type BlobSignedResource string
+
+var (
+	// BlobResource grants access to the content and metadata of a Blob
+	BlobResource BlobSignedResource = "b"
+
+	// BlobSnapshotResource grants access to the content and metadata of a Blob Snapshot
+	BlobSnapshotResource BlobSignedResource = "bs"
+
+	// BlobVersionResource grants access to the content and metadata of a Blob Version
+	BlobVersionResource BlobSignedResource = "bv"
+
+	// ContainerResource grants access to the content and metadata of any Blob within the Container
+	// and to the list of Blobs in the Container
+	ContainerResource BlobSignedResource = "c"
+)
+
+// BlobPermissions specifies the operations which a Blob (or Container) Shared Access Signature grants
+type BlobPermissions struct {
+	Read   bool
+	Add    bool
+	Create bool
+	Write  bool
+	Delete bool
+	List   bool
+}
+
+// String returns the permissions in the order required by the Storage Service
+func (p BlobPermissions) String() string {
+	var sb strings.Builder
+	if p.Read {
+		sb.WriteString("r")
+	}
+	if p.Add {
+		sb.WriteString("a")
+	}
+	if p.Create {
+		sb.WriteString("c")
+	}
+	if p.Write {
+		sb.WriteString("w")
+	}
+	if p.Delete {
+		sb.WriteString("d")
+	}
+	if p.List {
+		sb.WriteString("l")
+	}
+	return sb.String()
+}
+
+type BlobSASInput struct {
+	// The name of the Container which the Shared Access Signature grants access to
+	ContainerName string
+
+	// The name of the Blob which the Shared Access Signature grants access to.
+	// This is required unless the Resource is `ContainerResource`.
+	BlobName string
+
+	// The type of resource which the Shared Access Signature grants access to
+	Resource BlobSignedResource
+
+	// The permissions granted by this Shared Access Signature.
+	// These can be omitted when they're granted by the Stored Access Policy specified in `Identifier`.
+	Permissions BlobPermissions
+
+	// The ID of a Stored Access Policy on the Container which this Shared Access Signature is associated with
+	Identifier *string
+
+	// The time at which this Shared Access Signature becomes valid
+	StartTime *time.Time
+
+	// The time at which this Shared Access Signature becomes invalid.
+	// This can be omitted when it's specified by the Stored Access Policy specified in `Identifier`.
+	ExpiryTime *time.Time
+
+	// The IP Address (or range of IP Addresses) from which requests will be accepted
+	IPRange *IPRange
+
+	// The protocol(s) permitted for requests made using this Shared Access Signature
+	Protocol Protocol
+
+	// The Snapshot which this Shared Access Signature grants access to.
+	// This is required when the Resource is `BlobSnapshotResource`.
+	Snapshot *string
+
+	// The Version ID which this Shared Access Signature grants access to.
+	// This is required when the Resource is `BlobVersionResource`.
+	VersionID *string
+
+	// The Encryption Scope which should be used to encrypt the request contents
+	EncryptionScope *string
+
+	// Overrides for the response headers returned when the resource is read using this Shared Access Signature
+	CacheControl       *string
+	ContentDisposition *string
+	ContentEncoding    *string
+	ContentLanguage    *string
+	ContentType        *string
+}
+
+// BuildBlobSAS returns the encoded query string for a Service Shared Access Signature granting access
+// to a Blob or Container, signed using the Access Key for the Storage Account
+func BuildBlobSAS(accountName, accountKey string, input BlobSASInput) (string, error) {
+	if accountName == "" {
+		return "", fmt.Errorf("`accountName` cannot be an empty string")
+	}
+	if accountKey == "" {
+		return "", fmt.Errorf("`accountKey` cannot be an empty string")
+	}
+	if err := input.validate(); err != nil {
+		return "", err
+	}
+
+	signature, err := computeSignature(accountKey, input.stringToSign(accountName))
+	if err != nil {
+		return "", fmt.Errorf("computing signature: %+v", err)
+	}
+
+	values := input.queryValues()
+	values.Set("sig", signature)
+	return values.Encode(), nil
+}
+
+func (input BlobSASInput) validate() error {
+	if input.ContainerName == "" {
+		return fmt.Errorf("`input.ContainerName` cannot be an empty string")
+	}
+	if err := naming.ValidateContainerName(input.ContainerName); err != nil {
+		return fmt.Errorf("`input.ContainerName` is not valid: %+v", err)
+	}
+
+	switch input.Resource {
+	case ContainerResource:
+		if input.BlobName != "" {
+			return fmt.Errorf("`input.BlobName` cannot be specified when `input.Resource` is %q", ContainerResource)
+		}
+	case BlobResource, BlobSnapshotResource, BlobVersionResource:
+		if input.BlobName == "" {
+			return fmt.Errorf("`input.BlobName` cannot be an empty string when `input.Resource` is %q", input.Resource)
+		}
+	default:
+		return fmt.Errorf("`input.Resource` must be one of %q, %q, %q or %q", BlobResource, BlobSnapshotResource, BlobVersionResource, ContainerResource)
+	}
+
+	if input.Resource == BlobSnapshotResource && (input.Snapshot == nil || *input.Snapshot == "") {
+		return fmt.Errorf("`input.Snapshot` must be specified when `input.Resource` is %q", BlobSnapshotResource)
+	}
+	if input.Resource != BlobSnapshotResource && input.Snapshot != nil {
+		return fmt.Errorf("`input.Snapshot` can only be specified when `input.Resource` is %q", BlobSnapshotResource)
+	}
+	if input.Resource == BlobVersionResource && (input.VersionID == nil || *input.VersionID == "") {
+		return fmt.Errorf("`input.VersionID` must be specified when `input.Resource` is %q", BlobVersionResource)
+	}
+	if input.Resource != BlobVersionResource && input.VersionID != nil {
+		return fmt.Errorf("`input.VersionID` can only be specified when `input.Resource` is %q", BlobVersionResource)
+	}
+
+	if input.Identifier == nil {
+		if input.Permissions.String() == "" {
+			return fmt.Errorf("`input.Permissions` must grant at least one permission when `input.Identifier` is not specified")
+		}
+		if input.ExpiryTime == nil || input.ExpiryTime.IsZero() {
+			return fmt.Errorf("`input.ExpiryTime` must be specified when `input.Identifier` is not specified")
+		}
+	}
+	if input.StartTime != nil && input.ExpiryTime != nil && !input.ExpiryTime.After(*input.StartTime) {
+		return fmt.Errorf("`input.ExpiryTime` must be after `input.StartTime`")
+	}
+
+	if err := validateIPRange(input.IPRange); err != nil {
+		return err
+	}
+	return validateProtocol(input.Protocol)
+}
+
+func (input BlobSASInput) canonicalizedResource(accountName string) string {
+	resource := fmt.Sprintf("/blob/%s/%s", accountName, input.ContainerName)
+	if input.Resource != ContainerResource {
+		resource = fmt.Sprintf("%s/%s", resource, input.BlobName)
+	}
+	return resource
+}
+
+// snapshotTime returns the value used for the `signedSnapshotTime` field, which is either the
+// Snapshot or the Version ID of the Blob, depending on the Signed Resource
+func (input BlobSASInput) snapshotTime() string {
+	if input.Snapshot != nil {
+		return *input.Snapshot
+	}
+	if input.VersionID != nil {
+		return *input.VersionID
+	}
+	return ""
+}
+
+func (input BlobSASInput) ipRange() string {
+	if input.IPRange == nil {
+		return ""
+	}
+	return input.IPRange.String()
+}
+
+// stringToSign returns the string-to-sign for a Service SAS for the Blob Service
+// https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas#version-2020-12-06-and-later
+func (input BlobSASInput) stringToSign(accountName string) string {
+	return strings.Join([]string{
+		input.Permissions.String(),
+		formatTime(input.StartTime),
+		formatTime(input.ExpiryTime),
+		input.canonicalizedResource(accountName),
+		valueOrEmpty(input.Identifier),
+		input.ipRange(),
+		string(input.Protocol),
+		signedVersion,
+		string(input.Resource),
+		input.snapshotTime(),
+		valueOrEmpty(input.EncryptionScope),
+		valueOrEmpty(input.CacheControl),
+		valueOrEmpty(input.ContentDisposition),
+		valueOrEmpty(input.ContentEncoding),
+		valueOrEmpty(input.ContentLanguage),
+		valueOrEmpty(input.ContentType),
+	}, "\n")
+}
+
+func (input BlobSASInput) queryValues() url.Values {
+	values := url.Values{}
+	values.Set("sv", signedVersion)
+	values.Set("sr", string(input.Resource))
+	setIfNotEmpty(values, "sp", input.Permissions.String())
+	setIfNotEmpty(values, "st", formatTime(input.StartTime))
+	setIfNotEmpty(values, "se", formatTime(input.ExpiryTime))
+	setIfNotEmpty(values, "si", valueOrEmpty(input.Identifier))
+	setIfNotEmpty(values, "sip", input.ipRange())
+	setIfNotEmpty(values, "spr", string(input.Protocol))
+	setIfNotEmpty(values, "ses", valueOrEmpty(input.EncryptionScope))
+	setIfNotEmpty(values, "snapshot", valueOrEmpty(input.Snapshot))
+	setIfNotEmpty(values, "versionid", valueOrEmpty(input.VersionID))
+	setIfNotEmpty(values, "rscc", valueOrEmpty(input.CacheControl))
+	setIfNotEmpty(values, "rscd", valueOrEmpty(input.ContentDisposition))
+	setIfNotEmpty(values, "rsce", valueOrEmpty(input.ContentEncoding))
+	setIfNotEmpty(values, "rscl", valueOrEmpty(input.ContentLanguage))
+	setIfNotEmpty(values, "rsct", valueOrEmpty(input.ContentType))
+	return values
+}
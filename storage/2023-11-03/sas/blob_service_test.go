@@ -0,0 +1,237 @@
+package sas
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+const testAccountName = "devstoreaccount1"
+
+// testAccountKey is the base64-encoded representation of the bytes 0x00 through 0x3F
+const testAccountKey = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIjJCUmJygpKissLS4vMDEyMzQ1Njc4OTo7PD0+Pw=="
+
+func TestBuildBlobSAS(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    BlobSASInput
+		Expected map[string]string
+	}{
+		{
+			Name: "Read-only Blob",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				BlobName:      "blob1.txt",
+				Resource:      BlobResource,
+				Permissions:   BlobPermissions{Read: true},
+				ExpiryTime:    pointer.To(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"sr":  "b",
+				"sp":  "r",
+				"se":  "2024-01-02T03:04:05Z",
+				"sig": "AjJTEhvHchMjlMqlUmaK5ujkqmxtr7ZyjskDYnxTuJ8=",
+			},
+		},
+		{
+			Name: "Container with all permissions, an IP Range and HTTPS only",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				Resource:      ContainerResource,
+				Permissions: BlobPermissions{
+					Read:   true,
+					Add:    true,
+					Create: true,
+					Write:  true,
+					Delete: true,
+					List:   true,
+				},
+				StartTime:  pointer.To(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				ExpiryTime: pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				IPRange: &IPRange{
+					Start: "10.0.0.1",
+					End:   "10.0.0.255",
+				},
+				Protocol: HTTPSOnly,
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"sr":  "c",
+				"sp":  "racwdl",
+				"st":  "2024-01-01T00:00:00Z",
+				"se":  "2024-01-02T00:00:00Z",
+				"sip": "10.0.0.1-10.0.0.255",
+				"spr": "https",
+				"sig": "C/L1HPGjOUZFBMH5N7k+j9HqGSM/dFZjf1HIRcaRKN0=",
+			},
+		},
+		{
+			Name: "Blob Snapshot using a Stored Access Policy and response header overrides",
+			Input: BlobSASInput{
+				ContainerName:      "container1",
+				BlobName:           "blob1.txt",
+				Resource:           BlobSnapshotResource,
+				Identifier:         pointer.To("policy1"),
+				Protocol:           HTTPSAndHTTP,
+				Snapshot:           pointer.To("2024-01-01T00:00:00.0000000Z"),
+				EncryptionScope:    pointer.To("scope1"),
+				CacheControl:       pointer.To("no-cache"),
+				ContentDisposition: pointer.To("attachment"),
+				ContentEncoding:    pointer.To("gzip"),
+				ContentLanguage:    pointer.To("en-GB"),
+				ContentType:        pointer.To("text/plain"),
+			},
+			Expected: map[string]string{
+				"sv":       "2023-11-03",
+				"sr":       "bs",
+				"si":       "policy1",
+				"spr":      "https,http",
+				"ses":      "scope1",
+				"snapshot": "2024-01-01T00:00:00.0000000Z",
+				"rscc":     "no-cache",
+				"rscd":     "attachment",
+				"rsce":     "gzip",
+				"rscl":     "en-GB",
+				"rsct":     "text/plain",
+				"sig":      "oqJzszphM+mDsxSNVjQVlK0xZ9LB3HRrm6VF022B3e4=",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := BuildBlobSAS(testAccountName, testAccountKey, v.Input)
+		if err != nil {
+			t.Fatalf("building SAS: %+v", err)
+		}
+
+		values, err := url.ParseQuery(actual)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", actual, err)
+		}
+		if len(values) != len(v.Expected) {
+			t.Fatalf("expected %d query parameters but got %d: %q", len(v.Expected), len(values), actual)
+		}
+		for key, expected := range v.Expected {
+			if value := values.Get(key); value != expected {
+				t.Fatalf("expected %q to be %q but got %q", key, expected, value)
+			}
+		}
+	}
+}
+
+func TestBuildBlobSASValidation(t *testing.T) {
+	expiry := pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	testData := []struct {
+		Name  string
+		Input BlobSASInput
+	}{
+		{
+			Name: "No Container Name",
+			Input: BlobSASInput{
+				Resource:    ContainerResource,
+				Permissions: BlobPermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "Blob Name for a Container",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				BlobName:      "blob1.txt",
+				Resource:      ContainerResource,
+				Permissions:   BlobPermissions{Read: true},
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "No Blob Name for a Blob",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				Resource:      BlobResource,
+				Permissions:   BlobPermissions{Read: true},
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "Unknown Resource",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				Resource:      "q",
+				Permissions:   BlobPermissions{Read: true},
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "Snapshot without a Snapshot",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				BlobName:      "blob1.txt",
+				Resource:      BlobSnapshotResource,
+				Permissions:   BlobPermissions{Read: true},
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "No Permissions or Identifier",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				BlobName:      "blob1.txt",
+				Resource:      BlobResource,
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "No Expiry or Identifier",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				BlobName:      "blob1.txt",
+				Resource:      BlobResource,
+				Permissions:   BlobPermissions{Read: true},
+			},
+		},
+		{
+			Name: "Expiry before Start",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				BlobName:      "blob1.txt",
+				Resource:      BlobResource,
+				Permissions:   BlobPermissions{Read: true},
+				StartTime:     pointer.To(expiry.Add(time.Hour)),
+				ExpiryTime:    expiry,
+			},
+		},
+		{
+			Name: "Invalid Protocol",
+			Input: BlobSASInput{
+				ContainerName: "container1",
+				BlobName:      "blob1.txt",
+				Resource:      BlobResource,
+				Permissions:   BlobPermissions{Read: true},
+				ExpiryTime:    expiry,
+				Protocol:      "http",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if _, err := BuildBlobSAS(testAccountName, testAccountKey, v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+
+	if _, err := BuildBlobSAS(testAccountName, "not-base64!", BlobSASInput{
+		ContainerName: "container1",
+		Resource:      ContainerResource,
+		Permissions:   BlobPermissions{List: true},
+		ExpiryTime:    expiry,
+	}); err == nil {
+		t.Fatalf("expected an error for an invalid account key but didn't get one")
+	}
+}
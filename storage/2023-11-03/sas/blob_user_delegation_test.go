@@ -0,0 +1,85 @@
+package sas
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/accounts"
+)
+
+func testUserDelegationKey() accounts.UserDelegationKey {
+	return accounts.UserDelegationKey{
+		SignedOid:     "00000000-0000-0000-0000-000000000001",
+		SignedTid:     "00000000-0000-0000-0000-000000000002",
+		SignedStart:   "2024-01-01T00:00:00Z",
+		SignedExpiry:  "2024-01-03T00:00:00Z",
+		SignedService: "b",
+		SignedVersion: "2023-11-03",
+		// the base64-encoded representation of the bytes 0x00 through 0x1F
+		Value: "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=",
+	}
+}
+
+func TestBuildBlobUserDelegationSAS(t *testing.T) {
+	input := BlobSASInput{
+		ContainerName: "container1",
+		BlobName:      "blob1.txt",
+		Resource:      BlobResource,
+		Permissions:   BlobPermissions{Read: true, Write: true},
+		ExpiryTime:    pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+		Protocol:      HTTPSOnly,
+	}
+	actual, err := BuildBlobUserDelegationSAS(testAccountName, testUserDelegationKey(), input)
+	if err != nil {
+		t.Fatalf("building SAS: %+v", err)
+	}
+
+	values, err := url.ParseQuery(actual)
+	if err != nil {
+		t.Fatalf("parsing %q: %+v", actual, err)
+	}
+	expected := map[string]string{
+		"sv":    "2023-11-03",
+		"sr":    "b",
+		"sp":    "rw",
+		"se":    "2024-01-02T00:00:00Z",
+		"spr":   "https",
+		"skoid": "00000000-0000-0000-0000-000000000001",
+		"sktid": "00000000-0000-0000-0000-000000000002",
+		"skt":   "2024-01-01T00:00:00Z",
+		"ske":   "2024-01-03T00:00:00Z",
+		"sks":   "b",
+		"skv":   "2023-11-03",
+		"sig":   "s5yspIBzbfXEdRYrFDiu/GuxHyoI0J1B1TX/wRMM4ek=",
+	}
+	if len(values) != len(expected) {
+		t.Fatalf("expected %d query parameters but got %d: %q", len(expected), len(values), actual)
+	}
+	for key, v := range expected {
+		if value := values.Get(key); value != v {
+			t.Fatalf("expected %q to be %q but got %q", key, v, value)
+		}
+	}
+}
+
+func TestBuildBlobUserDelegationSASValidation(t *testing.T) {
+	input := BlobSASInput{
+		ContainerName: "container1",
+		Resource:      ContainerResource,
+		Permissions:   BlobPermissions{List: true},
+		ExpiryTime:    pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+	}
+
+	key := testUserDelegationKey()
+	key.SignedOid = ""
+	if _, err := BuildBlobUserDelegationSAS(testAccountName, key, input); err == nil {
+		t.Fatalf("expected an error for an incomplete User Delegation Key but didn't get one")
+	}
+
+	input.Identifier = pointer.To("policy1")
+	if _, err := BuildBlobUserDelegationSAS(testAccountName, testUserDelegationKey(), input); err == nil {
+		t.Fatalf("expected an error when specifying a Stored Access Policy but didn't get one")
+	}
+}
@@ -0,0 +1,214 @@
+package sas
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/naming"
+)
+
+type FileSignedResource string
+
+var (
+	// FileResource grants access to the content and metadata of a File
+	FileResource FileSignedResource = "f"
+
+	// ShareResource grants access to the content and metadata of any File within the Share
+	// and to the list of Directories and Files in the Share
+	ShareResource FileSignedResource = "s"
+)
+
+// FilePermissions specifies the operations which a File (or Share) Shared Access Signature grants
+type FilePermissions struct {
+	Read   bool
+	Create bool
+	Write  bool
+	Delete bool
+
+	// List can only be granted when the Resource is `ShareResource`
+	List bool
+}
+
+// String returns the permissions in the order required by the Storage Service
+func (p FilePermissions) String() string {
+	var sb strings.Builder
+	if p.Read {
+		sb.WriteString("r")
+	}
+	if p.Create {
+		sb.WriteString("c")
+	}
+	if p.Write {
+		sb.WriteString("w")
+	}
+	if p.Delete {
+		sb.WriteString("d")
+	}
+	if p.List {
+		sb.WriteString("l")
+	}
+	return sb.String()
+}
+
+type FileSASInput struct {
+	// The name of the Share which the Shared Access Signature grants access to
+	ShareName string
+
+	// The path to the File which the Shared Access Signature grants access to, including any
+	// Directories (for example `directory/file.txt`). This is required unless the Resource is `ShareResource`.
+	FilePath string
+
+	// The type of resource which the Shared Access Signature grants access to
+	Resource FileSignedResource
+
+	// The permissions granted by this Shared Access Signature.
+	// These can be omitted when they're granted by the Stored Access Policy specified in `Identifier`.
+	Permissions FilePermissions
+
+	// The ID of a Stored Access Policy on the Share which this Shared Access Signature is associated with
+	Identifier *string
+
+	// The time at which this Shared Access Signature becomes valid
+	StartTime *time.Time
+
+	// The time at which this Shared Access Signature becomes invalid.
+	// This can be omitted when it's specified by the Stored Access Policy specified in `Identifier`.
+	ExpiryTime *time.Time
+
+	// The IP Address (or range of IP Addresses) from which requests will be accepted
+	IPRange *IPRange
+
+	// The protocol(s) permitted for requests made using this Shared Access Signature
+	Protocol Protocol
+
+	// Overrides for the response headers returned when the resource is read using this Shared Access Signature
+	CacheControl       *string
+	ContentDisposition *string
+	ContentEncoding    *string
+	ContentLanguage    *string
+	ContentType        *string
+}
+
+// BuildFileSAS returns the encoded query string for a Service Shared Access Signature granting access
+// to a File or Share, signed using the Access Key for the Storage Account
+func BuildFileSAS(accountName, accountKey string, input FileSASInput) (string, error) {
+	if accountName == "" {
+		return "", fmt.Errorf("`accountName` cannot be an empty string")
+	}
+	if accountKey == "" {
+		return "", fmt.Errorf("`accountKey` cannot be an empty string")
+	}
+	if err := input.validate(); err != nil {
+		return "", err
+	}
+
+	signature, err := computeSignature(accountKey, input.stringToSign(accountName))
+	if err != nil {
+		return "", fmt.Errorf("computing signature: %+v", err)
+	}
+
+	values := input.queryValues()
+	values.Set("sig", signature)
+	return values.Encode(), nil
+}
+
+func (input FileSASInput) validate() error {
+	if input.ShareName == "" {
+		return fmt.Errorf("`input.ShareName` cannot be an empty string")
+	}
+	if err := naming.ValidateShareName(input.ShareName); err != nil {
+		return fmt.Errorf("`input.ShareName` is not valid: %+v", err)
+	}
+
+	switch input.Resource {
+	case ShareResource:
+		if input.FilePath != "" {
+			return fmt.Errorf("`input.FilePath` cannot be specified when `input.Resource` is %q", ShareResource)
+		}
+	case FileResource:
+		if input.FilePath == "" {
+			return fmt.Errorf("`input.FilePath` cannot be an empty string when `input.Resource` is %q", FileResource)
+		}
+		if strings.HasPrefix(input.FilePath, "/") || strings.HasSuffix(input.FilePath, "/") {
+			return fmt.Errorf("`input.FilePath` cannot start or end with a `/`")
+		}
+		if input.Permissions.List {
+			return fmt.Errorf("`input.Permissions.List` can only be granted when `input.Resource` is %q", ShareResource)
+		}
+	default:
+		return fmt.Errorf("`input.Resource` must be one of %q or %q", FileResource, ShareResource)
+	}
+
+	if input.Identifier == nil {
+		if input.Permissions.String() == "" {
+			return fmt.Errorf("`input.Permissions` must grant at least one permission when `input.Identifier` is not specified")
+		}
+		if input.ExpiryTime == nil || input.ExpiryTime.IsZero() {
+			return fmt.Errorf("`input.ExpiryTime` must be specified when `input.Identifier` is not specified")
+		}
+	}
+	if input.StartTime != nil && input.ExpiryTime != nil && !input.ExpiryTime.After(*input.StartTime) {
+		return fmt.Errorf("`input.ExpiryTime` must be after `input.StartTime`")
+	}
+
+	if err := validateIPRange(input.IPRange); err != nil {
+		return err
+	}
+	return validateProtocol(input.Protocol)
+}
+
+func (input FileSASInput) canonicalizedResource(accountName string) string {
+	resource := fmt.Sprintf("/file/%s/%s", accountName, input.ShareName)
+	if input.Resource != ShareResource {
+		resource = fmt.Sprintf("%s/%s", resource, input.FilePath)
+	}
+	return resource
+}
+
+func (input FileSASInput) ipRange() string {
+	if input.IPRange == nil {
+		return ""
+	}
+	return input.IPRange.String()
+}
+
+// stringToSign returns the string-to-sign for a Service SAS for the File Service, which (unlike the Blob
+// Service) doesn't include the Signed Resource, Snapshot Time or Encryption Scope
+// https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas#version-2015-04-05-and-later
+func (input FileSASInput) stringToSign(accountName string) string {
+	return strings.Join([]string{
+		input.Permissions.String(),
+		formatTime(input.StartTime),
+		formatTime(input.ExpiryTime),
+		input.canonicalizedResource(accountName),
+		valueOrEmpty(input.Identifier),
+		input.ipRange(),
+		string(input.Protocol),
+		signedVersion,
+		valueOrEmpty(input.CacheControl),
+		valueOrEmpty(input.ContentDisposition),
+		valueOrEmpty(input.ContentEncoding),
+		valueOrEmpty(input.ContentLanguage),
+		valueOrEmpty(input.ContentType),
+	}, "\n")
+}
+
+func (input FileSASInput) queryValues() url.Values {
+	values := url.Values{}
+	values.Set("sv", signedVersion)
+	values.Set("sr", string(input.Resource))
+	setIfNotEmpty(values, "sp", input.Permissions.String())
+	setIfNotEmpty(values, "st", formatTime(input.StartTime))
+	setIfNotEmpty(values, "se", formatTime(input.ExpiryTime))
+	setIfNotEmpty(values, "si", valueOrEmpty(input.Identifier))
+	setIfNotEmpty(values, "sip", input.ipRange())
+	setIfNotEmpty(values, "spr", string(input.Protocol))
+	setIfNotEmpty(values, "rscc", valueOrEmpty(input.CacheControl))
+	setIfNotEmpty(values, "rscd", valueOrEmpty(input.ContentDisposition))
+	setIfNotEmpty(values, "rsce", valueOrEmpty(input.ContentEncoding))
+	setIfNotEmpty(values, "rscl", valueOrEmpty(input.ContentLanguage))
+	setIfNotEmpty(values, "rsct", valueOrEmpty(input.ContentType))
+	return values
+}
@@ -0,0 +1,214 @@
+package sas
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestBuildFileSAS(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    FileSASInput
+		Expected map[string]string
+	}{
+		{
+			Name: "Read-only File within a Directory",
+			Input: FileSASInput{
+				ShareName:   "share1",
+				FilePath:    "directory1/file1.txt",
+				Resource:    FileResource,
+				Permissions: FilePermissions{Read: true},
+				ExpiryTime:  pointer.To(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"sr":  "f",
+				"sp":  "r",
+				"se":  "2024-01-02T03:04:05Z",
+				"sig": "7rlguNUmjJc+7Lkflz+aIcqY8z1YLvZYdp7H751pj00=",
+			},
+		},
+		{
+			Name: "Share with all permissions, an IP Range and HTTPS only",
+			Input: FileSASInput{
+				ShareName: "share1",
+				Resource:  ShareResource,
+				Permissions: FilePermissions{
+					Read:   true,
+					Create: true,
+					Write:  true,
+					Delete: true,
+					List:   true,
+				},
+				StartTime:  pointer.To(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				ExpiryTime: pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				IPRange: &IPRange{
+					Start: "10.0.0.1",
+					End:   "10.0.0.255",
+				},
+				Protocol: HTTPSOnly,
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"sr":  "s",
+				"sp":  "rcwdl",
+				"st":  "2024-01-01T00:00:00Z",
+				"se":  "2024-01-02T00:00:00Z",
+				"sip": "10.0.0.1-10.0.0.255",
+				"spr": "https",
+				"sig": "2onTBsGVvpAHWrBowsrw3cgqbGZ0sPGZRtMi5pI2RH8=",
+			},
+		},
+		{
+			Name: "File using a Stored Access Policy and response header overrides",
+			Input: FileSASInput{
+				ShareName:          "share1",
+				FilePath:           "file1.txt",
+				Resource:           FileResource,
+				Identifier:         pointer.To("policy1"),
+				Protocol:           HTTPSAndHTTP,
+				CacheControl:       pointer.To("no-cache"),
+				ContentDisposition: pointer.To("attachment"),
+				ContentEncoding:    pointer.To("gzip"),
+				ContentLanguage:    pointer.To("en-GB"),
+				ContentType:        pointer.To("text/plain"),
+			},
+			Expected: map[string]string{
+				"sv":   "2023-11-03",
+				"sr":   "f",
+				"si":   "policy1",
+				"spr":  "https,http",
+				"rscc": "no-cache",
+				"rscd": "attachment",
+				"rsce": "gzip",
+				"rscl": "en-GB",
+				"rsct": "text/plain",
+				"sig":  "wDLhSnfEJgk0JFk3wkaJz32zH3haQKM06P3jxLKy1Xk=",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := BuildFileSAS(testAccountName, testAccountKey, v.Input)
+		if err != nil {
+			t.Fatalf("building SAS: %+v", err)
+		}
+
+		values, err := url.ParseQuery(actual)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", actual, err)
+		}
+		if len(values) != len(v.Expected) {
+			t.Fatalf("expected %d query parameters but got %d: %q", len(v.Expected), len(values), actual)
+		}
+		for key, expected := range v.Expected {
+			if value := values.Get(key); value != expected {
+				t.Fatalf("expected %q to be %q but got %q", key, expected, value)
+			}
+		}
+	}
+}
+
+func TestBuildFileSASValidation(t *testing.T) {
+	expiry := pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	testData := []struct {
+		Name  string
+		Input FileSASInput
+	}{
+		{
+			Name: "No Share Name",
+			Input: FileSASInput{
+				Resource:    ShareResource,
+				Permissions: FilePermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "File Path for a Share",
+			Input: FileSASInput{
+				ShareName:   "share1",
+				FilePath:    "file1.txt",
+				Resource:    ShareResource,
+				Permissions: FilePermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "No File Path for a File",
+			Input: FileSASInput{
+				ShareName:   "share1",
+				Resource:    FileResource,
+				Permissions: FilePermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "File Path with a Leading Slash",
+			Input: FileSASInput{
+				ShareName:   "share1",
+				FilePath:    "/file1.txt",
+				Resource:    FileResource,
+				Permissions: FilePermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "List for a File",
+			Input: FileSASInput{
+				ShareName:   "share1",
+				FilePath:    "file1.txt",
+				Resource:    FileResource,
+				Permissions: FilePermissions{List: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "Unknown Resource",
+			Input: FileSASInput{
+				ShareName:   "share1",
+				Resource:    "b",
+				Permissions: FilePermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "No Permissions or Identifier",
+			Input: FileSASInput{
+				ShareName:  "share1",
+				Resource:   ShareResource,
+				ExpiryTime: expiry,
+			},
+		},
+		{
+			Name: "No Expiry or Identifier",
+			Input: FileSASInput{
+				ShareName:   "share1",
+				Resource:    ShareResource,
+				Permissions: FilePermissions{Read: true},
+			},
+		},
+		{
+			Name: "Expiry before Start",
+			Input: FileSASInput{
+				ShareName:   "share1",
+				Resource:    ShareResource,
+				Permissions: FilePermissions{Read: true},
+				StartTime:   pointer.To(expiry.Add(time.Hour)),
+				ExpiryTime:  expiry,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if _, err := BuildFileSAS(testAccountName, testAccountKey, v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
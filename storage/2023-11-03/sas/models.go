@@ -0,0 +1,75 @@
+package sas
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// timeFormat is the ISO 8601 UTC format used for the signed start and expiry times
+const timeFormat = "2006-01-02T15:04:05Z"
+
+type Protocol string
+
+var (
+	// HTTPSAndHTTP allows requests made using both HTTPS and HTTP
+	HTTPSAndHTTP Protocol = "https,http"
+
+	// HTTPSOnly only allows requests made using HTTPS
+	HTTPSOnly Protocol = "https"
+)
+
+// IPRange specifies an IP Address (or range of IP Addresses) from which requests will be accepted
+type IPRange struct {
+	// Start specifies the first IP Address in the range
+	Start string
+
+	// End optionally specifies the last IP Address in the range, when omitted only
+	// requests from the Start IP Address will be accepted
+	End string
+}
+
+func (r IPRange) String() string {
+	if r.End == "" {
+		return r.Start
+	}
+	return fmt.Sprintf("%s-%s", r.Start, r.End)
+}
+
+func formatTime(input *time.Time) string {
+	if input == nil || input.IsZero() {
+		return ""
+	}
+	return input.UTC().Format(timeFormat)
+}
+
+func validateProtocol(input Protocol) error {
+	if input != "" && input != HTTPSAndHTTP && input != HTTPSOnly {
+		return fmt.Errorf("`input.Protocol` must be one of %q or %q", HTTPSAndHTTP, HTTPSOnly)
+	}
+	return nil
+}
+
+func validateIPRange(input *IPRange) error {
+	if input == nil {
+		return nil
+	}
+	if strings.TrimSpace(input.Start) == "" {
+		return fmt.Errorf("`input.IPRange.Start` cannot be an empty string")
+	}
+	return nil
+}
+
+func setIfNotEmpty(values url.Values, key, value string) {
+	if value != "" {
+		values.Set(key, value)
+	}
+}
+
+func valueOrEmpty(input *string) string {
+	if input == nil {
+		return ""
+	}
+	return *input
+}
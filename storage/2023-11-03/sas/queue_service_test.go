@@ -0,0 +1,150 @@
+package sas
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestBuildQueueSAS(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    QueueSASInput
+		Expected map[string]string
+	}{
+		{
+			Name: "Read and Process",
+			Input: QueueSASInput{
+				QueueName:   "queue1",
+				Permissions: QueuePermissions{Read: true, Process: true},
+				ExpiryTime:  pointer.To(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"sp":  "rp",
+				"se":  "2024-01-02T03:04:05Z",
+				"sig": "NSeMZW4WS00ybaLKfhriZS/LuyX/OIHLAbDknKQk4Gw=",
+			},
+		},
+		{
+			Name: "All permissions, an IP Address and HTTPS only",
+			Input: QueueSASInput{
+				QueueName: "queue1",
+				Permissions: QueuePermissions{
+					Read:    true,
+					Add:     true,
+					Update:  true,
+					Process: true,
+				},
+				StartTime:  pointer.To(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				ExpiryTime: pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				IPRange: &IPRange{
+					Start: "10.0.0.1",
+				},
+				Protocol: HTTPSOnly,
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"sp":  "raup",
+				"st":  "2024-01-01T00:00:00Z",
+				"se":  "2024-01-02T00:00:00Z",
+				"sip": "10.0.0.1",
+				"spr": "https",
+				"sig": "w6FDeXUQ4pIDt4FH4c8Cu3Hkb27R41Hr1VzNNOx9tEs=",
+			},
+		},
+		{
+			Name: "Stored Access Policy",
+			Input: QueueSASInput{
+				QueueName:  "queue1",
+				Identifier: pointer.To("policy1"),
+				Protocol:   HTTPSAndHTTP,
+			},
+			Expected: map[string]string{
+				"sv":  "2023-11-03",
+				"si":  "policy1",
+				"spr": "https,http",
+				"sig": "qQ/S6DIST8mLnR7kXGGTGz6oc3patHPy/BqtqTla1wQ=",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := BuildQueueSAS(testAccountName, testAccountKey, v.Input)
+		if err != nil {
+			t.Fatalf("building SAS: %+v", err)
+		}
+
+		values, err := url.ParseQuery(actual)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", actual, err)
+		}
+		if len(values) != len(v.Expected) {
+			t.Fatalf("expected %d query parameters but got %d: %q", len(v.Expected), len(values), actual)
+		}
+		for key, expected := range v.Expected {
+			if value := values.Get(key); value != expected {
+				t.Fatalf("expected %q to be %q but got %q", key, expected, value)
+			}
+		}
+	}
+}
+
+func TestBuildQueueSASValidation(t *testing.T) {
+	expiry := pointer.To(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	testData := []struct {
+		Name  string
+		Input QueueSASInput
+	}{
+		{
+			Name: "No Queue Name",
+			Input: QueueSASInput{
+				Permissions: QueuePermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "Invalid Queue Name",
+			Input: QueueSASInput{
+				QueueName:   "Queue1",
+				Permissions: QueuePermissions{Read: true},
+				ExpiryTime:  expiry,
+			},
+		},
+		{
+			Name: "No Permissions or Identifier",
+			Input: QueueSASInput{
+				QueueName:  "queue1",
+				ExpiryTime: expiry,
+			},
+		},
+		{
+			Name: "No Expiry or Identifier",
+			Input: QueueSASInput{
+				QueueName:   "queue1",
+				Permissions: QueuePermissions{Read: true},
+			},
+		},
+		{
+			Name: "Empty IP Range",
+			Input: QueueSASInput{
+				QueueName:   "queue1",
+				Permissions: QueuePermissions{Read: true},
+				ExpiryTime:  expiry,
+				IPRange:     &IPRange{},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if _, err := BuildQueueSAS(testAccountName, testAccountKey, v.Input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package sas
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// computeSignature returns the base64-encoded HMAC-SHA256 of `stringToSign` using the base64-encoded `key`
+func computeSignature(key, stringToSign string) (string, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", fmt.Errorf("decoding key: %+v", err)
+	}
+
+	h := hmac.New(sha256.New, decodedKey)
+	h.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
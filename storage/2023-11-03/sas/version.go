@@ -0,0 +1,4 @@
+package sas
+
+// signedVersion is the Storage Service version used to sign (and authorize) Shared Access Signatures
+const signedVersion = "2023-11-03"
@@ -0,0 +1,339 @@
+package entities
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+// maxBatchOperations is the maximum number of operations which can be included within a single changeset
+const maxBatchOperations = 100
+
+type BatchOperationType string
+
+const (
+	BatchOperationTypeDelete          BatchOperationType = "Delete"
+	BatchOperationTypeInsert          BatchOperationType = "Insert"
+	BatchOperationTypeInsertOrMerge   BatchOperationType = "InsertOrMerge"
+	BatchOperationTypeInsertOrReplace BatchOperationType = "InsertOrReplace"
+	BatchOperationTypeMerge           BatchOperationType = "Merge"
+	BatchOperationTypeUpdate          BatchOperationType = "Update"
+)
+
+func PossibleValuesForBatchOperationType() []string {
+	return []string{
+		string(BatchOperationTypeDelete),
+		string(BatchOperationTypeInsert),
+		string(BatchOperationTypeInsertOrMerge),
+		string(BatchOperationTypeInsertOrReplace),
+		string(BatchOperationTypeMerge),
+		string(BatchOperationTypeUpdate),
+	}
+}
+
+type BatchOperation struct {
+	// The type of operation which should be performed on this Entity
+	Type BatchOperationType
+
+	// The Entity which should be written, this is unused for Delete operations
+	Entity map[string]interface{}
+
+	// The PartitionKey and RowKey of the Entity, all operations within a Batch must share the same PartitionKey
+	PartitionKey string
+	RowKey       string
+
+	// An optional ETag which must match the current ETag of the Entity for Delete, Merge and Update
+	// operations to succeed. When omitted the operation is performed unconditionally.
+	ETag *string
+}
+
+type BatchInput struct {
+	// The operations which should be performed atomically, up to 100 operations can be specified
+	Operations []BatchOperation
+}
+
+type BatchOperationResult struct {
+	// The HTTP Status Code returned for this operation
+	StatusCode int
+
+	// The ETag of the Entity, where returned for this operation
+	ETag string
+}
+
+type BatchResponse struct {
+	HttpResponse *http.Response
+
+	// The results of each operation, in the same order as the operations within the BatchInput
+	Results []BatchOperationResult
+}
+
+// Batch performs the specified operations as a single atomic Entity Group Transaction, either all of
+// the operations succeed or none of them are applied.
+func (c Client) Batch(ctx context.Context, tableName string, input BatchInput) (result BatchResponse, err error) {
+	if tableName == "" {
+		return result, fmt.Errorf("`tableName` cannot be an empty string")
+	}
+
+	if err = validateBatchOperations(input.Operations); err != nil {
+		return
+	}
+
+	batchBoundary := fmt.Sprintf("batch_%s", uuid.New().String())
+	changesetBoundary := fmt.Sprintf("changeset_%s", uuid.New().String())
+	body, err := buildBatchPayload(c.Client.BaseUri, tableName, input.Operations, batchBoundary, changesetBoundary)
+	if err != nil {
+		return result, fmt.Errorf("building batch payload: %+v", err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: fmt.Sprintf("multipart/mixed; boundary=%s", batchBoundary),
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: batchOptions{},
+		Path:          "/$batch",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	err = req.Marshal(body)
+	if err != nil {
+		return result, fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	result.Results, err = parseBatchResponse(resp.Response)
+	if err != nil {
+		return result, fmt.Errorf("parsing batch response: %+v", err)
+	}
+
+	// when any operation fails the changeset is rolled back and only the failing operation is returned
+	for _, v := range result.Results {
+		if v.StatusCode >= http.StatusBadRequest {
+			return result, fmt.Errorf("the batch was rolled back since an operation failed with status %d", v.StatusCode)
+		}
+	}
+
+	return
+}
+
+type batchOptions struct{}
+
+func (b batchOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("Accept", acceptHeader(NoMetaData))
+	headers.Append("DataServiceVersion", "3.0")
+	return headers
+}
+
+func (b batchOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (b batchOptions) ToQuery() *client.QueryParams {
+	return nil
+}
+
+func validateBatchOperations(operations []BatchOperation) error {
+	if len(operations) == 0 {
+		return fmt.Errorf("`input.Operations` must contain at least one operation")
+	}
+	if len(operations) > maxBatchOperations {
+		return fmt.Errorf("`input.Operations` can contain at most %d operations but got %d", maxBatchOperations, len(operations))
+	}
+
+	partitionKey := operations[0].PartitionKey
+	rowKeys := make(map[string]struct{}, len(operations))
+	for i, v := range operations {
+		if !isValidBatchOperationType(v.Type) {
+			return fmt.Errorf("`input.Operations[%d].Type` must be one of %s but got %q", i, strings.Join(PossibleValuesForBatchOperationType(), ", "), v.Type)
+		}
+		if v.PartitionKey == "" {
+			return fmt.Errorf("`input.Operations[%d].PartitionKey` cannot be an empty string", i)
+		}
+		if v.RowKey == "" {
+			return fmt.Errorf("`input.Operations[%d].RowKey` cannot be an empty string", i)
+		}
+		if v.PartitionKey != partitionKey {
+			return fmt.Errorf("all operations within a batch must share the same PartitionKey, `input.Operations[%d].PartitionKey` was %q but expected %q", i, v.PartitionKey, partitionKey)
+		}
+		if _, exists := rowKeys[v.RowKey]; exists {
+			return fmt.Errorf("`input.Operations[%d].RowKey` %q is duplicated, an Entity can only appear once within a batch", i, v.RowKey)
+		}
+		rowKeys[v.RowKey] = struct{}{}
+	}
+
+	return nil
+}
+
+func isValidBatchOperationType(input BatchOperationType) bool {
+	for _, v := range PossibleValuesForBatchOperationType() {
+		if string(input) == v {
+			return true
+		}
+	}
+	return false
+}
+
+// buildBatchPayload builds the multipart/mixed payload for a batch, containing a single changeset
+func buildBatchPayload(baseUri, tableName string, operations []BatchOperation, batchBoundary, changesetBoundary string) ([]byte, error) {
+	baseUri = strings.TrimSuffix(baseUri, "/")
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "--%s\r\n", batchBoundary)
+	fmt.Fprintf(buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", changesetBoundary)
+
+	for i, v := range operations {
+		method := http.MethodPut
+		// the keys are already escaped, however the separator contains a space which isn't valid within a request line
+		path := strings.ReplaceAll(entityPath(tableName, v.PartitionKey, v.RowKey), ", ", ",")
+		includeBody := true
+		ifMatch := ""
+		switch v.Type {
+		case BatchOperationTypeDelete:
+			method = http.MethodDelete
+			includeBody = false
+			ifMatch = ifMatchHeader(v.ETag)
+		case BatchOperationTypeInsert:
+			method = http.MethodPost
+			path = fmt.Sprintf("/%s", tableName)
+		case BatchOperationTypeInsertOrMerge:
+			method = "MERGE"
+		case BatchOperationTypeInsertOrReplace:
+			method = http.MethodPut
+		case BatchOperationTypeMerge:
+			method = "MERGE"
+			ifMatch = ifMatchHeader(v.ETag)
+		case BatchOperationTypeUpdate:
+			method = http.MethodPut
+			ifMatch = ifMatchHeader(v.ETag)
+		}
+
+		fmt.Fprintf(buf, "--%s\r\n", changesetBoundary)
+		buf.WriteString("Content-Type: application/http\r\n")
+		buf.WriteString("Content-Transfer-Encoding: binary\r\n\r\n")
+		fmt.Fprintf(buf, "%s %s%s HTTP/1.1\r\n", method, baseUri, path)
+		fmt.Fprintf(buf, "Accept: %s\r\n", acceptHeader(NoMetaData))
+		fmt.Fprintf(buf, "Content-ID: %d\r\n", i+1)
+		buf.WriteString("DataServiceVersion: 3.0\r\n")
+		if ifMatch != "" {
+			fmt.Fprintf(buf, "If-Match: %s\r\n", ifMatch)
+		}
+		if v.Type == BatchOperationTypeInsert {
+			buf.WriteString("Prefer: return-no-content\r\n")
+		}
+
+		if includeBody {
+			body, err := json.Marshal(buildEntity(v.Entity, v.PartitionKey, v.RowKey))
+			if err != nil {
+				return nil, fmt.Errorf("marshalling entity for operation %d: %+v", i, err)
+			}
+			buf.WriteString("Content-Type: application/json\r\n")
+			fmt.Fprintf(buf, "Content-Length: %d\r\n\r\n", len(body))
+			buf.Write(body)
+			buf.WriteString("\r\n")
+		} else {
+			// terminate the headers, the trailing CRLF forms part of the next boundary delimiter
+			buf.WriteString("\r\n\r\n")
+		}
+	}
+
+	fmt.Fprintf(buf, "--%s--\r\n", changesetBoundary)
+	fmt.Fprintf(buf, "--%s--\r\n", batchBoundary)
+	return buf.Bytes(), nil
+}
+
+// parseBatchResponse parses the multipart/mixed response for a batch into the results for each operation
+func parseBatchResponse(resp *http.Response) ([]BatchOperationResult, error) {
+	if resp == nil || resp.Body == nil {
+		return nil, fmt.Errorf("the response body was empty")
+	}
+	defer resp.Body.Close()
+
+	batchReader, err := multipartReader(resp.Header.Get("Content-Type"), resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading batch response: %+v", err)
+	}
+
+	results := make([]BatchOperationResult, 0)
+	for {
+		part, err := batchReader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading batch response part: %+v", err)
+		}
+
+		changesetReader, err := multipartReader(part.Header.Get("Content-Type"), part)
+		if err != nil {
+			return nil, fmt.Errorf("reading changeset response: %+v", err)
+		}
+
+		for {
+			operationPart, err := changesetReader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("reading changeset response part: %+v", err)
+			}
+
+			operationResp, err := http.ReadResponse(bufio.NewReader(operationPart), nil)
+			if err != nil {
+				return nil, fmt.Errorf("parsing operation response: %+v", err)
+			}
+			// drain the body so that the next part can be read
+			_, _ = io.Copy(io.Discard, operationResp.Body)
+			operationResp.Body.Close()
+
+			results = append(results, BatchOperationResult{
+				StatusCode: operationResp.StatusCode,
+				ETag:       operationResp.Header.Get("ETag"),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+func multipartReader(contentType string, body io.Reader) (*multipart.Reader, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("parsing content type %q: %+v", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("expected a multipart content type but got %q", mediaType)
+	}
+	boundary, ok := params["boundary"]
+	if !ok {
+		return nil, fmt.Errorf("the content type %q did not contain a boundary", contentType)
+	}
+	return multipart.NewReader(body, boundary), nil
+}
@@ -0,0 +1,280 @@
+package entities
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestValidateBatchOperations(t *testing.T) {
+	tooMany := make([]BatchOperation, 0)
+	for i := 0; i < 101; i++ {
+		tooMany = append(tooMany, BatchOperation{
+			Type:         BatchOperationTypeInsert,
+			PartitionKey: "partition",
+			RowKey:       strings.Repeat("a", i+1),
+		})
+	}
+
+	testData := []struct {
+		Name          string
+		Input         []BatchOperation
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Empty",
+			Input:         []BatchOperation{},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Single Operation",
+			Input: []BatchOperation{
+				{
+					Type:         BatchOperationTypeInsert,
+					PartitionKey: "partition",
+					RowKey:       "row1",
+				},
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Shared PartitionKey",
+			Input: []BatchOperation{
+				{
+					Type:         BatchOperationTypeInsert,
+					PartitionKey: "partition",
+					RowKey:       "row1",
+				},
+				{
+					Type:         BatchOperationTypeDelete,
+					PartitionKey: "partition",
+					RowKey:       "row2",
+				},
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Mixed PartitionKeys",
+			Input: []BatchOperation{
+				{
+					Type:         BatchOperationTypeInsert,
+					PartitionKey: "partition1",
+					RowKey:       "row1",
+				},
+				{
+					Type:         BatchOperationTypeInsert,
+					PartitionKey: "partition2",
+					RowKey:       "row2",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Duplicate RowKey",
+			Input: []BatchOperation{
+				{
+					Type:         BatchOperationTypeInsert,
+					PartitionKey: "partition",
+					RowKey:       "row1",
+				},
+				{
+					Type:         BatchOperationTypeMerge,
+					PartitionKey: "partition",
+					RowKey:       "row1",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Missing RowKey",
+			Input: []BatchOperation{
+				{
+					Type:         BatchOperationTypeInsert,
+					PartitionKey: "partition",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Invalid Type",
+			Input: []BatchOperation{
+				{
+					Type:         "Upsert",
+					PartitionKey: "partition",
+					RowKey:       "row1",
+				},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Maximum Operations",
+			Input:         tooMany[0:100],
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Too Many Operations",
+			Input:         tooMany,
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateBatchOperations(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected the input to be valid but got: %+v", err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected the input to be invalid but it was valid")
+		}
+	}
+}
+
+func TestBuildBatchPayload(t *testing.T) {
+	operations := []BatchOperation{
+		{
+			Type:         BatchOperationTypeInsert,
+			PartitionKey: "partition",
+			RowKey:       "row1",
+			Entity: map[string]interface{}{
+				"hello": "world",
+			},
+		},
+		{
+			Type:         BatchOperationTypeDelete,
+			PartitionKey: "partition",
+			RowKey:       "row 2",
+			ETag:         pointer.To("W/\"abc\""),
+		},
+	}
+	payload, err := buildBatchPayload("https://account1.table.core.windows.net/", "table1", operations, "batch_1", "changeset_1")
+	if err != nil {
+		t.Fatalf("building payload: %+v", err)
+	}
+
+	batchReader := multipart.NewReader(bytes.NewReader(payload), "batch_1")
+	changeset, err := batchReader.NextPart()
+	if err != nil {
+		t.Fatalf("reading changeset: %+v", err)
+	}
+	if v := changeset.Header.Get("Content-Type"); v != "multipart/mixed; boundary=changeset_1" {
+		t.Fatalf("expected the changeset Content-Type to be %q but got %q", "multipart/mixed; boundary=changeset_1", v)
+	}
+
+	expected := []string{
+		"POST https://account1.table.core.windows.net/table1 HTTP/1.1\r\n" +
+			"Accept: application/json;odata=nometadata\r\n" +
+			"Content-ID: 1\r\n" +
+			"DataServiceVersion: 3.0\r\n" +
+			"Prefer: return-no-content\r\n" +
+			"Content-Type: application/json\r\n" +
+			"Content-Length: 60\r\n\r\n" +
+			`{"PartitionKey":"partition","RowKey":"row1","hello":"world"}`,
+		"DELETE https://account1.table.core.windows.net/table1(PartitionKey='partition',RowKey='row%202') HTTP/1.1\r\n" +
+			"Accept: application/json;odata=nometadata\r\n" +
+			"Content-ID: 2\r\n" +
+			"DataServiceVersion: 3.0\r\n" +
+			"If-Match: W/\"abc\"\r\n\r\n",
+	}
+
+	changesetReader := multipart.NewReader(changeset, "changeset_1")
+	for i, v := range expected {
+		part, err := changesetReader.NextPart()
+		if err != nil {
+			t.Fatalf("reading operation %d: %+v", i, err)
+		}
+		if ct := part.Header.Get("Content-Type"); ct != "application/http" {
+			t.Fatalf("expected operation %d to have the Content-Type %q but got %q", i, "application/http", ct)
+		}
+		actual, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("reading operation %d: %+v", i, err)
+		}
+		if string(actual) != v {
+			t.Fatalf("expected operation %d to be:\n%q\nbut got:\n%q", i, v, string(actual))
+		}
+	}
+	if _, err := changesetReader.NextPart(); err != io.EOF {
+		t.Fatalf("expected only %d operations within the changeset but got: %+v", len(expected), err)
+	}
+}
+
+func TestParseBatchResponse(t *testing.T) {
+	body := "--batchresponse_1\r\n" +
+		"Content-Type: multipart/mixed; boundary=changesetresponse_1\r\n\r\n" +
+		"--changesetresponse_1\r\n" +
+		"Content-Type: application/http\r\n" +
+		"Content-Transfer-Encoding: binary\r\n\r\n" +
+		"HTTP/1.1 204 No Content\r\n" +
+		"Content-ID: 1\r\n" +
+		"ETag: W/\"datetime'2024-01-01T00%3A00%3A00.0000000Z'\"\r\n\r\n" +
+		"\r\n--changesetresponse_1\r\n" +
+		"Content-Type: application/http\r\n" +
+		"Content-Transfer-Encoding: binary\r\n\r\n" +
+		"HTTP/1.1 204 No Content\r\n" +
+		"Content-ID: 2\r\n\r\n" +
+		"\r\n--changesetresponse_1--\r\n" +
+		"--batchresponse_1--\r\n"
+
+	resp := &http.Response{
+		StatusCode: http.StatusAccepted,
+		Header: http.Header{
+			"Content-Type": []string{"multipart/mixed; boundary=batchresponse_1"},
+		},
+		Body: io.NopCloser(strings.NewReader(body)),
+	}
+	results, err := parseBatchResponse(resp)
+	if err != nil {
+		t.Fatalf("parsing response: %+v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results but got %d", len(results))
+	}
+	if results[0].StatusCode != http.StatusNoContent || results[1].StatusCode != http.StatusNoContent {
+		t.Fatalf("expected both results to have the status %d but got %d and %d", http.StatusNoContent, results[0].StatusCode, results[1].StatusCode)
+	}
+	if expected := "W/\"datetime'2024-01-01T00%3A00%3A00.0000000Z'\""; results[0].ETag != expected {
+		t.Fatalf("expected the first ETag to be %q but got %q", expected, results[0].ETag)
+	}
+	if results[1].ETag != "" {
+		t.Fatalf("expected the second ETag to be empty but got %q", results[1].ETag)
+	}
+}
+
+func TestParseBatchResponseFailure(t *testing.T) {
+	body := "--batchresponse_1\r\n" +
+		"Content-Type: multipart/mixed; boundary=changesetresponse_1\r\n\r\n" +
+		"--changesetresponse_1\r\n" +
+		"Content-Type: application/http\r\n" +
+		"Content-Transfer-Encoding: binary\r\n\r\n" +
+		"HTTP/1.1 409 Conflict\r\n" +
+		"Content-ID: 1\r\n" +
+		"Content-Type: application/json;odata=nometadata;streaming=true;charset=utf-8\r\n\r\n" +
+		`{"odata.error":{"code":"EntityAlreadyExists","message":{"lang":"en-US","value":"0:The specified entity already exists."}}}` +
+		"\r\n--changesetresponse_1--\r\n" +
+		"--batchresponse_1--\r\n"
+
+	resp := &http.Response{
+		StatusCode: http.StatusAccepted,
+		Header: http.Header{
+			"Content-Type": []string{"multipart/mixed; boundary=batchresponse_1"},
+		},
+		Body: io.NopCloser(strings.NewReader(body)),
+	}
+	results, err := parseBatchResponse(resp)
+	if err != nil {
+		t.Fatalf("parsing response: %+v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result but got %d", len(results))
+	}
+	if results[0].StatusCode != http.StatusConflict {
+		t.Fatalf("expected the status %d but got %d", http.StatusConflict, results[0].StatusCode)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteEntityInput struct {
@@ -17,6 +18,10 @@ type DeleteEntityInput struct {
 	// because they are canonically sorted. For example, you should convert the value 1 to 0000001 to ensure proper sorting.
 	RowKey       string
 	PartitionKey string
+
+	// The ETag of the Entity, which must match for the Entity to be deleted.
+	// When omitted, the Entity is deleted regardless of its ETag.
+	ETag *string
 }
 
 type DeleteEntityResponse struct {
@@ -43,9 +48,11 @@ func (c Client) Delete(ctx context.Context, tableName string, input DeleteEntity
 		ExpectedStatusCodes: []int{
 			http.StatusNoContent,
 		},
-		HttpMethod:    http.MethodDelete,
-		OptionsObject: deleteEntitiesOptions{},
-		Path:          fmt.Sprintf("/%s(PartitionKey='%s', RowKey='%s')", tableName, input.PartitionKey, input.RowKey),
+		HttpMethod: http.MethodDelete,
+		OptionsObject: deleteEntitiesOptions{
+			etag: input.ETag,
+		},
+		Path: entityPath(tableName, input.PartitionKey, input.RowKey),
 	}
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -60,18 +67,20 @@ func (c Client) Delete(ctx context.Context, tableName string, input DeleteEntity
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 	return
 }
 
-type deleteEntitiesOptions struct{}
+type deleteEntitiesOptions struct {
+	etag *string
+}
 
 func (d deleteEntitiesOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	headers.Append("Accept", "application/json")
-	headers.Append("If-Match", "*")
+	headers.Append("Accept", acceptHeader(NoMetaData))
+	headers.Append("If-Match", ifMatchHeader(d.etag))
 	return headers
 }
 
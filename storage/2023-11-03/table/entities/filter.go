@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StringLiteral returns the OData literal for the specified string, for use within a Filter,
+// wrapping it in single quotes and escaping any single quotes it contains
+func StringLiteral(input string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(input, "'", "''"))
+}
+
+// DateTimeLiteral returns the OData literal for the specified time (an Edm.DateTime), for use within a Filter
+// e.g. `datetime'2024-01-01T00:00:00Z'`
+func DateTimeLiteral(input time.Time) string {
+	return fmt.Sprintf("datetime'%s'", input.UTC().Format(time.RFC3339Nano))
+}
+
+// GuidLiteral returns the OData literal for the specified GUID (an Edm.Guid), for use within a Filter
+// e.g. `guid'00000000-0000-0000-0000-000000000000'`
+func GuidLiteral(input string) string {
+	return fmt.Sprintf("guid'%s'", input)
+}
+
+// Int64Literal returns the OData literal for the specified 64-bit integer (an Edm.Int64), for use within a Filter
+// e.g. `123L`
+func Int64Literal(input int64) string {
+	return fmt.Sprintf("%dL", input)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetEntityInput struct {
@@ -20,10 +21,15 @@ type GetEntityInput struct {
 type GetEntityResponse struct {
 	HttpResponse *http.Response
 
+	// The ETag of the Entity, which can be used for optimistic concurrency when updating or deleting it
+	ETag string
+
+	// The Entity, where numeric values are returned as a `json.Number` and (depending on the MetaDataLevel)
+	// the OData types for properties are returned as `{name}@odata.type`
 	Entity map[string]interface{}
 }
 
-// Get queries entities in a table and includes the $filter and $select options.
+// Get retrieves the Entity identified by the specified PartitionKey and RowKey
 func (c Client) Get(ctx context.Context, tableName string, input GetEntityInput) (result GetEntityResponse, err error) {
 	if tableName == "" {
 		return result, fmt.Errorf("`tableName` cannot be an empty string")
@@ -45,7 +51,7 @@ func (c Client) Get(ctx context.Context, tableName string, input GetEntityInput)
 		OptionsObject: getEntitiesOptions{
 			MetaDataLevel: input.MetaDataLevel,
 		},
-		Path: fmt.Sprintf("/%s(PartitionKey='%s', RowKey='%s')", tableName, input.PartitionKey, input.RowKey),
+		Path: entityPath(tableName, input.PartitionKey, input.RowKey),
 	}
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -60,7 +66,11 @@ func (c Client) Get(ctx context.Context, tableName string, input GetEntityInput)
 		result.HttpResponse = resp.Response
 
 		if err == nil {
-			err = resp.Unmarshal(&result.Entity)
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+			}
+
+			result.Entity, err = decodeEntity(resp.Response)
 			if err != nil {
 				err = fmt.Errorf("unmarshalling response: %+v", err)
 				return
@@ -68,7 +78,7 @@ func (c Client) Get(ctx context.Context, tableName string, input GetEntityInput)
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -81,7 +91,7 @@ type getEntitiesOptions struct {
 
 func (g getEntitiesOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	headers.Append("Accept", fmt.Sprintf("application/json;odata=%s", g.MetaDataLevel))
+	headers.Append("Accept", acceptHeader(g.MetaDataLevel))
 	headers.Append("DataServiceVersion", "3.0;NetFx")
 	headers.Append("MaxDataServiceVersion", "3.0;NetFx")
 	return headers
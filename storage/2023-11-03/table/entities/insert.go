@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type InsertEntityInput struct {
@@ -29,6 +30,9 @@ type InsertEntityInput struct {
 
 type InsertResponse struct {
 	HttpResponse *http.Response
+
+	// The ETag of the Entity
+	ETag string
 }
 
 // Insert inserts a new entity into a table.
@@ -63,10 +67,8 @@ func (c Client) Insert(ctx context.Context, tableName string, input InsertEntity
 		return
 	}
 
-	input.Entity["PartitionKey"] = input.PartitionKey
-	input.Entity["RowKey"] = input.RowKey
-
-	err = req.Marshal(&input.Entity)
+	entity := buildEntity(input.Entity, input.PartitionKey, input.RowKey)
+	err = req.Marshal(&entity)
 	if err != nil {
 		return result, fmt.Errorf("marshalling request: %+v", err)
 	}
@@ -75,9 +77,15 @@ func (c Client) Insert(ctx context.Context, tableName string, input InsertEntity
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -90,7 +98,7 @@ type insertOptions struct {
 
 func (i insertOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	headers.Append("Accept", fmt.Sprintf("application/json;odata=%s", i.MetaDataLevel))
+	headers.Append("Accept", acceptHeader(i.MetaDataLevel))
 	headers.Append("Prefer", "return-no-content")
 	return headers
 }
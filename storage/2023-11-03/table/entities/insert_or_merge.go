@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type InsertOrMergeEntityInput struct {
@@ -26,6 +27,9 @@ type InsertOrMergeEntityInput struct {
 
 type InsertOrMergeResponse struct {
 	HttpResponse *http.Response
+
+	// The ETag of the Entity
+	ETag string
 }
 
 // InsertOrMerge updates an existing entity or inserts a new entity if it does not exist in the table.
@@ -50,7 +54,7 @@ func (c Client) InsertOrMerge(ctx context.Context, tableName string, input Inser
 		},
 		HttpMethod:    "MERGE",
 		OptionsObject: insertOrMergeOptions{},
-		Path:          fmt.Sprintf("/%s(PartitionKey='%s', RowKey='%s')", tableName, input.PartitionKey, input.RowKey),
+		Path:          entityPath(tableName, input.PartitionKey, input.RowKey),
 	}
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -59,10 +63,8 @@ func (c Client) InsertOrMerge(ctx context.Context, tableName string, input Inser
 		return
 	}
 
-	input.Entity["PartitionKey"] = input.PartitionKey
-	input.Entity["RowKey"] = input.RowKey
-
-	err = req.Marshal(&input.Entity)
+	entity := buildEntity(input.Entity, input.PartitionKey, input.RowKey)
+	err = req.Marshal(&entity)
 	if err != nil {
 		return result, fmt.Errorf("marshalling request: %+v", err)
 	}
@@ -71,9 +73,15 @@ func (c Client) InsertOrMerge(ctx context.Context, tableName string, input Inser
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -84,7 +92,7 @@ type insertOrMergeOptions struct{}
 
 func (i insertOrMergeOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	headers.Append("Accept", "application/json")
+	headers.Append("Accept", acceptHeader(NoMetaData))
 	headers.Append("Prefer", "return-no-content")
 	return headers
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type InsertOrReplaceEntityInput struct {
@@ -26,6 +27,9 @@ type InsertOrReplaceEntityInput struct {
 
 type InsertOrReplaceResponse struct {
 	HttpResponse *http.Response
+
+	// The ETag of the Entity
+	ETag string
 }
 
 // InsertOrReplace replaces an existing entity or inserts a new entity if it does not exist in the table.
@@ -48,9 +52,9 @@ func (c Client) InsertOrReplace(ctx context.Context, tableName string, input Ins
 		ExpectedStatusCodes: []int{
 			http.StatusNoContent,
 		},
-		HttpMethod:    "MERGE",
+		HttpMethod:    http.MethodPut,
 		OptionsObject: insertOrReplaceOptions{},
-		Path:          fmt.Sprintf("/%s(PartitionKey='%s', RowKey='%s')", tableName, input.PartitionKey, input.RowKey),
+		Path:          entityPath(tableName, input.PartitionKey, input.RowKey),
 	}
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -59,10 +63,8 @@ func (c Client) InsertOrReplace(ctx context.Context, tableName string, input Ins
 		return
 	}
 
-	input.Entity["PartitionKey"] = input.PartitionKey
-	input.Entity["RowKey"] = input.RowKey
-
-	err = req.Marshal(&input.Entity)
+	entity := buildEntity(input.Entity, input.PartitionKey, input.RowKey)
+	err = req.Marshal(&entity)
 	if err != nil {
 		return result, fmt.Errorf("marshalling request: %+v", err)
 	}
@@ -71,9 +73,15 @@ func (c Client) InsertOrReplace(ctx context.Context, tableName string, input Ins
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+			}
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -84,7 +92,7 @@ type insertOrReplaceOptions struct{}
 
 func (i insertOrReplaceOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	headers.Append("Accept", "application/json")
+	headers.Append("Accept", acceptHeader(NoMetaData))
 	headers.Append("Prefer", "return-no-content")
 	return headers
 }
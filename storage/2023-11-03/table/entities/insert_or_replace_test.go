@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestInsertOrReplaceSendsAPut(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a MERGE would retain properties which aren't specified, and an If-Match would make this an Update
+		if r.Method != http.MethodPut {
+			t.Errorf("expected a %s request but got %s", http.MethodPut, r.Method)
+		}
+		if v := r.Header.Get("If-Match"); v != "" {
+			t.Errorf("expected no `If-Match` header but got %q", v)
+		}
+		w.Header().Set("ETag", "W/\"datetime'2024-01-02T03%3A04%3A05Z'\"")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	entitiesClient, err := NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := entitiesClient.InsertOrReplace(ctx, "table", InsertOrReplaceEntityInput{
+		PartitionKey: "hello",
+		RowKey:       "there",
+		Entity: map[string]interface{}{
+			"hello": "pandas",
+		},
+	})
+	if err != nil {
+		t.Fatalf("inserting or replacing: %+v", err)
+	}
+	if result.ETag != "W/\"datetime'2024-01-02T03%3A04%3A05Z'\"" {
+		t.Fatalf("expected the ETag to be returned but got %q", result.ETag)
+	}
+}
@@ -3,9 +3,11 @@ package entities
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/jackofallops/giovanni/storage/2020-08-04/table/tables"
@@ -82,7 +84,8 @@ func TestEntitiesLifecycle(t *testing.T) {
 		PartitionKey: partitionKey,
 		RowKey:       rowKey,
 		Entity: map[string]interface{}{
-			"hello": "ther88e",
+			"hello":   "ther88e",
+			"dropped": "value",
 		},
 	}
 	if _, err := entitiesClient.InsertOrMerge(ctx, tableName, insertOrMergeInput); err != nil {
@@ -101,6 +104,23 @@ func TestEntitiesLifecycle(t *testing.T) {
 		t.Logf("Error inserting/replacing: %s", err)
 	}
 
+	t.Logf("[DEBUG] Retrieving the Replaced Entity..")
+	replaced, err := entitiesClient.Get(ctx, tableName, GetEntityInput{
+		MetaDataLevel: NoMetaData,
+		PartitionKey:  partitionKey,
+		RowKey:        rowKey,
+	})
+	if err != nil {
+		t.Fatalf("Error retrieving the replaced entity: %s", err)
+	}
+	if v := replaced.Entity["hello"]; v != "pandas" {
+		t.Fatalf("Expected `hello` to be %q but got %v", "pandas", v)
+	}
+	// a property which isn't specified is removed when the entity is replaced, unlike a merge
+	if _, ok := replaced.Entity["dropped"]; ok {
+		t.Fatalf("Expected `dropped` to be removed when replacing the entity")
+	}
+
 	t.Logf("[DEBUG] Querying..")
 	queryInput := QueryEntitiesInput{
 		MetaDataLevel: NoMetaData,
@@ -125,6 +145,18 @@ func TestEntitiesLifecycle(t *testing.T) {
 		}
 	}
 
+	t.Logf("[DEBUG] Querying all pages..")
+	allResults, err := entitiesClient.QueryComplete(ctx, tableName, QueryEntitiesInput{
+		Filter: pointer.To(fmt.Sprintf("PartitionKey eq %s", StringLiteral(partitionKey))),
+		Top:    pointer.To(1),
+	})
+	if err != nil {
+		t.Fatalf("Error querying all pages: %s", err)
+	}
+	if len(allResults) != 1 {
+		t.Fatalf("Expected 1 item but got %d", len(allResults))
+	}
+
 	t.Logf("[DEBUG] Retrieving..")
 	getInput := GetEntityInput{
 		MetaDataLevel: MinimalMetaData,
@@ -145,6 +177,44 @@ func TestEntitiesLifecycle(t *testing.T) {
 		t.Fatalf("Expected Row Key to be %q but got %q", rowKey, rowKey2)
 	}
 
+	t.Logf("[DEBUG] Merging with the ETag..")
+	mergeResult, err := entitiesClient.Merge(ctx, tableName, MergeEntityInput{
+		PartitionKey: partitionKey,
+		RowKey:       rowKey,
+		ETag:         &getResults.ETag,
+		Entity: map[string]interface{}{
+			"merged": "value",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error merging: %s", err)
+	}
+
+	t.Logf("[DEBUG] Updating with a stale ETag..")
+	_, err = entitiesClient.Update(ctx, tableName, UpdateEntityInput{
+		PartitionKey: partitionKey,
+		RowKey:       rowKey,
+		ETag:         &getResults.ETag,
+		Entity: map[string]interface{}{
+			"hello": "stale",
+		},
+	})
+	if err == nil {
+		t.Fatalf("Expected an error when updating with a stale ETag but didn't get one")
+	}
+
+	t.Logf("[DEBUG] Updating with the latest ETag..")
+	if _, err := entitiesClient.Update(ctx, tableName, UpdateEntityInput{
+		PartitionKey: partitionKey,
+		RowKey:       rowKey,
+		ETag:         &mergeResult.ETag,
+		Entity: map[string]interface{}{
+			"hello": "updated",
+		},
+	}); err != nil {
+		t.Fatalf("Error updating: %s", err)
+	}
+
 	t.Logf("[DEBUG] Deleting..")
 	deleteInput := DeleteEntityInput{
 		PartitionKey: partitionKey,
@@ -153,4 +223,56 @@ func TestEntitiesLifecycle(t *testing.T) {
 	if _, err := entitiesClient.Delete(ctx, tableName, deleteInput); err != nil {
 		t.Logf("Error deleting: %s", err)
 	}
+
+	t.Logf("[DEBUG] Inserting in a Batch..")
+	batchResult, err := entitiesClient.Batch(ctx, tableName, BatchInput{
+		Operations: []BatchOperation{
+			{
+				Type:         BatchOperationTypeInsert,
+				PartitionKey: partitionKey,
+				RowKey:       "batch1",
+				Entity: map[string]interface{}{
+					"hello": "world",
+				},
+			},
+			{
+				Type:         BatchOperationTypeInsertOrReplace,
+				PartitionKey: partitionKey,
+				RowKey:       "batch2",
+				Entity: map[string]interface{}{
+					"hello": "there",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error performing batch: %s", err)
+	}
+	if len(batchResult.Results) != 2 {
+		t.Fatalf("Expected 2 batch results but got %d", len(batchResult.Results))
+	}
+	for i, v := range batchResult.Results {
+		if v.StatusCode != http.StatusNoContent {
+			t.Fatalf("Expected batch operation %d to return status %d but got %d", i, http.StatusNoContent, v.StatusCode)
+		}
+	}
+
+	t.Logf("[DEBUG] Deleting in a Batch..")
+	if _, err := entitiesClient.Batch(ctx, tableName, BatchInput{
+		Operations: []BatchOperation{
+			{
+				Type:         BatchOperationTypeDelete,
+				PartitionKey: partitionKey,
+				RowKey:       "batch1",
+			},
+			{
+				Type:         BatchOperationTypeDelete,
+				PartitionKey: partitionKey,
+				RowKey:       "batch2",
+				ETag:         pointer.To(batchResult.Results[1].ETag),
+			},
+		},
+	}); err != nil {
+		t.Fatalf("Error performing batch deletion: %s", err)
+	}
 }
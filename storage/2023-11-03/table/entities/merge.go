@@ -0,0 +1,110 @@
+package entities
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+)
+
+type MergeEntityInput struct {
+	// The properties which should merged into the existing Entity, by default all values are strings
+	// To explicitly type a property, specify the appropriate OData data type by setting
+	// the m:type attribute within the property definition
+	Entity map[string]interface{}
+
+	// The PartitionKey and RowKey identifying the existing Entity
+	RowKey       string
+	PartitionKey string
+
+	// The ETag of the existing Entity, which must match for the Entity to be updated.
+	// When omitted, the Entity is updated regardless of its ETag (`If-Match: *`).
+	ETag *string
+}
+
+type MergeEntityResponse struct {
+	HttpResponse *http.Response
+
+	// The new ETag of the Entity
+	ETag string
+}
+
+// Merge updates an existing entity by merging the specified properties into the existing entity.
+// The existing entity is only updated if its ETag matches the specified ETag (or any ETag, when omitted).
+func (c Client) Merge(ctx context.Context, tableName string, input MergeEntityInput) (result MergeEntityResponse, err error) {
+	if tableName == "" {
+		return result, fmt.Errorf("`tableName` cannot be an empty string")
+	}
+
+	if input.PartitionKey == "" {
+		return result, fmt.Errorf("`input.PartitionKey` cannot be an empty string")
+	}
+
+	if input.RowKey == "" {
+		return result, fmt.Errorf("`input.RowKey` cannot be an empty string")
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+		},
+		HttpMethod: "MERGE",
+		OptionsObject: mergeOptions{
+			etag: input.ETag,
+		},
+		Path: entityPath(tableName, input.PartitionKey, input.RowKey),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	entity := buildEntity(input.Entity, input.PartitionKey, input.RowKey)
+	err = req.Marshal(&entity)
+	if err != nil {
+		return result, fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if resp.Header != nil {
+				result.ETag = resp.Header.Get("ETag")
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
+		return
+	}
+
+	return
+}
+
+type mergeOptions struct {
+	etag *string
+}
+
+func (o mergeOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("Accept", acceptHeader(NoMetaData))
+	headers.Append("If-Match", ifMatchHeader(o.etag))
+	return headers
+}
+
+func (o mergeOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o mergeOptions) ToQuery() *client.QueryParams {
+	return nil
+}
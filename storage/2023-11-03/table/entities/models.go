@@ -1,5 +1,15 @@
 package entities
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
 type MetaDataLevel string
 
 var (
@@ -7,3 +17,64 @@ var (
 	MinimalMetaData MetaDataLevel = "minimalmetadata"
 	FullMetaData    MetaDataLevel = "fullmetadata"
 )
+
+// acceptHeader returns the value for the `Accept` header for the specified MetaDataLevel,
+// defaulting to `NoMetaData` when no level is specified
+func acceptHeader(level MetaDataLevel) string {
+	if level == "" {
+		level = NoMetaData
+	}
+	return fmt.Sprintf("application/json;odata=%s", level)
+}
+
+// entityPath returns the path for the Entity identified by the specified PartitionKey and RowKey
+// within the specified Table, escaping the keys as required by OData
+func entityPath(tableName, partitionKey, rowKey string) string {
+	return fmt.Sprintf("/%s(PartitionKey='%s', RowKey='%s')", tableName, escapeKey(partitionKey), escapeKey(rowKey))
+}
+
+// escapeKey escapes a PartitionKey or RowKey for use within a path, single quotes are
+// doubled per the OData specification and the result is then percent-encoded
+func escapeKey(input string) string {
+	return url.PathEscape(strings.ReplaceAll(input, "'", "''"))
+}
+
+// ifMatchHeader returns the value for the `If-Match` header, which is the specified ETag
+// or a wildcard (matching any ETag) when no ETag is specified
+func ifMatchHeader(etag *string) string {
+	if etag == nil || *etag == "" {
+		return "*"
+	}
+	return *etag
+}
+
+// buildEntity returns the Entity to be sent to the Table Service, including the PartitionKey and RowKey
+func buildEntity(entity map[string]interface{}, partitionKey, rowKey string) map[string]interface{} {
+	out := make(map[string]interface{}, len(entity)+2)
+	for k, v := range entity {
+		out[k] = v
+	}
+	out["PartitionKey"] = partitionKey
+	out["RowKey"] = rowKey
+	return out
+}
+
+// decodeEntity decodes the JSON representation of an Entity, retaining numeric values as a
+// `json.Number` so that Edm.Int64 and Edm.Double values don't lose precision, and retaining any
+// OData type annotations (e.g. `Timestamp@odata.type`) returned by the Table Service
+func decodeEntity(resp *http.Response) (map[string]interface{}, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	out := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,117 @@
+package entities
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestEntityPath(t *testing.T) {
+	testData := []struct {
+		PartitionKey string
+		RowKey       string
+		Expected     string
+	}{
+		{
+			PartitionKey: "partition",
+			RowKey:       "row",
+			Expected:     "/table1(PartitionKey='partition', RowKey='row')",
+		},
+		{
+			PartitionKey: "o'brien",
+			RowKey:       "row 1",
+			Expected:     "/table1(PartitionKey='o%27%27brien', RowKey='row%201')",
+		},
+		{
+			PartitionKey: "a/b",
+			RowKey:       "100%",
+			Expected:     "/table1(PartitionKey='a%2Fb', RowKey='100%25')",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.PartitionKey, v.RowKey)
+
+		if actual := entityPath("table1", v.PartitionKey, v.RowKey); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestIfMatchHeader(t *testing.T) {
+	if actual := ifMatchHeader(nil); actual != "*" {
+		t.Fatalf("expected %q but got %q", "*", actual)
+	}
+	if actual := ifMatchHeader(pointer.To("")); actual != "*" {
+		t.Fatalf("expected %q but got %q", "*", actual)
+	}
+	etag := `W/"datetime'2024-01-01T00%3A00%3A00.0000000Z'"`
+	if actual := ifMatchHeader(&etag); actual != etag {
+		t.Fatalf("expected %q but got %q", etag, actual)
+	}
+}
+
+func TestAcceptHeader(t *testing.T) {
+	if actual := acceptHeader(""); actual != "application/json;odata=nometadata" {
+		t.Fatalf("expected the default to be nometadata but got %q", actual)
+	}
+	if actual := acceptHeader(FullMetaData); actual != "application/json;odata=fullmetadata" {
+		t.Fatalf("expected fullmetadata but got %q", actual)
+	}
+}
+
+func TestDecodeEntityPreservesTypes(t *testing.T) {
+	body := `{"PartitionKey":"partition","RowKey":"row","Count":9007199254740993,"Count@odata.type":"Edm.Int64","Ratio":1.5,"Enabled":true}`
+	resp := &http.Response{
+		Body: io.NopCloser(strings.NewReader(body)),
+	}
+
+	actual, err := decodeEntity(resp)
+	if err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+
+	count, ok := actual["Count"].(json.Number)
+	if !ok {
+		t.Fatalf("expected `Count` to be a json.Number but got %T", actual["Count"])
+	}
+	if count.String() != "9007199254740993" {
+		t.Fatalf("expected `Count` to retain its precision but got %q", count.String())
+	}
+	if actual["Count@odata.type"] != "Edm.Int64" {
+		t.Fatalf("expected the type annotation to be retained but got %v", actual["Count@odata.type"])
+	}
+	if actual["Enabled"] != true {
+		t.Fatalf("expected `Enabled` to be true but got %v", actual["Enabled"])
+	}
+
+	// the body should remain readable
+	remaining, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %+v", err)
+	}
+	if string(remaining) != body {
+		t.Fatalf("expected the body to be readable after decoding")
+	}
+}
+
+func TestBuildEntity(t *testing.T) {
+	input := map[string]interface{}{
+		"hello": "world",
+	}
+	actual := buildEntity(input, "partition", "row")
+	if actual["PartitionKey"] != "partition" || actual["RowKey"] != "row" || actual["hello"] != "world" {
+		t.Fatalf("unexpected entity: %+v", actual)
+	}
+	if _, ok := input["PartitionKey"]; ok {
+		t.Fatalf("expected the input not to be modified")
+	}
+
+	if actual := buildEntity(nil, "partition", "row"); len(actual) != 2 {
+		t.Fatalf("expected a nil entity to contain only the keys but got %+v", actual)
+	}
+}
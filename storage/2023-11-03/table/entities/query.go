@@ -9,16 +9,18 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type QueryEntitiesInput struct {
-	// An optional OData filter
+	// An optional OData filter, for example `PartitionKey eq 'abc' and Timestamp ge datetime'2024-01-01T00:00:00Z'`.
+	// The helpers `StringLiteral`, `DateTimeLiteral` and `GuidLiteral` can be used to build the literal values.
 	Filter *string
 
-	// An optional comma-separated
+	// An optional list of the properties which should be returned for each Entity
 	PropertyNamesToSelect *[]string
 
-	// An optional OData top
+	// An optional maximum number of Entities to return, up to 1000
 	Top *int
 
 	PartitionKey string
@@ -37,6 +39,8 @@ type QueryEntitiesInput struct {
 type QueryEntitiesResponse struct {
 	HttpResponse *http.Response
 
+	// The continuation tokens which should be used to retrieve the next page of results, which
+	// are empty when no further results are available
 	NextPartitionKey string
 	NextRowKey       string
 
@@ -50,13 +54,17 @@ func (c Client) Query(ctx context.Context, tableName string, input QueryEntities
 		return result, fmt.Errorf("`tableName` cannot be an empty string")
 	}
 
+	if input.Top != nil && (*input.Top <= 0 || *input.Top > 1000) {
+		return result, fmt.Errorf("`input.Top` can either be nil or between 1 and 1000")
+	}
+
 	additionalParameters := make([]string, 0)
 	if input.PartitionKey != "" {
-		additionalParameters = append(additionalParameters, "PartitionKey='%s'", input.PartitionKey)
+		additionalParameters = append(additionalParameters, fmt.Sprintf("PartitionKey='%s'", escapeKey(input.PartitionKey)))
 	}
 
 	if input.RowKey != "" {
-		additionalParameters = append(additionalParameters, "RowKey='%s'", input.RowKey)
+		additionalParameters = append(additionalParameters, fmt.Sprintf("RowKey='%s'", escapeKey(input.RowKey)))
 	}
 
 	path := fmt.Sprintf("/%s", tableName)
@@ -88,6 +96,11 @@ func (c Client) Query(ctx context.Context, tableName string, input QueryEntities
 		result.HttpResponse = resp.Response
 
 		if err == nil {
+			if resp.Header != nil {
+				result.NextPartitionKey = resp.Header.Get("x-ms-continuation-NextPartitionKey")
+				result.NextRowKey = resp.Header.Get("x-ms-continuation-NextRowKey")
+			}
+
 			err = resp.Unmarshal(&result)
 			if err != nil {
 				err = fmt.Errorf("unmarshalling response: %+v", err)
@@ -96,7 +109,7 @@ func (c Client) Query(ctx context.Context, tableName string, input QueryEntities
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -109,7 +122,7 @@ type queryOptions struct {
 
 func (q queryOptions) ToHeaders() *client.Headers {
 	headers := &client.Headers{}
-	headers.Append("Accept", fmt.Sprintf("application/json;odata=%s", q.input.MetaDataLevel))
+	headers.Append("Accept", acceptHeader(q.input.MetaDataLevel))
 	headers.Append("DataServiceVersion", "3.0;NetFx")
 	headers.Append("MaxDataServiceVersion", "3.0;NetFx")
 	return headers
@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"context"
+	"fmt"
+)
+
+// QueryEntitiesIterator retrieves successive pages of Entities from a Table, following the
+// continuation tokens returned by the service until all of the results have been retrieved.
+type QueryEntitiesIterator struct {
+	client    Client
+	tableName string
+	input     QueryEntitiesInput
+	done      bool
+}
+
+// NewQueryEntitiesIterator returns an iterator over the Entities within the specified Table matching `input`.
+// The `NextPartitionKey` and `NextRowKey` within `input` (if specified) are used as the starting point.
+func (c Client) NewQueryEntitiesIterator(tableName string, input QueryEntitiesInput) *QueryEntitiesIterator {
+	return &QueryEntitiesIterator{
+		client:    c,
+		tableName: tableName,
+		input:     input,
+	}
+}
+
+// NotDone returns whether there are further pages of results to be retrieved
+func (i *QueryEntitiesIterator) NotDone() bool {
+	return !i.done
+}
+
+// Next retrieves the next page of results
+func (i *QueryEntitiesIterator) Next(ctx context.Context) (result QueryEntitiesResponse, err error) {
+	if i.done {
+		err = fmt.Errorf("no more results are available")
+		return
+	}
+
+	result, err = i.client.Query(ctx, i.tableName, i.input)
+	if err != nil {
+		return
+	}
+
+	if result.NextPartitionKey == "" && result.NextRowKey == "" {
+		i.done = true
+		return
+	}
+
+	nextPartitionKey := result.NextPartitionKey
+	i.input.NextPartitionKey = &nextPartitionKey
+	i.input.NextRowKey = nil
+	if result.NextRowKey != "" {
+		nextRowKey := result.NextRowKey
+		i.input.NextRowKey = &nextRowKey
+	}
+
+	return
+}
+
+// QueryComplete retrieves all of the Entities within the specified Table matching `input`,
+// following the continuation tokens until all pages of results have been retrieved
+func (c Client) QueryComplete(ctx context.Context, tableName string, input QueryEntitiesInput) (result []map[string]interface{}, err error) {
+	result = make([]map[string]interface{}, 0)
+
+	iterator := c.NewQueryEntitiesIterator(tableName, input)
+	for iterator.NotDone() {
+		var page QueryEntitiesResponse
+		page, err = iterator.Next(ctx)
+		if err != nil {
+			err = fmt.Errorf("querying entities: %+v", err)
+			return
+		}
+		result = append(result, page.Entities...)
+	}
+
+	return
+}
@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestFilterLiterals(t *testing.T) {
+	testData := []struct {
+		Actual   string
+		Expected string
+	}{
+		{
+			Actual:   StringLiteral("hello"),
+			Expected: "'hello'",
+		},
+		{
+			Actual:   StringLiteral("o'brien"),
+			Expected: "'o''brien'",
+		},
+		{
+			Actual:   DateTimeLiteral(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			Expected: "datetime'2024-01-02T03:04:05Z'",
+		},
+		{
+			Actual:   DateTimeLiteral(time.Date(2024, 1, 2, 4, 4, 5, 500000000, time.FixedZone("UTC+1", 3600))),
+			Expected: "datetime'2024-01-02T03:04:05.5Z'",
+		},
+		{
+			Actual:   GuidLiteral("00000000-0000-0000-0000-000000000001"),
+			Expected: "guid'00000000-0000-0000-0000-000000000001'",
+		},
+		{
+			Actual:   Int64Literal(9007199254740993),
+			Expected: "9007199254740993L",
+		},
+	}
+
+	for _, v := range testData {
+		if v.Actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, v.Actual)
+		}
+	}
+}
+
+func TestQueryOptionsEncodesFilter(t *testing.T) {
+	filter := "Timestamp ge " + DateTimeLiteral(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) + " and Id eq " + GuidLiteral("00000000-0000-0000-0000-000000000001") + " and Name eq " + StringLiteral("a&b")
+	options := queryOptions{
+		input: QueryEntitiesInput{
+			Filter:                &filter,
+			PropertyNamesToSelect: &[]string{"Name", "Id"},
+			Top:                   pointer.To(10),
+			NextPartitionKey:      pointer.To("1!8!cGFydGl0aW9u"),
+			NextRowKey:            pointer.To("1!4!cm93"),
+		},
+	}
+
+	encoded := options.ToQuery().Values().Encode()
+	decoded, err := url.ParseQuery(encoded)
+	if err != nil {
+		t.Fatalf("parsing %q: %+v", encoded, err)
+	}
+
+	expected := map[string]string{
+		"$filter":          "Timestamp ge datetime'2024-01-02T03:04:05Z' and Id eq guid'00000000-0000-0000-0000-000000000001' and Name eq 'a&b'",
+		"$select":          "Name,Id",
+		"$top":             "10",
+		"NextPartitionKey": "1!8!cGFydGl0aW9u",
+		"NextRowKey":       "1!4!cm93",
+	}
+	if len(decoded) != len(expected) {
+		t.Fatalf("expected %d query parameters but got %d: %q", len(expected), len(decoded), encoded)
+	}
+	for k, v := range expected {
+		if actual := decoded.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+}
@@ -11,5 +11,6 @@ type StorageTable interface {
 	Create(ctx context.Context, tableName string) (resp CreateTableResponse, err error)
 	GetResourceManagerResourceID(subscriptionID, resourceGroup, accountName, tableName string) string
 	Query(ctx context.Context, input QueryInput) (resp GetResponse, err error)
+	QueryComplete(ctx context.Context, input QueryInput) (resp []GetResultItem, err error)
 	SetACL(ctx context.Context, tableName string, acls []SignedIdentifier) (resp SetACLResponse, err error)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type createTableRequest struct {
@@ -18,9 +19,9 @@ type CreateTableResponse struct {
 }
 
 // Create creates a new table in the storage account.
+// A TableAlreadyExistsError is returned if a Table with this name already exists.
 func (c Client) Create(ctx context.Context, tableName string) (result CreateTableResponse, err error) {
-	if tableName == "" {
-		err = fmt.Errorf("`tableName` cannot be an empty string")
+	if err = validateTableName(tableName); err != nil {
 		return
 	}
 
@@ -42,16 +43,23 @@ func (c Client) Create(ctx context.Context, tableName string) (result CreateTabl
 
 	err = req.Marshal(&createTableRequest{TableName: tableName})
 	if err != nil {
-		return result, fmt.Errorf("marshalling request")
+		return result, fmt.Errorf("marshalling request: %+v", err)
 	}
 
 	var resp *client.Response
 	resp, err = req.Execute(ctx)
 	if resp != nil && resp.Response != nil {
 		result.HttpResponse = resp.Response
+
+		if err != nil && resp.StatusCode == http.StatusConflict && errorCode(resp.Response) == "TableAlreadyExists" {
+			err = TableAlreadyExistsError{
+				TableName: tableName,
+			}
+			return
+		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
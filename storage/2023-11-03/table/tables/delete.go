@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type DeleteTableResponse struct {
@@ -15,8 +16,7 @@ type DeleteTableResponse struct {
 
 // Delete deletes the specified table and any data it contains.
 func (c Client) Delete(ctx context.Context, tableName string) (result DeleteTableResponse, err error) {
-	if tableName == "" {
-		err = fmt.Errorf("`tableName` cannot be an empty string")
+	if err = validateTableName(tableName); err != nil {
 		return
 	}
 
@@ -42,7 +42,7 @@ func (c Client) Delete(ctx context.Context, tableName string) (result DeleteTabl
 		result.HttpResponse = resp.Response
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
@@ -0,0 +1,54 @@
+package tables
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+var _ error = TableAlreadyExistsError{}
+
+// TableAlreadyExistsError is returned when attempting to create a Table which already exists
+type TableAlreadyExistsError struct {
+	// The name of the Table which already exists
+	TableName string
+}
+
+func (e TableAlreadyExistsError) Error() string {
+	return fmt.Sprintf("the table %q already exists", e.TableName)
+}
+
+type errorResponse struct {
+	ODataError struct {
+		Code string `json:"code"`
+	} `json:"odata.error"`
+}
+
+// errorCode returns the error code for a failed request, using the `x-ms-error-code` header
+// where present, falling back to the JSON error returned within the response body
+func errorCode(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	if code := resp.Header.Get("x-ms-error-code"); code != "" {
+		return code
+	}
+	if resp.Body == nil {
+		return ""
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ""
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewBuffer(respBody))
+
+	var res errorResponse
+	if err := json.Unmarshal(respBody, &res); err != nil {
+		return ""
+	}
+	return res.ODataError.Code
+}
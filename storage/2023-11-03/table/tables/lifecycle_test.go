@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/jackofallops/giovanni/storage/internal/testhelpers"
@@ -51,6 +52,13 @@ func TestTablesLifecycle(t *testing.T) {
 		t.Fatalf("Error creating Table %q: %s", tableName, err)
 	}
 
+	t.Logf("[DEBUG] Creating Table again..")
+	if _, err := tablesClient.Create(ctx, tableName); err == nil {
+		t.Fatalf("Expected an error when creating Table %q again but didn't get one", tableName)
+	} else if _, ok := err.(TableAlreadyExistsError); !ok {
+		t.Fatalf("Expected a TableAlreadyExistsError when creating Table %q again but got: %+v", tableName, err)
+	}
+
 	// first look it up directly and confirm it's there
 	t.Logf("[DEBUG] Checking if Table exists..")
 	if _, err := tablesClient.Exists(ctx, tableName); err != nil {
@@ -75,6 +83,21 @@ func TestTablesLifecycle(t *testing.T) {
 		t.Fatalf("%q was not found in the Query response!", tableName)
 	}
 
+	t.Logf("[DEBUG] Querying for all pages of Tables..")
+	allTables, err := tablesClient.QueryComplete(ctx, QueryInput{Top: pointer.To(1)})
+	if err != nil {
+		t.Fatalf("Error retrieving all Tables: %s", err)
+	}
+	found = false
+	for _, v := range allTables {
+		if v.TableName == tableName {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("%q was not found in the QueryComplete response!", tableName)
+	}
+
 	t.Logf("[DEBUG] Setting ACL's for Table %q..", tableName)
 	acls := []SignedIdentifier{
 		{
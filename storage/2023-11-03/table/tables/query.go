@@ -7,21 +7,35 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/responseerror"
 )
 
 type GetResponse struct {
 	HttpResponse *http.Response
 
+	// The continuation token which should be used to retrieve the next page of results,
+	// which is empty when no further results are available
+	NextTableName string
+
 	MetaData string          `json:"odata.metadata,omitempty"`
 	Tables   []GetResultItem `json:"value"`
 }
 
 type QueryInput struct {
 	MetaDataLevel MetaDataLevel
+
+	// An optional continuation token, returned as `NextTableName` from a previous Query
+	NextTableName *string
+
+	// An optional maximum number of Tables to return, up to 1000
+	Top *int
 }
 
 // Query returns a list of tables under the specified account.
 func (c Client) Query(ctx context.Context, input QueryInput) (result GetResponse, err error) {
+	if input.Top != nil && (*input.Top <= 0 || *input.Top > 1000) {
+		return result, fmt.Errorf("`input.Top` can either be nil or between 1 and 1000")
+	}
 
 	opts := client.RequestOptions{
 		ExpectedStatusCodes: []int{
@@ -29,7 +43,7 @@ func (c Client) Query(ctx context.Context, input QueryInput) (result GetResponse
 		},
 		HttpMethod: http.MethodGet,
 		OptionsObject: queryOptions{
-			metaDataLevel: input.MetaDataLevel,
+			input: input,
 		},
 		Path: "/Tables",
 	}
@@ -46,6 +60,8 @@ func (c Client) Query(ctx context.Context, input QueryInput) (result GetResponse
 		result.HttpResponse = resp.Response
 
 		if err == nil {
+			result.NextTableName = resp.Header.Get("x-ms-continuation-NextTableName")
+
 			err = resp.Unmarshal(&result)
 			if err != nil {
 				err = fmt.Errorf("unmarshalling response: %+v", err)
@@ -54,23 +70,49 @@ func (c Client) Query(ctx context.Context, input QueryInput) (result GetResponse
 		}
 	}
 	if err != nil {
-		err = fmt.Errorf("executing request: %+v", err)
+		err = fmt.Errorf("executing request: %w", responseerror.New(resp, err))
 		return
 	}
 
 	return
 }
 
+// QueryComplete returns all of the tables under the specified account, following the
+// continuation tokens until all pages of results have been retrieved.
+func (c Client) QueryComplete(ctx context.Context, input QueryInput) (result []GetResultItem, err error) {
+	result = make([]GetResultItem, 0)
+
+	for {
+		var page GetResponse
+		page, err = c.Query(ctx, input)
+		if err != nil {
+			return
+		}
+		result = append(result, page.Tables...)
+
+		if page.NextTableName == "" {
+			break
+		}
+		nextTableName := page.NextTableName
+		input.NextTableName = &nextTableName
+	}
+
+	return
+}
+
 type queryOptions struct {
-	metaDataLevel MetaDataLevel
+	input QueryInput
 }
 
 func (q queryOptions) ToHeaders() *client.Headers {
-	// NOTE: whilst this supports ContinuationTokens and 'Top'
-	// it appears that 'Skip' returns a '501 Not Implemented'
-	// as such, we intentionally don't support those right now
+	// NOTE: it appears that 'Skip' returns a '501 Not Implemented'
+	// as such, we intentionally don't support that right now
+	metaDataLevel := q.input.MetaDataLevel
+	if metaDataLevel == "" {
+		metaDataLevel = NoMetaData
+	}
 	headers := &client.Headers{}
-	headers.Append("Accept", fmt.Sprintf("application/json;odata=%s", q.metaDataLevel))
+	headers.Append("Accept", fmt.Sprintf("application/json;odata=%s", metaDataLevel))
 	return headers
 }
 
@@ -79,5 +121,12 @@ func (q queryOptions) ToOData() *odata.Query {
 }
 
 func (q queryOptions) ToQuery() *client.QueryParams {
-	return nil
+	out := &client.QueryParams{}
+	if q.input.NextTableName != nil {
+		out.Append("NextTableName", *q.input.NextTableName)
+	}
+	if q.input.Top != nil {
+		out.Append("$top", fmt.Sprintf("%d", *q.input.Top))
+	}
+	return out
 }
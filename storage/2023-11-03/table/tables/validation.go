@@ -0,0 +1,24 @@
+package tables
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var tableNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]{2,62}$`)
+
+// validateTableName validates that the specified Table Name is between 3 and 63 alphanumeric
+// characters, starting with a letter - and isn't the reserved name `tables`
+func validateTableName(input string) error {
+	if input == "" {
+		return fmt.Errorf("`tableName` cannot be an empty string")
+	}
+	if !tableNameRegex.MatchString(input) {
+		return fmt.Errorf("`tableName` must be between 3 and 63 alphanumeric characters and start with a letter, got %q", input)
+	}
+	if strings.EqualFold(input, "tables") {
+		return fmt.Errorf("`tableName` cannot be the reserved name %q", input)
+	}
+	return nil
+}
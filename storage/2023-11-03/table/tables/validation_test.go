@@ -0,0 +1,69 @@
+package tables
+
+import "testing"
+
+func TestValidateTableName(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         string
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Empty",
+			Input:         "",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Too Short",
+			Input:         "ab",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Minimum Length",
+			Input:         "abc",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Mixed Case and Numbers",
+			Input:         "MyTable123",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Maximum Length",
+			Input:         "a23456789012345678901234567890123456789012345678901234567890123",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Too Long",
+			Input:         "a234567890123456789012345678901234567890123456789012345678901234",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Starts with a Number",
+			Input:         "1table",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Contains a Hyphen",
+			Input:         "my-table",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Reserved Name",
+			Input:         "Tables",
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateTableName(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Input)
+		}
+	}
+}
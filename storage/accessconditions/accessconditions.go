@@ -0,0 +1,49 @@
+package accessconditions
+
+import (
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// AccessConditions are the conditional headers which can be specified to perform an operation only when
+// the resource is in the expected state, allowing for optimistic concurrency. When a condition isn't met
+// the operation returns a `responseerror.ConditionNotMetError`.
+type AccessConditions struct {
+	// Optional - Only perform the operation if the ETag of the resource matches the specified value.
+	// Specify `*` to only perform the operation if the resource exists.
+	IfMatch *string
+
+	// Optional - Only perform the operation if the ETag of the resource doesn't match the specified value.
+	// Specify `*` to only perform the operation if the resource doesn't exist.
+	IfNoneMatch *string
+
+	// Optional - Only perform the operation if the resource has been modified since the specified date and time,
+	// which must be in RFC1123 format (for example `Mon, 02 Jan 2006 15:04:05 GMT`)
+	IfModifiedSince *string
+
+	// Optional - Only perform the operation if the resource has not been modified since the specified date and time,
+	// which must be in RFC1123 format (for example `Mon, 02 Jan 2006 15:04:05 GMT`)
+	IfUnmodifiedSince *string
+}
+
+// SetIntoHeaders returns the conditional headers for the specified AccessConditions
+func SetIntoHeaders(input AccessConditions) client.Headers {
+	headers := client.Headers{}
+
+	if input.IfMatch != nil {
+		headers.Append("If-Match", *input.IfMatch)
+	}
+
+	if input.IfNoneMatch != nil {
+		headers.Append("If-None-Match", *input.IfNoneMatch)
+	}
+
+	if input.IfModifiedSince != nil {
+		headers.Append("If-Modified-Since", *input.IfModifiedSince)
+	}
+
+	if input.IfUnmodifiedSince != nil {
+		headers.Append("If-Unmodified-Since", *input.IfUnmodifiedSince)
+	}
+
+	return headers
+}
@@ -0,0 +1,33 @@
+package accessconditions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestSetIntoHeaders(t *testing.T) {
+	headers := SetIntoHeaders(AccessConditions{})
+	if v := len(headers.Headers()); v != 0 {
+		t.Fatalf("expected no headers when no conditions are specified but got %d", v)
+	}
+
+	headers = SetIntoHeaders(AccessConditions{
+		IfMatch:           pointer.To("\"0x8D0000000000001\""),
+		IfNoneMatch:       pointer.To("*"),
+		IfModifiedSince:   pointer.To("Mon, 01 Jan 2024 00:00:00 GMT"),
+		IfUnmodifiedSince: pointer.To("Tue, 02 Jan 2024 00:00:00 GMT"),
+	})
+	actual := headers.Headers()
+	expected := map[string]string{
+		"If-Match":            "\"0x8D0000000000001\"",
+		"If-None-Match":       "*",
+		"If-Modified-Since":   "Mon, 01 Jan 2024 00:00:00 GMT",
+		"If-Unmodified-Since": "Tue, 02 Jan 2024 00:00:00 GMT",
+	}
+	for k, v := range expected {
+		if actual.Get(k) != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual.Get(k))
+		}
+	}
+}
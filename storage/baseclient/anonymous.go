@@ -0,0 +1,47 @@
+package baseclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// AnonymousAccessError is returned when a request which requires authorization is sent using a Client configured
+// for anonymous (public) access
+type AnonymousAccessError struct {
+	// The HTTP Method of the request, for example `PUT`
+	Method string
+}
+
+func (e AnonymousAccessError) Error() string {
+	return fmt.Sprintf("the %s request requires authorization but the client is configured for anonymous access, only GET and HEAD requests can be sent anonymously", e.Method)
+}
+
+// SetAnonymous configures whether requests are sent anonymously (without authorization), which allows Blobs within
+// a Container with public access to be read without an Authorizer
+func (c *Client) SetAnonymous(anonymous bool) {
+	c.Anonymous = anonymous
+}
+
+// validateAnonymousRequest validates that the request can be sent anonymously - since only read operations
+// (GET and HEAD requests) are permitted for Containers with public access, and no Authorizer can be configured
+func (c *Client) validateAnonymousRequest(ctx context.Context, req *client.Request) error {
+	if req.Request == nil {
+		return fmt.Errorf("req.Request was nil")
+	}
+	if c.AuthorizerFor(ctx) != nil || c.AuthorizeRequest != nil {
+		return fmt.Errorf("an Authorizer cannot be configured for a client configured for anonymous access")
+	}
+
+	switch strings.ToUpper(req.Method) {
+	case http.MethodGet, http.MethodHead:
+		return nil
+	}
+
+	return AnonymousAccessError{
+		Method: req.Method,
+	}
+}
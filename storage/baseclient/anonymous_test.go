@@ -0,0 +1,129 @@
+package baseclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestExecuteAnonymously(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	baseClient.SetAnonymous(true)
+
+	testData := []struct {
+		Name                       string
+		HttpMethod                 string
+		ExpectAnonymousAccessError bool
+	}{
+		{
+			Name:       "Get",
+			HttpMethod: http.MethodGet,
+		},
+		{
+			Name:       "Head",
+			HttpMethod: http.MethodHead,
+		},
+		{
+			Name:                       "Put",
+			HttpMethod:                 http.MethodPut,
+			ExpectAnonymousAccessError: true,
+		},
+		{
+			Name:                       "Delete",
+			HttpMethod:                 http.MethodDelete,
+			ExpectAnonymousAccessError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		requests = 0
+		req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod:    v.HttpMethod,
+			OptionsObject: testOptions{},
+			Path:          "/container/blob",
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		_, err = req.Execute(ctx)
+		if v.ExpectAnonymousAccessError {
+			var anonymousAccessErr AnonymousAccessError
+			if !errors.As(err, &anonymousAccessErr) {
+				t.Fatalf("expected an AnonymousAccessError but got: %+v", err)
+			}
+			if anonymousAccessErr.Method != v.HttpMethod {
+				t.Fatalf("expected the Method to be %q but got %q", v.HttpMethod, anonymousAccessErr.Method)
+			}
+			if requests != 0 {
+				t.Fatalf("expected the request not to be sent")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if requests != 1 {
+			t.Fatalf("expected the request to be sent")
+		}
+	}
+}
+
+func TestExecuteAnonymouslyWithAuthorizer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	baseClient, err := New("https://account1.blob.core.windows.net", "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	baseClient.SetAnonymous(true)
+	baseClient.AuthorizeRequest = func(ctx context.Context, req *http.Request, authorizer auth.Authorizer) error {
+		return nil
+	}
+
+	req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: testOptions{},
+		Path:          "/container/blob",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	if _, err := req.Execute(ctx); err == nil {
+		t.Fatalf("expected an error when an Authorizer is configured for anonymous access but didn't get one")
+	}
+}
@@ -0,0 +1,30 @@
+package baseclient
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/jackofallops/giovanni/storage/requestoptions"
+)
+
+// AuthorizerFor returns the Authorizer used to authorize a request sent using `ctx`, which is the `Authorizer`
+// specified within the `requestoptions.RequestOptions` attached to `ctx` when one is specified - otherwise the
+// Authorizer configured on the Client.
+func (c *Client) AuthorizerFor(ctx context.Context) auth.Authorizer {
+	if authorizer := authorizerOverride(ctx); authorizer != nil {
+		return authorizer
+	}
+	return c.Authorizer
+}
+
+// authorizerOverride returns the Authorizer specified within the RequestOptions attached to `ctx`, if any
+func authorizerOverride(ctx context.Context) auth.Authorizer {
+	if ctx == nil {
+		return nil
+	}
+	options, ok := requestoptions.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return options.Authorizer
+}
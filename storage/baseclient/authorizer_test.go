@@ -0,0 +1,130 @@
+package baseclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/requestoptions"
+	"golang.org/x/oauth2"
+)
+
+type staticAuthorizer struct {
+	token string
+}
+
+func (a staticAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: a.token,
+		TokenType:   "Bearer",
+	}, nil
+}
+
+func (a staticAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+func TestExecuteWithAuthorizerOverride(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ms-request-id", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, withHTTPClient := range []bool{false, true} {
+		t.Logf("[DEBUG] Testing with HTTPClient %t..", withHTTPClient)
+
+		baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+		baseClient.SetAuthorizer(staticAuthorizer{token: "client"})
+		if withHTTPClient {
+			baseClient.SetHTTPClient(&http.Client{})
+		}
+
+		testData := []struct {
+			Name     string
+			Ctx      context.Context
+			Expected string
+		}{
+			{
+				Name:     "Client Authorizer",
+				Ctx:      ctx,
+				Expected: "Bearer client",
+			},
+			{
+				Name: "Request Authorizer",
+				Ctx: requestoptions.WithRequestOptions(ctx, requestoptions.RequestOptions{
+					Authorizer: staticAuthorizer{token: "request"},
+				}),
+				Expected: "Bearer request",
+			},
+			{
+				Name:     "Client Authorizer after a Request Authorizer",
+				Ctx:      ctx,
+				Expected: "Bearer client",
+			},
+		}
+
+		for _, v := range testData {
+			t.Logf("[DEBUG] Testing %q..", v.Name)
+
+			req, err := baseClient.NewRequest(v.Ctx, client.RequestOptions{
+				ExpectedStatusCodes: []int{
+					http.StatusOK,
+				},
+				HttpMethod:    http.MethodGet,
+				OptionsObject: testOptions{},
+				Path:          "/container/blob",
+			})
+			if err != nil {
+				t.Fatalf("building request: %+v", err)
+			}
+
+			resp, err := req.Execute(v.Ctx)
+			if err != nil {
+				t.Fatalf("executing request: %+v", err)
+			}
+			if actual := RequestID(resp.Response); actual != v.Expected {
+				t.Fatalf("expected the request to be authorized using %q but got %q", v.Expected, actual)
+			}
+		}
+	}
+}
+
+func TestExecuteAnonymouslyWithAuthorizerOverride(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	baseClient, err := New("https://account1.blob.core.windows.net", "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	baseClient.SetAnonymous(true)
+
+	ctx = requestoptions.WithRequestOptions(ctx, requestoptions.RequestOptions{
+		Authorizer: staticAuthorizer{token: "request"},
+	})
+	req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: testOptions{},
+		Path:          "/container/blob",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	if _, err := req.Execute(ctx); err == nil {
+		t.Fatalf("expected an error when an Authorizer is specified for anonymous access but didn't get one")
+	}
+}
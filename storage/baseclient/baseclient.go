@@ -0,0 +1,272 @@
+// Package baseclient wraps the base Storage client from `go-azure-sdk`, allowing the `*http.Client`
+// used to send requests to be customised (for example to configure a proxy, TLS settings or connection pooling)
+// and each request to be logged and traced.
+package baseclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane/storage"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/retrypolicy"
+)
+
+var _ client.BaseClient = &Client{}
+
+// Client is the base client used by each Storage API. When HTTPClient and RetryPolicy are nil requests are sent
+// exactly as they are by the underlying `storage.Client`.
+//
+// A Client is safe for concurrent use by multiple goroutines once it has been configured, since sending a
+// request doesn't modify the Client. As such the Client should be configured (for example using SetAuthorizer,
+// SetHTTPClient, SetLogger and SetTracer) before it's shared - and not modified whilst requests are being sent.
+// Any Logger or Tracer must also be safe for concurrent use.
+type Client struct {
+	*storage.Client
+
+	// HTTPClient is an optional `*http.Client` used to send requests. When specified (and no RetryPolicy is
+	// configured) requests are retried in the same manner as the underlying `storage.Client` - for example when
+	// rate limited, or when the RetryFunc for the request identifies an eventual consistency failure.
+	HTTPClient *http.Client
+
+	// RetryPolicy is an optional policy used to retry requests which fail with a transient error. When specified
+	// this is the only layer which retries requests (replacing the retries performed by the underlying
+	// `storage.Client`), so that non-idempotent requests are only retried when the policy allows it.
+	RetryPolicy *retrypolicy.Policy
+
+	// Logger is an optional Logger used to log the method, URL, status code, request ID and latency of
+	// each request. Any Shared Access Signature is redacted from the URL.
+	Logger Logger
+
+	// LogHeaders specifies whether the request and response headers should also be logged, when a Logger
+	// is configured. The values of the `Authorization` header and any Shared Access Signatures are redacted.
+	LogHeaders bool
+
+	// LogBody specifies whether (the first 4KiB of) the request and response bodies should also be logged,
+	// when a Logger is configured.
+	LogBody bool
+
+	// Tracer is an optional Tracer used to start a Span for each operation, see the Tracer interface for more information
+	Tracer Tracer
+
+	// Anonymous specifies whether requests are sent without authorization, for reading from a Container with
+	// public access. When true only GET and HEAD requests can be sent, other requests return an AnonymousAccessError.
+	Anonymous bool
+
+	// SecondaryFailover optionally configures read requests which repeatedly fail against the primary endpoint to
+	// be retried against the secondary endpoint, see SecondaryFailover for more information
+	SecondaryFailover *SecondaryFailover
+
+	// GenerateClientRequestID specifies whether a Client Request ID (a UUID) should be generated and sent in the
+	// `x-ms-client-request-id` header for each request which doesn't already specify one, see ClientRequestID
+	GenerateClientRequestID bool
+
+	// DryRun specifies whether requests are built and authorized without being sent, in which case each operation
+	// returns a DryRunError containing the request which would have been sent, see BuildRequest
+	DryRun bool
+
+	// componentName is the name of the Storage API, for example `blob/blobs`, which is used to name Spans
+	componentName string
+}
+
+// New returns a Client for the specified Storage API
+func New(baseUri, componentName, apiVersion string) (*Client, error) {
+	storageClient, err := storage.NewStorageClient(baseUri, componentName, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		Client:        storageClient,
+		componentName: componentName,
+	}, nil
+}
+
+// SetHTTPClient configures the `*http.Client` used to send requests
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.HTTPClient = httpClient
+}
+
+// SetRetryPolicy configures the policy used to retry requests, which replaces the retries performed by the
+// underlying `storage.Client` - a nil value restores these
+func (c *Client) SetRetryPolicy(policy *retrypolicy.Policy) {
+	c.RetryPolicy = policy
+}
+
+// NewRequest builds a request using the underlying `storage.Client`, which is sent using this Client
+func (c *Client) NewRequest(ctx context.Context, input client.RequestOptions) (*client.Request, error) {
+	req, err := c.Client.NewRequest(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	req.Client = c
+	withOperationName(req, operationName())
+	return req, nil
+}
+
+// SetLogger configures the Logger used to log each request
+func (c *Client) SetLogger(logger Logger) {
+	c.Logger = logger
+}
+
+// SetTracer configures the Tracer used to start a Span for each operation
+func (c *Client) SetTracer(tracer Tracer) {
+	c.Tracer = tracer
+}
+
+// Execute sends the request using the HTTPClient when one is configured, otherwise using the underlying
+// `storage.Client` - logging the request when a Logger is configured, and tracing it when a Tracer is configured.
+// When a RetryPolicy is configured requests are retried according to it, and when SecondaryFailover is configured
+// read requests which repeatedly fail are retried against the secondary endpoint. When DryRun is configured the
+// request is authorized but not sent, and a DryRunError is returned.
+func (c *Client) Execute(ctx context.Context, req *client.Request) (*client.Response, error) {
+	if err := c.prepare(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if c.DryRun {
+		if err := c.authorize(ctx, req); err != nil {
+			return nil, err
+		}
+		return nil, DryRunError{
+			Request: req.Request,
+		}
+	}
+
+	if c.Tracer != nil {
+		return c.executeWithTracing(ctx, req)
+	}
+	return c.executeWithRetries(ctx, req)
+}
+
+// executeWithRetries sends the request, retrying it according to the RetryPolicy when one is configured
+func (c *Client) executeWithRetries(ctx context.Context, req *client.Request) (*client.Response, error) {
+	if c.RetryPolicy != nil {
+		return c.RetryPolicy.Execute(ctx, req, c.executeAttempt)
+	}
+	return c.executeAttempt(ctx, req)
+}
+
+// executeAttempt sends a single attempt of the request, which may fail over to the secondary endpoint
+func (c *Client) executeAttempt(ctx context.Context, req *client.Request) (*client.Response, error) {
+	if c.SecondaryFailover != nil && canFailover(req) {
+		return c.executeWithSecondaryFailover(ctx, req)
+	}
+	return c.executeOnce(ctx, req)
+}
+
+func (c *Client) executeOnce(ctx context.Context, req *client.Request) (*client.Response, error) {
+	if c.Tracer == nil {
+		return c.send(ctx, req)
+	}
+	return c.sendWithTracing(ctx, req)
+}
+
+func (c *Client) send(ctx context.Context, req *client.Request) (*client.Response, error) {
+	if c.Logger == nil {
+		return c.execute(ctx, req)
+	}
+	return c.executeWithLogging(ctx, req)
+}
+
+func (c *Client) execute(ctx context.Context, req *client.Request) (*client.Response, error) {
+	if c.HTTPClient == nil && c.RetryPolicy == nil {
+		if authorizer := authorizerOverride(ctx); authorizer != nil {
+			// the underlying client authorizes the request using its own Authorizer, so a copy of it is used
+			// rather than modifying the Authorizer on a Client which may be sending other requests concurrently
+			sdkClient := *c.Client.Client.Client
+			sdkClient.Authorizer = authorizer
+			return sdkClient.Execute(ctx, req)
+		}
+		return c.Client.Execute(ctx, req)
+	}
+
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+
+	var err error
+	resp := &client.Response{}
+	resp.Response, err = c.httpClient(ctx, req).Do(req.Request.WithContext(ctx))
+	if err != nil {
+		return resp, err
+	}
+	if resp.Response == nil {
+		return resp, fmt.Errorf("HTTP response was nil; connection may have been reset")
+	}
+
+	if c.ResponseMiddlewares != nil {
+		for _, m := range *c.ResponseMiddlewares {
+			r, err := m(req.Request, resp.Response)
+			if err != nil {
+				return resp, err
+			}
+			resp.Response = r
+		}
+	}
+
+	// this is best-effort, since the Blob, File and Queue APIs return XML rather than JSON
+	resp.OData, _ = odata.FromResponse(resp.Response)
+
+	if !containsStatusCode(req.ValidStatusCodes, resp.StatusCode) {
+		if f := req.ValidStatusFunc; f != nil && f(resp.Response, resp.OData) {
+			return resp, nil
+		}
+		return resp, unexpectedStatusError(req, resp)
+	}
+
+	return resp, nil
+}
+
+// unexpectedStatusError returns an error in the same format as the underlying `storage.Client`, so that the
+// error messages returned are the same regardless of whether a custom HTTPClient is used
+func unexpectedStatusError(req *client.Request, resp *client.Response) error {
+	status := fmt.Sprintf("%d", resp.StatusCode)
+	statusText := resp.Status
+	if statusText == "" {
+		statusText = http.StatusText(resp.StatusCode)
+	}
+	if statusText != "" {
+		status = fmt.Sprintf("%s (%s)", status, statusText)
+	}
+
+	var errText string
+	if req.CustomErrorParser != nil {
+		if err := req.CustomErrorParser.FromResponse(resp.Response); err != nil {
+			errText = err.Error()
+		}
+	}
+
+	if errText == "" {
+		switch {
+		case resp.OData != nil && resp.OData.Error != nil && resp.OData.Error.String() != "":
+			errText = fmt.Sprintf("error: %s", resp.OData.Error)
+
+		default:
+			defer resp.Body.Close()
+
+			respBody, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return fmt.Errorf("unexpected status %s, could not read response body", status)
+			}
+			if len(respBody) == 0 {
+				return fmt.Errorf("unexpected status %s received with no body", status)
+			}
+
+			errText = fmt.Sprintf("response: %s", respBody)
+		}
+	}
+
+	return fmt.Errorf("unexpected status %s with %s", status, errText)
+}
+
+func containsStatusCode(expected []int, actual int) bool {
+	for _, v := range expected {
+		if actual == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package baseclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+type testOptions struct{}
+
+func (testOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (testOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (testOptions) ToQuery() *client.QueryParams {
+	return nil
+}
+
+func TestExecuteWithHTTPClient(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-ms-version") != "2023-11-03" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path == "/missing" {
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	transport := &countingTransport{}
+	baseClient.SetHTTPClient(&http.Client{
+		Transport: transport,
+	})
+
+	testData := []struct {
+		Name        string
+		Path        string
+		ExpectError bool
+	}{
+		{
+			Name:        "Success",
+			Path:        "/container/blob",
+			ExpectError: false,
+		},
+		{
+			Name:        "Unexpected Status",
+			Path:        "/missing",
+			ExpectError: true,
+		},
+	}
+
+	for i, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod:    http.MethodGet,
+			OptionsObject: testOptions{},
+			Path:          v.Path,
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		resp, err := req.Execute(ctx)
+		if transport.requests != i+1 {
+			t.Fatalf("expected the request to be sent using the custom HTTP Client")
+		}
+		if resp == nil || resp.Response == nil {
+			t.Fatalf("expected a response to be returned")
+		}
+
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !strings.Contains(err.Error(), "unexpected status 404") {
+				t.Fatalf("expected the error to contain the status code but got: %+v", err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
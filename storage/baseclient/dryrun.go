@@ -0,0 +1,99 @@
+package baseclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/requestoptions"
+)
+
+// DryRunError is returned from each operation when the Client is configured for a dry run, in which case the
+// request is built and authorized exactly as it would be sent - but isn't sent
+type DryRunError struct {
+	// The fully built (and authorized) request which would have been sent
+	Request *http.Request
+}
+
+func (e DryRunError) Error() string {
+	if e.Request == nil || e.Request.URL == nil {
+		return "the request wasn't sent since the client is configured for a dry run"
+	}
+	return fmt.Sprintf("the %s request to %q wasn't sent since the client is configured for a dry run", e.Request.Method, sanitizeURL(e.Request.URL))
+}
+
+// SetDryRun configures whether requests are built and authorized without being sent, in which case each operation
+// returns a DryRunError containing the request which would have been sent
+func (c *Client) SetDryRun(dryRun bool) {
+	c.DryRun = dryRun
+}
+
+// BuildRequest builds and authorizes the request for `input` exactly as it would be sent using this Client (including
+// any RequestOptions attached to `ctx`), and returns it without sending it - for example to inspect the headers and
+// query string, or to obtain a pre-signed request which can be sent using another client
+func (c *Client) BuildRequest(ctx context.Context, input client.RequestOptions) (*http.Request, error) {
+	req, err := c.NewRequest(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.prepare(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	return req.Request, nil
+}
+
+// prepare validates and updates the request prior to it being sent (or failed over) - including applying any
+// RequestOptions attached to `ctx` - which is performed once regardless of how many times the request is then sent
+func (c *Client) prepare(ctx context.Context, req *client.Request) error {
+	if err := requestoptions.Apply(ctx, req); err != nil {
+		return err
+	}
+
+	if c.Anonymous {
+		if err := c.validateAnonymousRequest(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	if c.GenerateClientRequestID {
+		setClientRequestID(req)
+	}
+
+	return nil
+}
+
+// authorize authorizes the request using the Authorizer for `ctx` and applies any RequestMiddlewares, in the
+// same manner as the underlying `storage.Client`
+func (c *Client) authorize(ctx context.Context, req *client.Request) error {
+	if req.Request == nil {
+		return fmt.Errorf("req.Request was nil")
+	}
+
+	authorizer := c.AuthorizerFor(ctx)
+	if c.AuthorizeRequest != nil {
+		if err := c.AuthorizeRequest(ctx, req.Request, authorizer); err != nil {
+			return fmt.Errorf("authorizing request: %+v", err)
+		}
+	} else if authorizer != nil {
+		if err := auth.SetAuthHeader(ctx, req.Request, authorizer); err != nil {
+			return fmt.Errorf("authorizing request: %+v", err)
+		}
+	}
+
+	if c.RequestMiddlewares != nil {
+		for _, m := range *c.RequestMiddlewares {
+			r, err := m(req.Request)
+			if err != nil {
+				return err
+			}
+			req.Request = r
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package baseclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/requestoptions"
+	"github.com/jackofallops/giovanni/storage/responseerror"
+	"github.com/jackofallops/giovanni/storage/retrypolicy"
+)
+
+func TestBuildRequest(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	baseClient, err := New("https://account1.blob.core.windows.net", "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	authorizer, err := auth.NewSharedKeyAuthorizer("account1", "a2V5", auth.SharedKey)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+	baseClient.SetAuthorizer(authorizer)
+
+	ctx = requestoptions.WithRequestOptions(ctx, requestoptions.RequestOptions{
+		Timeout:         pointer.To(10),
+		ClientRequestID: "my-correlation-id",
+	})
+	req, err := baseClient.BuildRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: testOptions{},
+		Path:          "/container/blob",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	if v := req.URL.String(); v != "https://account1.blob.core.windows.net/container/blob?timeout=10" {
+		t.Fatalf("expected the URL to be %q but got %q", "https://account1.blob.core.windows.net/container/blob?timeout=10", v)
+	}
+	if v := req.Header.Get("x-ms-version"); v != "2023-11-03" {
+		t.Fatalf("expected the `x-ms-version` header to be %q but got %q", "2023-11-03", v)
+	}
+	if v := req.Header.Get("x-ms-client-request-id"); v != "my-correlation-id" {
+		t.Fatalf("expected the `x-ms-client-request-id` header to be %q but got %q", "my-correlation-id", v)
+	}
+	if v := req.Header.Get("Authorization"); !strings.HasPrefix(v, "SharedKey account1:") {
+		t.Fatalf("expected the request to be authorized using SharedKey but got %q", v)
+	}
+}
+
+func TestBuildRequestAnonymously(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	baseClient, err := New("https://account1.blob.core.windows.net", "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	baseClient.SetAnonymous(true)
+
+	req, err := baseClient.BuildRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: testOptions{},
+		Path:          "/container/blob",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if v := req.Header.Get("Authorization"); v != "" {
+		t.Fatalf("expected the request not to be authorized but got %q", v)
+	}
+
+	_, err = baseClient.BuildRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: testOptions{},
+		Path:          "/container/blob",
+	})
+	var anonymousAccessErr AnonymousAccessError
+	if !errors.As(err, &anonymousAccessErr) {
+		t.Fatalf("expected an AnonymousAccessError but got: %+v", err)
+	}
+}
+
+func TestExecuteDryRun(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	testData := []struct {
+		Name       string
+		HTTPClient *http.Client
+	}{
+		{
+			Name: "Default",
+		},
+		{
+			Name:       "Custom HTTPClient",
+			HTTPClient: server.Client(),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+		authorizer, err := auth.NewSharedKeyAuthorizer("account1", "a2V5", auth.SharedKey)
+		if err != nil {
+			t.Fatalf("building authorizer: %+v", err)
+		}
+		baseClient.SetAuthorizer(authorizer)
+		baseClient.SetHTTPClient(v.HTTPClient)
+		baseClient.SetGenerateClientRequestID(true)
+		baseClient.SetDryRun(true)
+		baseClient.SetRetryPolicy(pointer.To(retrypolicy.Default()))
+
+		req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+			ExpectedStatusCodes: []int{
+				http.StatusCreated,
+			},
+			HttpMethod:    http.MethodPut,
+			OptionsObject: testOptions{},
+			Path:          "/container/blob",
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		// operations wrap the error returned when executing the request, which should remain accessible
+		_, err = req.Execute(ctx)
+		err = fmt.Errorf("executing request: %w", responseerror.New(nil, err))
+
+		var dryRunErr DryRunError
+		if !errors.As(err, &dryRunErr) {
+			t.Fatalf("expected a DryRunError but got: %+v", err)
+		}
+		if requests != 0 {
+			t.Fatalf("expected the request not to be sent")
+		}
+		if dryRunErr.Request.Method != http.MethodPut {
+			t.Fatalf("expected the Method to be %q but got %q", http.MethodPut, dryRunErr.Request.Method)
+		}
+		if v := dryRunErr.Request.URL.Path; v != "/container/blob" {
+			t.Fatalf("expected the Path to be %q but got %q", "/container/blob", v)
+		}
+		if v := dryRunErr.Request.Header.Get("x-ms-client-request-id"); v == "" {
+			t.Fatalf("expected a Client Request ID to be generated")
+		}
+		if v := dryRunErr.Request.Header.Get("Authorization"); !strings.HasPrefix(v, "SharedKey account1:") {
+			t.Fatalf("expected the request to be authorized using SharedKey but got %q", v)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package baseclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const (
+	// defaultFailoverPrimaryAttempts is the number of times a read is sent to the primary endpoint before failing over
+	defaultFailoverPrimaryAttempts = 2
+
+	// defaultFailoverRetryDelay is the delay between the attempts sent to the primary endpoint
+	defaultFailoverRetryDelay = 1 * time.Second
+)
+
+// SecondaryFailover configures read requests (GET and HEAD requests) which repeatedly fail against the primary
+// endpoint with a transient error (a timeout, a 500, a 503 or a 504) to be retried against the secondary endpoint
+// of a Storage Account using read-access geo-redundant replication (RA-GRS/RA-GZRS). Other requests are never
+// sent to the secondary endpoint, since it's read-only.
+//
+// Since the data within the secondary endpoint is replicated asynchronously, reads served from the secondary
+// endpoint may not include the latest writes - ServedBySecondary can be used to determine which endpoint served
+// a response.
+type SecondaryFailover struct {
+	// The number of times a read request is sent to the primary endpoint before it's sent to the secondary
+	// endpoint, defaults to 2
+	PrimaryAttempts int
+
+	// The delay between the attempts sent to the primary endpoint, defaults to 1 second
+	RetryDelay time.Duration
+}
+
+// SetSecondaryFailover configures read requests which repeatedly fail against the primary endpoint to be retried
+// against the secondary endpoint - a nil value disables this
+func (c *Client) SetSecondaryFailover(failover *SecondaryFailover) {
+	c.SecondaryFailover = failover
+}
+
+// ServedBySecondary returns whether the response was served by the secondary endpoint of the Storage Account, for
+// example when the request failed over from the primary endpoint (see SecondaryFailover). False is returned when
+// `resp` is nil.
+func ServedBySecondary(resp *http.Response) bool {
+	if resp == nil || resp.Request == nil || resp.Request.URL == nil {
+		return false
+	}
+	accountName, _, _ := strings.Cut(resp.Request.URL.Hostname(), ".")
+	return strings.HasSuffix(accountName, secondarySuffix)
+}
+
+func (c *Client) validateSecondaryFailover() error {
+	if c.SecondaryFailover.PrimaryAttempts < 0 {
+		return fmt.Errorf("`SecondaryFailover.PrimaryAttempts` must be greater than or equal to 0")
+	}
+	if c.SecondaryFailover.RetryDelay < 0 {
+		return fmt.Errorf("`SecondaryFailover.RetryDelay` must be greater than or equal to 0")
+	}
+	return nil
+}
+
+// canFailover determines whether the request can be sent to the secondary endpoint, which is read-only
+func canFailover(req *client.Request) bool {
+	if req.Request == nil {
+		return false
+	}
+
+	switch strings.ToUpper(req.Method) {
+	case http.MethodGet, http.MethodHead:
+		return true
+	}
+
+	return false
+}
+
+func (c *Client) executeWithSecondaryFailover(ctx context.Context, req *client.Request) (*client.Response, error) {
+	if err := c.validateSecondaryFailover(); err != nil {
+		return nil, err
+	}
+
+	attempts := c.SecondaryFailover.PrimaryAttempts
+	if attempts == 0 {
+		attempts = defaultFailoverPrimaryAttempts
+	}
+	delay := c.SecondaryFailover.RetryDelay
+	if delay == 0 {
+		delay = defaultFailoverRetryDelay
+	}
+
+	var resp *client.Response
+	var err error
+	for attempt := 1; ; attempt++ {
+		resp, err = c.executeOnce(ctx, req)
+		if !isTransientFailure(ctx, resp, err) {
+			return resp, err
+		}
+		if attempt >= attempts {
+			break
+		}
+
+		drainResponse(resp)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, fmt.Errorf("waiting to retry request: %+v", ctx.Err())
+		case <-timer.C:
+		}
+	}
+
+	host, hostErr := SecondaryHost(req.URL.Host)
+	if hostErr != nil {
+		// the secondary endpoint can't be derived from the base URI (e.g. the Storage Emulator), so the error
+		// from the primary endpoint is returned as-is
+		return resp, err
+	}
+	drainResponse(resp)
+
+	// the request is copied so that subsequent attempts (for example by a RetryPolicy) start at the primary endpoint
+	secondary := *req
+	secondary.Request = req.Request.Clone(ctx)
+	secondary.URL.Host = host
+	secondary.Host = host
+	return c.executeOnce(ctx, &secondary)
+}
+
+// isTransientFailure determines whether the request failed with a transient error, which may succeed against
+// the secondary endpoint
+func isTransientFailure(ctx context.Context, resp *client.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if resp != nil && resp.Response != nil {
+		switch resp.StatusCode {
+		case http.StatusRequestTimeout, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	// no response was received, for example since the connection couldn't be established
+	return err != nil
+}
+
+// drainResponse drains and closes the body of a response which is being discarded, so that the connection can
+// be reused
+func drainResponse(resp *client.Response) {
+	if resp == nil || resp.Response == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
@@ -0,0 +1,212 @@
+package baseclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// failoverTransport returns `primaryStatusCode` for requests sent to the primary endpoint and a 200 for requests
+// sent to the secondary endpoint, recording the host each request was sent to
+type failoverTransport struct {
+	primaryStatusCode int
+
+	mutex sync.Mutex
+	hosts []string
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mutex.Lock()
+	t.hosts = append(t.hosts, req.URL.Host)
+	t.mutex.Unlock()
+
+	statusCode := t.primaryStatusCode
+	if strings.HasPrefix(req.URL.Host, "account1-secondary.") {
+		statusCode = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func TestExecuteWithSecondaryFailover(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// the failover is tested without the retries performed for each attempt sent using the HTTPClient
+	defer func(v int) {
+		retryMax = v
+	}(retryMax)
+	retryMax = 0
+
+	testData := []struct {
+		Name                      string
+		HttpMethod                string
+		PrimaryStatusCode         int
+		ExpectedHosts             []string
+		ExpectedServedBySecondary bool
+		ExpectError               bool
+	}{
+		{
+			Name:              "Get from a healthy Primary",
+			HttpMethod:        http.MethodGet,
+			PrimaryStatusCode: http.StatusOK,
+			ExpectedHosts: []string{
+				"account1.blob.core.windows.net",
+			},
+		},
+		{
+			Name:              "Get failing over to the Secondary",
+			HttpMethod:        http.MethodGet,
+			PrimaryStatusCode: http.StatusServiceUnavailable,
+			ExpectedHosts: []string{
+				"account1.blob.core.windows.net",
+				"account1.blob.core.windows.net",
+				"account1-secondary.blob.core.windows.net",
+			},
+			ExpectedServedBySecondary: true,
+		},
+		{
+			Name:              "Head failing over to the Secondary",
+			HttpMethod:        http.MethodHead,
+			PrimaryStatusCode: http.StatusInternalServerError,
+			ExpectedHosts: []string{
+				"account1.blob.core.windows.net",
+				"account1.blob.core.windows.net",
+				"account1-secondary.blob.core.windows.net",
+			},
+			ExpectedServedBySecondary: true,
+		},
+		{
+			Name:              "Get which isn't a Transient Failure",
+			HttpMethod:        http.MethodGet,
+			PrimaryStatusCode: http.StatusNotFound,
+			ExpectedHosts: []string{
+				"account1.blob.core.windows.net",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:              "Put is never sent to the Secondary",
+			HttpMethod:        http.MethodPut,
+			PrimaryStatusCode: http.StatusServiceUnavailable,
+			ExpectedHosts: []string{
+				"account1.blob.core.windows.net",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:              "Delete is never sent to the Secondary",
+			HttpMethod:        http.MethodDelete,
+			PrimaryStatusCode: http.StatusServiceUnavailable,
+			ExpectedHosts: []string{
+				"account1.blob.core.windows.net",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		baseClient, err := New("https://account1.blob.core.windows.net", "blob/blobs", "2023-11-03")
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+		transport := &failoverTransport{
+			primaryStatusCode: v.PrimaryStatusCode,
+		}
+		baseClient.SetHTTPClient(&http.Client{
+			Transport: transport,
+		})
+		baseClient.SetSecondaryFailover(&SecondaryFailover{
+			PrimaryAttempts: 2,
+			RetryDelay:      time.Millisecond,
+		})
+
+		req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod:    v.HttpMethod,
+			OptionsObject: testOptions{},
+			Path:          "/container/blob",
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		resp, err := req.Execute(ctx)
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("executing request: %+v", err)
+		}
+
+		if strings.Join(transport.hosts, ",") != strings.Join(v.ExpectedHosts, ",") {
+			t.Fatalf("expected the requests to be sent to %v but got %v", v.ExpectedHosts, transport.hosts)
+		}
+		if resp == nil {
+			t.Fatalf("expected a response but got nil")
+		}
+		if actual := ServedBySecondary(resp.Response); actual != v.ExpectedServedBySecondary {
+			t.Fatalf("expected ServedBySecondary to be %t but got %t", v.ExpectedServedBySecondary, actual)
+		}
+
+		// the original request is unchanged, so subsequent attempts start at the primary endpoint
+		if req.URL.Host != "account1.blob.core.windows.net" {
+			t.Fatalf("expected the original request to target the primary endpoint but got %q", req.URL.Host)
+		}
+	}
+}
+
+func TestServedBySecondary(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *http.Response
+		Expected bool
+	}{
+		{
+			Name:     "Nil Response",
+			Input:    nil,
+			Expected: false,
+		},
+		{
+			Name: "Primary",
+			Input: &http.Response{
+				Request: &http.Request{
+					URL: &url.URL{Scheme: "https", Host: "account1.blob.core.windows.net", Path: "/container"},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Secondary",
+			Input: &http.Response{
+				Request: &http.Request{
+					URL: &url.URL{Scheme: "https", Host: "account1-secondary.blob.core.windows.net", Path: "/container"},
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if actual := ServedBySecondary(v.Input); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package baseclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// maxLoggedBodySize is the maximum number of bytes of a request or response body which are logged
+const maxLoggedBodySize = 4096
+
+const redacted = "REDACTED"
+
+// Logger is used to log requests, which is implemented by `*log.Logger`
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// redactedHeaders are the headers whose values are never logged, since they contain credentials
+var redactedHeaders = map[string]struct{}{
+	"Authorization":                   {},
+	"X-Ms-Copy-Source-Authorization":  {},
+	"X-Ms-Encryption-Key":             {},
+	"X-Ms-Source-Encryption-Key":      {},
+	"X-Ms-Copy-Source-Encryption-Key": {},
+}
+
+// redactedBodyPatterns match any credentials within a request or response body, alongside their replacement - for
+// example the Authorization header for each sub-request within a Blob Batch, or the key returned from
+// GetUserDelegationKey
+var redactedBodyPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{
+		pattern:     regexp.MustCompile(`(?im)^(authorization:)[^\r\n]*`),
+		replacement: "${1} " + redacted,
+	},
+	{
+		pattern:     regexp.MustCompile(`(?i)([?&]sig=)[^&\s"'<]*`),
+		replacement: "${1}" + redacted,
+	},
+	{
+		// the body may have been truncated, so the closing element is optional
+		pattern:     regexp.MustCompile(`(<Value>)[^<]*`),
+		replacement: "${1}" + redacted,
+	},
+}
+
+func (c *Client) executeWithLogging(ctx context.Context, req *client.Request) (*client.Response, error) {
+	var requestBody []byte
+	if c.LogBody && req.Request != nil && req.Body != nil {
+		requestBody, req.Body = peekBody(req.Body)
+	}
+
+	start := time.Now()
+	resp, err := c.execute(ctx, req)
+	latency := time.Since(start)
+
+	if req.Request == nil {
+		return resp, err
+	}
+
+	method := req.Method
+	uri := sanitizeURL(req.URL)
+	if resp == nil || resp.Response == nil {
+		c.Logger.Printf("[DEBUG] %s %s failed after %s: %+v", method, uri, latency, err)
+		return resp, err
+	}
+
+	if clientRequestID := req.Header.Get("x-ms-client-request-id"); clientRequestID != "" {
+		c.Logger.Printf("[DEBUG] %s %s returned %d (Request ID %q, Client Request ID %q) in %s", method, uri, resp.StatusCode, resp.Header.Get("x-ms-request-id"), clientRequestID, latency)
+	} else {
+		c.Logger.Printf("[DEBUG] %s %s returned %d (Request ID %q) in %s", method, uri, resp.StatusCode, resp.Header.Get("x-ms-request-id"), latency)
+	}
+
+	if c.LogHeaders {
+		c.Logger.Printf("[DEBUG] Request Headers for %s %s:\n%s", method, uri, sanitizeHeaders(req.Header))
+		c.Logger.Printf("[DEBUG] Response Headers for %s %s:\n%s", method, uri, sanitizeHeaders(resp.Header))
+	}
+
+	if c.LogBody {
+		if len(requestBody) > 0 {
+			c.Logger.Printf("[DEBUG] Request Body for %s %s:\n%s", method, uri, sanitizeBody(requestBody))
+		}
+		if resp.Body != nil {
+			var responseBody []byte
+			responseBody, resp.Body = peekBody(resp.Body)
+			if len(responseBody) > 0 {
+				c.Logger.Printf("[DEBUG] Response Body for %s %s:\n%s", method, uri, sanitizeBody(responseBody))
+			}
+		}
+	}
+
+	return resp, err
+}
+
+// peekBody returns (up to) the first `maxLoggedBodySize` bytes of `body`, alongside a ReadCloser which
+// returns the entire body - so that large (or streamed) bodies aren't buffered in memory to be logged
+func peekBody(body io.ReadCloser) ([]byte, io.ReadCloser) {
+	prefix, err := io.ReadAll(io.LimitReader(body, maxLoggedBodySize))
+	if err != nil {
+		// the error is returned to the caller when the remainder of the body is read
+		return nil, &multiReadCloser{
+			Reader: io.MultiReader(bytes.NewReader(prefix), &errorReader{err: err}),
+			closer: body,
+		}
+	}
+
+	return prefix, &multiReadCloser{
+		Reader: io.MultiReader(bytes.NewReader(prefix), body),
+		closer: body,
+	}
+}
+
+type multiReadCloser struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (m *multiReadCloser) Close() error {
+	return m.closer.Close()
+}
+
+type errorReader struct {
+	err error
+}
+
+func (e *errorReader) Read([]byte) (int, error) {
+	return 0, e.err
+}
+
+// sanitizeURL returns `input` with any credentials (or Shared Access Signature) redacted
+func sanitizeURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	out := *input
+	out.User = nil
+	if out.RawQuery != "" {
+		query := out.Query()
+		if query.Has("sig") {
+			query.Set("sig", redacted)
+		}
+		out.RawQuery = query.Encode()
+	}
+	return out.String()
+}
+
+// sanitizeHeaders returns the sorted headers, one per line, with any credentials redacted
+func sanitizeHeaders(headers http.Header) string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, k := range keys {
+		value := strings.Join(headers.Values(k), ", ")
+		if _, ok := redactedHeaders[http.CanonicalHeaderKey(k)]; ok {
+			value = redacted
+		} else if u, err := url.Parse(value); err == nil && u.Query().Has("sig") {
+			// for example the `x-ms-copy-source` header can contain a SAS URL, and the `x-ms-rename-source`
+			// header a path with a SAS query string
+			value = sanitizeURL(u)
+		}
+		lines = append(lines, k+": "+value)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sanitizeBody returns the (logged portion of the) body with any credentials redacted
+func sanitizeBody(body []byte) []byte {
+	for _, v := range redactedBodyPatterns {
+		body = v.pattern.ReplaceAll(body, []byte(v.replacement))
+	}
+	return body
+}
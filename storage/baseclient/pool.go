@@ -0,0 +1,41 @@
+package baseclient
+
+import (
+	"net/http"
+	"time"
+)
+
+// TransportOptions configures the connection pool of the `*http.Client` returned from NewHTTPClient. Any values
+// which aren't specified default to the values used by `http.DefaultTransport`.
+type TransportOptions struct {
+	// MaxIdleConns is the maximum number of idle (keep-alive) connections across all hosts
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost is the maximum number of idle (keep-alive) connections to keep for each host. This
+	// defaults to 2 (`http.DefaultMaxIdleConnsPerHost`) - which should be increased when sending many concurrent
+	// requests to the same Storage Account, since otherwise new connections are continually opened.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout is the maximum amount of time an idle (keep-alive) connection remains open
+	IdleConnTimeout time.Duration
+}
+
+// NewHTTPClient returns an `*http.Client` using a connection pool configured using `options`, which can be
+// passed to SetHTTPClient. A single `*http.Client` (and so connection pool) can be shared between Clients and
+// used concurrently from multiple goroutines.
+func NewHTTPClient(options TransportOptions) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if options.MaxIdleConns > 0 {
+		transport.MaxIdleConns = options.MaxIdleConns
+	}
+	if options.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = options.MaxIdleConnsPerHost
+	}
+	if options.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = options.IdleConnTimeout
+	}
+
+	return &http.Client{
+		Transport: transport,
+	}
+}
@@ -0,0 +1,202 @@
+package baseclient
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+
+	testData := []struct {
+		Name                        string
+		Input                       TransportOptions
+		ExpectedMaxIdleConns        int
+		ExpectedMaxIdleConnsPerHost int
+		ExpectedIdleConnTimeout     time.Duration
+	}{
+		{
+			Name:                        "Defaults",
+			Input:                       TransportOptions{},
+			ExpectedMaxIdleConns:        defaultTransport.MaxIdleConns,
+			ExpectedMaxIdleConnsPerHost: defaultTransport.MaxIdleConnsPerHost,
+			ExpectedIdleConnTimeout:     defaultTransport.IdleConnTimeout,
+		},
+		{
+			Name: "Configured",
+			Input: TransportOptions{
+				MaxIdleConns:        500,
+				MaxIdleConnsPerHost: 100,
+				IdleConnTimeout:     30 * time.Second,
+			},
+			ExpectedMaxIdleConns:        500,
+			ExpectedMaxIdleConnsPerHost: 100,
+			ExpectedIdleConnTimeout:     30 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		transport, ok := NewHTTPClient(v.Input).Transport.(*http.Transport)
+		if !ok {
+			t.Fatalf("expected the Transport to be an `*http.Transport`")
+		}
+		if transport == defaultTransport {
+			t.Fatalf("expected `http.DefaultTransport` not to be modified")
+		}
+		if transport.MaxIdleConns != v.ExpectedMaxIdleConns {
+			t.Fatalf("expected MaxIdleConns to be %d but got %d", v.ExpectedMaxIdleConns, transport.MaxIdleConns)
+		}
+		if transport.MaxIdleConnsPerHost != v.ExpectedMaxIdleConnsPerHost {
+			t.Fatalf("expected MaxIdleConnsPerHost to be %d but got %d", v.ExpectedMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+		}
+		if transport.IdleConnTimeout != v.ExpectedIdleConnTimeout {
+			t.Fatalf("expected IdleConnTimeout to be %s but got %s", v.ExpectedIdleConnTimeout, transport.IdleConnTimeout)
+		}
+	}
+}
+
+// TestConcurrentRequests sends requests from multiple goroutines using a single Client, which
+// should be run using `go test -race` to detect any unsafe access
+func TestConcurrentRequests(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		w.Header().Set("x-ms-request-id", "00000000-0000-0000-0000-000000000000")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	baseClient.SetHTTPClient(NewHTTPClient(TransportOptions{
+		MaxIdleConnsPerHost: 10,
+	}))
+	baseClient.SetLogger(log.New(io.Discard, "", 0))
+	baseClient.LogHeaders = true
+
+	goroutines := 10
+	requestsPerGoroutine := 20
+	errs := make(chan error, goroutines*requestsPerGoroutine)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < requestsPerGoroutine; j++ {
+				if err := sendTestRequest(ctx, baseClient); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if expected := int64(goroutines * requestsPerGoroutine); requests != expected {
+		t.Fatalf("expected %d requests but got %d", expected, requests)
+	}
+}
+
+// BenchmarkPooledClient sends requests using a single Client (and connection pool) shared between goroutines
+func BenchmarkPooledClient(b *testing.B) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+	if err != nil {
+		b.Fatalf("building client: %+v", err)
+	}
+	httpClient := NewHTTPClient(TransportOptions{
+		MaxIdleConnsPerHost: 100,
+	})
+	defer httpClient.CloseIdleConnections()
+	baseClient.SetHTTPClient(httpClient)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if err := sendTestRequest(ctx, baseClient); err != nil {
+				b.Errorf("sending request: %+v", err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkPerCallClient sends each request using a new Client (and connection pool)
+func BenchmarkPerCallClient(b *testing.B) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+			if err != nil {
+				b.Errorf("building client: %+v", err)
+				return
+			}
+			httpClient := NewHTTPClient(TransportOptions{})
+			baseClient.SetHTTPClient(httpClient)
+
+			err = sendTestRequest(ctx, baseClient)
+			httpClient.CloseIdleConnections()
+			if err != nil {
+				b.Errorf("sending request: %+v", err)
+				return
+			}
+		}
+	})
+}
+
+func sendTestRequest(ctx context.Context, baseClient *Client) error {
+	req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: testOptions{},
+		Path:          "/container/blob",
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil && resp.Body != nil {
+		// the body must be read and closed so that the connection is returned to the pool
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+	return err
+}
@@ -0,0 +1,53 @@
+package baseclient
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// RequestID returns the ID of the request (from the `x-ms-request-id` header) for the `HttpResponse` returned
+// within every Response - which is useful when raising a support ticket. An empty string is returned when
+// `resp` is nil or the header wasn't returned.
+//
+// When the request failed the RequestID is also available from the `responseerror.ResponseError` within the error.
+func RequestID(resp *http.Response) string {
+	if resp == nil || resp.Header == nil {
+		return ""
+	}
+	return resp.Header.Get("x-ms-request-id")
+}
+
+// ClientRequestID returns the client-generated correlation ID for the request (from the `x-ms-client-request-id`
+// header), which the service echoes back in the `HttpResponse` returned within every Response when one was sent.
+// An empty string is returned when `resp` is nil or the header wasn't returned.
+//
+// A Client Request ID can be sent using the `ClientRequestID` field within the `requestoptions.RequestOptions`, or
+// generated for each request by configuring GenerateClientRequestID.
+func ClientRequestID(resp *http.Response) string {
+	if resp == nil || resp.Header == nil {
+		return ""
+	}
+	return resp.Header.Get("x-ms-client-request-id")
+}
+
+// SetGenerateClientRequestID configures whether a Client Request ID (a UUID) is generated for each request which
+// doesn't already specify one
+func (c *Client) SetGenerateClientRequestID(generate bool) {
+	c.GenerateClientRequestID = generate
+}
+
+// setClientRequestID generates a Client Request ID for the request when one hasn't been specified - which is retained
+// when the request is retried, so that each attempt can be correlated
+func setClientRequestID(req *client.Request) {
+	if req.Request == nil {
+		return
+	}
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+	if req.Header.Get("x-ms-client-request-id") == "" {
+		req.Header.Set("x-ms-client-request-id", uuid.New().String())
+	}
+}
@@ -0,0 +1,158 @@
+package baseclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestRequestID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *http.Response
+		Expected string
+	}{
+		{
+			Name:     "Nil Response",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name:     "No Headers",
+			Input:    &http.Response{},
+			Expected: "",
+		},
+		{
+			Name: "Request ID",
+			Input: &http.Response{
+				Header: http.Header{
+					"X-Ms-Request-Id": []string{"abc123"},
+				},
+			},
+			Expected: "abc123",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if actual := RequestID(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestClientRequestID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *http.Response
+		Expected string
+	}{
+		{
+			Name:     "Nil Response",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name:     "No Headers",
+			Input:    &http.Response{},
+			Expected: "",
+		},
+		{
+			Name: "Client Request ID",
+			Input: &http.Response{
+				Header: http.Header{
+					"X-Ms-Client-Request-Id": []string{"correlation-1"},
+				},
+			},
+			Expected: "correlation-1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if actual := ClientRequestID(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestGenerateClientRequestID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the service echoes back the Client Request ID
+		w.Header().Set("x-ms-client-request-id", r.Header.Get("x-ms-client-request-id"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	baseClient.SetHTTPClient(server.Client())
+
+	testData := []struct {
+		Name     string
+		Generate bool
+		Input    string
+	}{
+		{
+			Name: "Disabled",
+		},
+		{
+			Name:     "Generated",
+			Generate: true,
+		},
+		{
+			Name:     "Specified",
+			Generate: true,
+			Input:    "correlation-1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		baseClient.SetGenerateClientRequestID(v.Generate)
+		req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod:    http.MethodGet,
+			OptionsObject: testOptions{},
+			Path:          "/container/blob",
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		if v.Input != "" {
+			req.Header.Set("x-ms-client-request-id", v.Input)
+		}
+
+		resp, err := req.Execute(ctx)
+		if err != nil {
+			t.Fatalf("executing request: %+v", err)
+		}
+
+		actual := ClientRequestID(resp.Response)
+		switch {
+		case !v.Generate && actual != "":
+			t.Fatalf("expected no Client Request ID but got %q", actual)
+		case v.Input != "" && actual != v.Input:
+			t.Fatalf("expected the Client Request ID to be %q but got %q", v.Input, actual)
+		case v.Generate && v.Input == "":
+			if _, err := uuid.Parse(actual); err != nil {
+				t.Fatalf("expected the generated Client Request ID to be a UUID but got %q: %+v", actual, err)
+			}
+		}
+	}
+}
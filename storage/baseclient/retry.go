@@ -0,0 +1,119 @@
+package baseclient
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// these match the underlying `storage.Client`
+var (
+	// defaultHTTPClient is used to send requests when a RetryPolicy is configured without a HTTPClient
+	defaultHTTPClient = NewHTTPClient(TransportOptions{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: runtime.GOMAXPROCS(0) + 1,
+		IdleConnTimeout:     90 * time.Second,
+	})
+
+	// retryWaitMin and retryWaitMax bound the exponential backoff between retries
+	retryWaitMin = 1 * time.Second
+	retryWaitMax = 61 * time.Second
+
+	// retryMax is the number of retries performed for a request, which takes approximately 10 minutes
+	retryMax = 16
+)
+
+// httpClient returns the `*http.Client` used to send the request. When a RetryPolicy is configured it's the only
+// layer which retries requests, so each attempt is sent once - otherwise the request is retried in the same manner
+// as the underlying `storage.Client`.
+func (c *Client) httpClient(ctx context.Context, req *client.Request) *http.Client {
+	if c.RetryPolicy == nil {
+		return c.retryableHTTPClient(ctx, req)
+	}
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return defaultHTTPClient
+}
+
+// retryableHTTPClient returns an `*http.Client` which sends the request using the HTTPClient, retrying it in the
+// same manner as the underlying `storage.Client` - that is rate limiting (a 429), server errors, a 408 or a 424,
+// and any eventual consistency failures identified by the RetryFunc for the request (for example a 409 when a
+// Container is being deleted).
+func (c *Client) retryableHTTPClient(ctx context.Context, req *client.Request) *http.Client {
+	r := retryablehttp.NewClient()
+	r.HTTPClient = c.HTTPClient
+	r.Backoff = retryBackoff
+	r.CheckRetry = c.checkRetry(req)
+	r.ErrorHandler = client.RetryableErrorHandler
+	r.RetryWaitMin = retryWaitMin
+	r.RetryWaitMax = retryWaitMax
+
+	// requests are logged using the Logger (which redacts any Shared Access Signature) rather than by retryablehttp
+	r.Logger = nil
+
+	r.RetryMax = retryMax
+	if deadline, ok := ctx.Deadline(); ok {
+		// extend the number of retries when the deadline exceeds 10 minutes
+		if timeout := time.Until(deadline); timeout > 10*time.Minute {
+			r.RetryMax = int(math.Round(timeout.Minutes())) + 6
+		}
+	}
+
+	return r.StandardClient()
+}
+
+// checkRetry returns the CheckRetry function used by the underlying `storage.Client` for the request
+func (c *Client) checkRetry(req *client.Request) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if resp == nil {
+			if req.IsIdempotent() {
+				return true, nil
+			}
+			return false, fmt.Errorf("HTTP response was nil; connection may have been reset")
+		}
+
+		if !c.DisableRetries {
+			if resp.StatusCode == http.StatusFailedDependency || resp.StatusCode == http.StatusRequestTimeout {
+				return true, nil
+			}
+
+			// this is best-effort, since the Blob, File and Queue APIs return XML rather than JSON
+			o, _ := odata.FromResponse(resp)
+			if f := req.RetryFunc; f != nil {
+				shouldRetry, err := f(resp, o)
+				if err != nil || shouldRetry {
+					return shouldRetry, err
+				}
+			}
+		}
+
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+}
+
+// retryBackoff honours any `Retry-After` header returned by the API, otherwise backing off exponentially
+func retryBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if v, ok := resp.Header["Retry-After"]; ok {
+			if sleep, err := strconv.ParseInt(v[0], 10, 64); err == nil {
+				return time.Second * time.Duration(sleep)
+			}
+		}
+	}
+
+	mult := math.Pow(2, float64(attemptNum)) * float64(min)
+	sleep := time.Duration(mult)
+	if float64(sleep) != mult || sleep > max {
+		sleep = max
+	}
+	return sleep
+}
@@ -0,0 +1,192 @@
+package baseclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/retrypolicy"
+)
+
+func TestExecuteWithHTTPClientRetries(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	defer func(min, max time.Duration) {
+		retryWaitMin = min
+		retryWaitMax = max
+	}(retryWaitMin, retryWaitMax)
+	retryWaitMin = time.Millisecond
+	retryWaitMax = 10 * time.Millisecond
+
+	testData := []struct {
+		Name             string
+		FailureCode      string
+		FailureStatus    int
+		RetryFunc        client.RequestRetryFunc
+		ExpectedRequests int
+		ExpectError      bool
+	}{
+		{
+			Name:             "Rate Limited",
+			FailureStatus:    http.StatusTooManyRequests,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Server Error",
+			FailureStatus:    http.StatusServiceUnavailable,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Conflict without a RetryFunc",
+			FailureCode:      "ContainerBeingDeleted",
+			FailureStatus:    http.StatusConflict,
+			ExpectedRequests: 1,
+			ExpectError:      true,
+		},
+		{
+			Name:          "Conflict retried by the RetryFunc",
+			FailureCode:   "ContainerBeingDeleted",
+			FailureStatus: http.StatusConflict,
+			RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+				return resp != nil && resp.StatusCode == http.StatusConflict && resp.Header.Get("x-ms-error-code") == "ContainerBeingDeleted", nil
+			},
+			ExpectedRequests: 3,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests < 3 {
+				if v.FailureCode != "" {
+					w.Header().Set("x-ms-error-code", v.FailureCode)
+				}
+				w.WriteHeader(v.FailureStatus)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		baseClient, err := New(server.URL, "blob/containers", "2023-11-03")
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+		baseClient.SetHTTPClient(server.Client())
+
+		req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+			ExpectedStatusCodes: []int{
+				http.StatusCreated,
+			},
+			HttpMethod:    http.MethodPut,
+			OptionsObject: testOptions{},
+			Path:          "/container",
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		req.RetryFunc = v.RetryFunc
+
+		_, err = req.Execute(ctx)
+		server.Close()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if requests != v.ExpectedRequests {
+			t.Fatalf("expected %d requests but got %d", v.ExpectedRequests, requests)
+		}
+	}
+}
+
+func TestExecuteWithRetryPolicy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name             string
+		Method           string
+		HTTPClient       bool
+		ExpectedRequests int
+	}{
+		{
+			Name:             "Idempotent",
+			Method:           http.MethodGet,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Idempotent using a HTTPClient",
+			Method:           http.MethodGet,
+			HTTPClient:       true,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Non-Idempotent",
+			Method:           http.MethodPost,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Non-Idempotent using a HTTPClient",
+			Method:           http.MethodPost,
+			HTTPClient:       true,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+
+		baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+		if v.HTTPClient {
+			baseClient.SetHTTPClient(server.Client())
+		}
+
+		// the policy is the only layer which retries the request, so the transport doesn't also retry each attempt
+		baseClient.SetRetryPolicy(&retrypolicy.Policy{
+			MaxRetries:    2,
+			RetryDelay:    time.Millisecond,
+			MaxRetryDelay: time.Millisecond,
+		})
+
+		req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod:    v.Method,
+			OptionsObject: testOptions{},
+			Path:          "/container/blob",
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		_, err = req.Execute(ctx)
+		server.Close()
+
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if requests != v.ExpectedRequests {
+			t.Fatalf("expected %d requests but got %d", v.ExpectedRequests, requests)
+		}
+	}
+}
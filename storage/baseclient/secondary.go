@@ -0,0 +1,60 @@
+package baseclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// secondarySuffix is the suffix appended to the Account Name to form the host of the secondary endpoint
+// of a Storage Account with read-access geo-redundant replication (RA-GRS/RA-GZRS)
+const secondarySuffix = "-secondary"
+
+// SecondaryHost returns the host of the secondary endpoint for the specified (primary) host, for example
+// `account1-secondary.blob.core.windows.net` for `account1.blob.core.windows.net`. When the host is already
+// the secondary endpoint it's returned as-is. An error is returned when the host is an IP Address (for
+// example the Storage Emulator), since the secondary endpoint can't be derived from it.
+func SecondaryHost(host string) (string, error) {
+	hostname, port := host, ""
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		hostname, port = h, p
+	}
+
+	if net.ParseIP(hostname) != nil {
+		return "", fmt.Errorf("the secondary endpoint can't be derived from the IP Address %q", hostname)
+	}
+
+	accountName, domain, ok := strings.Cut(hostname, ".")
+	if !ok || accountName == "" || domain == "" {
+		return "", fmt.Errorf("the secondary endpoint can't be derived from the host %q", host)
+	}
+
+	if !strings.HasSuffix(accountName, secondarySuffix) {
+		hostname = fmt.Sprintf("%s%s.%s", accountName, secondarySuffix, domain)
+	}
+
+	if port != "" {
+		return net.JoinHostPort(hostname, port), nil
+	}
+	return hostname, nil
+}
+
+// NewSecondaryRequest builds a request in the same way as NewRequest, which is sent to the secondary endpoint
+// of the Storage Account (see SecondaryHost) rather than the configured base URI
+func (c *Client) NewSecondaryRequest(ctx context.Context, input client.RequestOptions) (*client.Request, error) {
+	req, err := c.NewRequest(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := SecondaryHost(req.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Host = host
+	req.Host = host
+	return req, nil
+}
@@ -0,0 +1,107 @@
+package baseclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestSecondaryHost(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Valid    bool
+	}{
+		{
+			Name:     "Blob Endpoint",
+			Input:    "account1.blob.core.windows.net",
+			Expected: "account1-secondary.blob.core.windows.net",
+			Valid:    true,
+		},
+		{
+			Name:     "Queue Endpoint in another Cloud",
+			Input:    "account1.queue.core.chinacloudapi.cn",
+			Expected: "account1-secondary.queue.core.chinacloudapi.cn",
+			Valid:    true,
+		},
+		{
+			Name:     "With a Port",
+			Input:    "account1.blob.core.windows.net:443",
+			Expected: "account1-secondary.blob.core.windows.net:443",
+			Valid:    true,
+		},
+		{
+			Name:     "Already the Secondary Endpoint",
+			Input:    "account1-secondary.blob.core.windows.net",
+			Expected: "account1-secondary.blob.core.windows.net",
+			Valid:    true,
+		},
+		{
+			Name:  "IP Address",
+			Input: "127.0.0.1:10000",
+			Valid: false,
+		},
+		{
+			Name:  "No Domain",
+			Input: "localhost",
+			Valid: false,
+		},
+		{
+			Name:  "Empty",
+			Input: "",
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := SecondaryHost(v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.Valid {
+			if err == nil {
+				t.Fatalf("expected %q to be invalid but got %q", v.Input, actual)
+			}
+			continue
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestNewSecondaryRequest(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	baseClient, err := New("https://account1.blob.core.windows.net", "blob/accounts", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	req, err := baseClient.NewSecondaryRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: testOptions{},
+		Path:          "/",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	expected := "account1-secondary.blob.core.windows.net"
+	if req.URL.Host != expected {
+		t.Fatalf("expected the URL Host to be %q but got %q", expected, req.URL.Host)
+	}
+	if req.Host != expected {
+		t.Fatalf("expected the Host to be %q but got %q", expected, req.Host)
+	}
+}
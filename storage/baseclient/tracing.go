@@ -0,0 +1,215 @@
+package baseclient
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// Tracer starts a Span for each operation performed by a Client, which covers every attempt of the request -
+// including any retries and failover to the secondary endpoint. This is intentionally a minimal interface so that
+// this SDK doesn't depend on a specific tracing library - for example an OpenTelemetry `trace.Tracer` can be
+// used by wrapping it in a type implementing this interface (see the README for an example).
+type Tracer interface {
+	// Start starts a Span named `name` (for example `Blobs.PutBlockBlob`) as a child of any Span within `ctx`,
+	// returning a context containing the new Span - which is used to send the request, so that the Span can be
+	// propagated to the `*http.Client`.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced operation
+type Span interface {
+	// SetAttribute records `key` with the (string or int) value `value` on the Span
+	SetAttribute(key string, value interface{})
+
+	// AddEvent records an event named `name` with the (string or int) `attributes` on the Span, which is used to
+	// record each attempt of the request (see EventAttempt)
+	AddEvent(name string, attributes map[string]interface{})
+
+	// RecordError records that the operation failed with `err`
+	RecordError(err error)
+
+	// End completes the Span
+	End()
+}
+
+// The attributes recorded on each Span, which match the OpenTelemetry Semantic Conventions for HTTP and Azure
+const (
+	AttributeErrorType      = "error.type"
+	AttributeHTTPMethod     = "http.request.method"
+	AttributeHTTPStatusCode = "http.response.status_code"
+	AttributeRequestID      = "az.service_request_id"
+	AttributeResendCount    = "http.request.resend_count"
+	AttributeServerAddress  = "server.address"
+)
+
+// EventAttempt is the name of the event recorded on the Span for each attempt of the request, with the
+// AttributeResendCount, AttributeServerAddress and (when a response was received) AttributeHTTPStatusCode and
+// AttributeRequestID attributes - and AttributeErrorType when the attempt failed
+const EventAttempt = "Attempt"
+
+type traceKey struct{}
+
+// trace is the state of the Span for an operation, which is attached to the context used to send each attempt
+type trace struct {
+	span     Span
+	attempts int
+}
+
+type operationKey struct{}
+
+// withOperationName attaches the name of the operation which built the request (see operationName) to the request,
+// which is used to name the Span
+func withOperationName(req *client.Request, name string) {
+	if req.Request != nil && name != "" {
+		req.Request = req.Request.WithContext(context.WithValue(req.Context(), operationKey{}, name))
+	}
+}
+
+// operationName returns the name of the operation which is building a request, for example `PutBlockBlob` - which
+// is the first exported method within the package calling NewRequest (or NewSecondaryRequest), since a request can
+// be built by an unexported helper of an operation. An empty string is returned when this can't be determined.
+//
+// This must be called directly from NewRequest, so that the frames of the Client within this package are skipped.
+func operationName() string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)])
+
+	// the first frame is this function, which determines the package to skip
+	frame, more := frames.Next()
+	basePackage, _ := splitFunctionName(frame.Function)
+
+	operationPackage := ""
+	for more {
+		frame, more = frames.Next()
+		pkg, name := splitFunctionName(frame.Function)
+		if pkg == basePackage && strings.HasPrefix(name, "(*Client).") {
+			continue
+		}
+		if operationPackage == "" {
+			operationPackage = pkg
+		}
+		if pkg != operationPackage {
+			break
+		}
+
+		// methods are named `Client.PutBlockBlob` or `(*Client).PutBlockBlob`, whereas closures have a `.funcN` suffix
+		if parts := strings.Split(name, "."); len(parts) == 2 && parts[1] != "" && strings.ToUpper(parts[1][:1]) == parts[1][:1] {
+			return parts[1]
+		}
+	}
+
+	return ""
+}
+
+// splitFunctionName splits the fully qualified name of a function (for example
+// `github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs.Client.PutBlockBlob`) into the path of the
+// package and the name of the function within it
+func splitFunctionName(input string) (string, string) {
+	slash := strings.LastIndex(input, "/")
+	dot := strings.Index(input[slash+1:], ".")
+	if dot < 0 {
+		return input, ""
+	}
+	return input[:slash+1+dot], input[slash+1+dot+1:]
+}
+
+// executeWithTracing starts a Span for the operation, which covers every attempt of the request
+func (c *Client) executeWithTracing(ctx context.Context, req *client.Request) (*client.Response, error) {
+	if req.Request == nil {
+		return c.executeWithRetries(ctx, req)
+	}
+
+	ctx, span := c.Tracer.Start(ctx, spanName(c.componentName, req))
+	defer span.End()
+	ctx = context.WithValue(ctx, traceKey{}, &trace{
+		span: span,
+	})
+
+	span.SetAttribute(AttributeHTTPMethod, req.Method)
+	if req.URL != nil {
+		span.SetAttribute(AttributeServerAddress, req.URL.Hostname())
+	}
+
+	resp, err := c.executeWithRetries(ctx, req)
+	if resp != nil && resp.Response != nil {
+		span.SetAttribute(AttributeHTTPStatusCode, resp.StatusCode)
+		if v := resp.Header.Get("x-ms-request-id"); v != "" {
+			span.SetAttribute(AttributeRequestID, v)
+		}
+	}
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return resp, err
+}
+
+// sendWithTracing sends a single attempt of the request, recording it as an event on the Span for the operation
+// when it's being traced
+func (c *Client) sendWithTracing(ctx context.Context, req *client.Request) (*client.Response, error) {
+	t, ok := ctx.Value(traceKey{}).(*trace)
+	if !ok || req.Request == nil {
+		return c.send(ctx, req)
+	}
+
+	req.Request = req.Request.WithContext(ctx)
+	resp, err := c.send(ctx, req)
+
+	attributes := map[string]interface{}{
+		AttributeResendCount: t.attempts,
+	}
+	t.attempts++
+	if req.URL != nil {
+		attributes[AttributeServerAddress] = req.URL.Hostname()
+	}
+	if resp != nil && resp.Response != nil {
+		attributes[AttributeHTTPStatusCode] = resp.StatusCode
+		if v := resp.Header.Get("x-ms-request-id"); v != "" {
+			attributes[AttributeRequestID] = v
+		}
+	}
+	if err != nil {
+		// the OpenTelemetry Semantic Conventions use the status code as the error type when one was returned
+		attributes[AttributeErrorType] = "_OTHER"
+		if resp != nil && resp.Response != nil {
+			attributes[AttributeErrorType] = strconv.Itoa(resp.StatusCode)
+		}
+	}
+	t.span.AddEvent(EventAttempt, attributes)
+
+	return resp, err
+}
+
+// spanName returns the name of the Span for the request, which is comprised of the Storage API and the operation -
+// for example `Blobs.PutBlockBlob`. When the operation can't be determined the method and `comp` are used instead,
+// for example `Blobs.Put` or `Blobs.GetMetadata` (for a GET request with `comp=metadata`).
+func spanName(componentName string, req *client.Request) string {
+	component := componentName
+	if i := strings.LastIndex(component, "/"); i >= 0 {
+		component = component[i+1:]
+	}
+
+	if name, ok := req.Context().Value(operationKey{}).(string); ok {
+		return title(component) + "." + name
+	}
+
+	operation := title(strings.ToLower(req.Method))
+	if req.URL != nil {
+		if comp := req.URL.Query().Get("comp"); comp != "" {
+			operation += title(comp)
+		}
+	}
+
+	return title(component) + "." + operation
+}
+
+func title(input string) string {
+	if input == "" {
+		return input
+	}
+	return strings.ToUpper(input[:1]) + input[1:]
+}
@@ -0,0 +1,267 @@
+package baseclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/jackofallops/giovanni/storage/retrypolicy"
+)
+
+type testSpanKey struct{}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{
+		name:       name,
+		attributes: map[string]interface{}{},
+	}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+type testSpan struct {
+	name       string
+	attributes map[string]interface{}
+	events     []testEvent
+	err        error
+	ended      bool
+}
+
+type testEvent struct {
+	name       string
+	attributes map[string]interface{}
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *testSpan) AddEvent(name string, attributes map[string]interface{}) {
+	s.events = append(s.events, testEvent{
+		name:       name,
+		attributes: attributes,
+	})
+}
+
+func (s *testSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+type contextCheckingTransport struct {
+	sawSpan bool
+}
+
+func (t *contextCheckingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	_, t.sawSpan = req.Context().Value(testSpanKey{}).(*testSpan)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestExecuteWithTracing(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ms-request-id", "request-1")
+		if r.URL.Query().Get("comp") == "metadata" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	transport := &contextCheckingTransport{}
+	baseClient.SetHTTPClient(&http.Client{
+		Transport: transport,
+	})
+	tracer := &testTracer{}
+	baseClient.SetTracer(tracer)
+
+	testData := []struct {
+		Name               string
+		Comp               string
+		ExpectedSpanName   string
+		ExpectedStatusCode int
+		ExpectError        bool
+	}{
+		{
+			Name:               "Put",
+			ExpectedSpanName:   "Blobs.Put",
+			ExpectedStatusCode: http.StatusCreated,
+		},
+		{
+			Name:               "Put MetaData",
+			Comp:               "metadata",
+			ExpectedSpanName:   "Blobs.PutMetadata",
+			ExpectedStatusCode: http.StatusNotFound,
+			ExpectError:        true,
+		},
+	}
+
+	for i, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		transport.sawSpan = false
+		req, err := baseClient.NewRequest(ctx, client.RequestOptions{
+			ExpectedStatusCodes: []int{
+				http.StatusCreated,
+			},
+			HttpMethod:    http.MethodPut,
+			OptionsObject: testOptions{},
+			Path:          "/container/blob",
+		})
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		if v.Comp != "" {
+			req.URL.RawQuery = "comp=" + v.Comp
+		}
+
+		_, err = req.Execute(ctx)
+		if v.ExpectError != (err != nil) {
+			t.Fatalf("expected an error to be returned: %t but got: %+v", v.ExpectError, err)
+		}
+
+		if len(tracer.spans) != i+1 {
+			t.Fatalf("expected %d spans but got %d", i+1, len(tracer.spans))
+		}
+		span := tracer.spans[i]
+		if span.name != v.ExpectedSpanName {
+			t.Fatalf("expected the span to be named %q but got %q", v.ExpectedSpanName, span.name)
+		}
+		if !span.ended {
+			t.Fatalf("expected the span to have been ended")
+		}
+		if span.attributes[AttributeHTTPMethod] != http.MethodPut {
+			t.Fatalf("expected the method to be recorded but got %+v", span.attributes[AttributeHTTPMethod])
+		}
+		if span.attributes[AttributeHTTPStatusCode] != v.ExpectedStatusCode {
+			t.Fatalf("expected the status code %d to be recorded but got %+v", v.ExpectedStatusCode, span.attributes[AttributeHTTPStatusCode])
+		}
+		if span.attributes[AttributeRequestID] != "request-1" {
+			t.Fatalf("expected the request ID to be recorded but got %+v", span.attributes[AttributeRequestID])
+		}
+		if v.ExpectError != (span.err != nil) {
+			t.Fatalf("expected an error to be recorded: %t but got: %+v", v.ExpectError, span.err)
+		}
+		if !transport.sawSpan {
+			t.Fatalf("expected the span to be propagated to the HTTP Client")
+		}
+	}
+}
+
+// testOperations mimics the Client for a Storage API, where an operation builds the request using an unexported helper
+type testOperations struct {
+	client *Client
+}
+
+func (o testOperations) PutBlockBlob(ctx context.Context) (*client.Response, error) {
+	return o.put(ctx)
+}
+
+func (o testOperations) put(ctx context.Context) (*client.Response, error) {
+	req, err := o.client.NewRequest(ctx, client.RequestOptions{
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: testOptions{},
+		Path:          "/container/blob",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return req.Execute(ctx)
+}
+
+func TestExecuteWithTracingRetries(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("x-ms-request-id", fmt.Sprintf("request-%d", requests))
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	baseClient, err := New(server.URL, "blob/blobs", "2023-11-03")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	transport := &contextCheckingTransport{}
+	baseClient.SetHTTPClient(&http.Client{
+		Transport: transport,
+	})
+	baseClient.SetRetryPolicy(&retrypolicy.Policy{
+		MaxRetries:    2,
+		RetryDelay:    time.Millisecond,
+		MaxRetryDelay: time.Millisecond,
+	})
+	tracer := &testTracer{}
+	baseClient.SetTracer(tracer)
+
+	if _, err := (testOperations{client: baseClient}).PutBlockBlob(ctx); err != nil {
+		t.Fatalf("executing request: %+v", err)
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("expected a single span for the operation but got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != "Blobs.PutBlockBlob" {
+		t.Fatalf("expected the span to be named %q but got %q", "Blobs.PutBlockBlob", span.name)
+	}
+	if span.attributes[AttributeHTTPStatusCode] != http.StatusCreated {
+		t.Fatalf("expected the final status code to be recorded but got %+v", span.attributes[AttributeHTTPStatusCode])
+	}
+	if span.attributes[AttributeRequestID] != "request-3" {
+		t.Fatalf("expected the final request ID to be recorded but got %+v", span.attributes[AttributeRequestID])
+	}
+	if span.err != nil {
+		t.Fatalf("expected no error to be recorded but got: %+v", span.err)
+	}
+	if !transport.sawSpan {
+		t.Fatalf("expected the span to be propagated to the HTTP Client")
+	}
+
+	if len(span.events) != 3 {
+		t.Fatalf("expected an event for each of the 3 attempts but got %d", len(span.events))
+	}
+	expectedStatusCodes := []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusCreated}
+	for i, event := range span.events {
+		if event.name != EventAttempt {
+			t.Fatalf("expected event %d to be named %q but got %q", i, EventAttempt, event.name)
+		}
+		if event.attributes[AttributeResendCount] != i {
+			t.Fatalf("expected event %d to record a resend count of %d but got %+v", i, i, event.attributes[AttributeResendCount])
+		}
+		if event.attributes[AttributeHTTPStatusCode] != expectedStatusCodes[i] {
+			t.Fatalf("expected event %d to record the status code %d but got %+v", i, expectedStatusCodes[i], event.attributes[AttributeHTTPStatusCode])
+		}
+		if _, failed := event.attributes[AttributeErrorType]; failed != (i < 2) {
+			t.Fatalf("expected event %d to record an error type: %t but got %+v", i, i < 2, event.attributes[AttributeErrorType])
+		}
+	}
+}
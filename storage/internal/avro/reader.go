@@ -0,0 +1,329 @@
+// Package avro reads the records within an Avro Object Container File, which is the format used by the Storage
+// Service to frame the response of some operations (for example Query Blob Contents). Only the subset of Avro needed
+// for this is supported - notably only the `null` codec is supported, since the Storage Service doesn't compress
+// these responses.
+package avro
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// magic is the 4 bytes at the start of every Object Container File
+var magic = []byte{'O', 'b', 'j', 1}
+
+// maxBlockSize is the largest block which will be read into memory, to guard against a corrupt size
+const maxBlockSize = 128 * 1024 * 1024
+
+// Record is a decoded Avro `record`
+type Record struct {
+	// Name is the full name of the record, including the namespace
+	Name string
+
+	// Fields contains the decoded value of each field, keyed by the name of the field
+	Fields map[string]interface{}
+}
+
+// ShortName returns the name of the record without the namespace
+func (r Record) ShortName() string {
+	return shortName(r.Name)
+}
+
+// Reader reads the objects within an Avro Object Container File
+type Reader struct {
+	reader *bufio.Reader
+
+	// schema is the Schema of the objects, which is parsed from the header
+	schema *Schema
+
+	// sync is the 16-byte marker which follows each block
+	sync []byte
+
+	// block contains the objects within the current block which haven't yet been read
+	block *bytes.Reader
+
+	// remaining is the number of objects within the current block which haven't yet been read
+	remaining int64
+}
+
+// NewReader returns a Reader for the Object Container File within `r` - the header is read lazily, when the first
+// object is read
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		reader: bufio.NewReader(r),
+	}
+}
+
+// Next returns the next object, or io.EOF when there are no more objects. Records are returned as a Record.
+func (r *Reader) Next() (interface{}, error) {
+	if r.schema == nil {
+		if err := r.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	for r.remaining == 0 {
+		if err := r.readBlock(); err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := decode(r.block, r.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decoding object: %+v", err)
+	}
+	r.remaining--
+	return value, nil
+}
+
+func (r *Reader) readHeader() error {
+	header := make([]byte, len(magic))
+	if _, err := io.ReadFull(r.reader, header); err != nil {
+		return fmt.Errorf("reading header: %+v", unexpectedEOF(err))
+	}
+	if !bytes.Equal(header, magic) {
+		return fmt.Errorf("expected the magic bytes %q but got %q", magic, header)
+	}
+
+	metadata, err := decode(r.reader, &Schema{Type: "map", Values: &Schema{Type: "bytes"}})
+	if err != nil {
+		return fmt.Errorf("reading metadata: %+v", err)
+	}
+	values := metadata.(map[string]interface{})
+
+	if codec, ok := values["avro.codec"].([]byte); ok && len(codec) > 0 && string(codec) != "null" {
+		return fmt.Errorf("the codec %q isn't supported", string(codec))
+	}
+
+	rawSchema, ok := values["avro.schema"].([]byte)
+	if !ok {
+		return fmt.Errorf("the header doesn't contain a schema")
+	}
+	if r.schema, err = ParseSchema(rawSchema); err != nil {
+		return fmt.Errorf("parsing schema: %+v", err)
+	}
+
+	r.sync = make([]byte, 16)
+	if _, err := io.ReadFull(r.reader, r.sync); err != nil {
+		return fmt.Errorf("reading sync marker: %+v", unexpectedEOF(err))
+	}
+	return nil
+}
+
+func (r *Reader) readBlock() error {
+	if r.block != nil && r.block.Len() > 0 {
+		return fmt.Errorf("%d bytes remained within the block once all objects were read", r.block.Len())
+	}
+
+	count, err := binary.ReadVarint(r.reader)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.EOF
+		}
+		return fmt.Errorf("reading the number of objects within the block: %+v", unexpectedEOF(err))
+	}
+	if count < 0 {
+		return fmt.Errorf("the block contains a negative number of objects (%d)", count)
+	}
+
+	size, err := binary.ReadVarint(r.reader)
+	if err != nil {
+		return fmt.Errorf("reading the size of the block: %+v", unexpectedEOF(err))
+	}
+	if size < 0 || size > maxBlockSize {
+		return fmt.Errorf("the block size %d must be between 0 and %d bytes", size, maxBlockSize)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.reader, data); err != nil {
+		return fmt.Errorf("reading block: %+v", unexpectedEOF(err))
+	}
+
+	sync := make([]byte, len(r.sync))
+	if _, err := io.ReadFull(r.reader, sync); err != nil {
+		return fmt.Errorf("reading sync marker: %+v", unexpectedEOF(err))
+	}
+	if !bytes.Equal(sync, r.sync) {
+		return fmt.Errorf("the sync marker following the block doesn't match the sync marker in the header")
+	}
+
+	r.block = bytes.NewReader(data)
+	r.remaining = count
+	return nil
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// decode decodes a single value of the specified Schema from `r`
+func decode(r byteReader, schema *Schema) (interface{}, error) {
+	switch schema.Type {
+	case "null":
+		return nil, nil
+
+	case "boolean":
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return b != 0, nil
+
+	case "int", "long":
+		// Avro uses the same zig-zag encoding as `binary.ReadVarint`
+		v, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return v, nil
+
+	case "float":
+		b := make([]byte, 4)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), nil
+
+	case "double":
+		b := make([]byte, 8)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+
+	case "bytes":
+		return readBytes(r)
+
+	case "string":
+		b, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+
+	case "fixed":
+		b := make([]byte, schema.Size)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return b, nil
+
+	case "enum":
+		i, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if i < 0 || i >= int64(len(schema.Symbols)) {
+			return nil, fmt.Errorf("the enum index %d is out of range for %q", i, schema.Name)
+		}
+		return schema.Symbols[i], nil
+
+	case "union":
+		i, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if i < 0 || i >= int64(len(schema.Types)) {
+			return nil, fmt.Errorf("the union index %d is out of range", i)
+		}
+		return decode(r, schema.Types[i])
+
+	case "record":
+		record := Record{
+			Name:   schema.Name,
+			Fields: make(map[string]interface{}, len(schema.Fields)),
+		}
+		for _, field := range schema.Fields {
+			v, err := decode(r, field.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("decoding the field %q of %q: %+v", field.Name, schema.Name, err)
+			}
+			record.Fields[field.Name] = v
+		}
+		return record, nil
+
+	case "array":
+		items := make([]interface{}, 0)
+		err := readBlocks(r, func() error {
+			v, err := decode(r, schema.Items)
+			if err != nil {
+				return err
+			}
+			items = append(items, v)
+			return nil
+		})
+		return items, err
+
+	case "map":
+		values := make(map[string]interface{})
+		err := readBlocks(r, func() error {
+			key, err := readBytes(r)
+			if err != nil {
+				return err
+			}
+			v, err := decode(r, schema.Values)
+			if err != nil {
+				return err
+			}
+			values[string(key)] = v
+			return nil
+		})
+		return values, err
+	}
+
+	return nil, fmt.Errorf("the type %q isn't supported", schema.Type)
+}
+
+// readBlocks reads the blocks which make up an `array` or `map`, calling `fn` to read each item
+func readBlocks(r byteReader, fn func() error) error {
+	for {
+		count, err := binary.ReadVarint(r)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			// a negative count is followed by the size of the block in bytes, which isn't needed here
+			count = -count
+			if _, err := binary.ReadVarint(r); err != nil {
+				return unexpectedEOF(err)
+			}
+		}
+		for i := int64(0); i < count; i++ {
+			if err := fn(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func readBytes(r byteReader) ([]byte, error) {
+	length, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if length < 0 || length > maxBlockSize {
+		return nil, fmt.Errorf("the length %d must be between 0 and %d bytes", length, maxBlockSize)
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return b, nil
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF, for use when the data ends part-way through an object
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
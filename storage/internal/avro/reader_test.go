@@ -0,0 +1,228 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+const testSchema = `{
+  "type": "record",
+  "name": "person",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "name", "type": "string"},
+    {"name": "age", "type": "long"},
+    {"name": "active", "type": "boolean"},
+    {"name": "nickname", "type": ["null", "string"]},
+    {"name": "tags", "type": {"type": "array", "items": "string"}},
+    {"name": "colour", "type": {"type": "enum", "name": "colour", "symbols": ["red", "green"]}},
+    {"name": "manager", "type": ["null", "person"]}
+  ]
+}`
+
+var testSync = []byte("0123456789abcdef")
+
+func appendLong(b []byte, v int64) []byte {
+	return binary.AppendVarint(b, v)
+}
+
+func appendBytes(b []byte, v []byte) []byte {
+	b = appendLong(b, int64(len(v)))
+	return append(b, v...)
+}
+
+func appendPerson(b []byte, name string, age int64, manager *string) []byte {
+	b = appendBytes(b, []byte(name))
+	b = appendLong(b, age)
+	b = append(b, 1)
+	b = appendLong(b, 1)
+	b = appendBytes(b, []byte("nick"))
+	b = appendLong(b, 2)
+	b = appendBytes(b, []byte("a"))
+	b = appendBytes(b, []byte("b"))
+	b = appendLong(b, 0)
+	b = appendLong(b, 1)
+	if manager == nil {
+		return appendLong(b, 0)
+	}
+	b = appendLong(b, 1)
+	return appendPerson(b, *manager, 50, nil)
+}
+
+func buildFile(schema string, codec string, blocks ...[][]byte) []byte {
+	b := append([]byte{}, magic...)
+	b = appendLong(b, 2)
+	b = appendBytes(b, []byte("avro.schema"))
+	b = appendBytes(b, []byte(schema))
+	b = appendBytes(b, []byte("avro.codec"))
+	b = appendBytes(b, []byte(codec))
+	b = appendLong(b, 0)
+	b = append(b, testSync...)
+
+	for _, objects := range blocks {
+		data := make([]byte, 0)
+		for _, object := range objects {
+			data = append(data, object...)
+		}
+		b = appendLong(b, int64(len(objects)))
+		b = appendBytes(b, data)
+		b = append(b, testSync...)
+	}
+	return b
+}
+
+func TestReader(t *testing.T) {
+	manager := "bob"
+	input := buildFile(testSchema, "null",
+		[][]byte{
+			appendPerson(nil, "alice", 30, nil),
+		},
+		[][]byte{
+			appendPerson(nil, "carol", 40, &manager),
+			appendPerson(nil, "dave", 20, nil),
+		},
+	)
+
+	reader := NewReader(bytes.NewReader(input))
+	names := make([]string, 0)
+	for {
+		v, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("reading object: %+v", err)
+		}
+
+		record, ok := v.(Record)
+		if !ok {
+			t.Fatalf("expected a Record but got %T", v)
+		}
+		if record.Name != "com.example.person" || record.ShortName() != "person" {
+			t.Fatalf("expected the record to be named %q but got %q", "com.example.person", record.Name)
+		}
+		if record.Fields["nickname"] != "nick" || record.Fields["active"] != true || record.Fields["colour"] != "green" {
+			t.Fatalf("unexpected fields %+v", record.Fields)
+		}
+		if !reflect.DeepEqual(record.Fields["tags"], []interface{}{"a", "b"}) {
+			t.Fatalf("expected the tags to be [a b] but got %+v", record.Fields["tags"])
+		}
+		names = append(names, record.Fields["name"].(string))
+
+		if record.Fields["name"] == "carol" {
+			m, ok := record.Fields["manager"].(Record)
+			if !ok || m.Fields["name"] != "bob" || m.Fields["age"] != int64(50) {
+				t.Fatalf("expected the manager to be bob but got %+v", record.Fields["manager"])
+			}
+		} else if record.Fields["manager"] != nil {
+			t.Fatalf("expected no manager but got %+v", record.Fields["manager"])
+		}
+	}
+
+	if !reflect.DeepEqual(names, []string{"alice", "carol", "dave"}) {
+		t.Fatalf("expected [alice carol dave] but got %v", names)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	valid := buildFile(testSchema, "null", [][]byte{
+		appendPerson(nil, "alice", 30, nil),
+	})
+
+	corruptSync := append([]byte{}, valid...)
+	corruptSync[len(corruptSync)-1] = 'X'
+
+	testData := []struct {
+		Name  string
+		Input []byte
+	}{
+		{
+			Name:  "Empty",
+			Input: []byte{},
+		},
+		{
+			Name:  "Invalid Magic",
+			Input: []byte("Obj\x02"),
+		},
+		{
+			Name:  "Unsupported Codec",
+			Input: buildFile(testSchema, "deflate"),
+		},
+		{
+			Name:  "Truncated",
+			Input: valid[:len(valid)-20],
+		},
+		{
+			Name:  "Corrupt Sync Marker",
+			Input: corruptSync,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		reader := NewReader(bytes.NewReader(v.Input))
+		var err error
+		for err == nil {
+			_, err = reader.Next()
+		}
+		if errors.Is(err, io.EOF) {
+			t.Fatalf("expected an error but got io.EOF")
+		}
+	}
+}
+
+func TestParseSchema(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input string
+		Valid bool
+	}{
+		{
+			Name:  "Primitive",
+			Input: `"long"`,
+			Valid: true,
+		},
+		{
+			Name:  "Union",
+			Input: `["null", {"type": "map", "values": "bytes"}]`,
+			Valid: true,
+		},
+		{
+			Name:  "Recursive Record",
+			Input: testSchema,
+			Valid: true,
+		},
+		{
+			Name:  "Unknown Type",
+			Input: `{"type": "record", "name": "a", "fields": [{"name": "b", "type": "c"}]}`,
+			Valid: false,
+		},
+		{
+			Name:  "Record without a Name",
+			Input: `{"type": "record", "fields": []}`,
+			Valid: false,
+		},
+		{
+			Name:  "Invalid JSON",
+			Input: `{`,
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, err := ParseSchema([]byte(v.Input))
+		if v.Valid && err != nil {
+			t.Fatalf("expected the schema to be valid but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected the schema to be invalid but it was valid")
+		}
+	}
+}
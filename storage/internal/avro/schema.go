@@ -0,0 +1,196 @@
+package avro
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Schema is a parsed Avro Schema, see https://avro.apache.org/docs/1.11.1/specification/
+type Schema struct {
+	// Type is the type of the Schema, either a primitive type (for example `long`) or a complex type
+	// (`record`, `enum`, `array`, `map`, `union` or `fixed`)
+	Type string
+
+	// Name is the full name of a named type (a `record`, `enum` or `fixed`), including the namespace
+	Name string
+
+	// Fields are the fields within a `record`
+	Fields []Field
+
+	// Symbols are the symbols within an `enum`
+	Symbols []string
+
+	// Items is the Schema of the items within an `array`
+	Items *Schema
+
+	// Values is the Schema of the values within a `map`
+	Values *Schema
+
+	// Types are the Schemas which make up a `union`
+	Types []*Schema
+
+	// Size is the number of bytes within a `fixed`
+	Size int
+}
+
+// Field is a single field within a `record`
+type Field struct {
+	Name   string
+	Schema *Schema
+}
+
+var primitiveTypes = map[string]struct{}{
+	"null":    {},
+	"boolean": {},
+	"int":     {},
+	"long":    {},
+	"float":   {},
+	"double":  {},
+	"bytes":   {},
+	"string":  {},
+}
+
+// ParseSchema parses the JSON representation of an Avro Schema
+func ParseSchema(input []byte) (*Schema, error) {
+	var raw interface{}
+	if err := json.Unmarshal(input, &raw); err != nil {
+		return nil, fmt.Errorf("unmarshalling schema: %+v", err)
+	}
+
+	p := schemaParser{
+		named: make(map[string]*Schema),
+	}
+	return p.parse(raw, "")
+}
+
+type schemaParser struct {
+	// named contains the named types which have been defined so far, keyed by both their full and short names
+	named map[string]*Schema
+}
+
+func (p schemaParser) parse(raw interface{}, namespace string) (*Schema, error) {
+	switch v := raw.(type) {
+	case string:
+		if _, ok := primitiveTypes[v]; ok {
+			return &Schema{Type: v}, nil
+		}
+		if named, ok := p.named[qualifiedName(v, namespace)]; ok {
+			return named, nil
+		}
+		if named, ok := p.named[v]; ok {
+			return named, nil
+		}
+		return nil, fmt.Errorf("unknown type %q", v)
+
+	case []interface{}:
+		schema := &Schema{Type: "union"}
+		for _, item := range v {
+			t, err := p.parse(item, namespace)
+			if err != nil {
+				return nil, err
+			}
+			schema.Types = append(schema.Types, t)
+		}
+		return schema, nil
+
+	case map[string]interface{}:
+		return p.parseComplex(v, namespace)
+	}
+
+	return nil, fmt.Errorf("unexpected schema of type %T", raw)
+}
+
+func (p schemaParser) parseComplex(raw map[string]interface{}, namespace string) (*Schema, error) {
+	typeName, ok := raw["type"].(string)
+	if !ok {
+		// e.g. `{"type": ["null", "string"]}`
+		return p.parse(raw["type"], namespace)
+	}
+
+	switch typeName {
+	case "record", "error", "enum", "fixed":
+		name, _ := raw["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("a %q must have a name", typeName)
+		}
+		if ns, ok := raw["namespace"].(string); ok && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+		schema := &Schema{
+			Type: typeName,
+			Name: qualifiedName(name, namespace),
+		}
+		if i := strings.LastIndex(schema.Name, "."); i >= 0 {
+			namespace = schema.Name[:i]
+		}
+
+		// the type is registered before the fields are parsed, since a record can reference itself
+		p.named[schema.Name] = schema
+		p.named[shortName(schema.Name)] = schema
+
+		switch typeName {
+		case "record", "error":
+			schema.Type = "record"
+			fields, _ := raw["fields"].([]interface{})
+			for _, item := range fields {
+				field, ok := item.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("the fields of %q must be objects", schema.Name)
+				}
+				fieldName, _ := field["name"].(string)
+				fieldSchema, err := p.parse(field["type"], namespace)
+				if err != nil {
+					return nil, fmt.Errorf("parsing the field %q of %q: %+v", fieldName, schema.Name, err)
+				}
+				schema.Fields = append(schema.Fields, Field{
+					Name:   fieldName,
+					Schema: fieldSchema,
+				})
+			}
+
+		case "enum":
+			symbols, _ := raw["symbols"].([]interface{})
+			for _, item := range symbols {
+				symbol, _ := item.(string)
+				schema.Symbols = append(schema.Symbols, symbol)
+			}
+
+		case "fixed":
+			size, _ := raw["size"].(float64)
+			schema.Size = int(size)
+		}
+		return schema, nil
+
+	case "array":
+		items, err := p.parse(raw["items"], namespace)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the items of an array: %+v", err)
+		}
+		return &Schema{Type: typeName, Items: items}, nil
+
+	case "map":
+		values, err := p.parse(raw["values"], namespace)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the values of a map: %+v", err)
+		}
+		return &Schema{Type: typeName, Values: values}, nil
+	}
+
+	// e.g. `{"type": "long"}`
+	return p.parse(typeName, namespace)
+}
+
+func qualifiedName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func shortName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,49 @@
+package checksum
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"hash"
+	"hash/crc64"
+)
+
+// crc64Polynomial is the polynomial used by Azure Storage when computing the `x-ms-content-crc64` header
+const crc64Polynomial uint64 = 0x9A6C9329AC4BC9B5
+
+var crc64Table = crc64.MakeTable(crc64Polynomial)
+
+// MD5 returns the base64-encoded MD5 hash of the specified data, as used in the `Content-MD5` header
+func MD5(data []byte) string {
+	h := md5.Sum(data)
+	return MD5Encode(h[:])
+}
+
+// MD5Encode returns the base64-encoded representation of the specified MD5 hash
+func MD5Encode(sum []byte) string {
+	return base64.StdEncoding.EncodeToString(sum)
+}
+
+// CRC64 returns the base64-encoded CRC64 checksum of the specified data, as used in the `x-ms-content-crc64` header
+func CRC64(data []byte) string {
+	return EncodeCRC64(crc64.Checksum(data, crc64Table))
+}
+
+// EncodeCRC64 returns the base64-encoded representation of the specified CRC64 checksum - which Azure Storage
+// expects to be in little-endian byte order
+func EncodeCRC64(sum uint64) string {
+	out := make([]byte, 8)
+	binary.LittleEndian.PutUint64(out, sum)
+	return base64.StdEncoding.EncodeToString(out)
+}
+
+// NewMD5 returns a hash.Hash which can be used to compute the MD5 hash of streamed data
+func NewMD5() hash.Hash {
+	return md5.New()
+}
+
+// NewCRC64 returns a hash.Hash64 which can be used to compute the CRC64 checksum of streamed data
+// using the polynomial used by Azure Storage
+func NewCRC64() hash.Hash64 {
+	return crc64.New(crc64Table)
+}
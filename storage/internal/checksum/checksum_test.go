@@ -0,0 +1,33 @@
+package checksum
+
+import (
+	"testing"
+)
+
+func TestMD5(t *testing.T) {
+	// the MD5 of `hello world`
+	expected := "XrY7u+Ae7tCTyyK7j1rNww=="
+	if actual := MD5([]byte("hello world")); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+func TestCRC64MatchesStreamedChecksum(t *testing.T) {
+	data := []byte("hello world")
+
+	h := NewCRC64()
+	h.Write(data[:5])
+	h.Write(data[5:])
+
+	expected := CRC64(data)
+	if actual := EncodeCRC64(h.Sum64()); actual != expected {
+		t.Fatalf("expected the streamed checksum to be %q but got %q", expected, actual)
+	}
+}
+
+func TestCRC64Empty(t *testing.T) {
+	expected := "AAAAAAAAAAA="
+	if actual := CRC64([]byte{}); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -0,0 +1,22 @@
+package lastmodified
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Parse parses the `Last-Modified` header (an RFC1123 date) of a response, returning the zero value when the
+// header isn't present
+func Parse(header http.Header) (time.Time, error) {
+	v := header.Get("Last-Modified")
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	lastModified, err := time.Parse(time.RFC1123, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing `Last-Modified` header value %q: %+v", v, err)
+	}
+	return lastModified, nil
+}
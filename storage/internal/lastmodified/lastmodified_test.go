@@ -0,0 +1,57 @@
+package lastmodified
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         string
+		Expected      time.Time
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Missing",
+			Input:         "",
+			Expected:      time.Time{},
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "RFC1123",
+			Input:         "Tue, 02 Jan 2024 03:04:05 GMT",
+			Expected:      time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "RFC3339",
+			Input:         "2024-01-02T03:04:05Z",
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		header := http.Header{}
+		if v.Input != "" {
+			header.Set("Last-Modified", v.Input)
+		}
+
+		actual, err := Parse(header)
+		if !v.ShouldBeValid {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parsing: %+v", err)
+		}
+		if !actual.Equal(v.Expected) {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
@@ -2,24 +2,41 @@ package metadata
 
 import (
 	"net/http"
+	"sort"
 	"strings"
 )
 
-// ParseFromHeaders parses the metadata from the headers
+const headerPrefix = "x-ms-meta-"
+
+// ParseFromHeaders parses the metadata from the headers, stripping the `x-ms-meta-` prefix from each key.
+// Since header names are case-insensitive (and are canonicalized when the response is parsed) the keys
+// are returned lower-cased - `metadata.WithCasingOf` can be used to restore their casing. Where a header
+// has multiple values, these are joined with a comma.
+//
+// When the same key appears more than once with different casing (which can only happen when the headers haven't
+// been canonicalized) the values are joined with a comma, in the order of the (case-sensitive) header names.
 func ParseFromHeaders(headers http.Header) map[string]string {
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	// sort the header names so that duplicate keys are merged in a consistent order
+	sort.Strings(names)
+
 	metaData := make(map[string]string, 0)
-	prefix := "x-ms-meta-"
-	for k, v := range headers {
+	for _, k := range names {
+		v := headers[k]
 		key := strings.ToLower(k)
-		if !strings.HasPrefix(key, prefix) {
+		if !strings.HasPrefix(key, headerPrefix) || len(v) == 0 {
 			continue
 		}
 
-		// TODO: update this to support case-insensitive headers when the base layer is changed to `hashicorp/go-azure-sdk`
-		// (e.g. trim off the first 10 characters, but this can't be done until the base layer is updated, since
-		// `Azure/go-autorest` canonicalizes the header keys)
-		key = strings.TrimPrefix(key, prefix)
-		metaData[key] = v[0]
+		key = strings.TrimPrefix(key, headerPrefix)
+		value := strings.Join(v, ",")
+		if existing, ok := metaData[key]; ok {
+			value = strings.Join([]string{existing, value}, ",")
+		}
+		metaData[key] = value
 	}
 	return metaData
 }
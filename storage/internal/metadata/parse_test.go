@@ -0,0 +1,108 @@
+package metadata
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestParseFromHeaders(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    http.Header
+		Expected map[string]string
+	}{
+		{
+			Name:     "No Headers",
+			Input:    http.Header{},
+			Expected: map[string]string{},
+		},
+		{
+			Name: "No MetaData",
+			Input: http.Header{
+				"Content-Type": []string{"application/xml"},
+				"X-Ms-Version": []string{"2023-11-03"},
+			},
+			Expected: map[string]string{},
+		},
+		{
+			Name: "Canonicalized Headers",
+			Input: http.Header{
+				"Content-Type":      []string{"application/xml"},
+				"X-Ms-Meta-Hello":   []string{"world"},
+				"X-Ms-Meta-Abc_123": []string{"value"},
+			},
+			Expected: map[string]string{
+				"hello":   "world",
+				"abc_123": "value",
+			},
+		},
+		{
+			Name: "Non-Canonicalized Headers",
+			Input: http.Header{
+				"x-ms-meta-Hello": []string{"world"},
+			},
+			Expected: map[string]string{
+				"hello": "world",
+			},
+		},
+		{
+			Name: "Multiple Values",
+			Input: http.Header{
+				"X-Ms-Meta-Hello": []string{"there", "world"},
+			},
+			Expected: map[string]string{
+				"hello": "there,world",
+			},
+		},
+		{
+			Name: "Same Key with Different Casing",
+			Input: http.Header{
+				"x-ms-meta-hello": []string{"there"},
+				"X-Ms-Meta-Hello": []string{"world"},
+				"x-ms-meta-HELLO": []string{"again"},
+			},
+			// merged in the order of the (case-sensitive) header names
+			Expected: map[string]string{
+				"hello": "world,again,there",
+			},
+		},
+		{
+			Name: "Empty Value",
+			Input: http.Header{
+				"X-Ms-Meta-Hello": []string{""},
+			},
+			Expected: map[string]string{
+				"hello": "",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := ParseFromHeaders(v.Input)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestParseFromHeadersIsDeterministic(t *testing.T) {
+	input := http.Header{
+		"x-ms-meta-abc": []string{"1"},
+		"X-Ms-Meta-Abc": []string{"2"},
+		"x-ms-meta-ABC": []string{"3"},
+		"x-ms-meta-aBc": []string{"4"},
+	}
+
+	// the header names are sorted before the values are joined, rather than using the order of the map
+	expected := map[string]string{
+		"abc": "2,3,4,1",
+	}
+	for i := 0; i < 50; i++ {
+		if actual := ParseFromHeaders(input); !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	}
+}
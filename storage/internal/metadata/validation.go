@@ -3,7 +3,10 @@ package metadata
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+
+	"github.com/hashicorp/go-multierror"
 )
 
 var cSharpKeywords = map[string]*struct{}{
@@ -85,21 +88,51 @@ var cSharpKeywords = map[string]*struct{}{
 	"while":      {},
 }
 
-func Validate(input map[string]string) error {
+// maxSizeInBytes is the maximum combined size of the keys and values within the MetaData
+const maxSizeInBytes = 8 * 1024
+
+var keyRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 
+// Validate validates that each key within the MetaData is a valid C# identifier, that no two keys
+// differ only by case and that the combined size of the MetaData is within the limit. All of the
+// invalid keys are returned within a multierror, rather than only the first.
+func Validate(input map[string]string) error {
+	keys := make([]string, 0, len(input))
 	for k := range input {
-		isCSharpKeyword := cSharpKeywords[strings.ToLower(k)] != nil
-		if isCSharpKeyword {
-			return fmt.Errorf("%q is not a valid key (C# keyword)", k)
+		keys = append(keys, k)
+	}
+	// sort the keys so that the errors are returned in a consistent order
+	sort.Strings(keys)
+
+	var result *multierror.Error
+	seen := make(map[string]string, len(keys))
+	size := 0
+	for _, k := range keys {
+		size += len(k) + len(input[k])
+
+		if cSharpKeywords[strings.ToLower(k)] != nil {
+			result = multierror.Append(result, fmt.Errorf("%q is not a valid key (C# keyword)", k))
+			continue
 		}
 
-		// must begin with a letter, underscore
+		// must begin with a letter or an underscore
 		// the rest: letters, digits and underscores
-		r, _ := regexp.Compile(`^([A-Za-z_]{1}[A-Za-z0-9_]{1,})$`)
-		if !r.MatchString(k) {
-			return fmt.Errorf("MetaData must start with letters or an underscores. Got %q.", k)
+		if !keyRegex.MatchString(k) {
+			result = multierror.Append(result, fmt.Errorf("%q is not a valid key, MetaData keys must start with a letter or an underscore and contain only letters, digits and underscores", k))
+			continue
+		}
+
+		// keys are case-insensitive, so two keys differing only by case would collide
+		if existing, ok := seen[strings.ToLower(k)]; ok {
+			result = multierror.Append(result, fmt.Errorf("%q is not a valid key, since it collides with the key %q (MetaData keys are case-insensitive)", k, existing))
+			continue
 		}
+		seen[strings.ToLower(k)] = k
+	}
+
+	if size > maxSizeInBytes {
+		result = multierror.Append(result, fmt.Errorf("the combined size of the MetaData keys and values must be at most %d bytes but got %d", maxSizeInBytes, size))
 	}
 
-	return nil
+	return result.ErrorOrNil()
 }
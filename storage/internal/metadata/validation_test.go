@@ -1,6 +1,12 @@
 package metadata
 
-import "testing"
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+)
 
 func TestValidationCSharpKeywords(t *testing.T) {
 	for key := range cSharpKeywords {
@@ -52,6 +58,30 @@ func TestValidation(t *testing.T) {
 			Input:         "ABC123",
 			ShouldBeValid: true,
 		},
+		{
+			Input:         "a",
+			ShouldBeValid: true,
+		},
+		{
+			Input:         "_",
+			ShouldBeValid: true,
+		},
+		{
+			Input:         "abc-123",
+			ShouldBeValid: false,
+		},
+		{
+			Input:         "abc.123",
+			ShouldBeValid: false,
+		},
+		{
+			Input:         "abc 123",
+			ShouldBeValid: false,
+		},
+		{
+			Input:         "äbc",
+			ShouldBeValid: false,
+		},
 	}
 
 	for _, v := range testData {
@@ -66,3 +96,49 @@ func TestValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestValidationCaseInsensitiveCollisions(t *testing.T) {
+	err := Validate(map[string]string{
+		"Hello": "world",
+		"hello": "world",
+	})
+	if err == nil {
+		t.Fatalf("Expected an error for keys differing only by case but didn't get one")
+	}
+}
+
+func TestValidationSize(t *testing.T) {
+	err := Validate(map[string]string{
+		"hello": strings.Repeat("a", 8*1024-5),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error for metadata of exactly 8KiB but got: %+v", err)
+	}
+
+	err = Validate(map[string]string{
+		"hello": strings.Repeat("a", 8*1024-4),
+	})
+	if err == nil {
+		t.Fatalf("Expected an error for metadata larger than 8KiB but didn't get one")
+	}
+}
+
+func TestValidationReturnsAllErrors(t *testing.T) {
+	err := Validate(map[string]string{
+		"1abc":    "value",
+		"abc-def": "value",
+		"class":   "value",
+		"valid":   "value",
+	})
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	var multiErr *multierror.Error
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("Expected a multierror but got %T", err)
+	}
+	if len(multiErr.Errors) != 3 {
+		t.Fatalf("Expected 3 errors but got %d: %+v", len(multiErr.Errors), err)
+	}
+}
@@ -0,0 +1,33 @@
+package metadata
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// XMLMap is the MetaData for a resource as returned within the body of a List operation, where
+// each key is represented as an element within a `Metadata` element
+type XMLMap map[string]string
+
+func (m *XMLMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	out := XMLMap{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch v := token.(type) {
+		case xml.StartElement:
+			var value string
+			if err := d.DecodeElement(&value, &v); err != nil {
+				return fmt.Errorf("decoding the metadata value for %q: %+v", v.Name.Local, err)
+			}
+			out[v.Name.Local] = value
+
+		case xml.EndElement:
+			*m = out
+			return nil
+		}
+	}
+}
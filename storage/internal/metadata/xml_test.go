@@ -0,0 +1,35 @@
+package metadata
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestXMLMapUnmarshal(t *testing.T) {
+	input := `<Item><Name>example</Name><Metadata><hello>world</hello><Project>giovanni</Project><empty /></Metadata></Item>`
+
+	var actual struct {
+		Name     string `xml:"Name"`
+		MetaData XMLMap `xml:"Metadata"`
+	}
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	expected := map[string]string{
+		"hello":   "world",
+		"Project": "giovanni",
+		"empty":   "",
+	}
+	if len(actual.MetaData) != len(expected) {
+		t.Fatalf("expected %d items but got %d", len(expected), len(actual.MetaData))
+	}
+	for k, v := range expected {
+		if actual.MetaData[k] != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual.MetaData[k])
+		}
+	}
+	if actual.Name != "example" {
+		t.Fatalf("expected the Name to be %q but got %q", "example", actual.Name)
+	}
+}
@@ -0,0 +1,95 @@
+package nfsproperties
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// timeFormat is the ISO 8601 format used for the `x-ms-file-creation-time` and `x-ms-file-last-write-time` headers
+const timeFormat = "2006-01-02T15:04:05.0000000Z"
+
+var (
+	// idRegex matches a numeric POSIX user or group identifier
+	idRegex = regexp.MustCompile(`^[0-9]+$`)
+
+	// octalModeRegex matches a 4-digit octal mode, e.g. `0755`
+	octalModeRegex = regexp.MustCompile(`^[0-7]{4}$`)
+
+	// symbolicModeRegex matches a symbolic mode, e.g. `rwxr-x---`, including the setuid, setgid and sticky bits
+	symbolicModeRegex = regexp.MustCompile(`^[r-][w-][xsS-][r-][w-][xsS-][r-][w-][xtT-]$`)
+)
+
+// Properties are the POSIX properties which can be set on a File or Directory within an NFS Share
+type Properties struct {
+	// An optional numeric user identifier (UID) for the owner
+	Owner *string
+
+	// An optional numeric group identifier (GID) for the owning group
+	Group *string
+
+	// An optional mode, either in 4-digit octal (e.g. `0644`) or symbolic (e.g. `rw-r--r--`) notation
+	FileMode *string
+
+	CreationTime  *time.Time
+	LastWriteTime *time.Time
+}
+
+// Validate validates that the Owner and Group are numeric identifiers and that the FileMode is
+// in either octal or symbolic notation
+func (p Properties) Validate() error {
+	if p.Owner != nil && !idRegex.MatchString(*p.Owner) {
+		return fmt.Errorf("`Owner` must be a numeric user identifier but got %q", *p.Owner)
+	}
+	if p.Group != nil && !idRegex.MatchString(*p.Group) {
+		return fmt.Errorf("`Group` must be a numeric group identifier but got %q", *p.Group)
+	}
+	if p.FileMode != nil && !octalModeRegex.MatchString(*p.FileMode) && !symbolicModeRegex.MatchString(*p.FileMode) {
+		return fmt.Errorf("`FileMode` must be in 4-digit octal (e.g. `0644`) or symbolic (e.g. `rw-r--r--`) notation but got %q", *p.FileMode)
+	}
+	return nil
+}
+
+// Headers returns the headers for these Properties. Unlike the SMB properties no defaults are sent
+// for omitted values, which are instead defaulted (or preserved) by the service.
+func (p Properties) Headers() client.Headers {
+	headers := client.Headers{}
+
+	if p.Owner != nil {
+		headers.Append("x-ms-owner", *p.Owner)
+	}
+	if p.Group != nil {
+		headers.Append("x-ms-group", *p.Group)
+	}
+	if p.FileMode != nil {
+		headers.Append("x-ms-mode", *p.FileMode)
+	}
+	if p.CreationTime != nil {
+		headers.Append("x-ms-file-creation-time", p.CreationTime.UTC().Format(timeFormat))
+	}
+	if p.LastWriteTime != nil {
+		headers.Append("x-ms-file-last-write-time", p.LastWriteTime.UTC().Format(timeFormat))
+	}
+
+	return headers
+}
+
+// Info are the POSIX properties returned by the service when retrieving the properties of a File or
+// Directory within an NFS Share
+type Info struct {
+	Owner    string
+	Group    string
+	FileMode string
+}
+
+// ParseFromHeaders parses the POSIX properties from the headers of a response
+func ParseFromHeaders(headers http.Header) Info {
+	return Info{
+		Owner:    headers.Get("x-ms-owner"),
+		Group:    headers.Get("x-ms-group"),
+		FileMode: headers.Get("x-ms-mode"),
+	}
+}
@@ -0,0 +1,154 @@
+package nfsproperties
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	uid := "1000"
+	name := "root"
+	octal := "0755"
+	symbolic := "rwxr-s--T"
+	threeDigits := "755"
+	invalidOctal := "0789"
+	invalidSymbolic := "rwxrwxrwz"
+
+	testData := []struct {
+		Name          string
+		Input         Properties
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Empty",
+			Input:         Properties{},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Owner and Group",
+			Input: Properties{
+				Owner: &uid,
+				Group: &uid,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Non-Numeric Owner",
+			Input: Properties{
+				Owner: &name,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Non-Numeric Group",
+			Input: Properties{
+				Group: &name,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Octal Mode",
+			Input: Properties{
+				FileMode: &octal,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Symbolic Mode",
+			Input: Properties{
+				FileMode: &symbolic,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Three Digit Mode",
+			Input: Properties{
+				FileMode: &threeDigits,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Invalid Octal Mode",
+			Input: Properties{
+				FileMode: &invalidOctal,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Invalid Symbolic Mode",
+			Input: Properties{
+				FileMode: &invalidSymbolic,
+			},
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := v.Input.Validate()
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected the input to be valid but got: %+v", err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected the input to be invalid but it was valid")
+		}
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	h := Properties{}.Headers()
+	headers := h.Headers()
+	for _, k := range []string{"x-ms-owner", "x-ms-group", "x-ms-mode", "x-ms-file-creation-time", "x-ms-file-last-write-time"} {
+		if v := headers.Get(k); v != "" {
+			t.Fatalf("expected %q to be omitted but got %q", k, v)
+		}
+	}
+
+	uid := "1000"
+	gid := "2000"
+	mode := "0644"
+	createdAt := time.Date(2024, 1, 2, 4, 4, 5, 123456700, time.FixedZone("UTC+1", 3600))
+	h = Properties{
+		Owner:        &uid,
+		Group:        &gid,
+		FileMode:     &mode,
+		CreationTime: &createdAt,
+	}.Headers()
+	headers = h.Headers()
+	expected := map[string]string{
+		"x-ms-owner":                uid,
+		"x-ms-group":                gid,
+		"x-ms-mode":                 mode,
+		"x-ms-file-creation-time":   "2024-01-02T03:04:05.1234567Z",
+		"x-ms-file-last-write-time": "",
+	}
+	for k, v := range expected {
+		if actual := headers.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+	for _, k := range []string{"x-ms-file-permission", "x-ms-file-attributes"} {
+		if v := headers.Get(k); v != "" {
+			t.Fatalf("expected the SMB-only header %q to be omitted but got %q", k, v)
+		}
+	}
+}
+
+func TestParseFromHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("x-ms-owner", "1000")
+	headers.Set("x-ms-group", "2000")
+	headers.Set("x-ms-mode", "0755")
+
+	actual := ParseFromHeaders(headers)
+	expected := Info{
+		Owner:    "1000",
+		Group:    "2000",
+		FileMode: "0755",
+	}
+	if actual != expected {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
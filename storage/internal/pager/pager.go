@@ -0,0 +1,54 @@
+// Package pager contains the logic shared by the iterators which retrieve successive pages of results from
+// the List APIs, following the `NextMarker` returned by the service until all of the results have been retrieved.
+package pager
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListPageFunc retrieves the page of results starting at `marker` (or the first page when `marker` is nil),
+// returning the page alongside the marker for the next page - which is nil or empty when this is the last page
+type ListPageFunc[T any] func(ctx context.Context, marker *string) (page T, nextMarker *string, err error)
+
+// MarkerIterator retrieves successive pages of results using a ListPageFunc
+type MarkerIterator[T any] struct {
+	listPage ListPageFunc[T]
+	marker   *string
+	done     bool
+}
+
+// NewMarkerIterator returns a MarkerIterator which starts at `marker` (which can be nil to start at the first page)
+func NewMarkerIterator[T any](marker *string, listPage ListPageFunc[T]) *MarkerIterator[T] {
+	return &MarkerIterator[T]{
+		listPage: listPage,
+		marker:   marker,
+	}
+}
+
+// NotDone returns whether there are further pages of results to be retrieved
+func (i *MarkerIterator[T]) NotDone() bool {
+	return !i.done
+}
+
+// Next retrieves the next page of results. When an error is returned the same page is retrieved on the next call.
+func (i *MarkerIterator[T]) Next(ctx context.Context) (result T, err error) {
+	if i.done {
+		err = fmt.Errorf("no more results are available")
+		return
+	}
+
+	result, nextMarker, err := i.listPage(ctx, i.marker)
+	if err != nil {
+		return
+	}
+
+	if nextMarker == nil || *nextMarker == "" {
+		i.done = true
+	} else {
+		marker := *nextMarker
+		i.marker = &marker
+	}
+
+	return
+}
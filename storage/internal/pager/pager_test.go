@@ -0,0 +1,83 @@
+package pager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestMarkerIterator(t *testing.T) {
+	pages := map[string]string{
+		"":       "first",
+		"first":  "second",
+		"second": "",
+	}
+
+	var requestedMarkers []string
+	iterator := NewMarkerIterator(nil, func(ctx context.Context, marker *string) (string, *string, error) {
+		current := pointer.From(marker)
+		requestedMarkers = append(requestedMarkers, current)
+		return fmt.Sprintf("page-%s", current), pointer.To(pages[current]), nil
+	})
+
+	var results []string
+	for iterator.NotDone() {
+		page, err := iterator.Next(context.TODO())
+		if err != nil {
+			t.Fatalf("retrieving page: %+v", err)
+		}
+		results = append(results, page)
+	}
+
+	if len(results) != 3 || results[0] != "page-" || results[1] != "page-first" || results[2] != "page-second" {
+		t.Fatalf("unexpected pages: %+v", results)
+	}
+	if len(requestedMarkers) != 3 || requestedMarkers[1] != "first" || requestedMarkers[2] != "second" {
+		t.Fatalf("unexpected markers requested: %+v", requestedMarkers)
+	}
+
+	if _, err := iterator.Next(context.TODO()); err == nil {
+		t.Fatalf("expected an error when retrieving a page after the last page but didn't get one")
+	}
+}
+
+func TestMarkerIteratorStartingMarker(t *testing.T) {
+	var requested *string
+	iterator := NewMarkerIterator(pointer.To("start"), func(ctx context.Context, marker *string) (int, *string, error) {
+		requested = marker
+		return 1, nil, nil
+	})
+
+	if _, err := iterator.Next(context.TODO()); err != nil {
+		t.Fatalf("retrieving page: %+v", err)
+	}
+	if requested == nil || *requested != "start" {
+		t.Fatalf("expected the starting marker to be used but got %v", requested)
+	}
+	if iterator.NotDone() {
+		t.Fatalf("expected the iterator to be done when no NextMarker is returned")
+	}
+}
+
+func TestMarkerIteratorError(t *testing.T) {
+	calls := 0
+	iterator := NewMarkerIterator(nil, func(ctx context.Context, marker *string) (int, *string, error) {
+		calls++
+		if calls == 1 {
+			return 0, nil, fmt.Errorf("transient failure")
+		}
+		return calls, nil, nil
+	})
+
+	if _, err := iterator.Next(context.TODO()); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !iterator.NotDone() {
+		t.Fatalf("expected the iterator not to be done after an error")
+	}
+	if page, err := iterator.Next(context.TODO()); err != nil || page != 2 {
+		t.Fatalf("expected the page to be retried but got %d / %+v", page, err)
+	}
+}
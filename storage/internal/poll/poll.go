@@ -0,0 +1,106 @@
+// Package poll contains the logic shared by the helpers which wait for long-running data-plane operations
+// (such as copying or rehydrating a Blob) to complete, by polling the resource until it reaches a terminal state.
+package poll
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+const (
+	// backoffMultiplier is the factor by which the interval between polls grows after each poll
+	backoffMultiplier = 1.5
+
+	// maxInterval is the longest interval between polls, unless the initial interval is longer
+	maxInterval = 1 * time.Minute
+
+	// jitterFraction is the (maximum) fraction of the interval by which each wait is randomly shortened or lengthened
+	jitterFraction = 0.1
+)
+
+// Func polls the resource once, returning the observed state and whether a terminal state has been reached.
+// Returning an error stops polling immediately.
+type Func[T any] func(ctx context.Context) (state T, done bool, err error)
+
+// TimeoutError is returned from Until when the context is cancelled (or its deadline passes) before a
+// terminal state has been reached
+type TimeoutError struct {
+	// The number of times the resource was polled
+	Attempts int
+
+	Err error
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("no terminal state was reached after %d attempts: %+v", e.Attempts, e.Err)
+}
+
+func (e TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Until calls `fn` until it reports that a terminal state has been reached, it returns an error, or the context
+// is cancelled - waiting `interval` after the first poll and backing off (with jitter) between successive polls.
+// The state observed during the most recent poll is always returned, including alongside a TimeoutError when
+// the context is cancelled or its deadline passes.
+func Until[T any](ctx context.Context, interval time.Duration, fn Func[T]) (state T, err error) {
+	if interval <= 0 {
+		return state, fmt.Errorf("`interval` must be greater than 0")
+	}
+
+	current := interval
+	for attempts := 1; ; attempts++ {
+		latest, done, pollErr := fn(ctx)
+		if pollErr != nil {
+			// a poll which was interrupted by the context being cancelled is treated as a timeout
+			if ctx.Err() != nil {
+				return state, TimeoutError{
+					Attempts: attempts,
+					Err:      ctx.Err(),
+				}
+			}
+			return latest, pollErr
+		}
+		state = latest
+		if done {
+			return state, nil
+		}
+
+		timer := time.NewTimer(withJitter(current))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return state, TimeoutError{
+				Attempts: attempts,
+				Err:      ctx.Err(),
+			}
+		case <-timer.C:
+		}
+
+		current = nextInterval(current, interval)
+	}
+}
+
+// nextInterval returns the interval which should follow `current`, which grows by the backoff multiplier
+// up to the maximum interval - or `initial` when that's longer than the maximum interval
+func nextInterval(current, initial time.Duration) time.Duration {
+	limit := maxInterval
+	if initial > limit {
+		limit = initial
+	}
+
+	next := time.Duration(float64(current) * backoffMultiplier)
+	if next > limit {
+		next = limit
+	}
+	return next
+}
+
+// withJitter randomly shortens or lengthens `interval` by up to the jitter fraction, so that many callers
+// waiting on the same operation don't poll in lockstep
+func withJitter(interval time.Duration) time.Duration {
+	jitter := (rand.Float64()*2 - 1) * jitterFraction
+	return time.Duration(float64(interval) * (1 + jitter))
+}
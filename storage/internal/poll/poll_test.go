@@ -0,0 +1,117 @@
+package poll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestUntil(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	attempts := 0
+	state, err := Until(ctx, time.Millisecond, func(ctx context.Context) (int, bool, error) {
+		attempts++
+		return attempts, attempts == 3, nil
+	})
+	if err != nil {
+		t.Fatalf("polling: %+v", err)
+	}
+	if state != 3 {
+		t.Fatalf("expected the state to be 3 but got %d", state)
+	}
+}
+
+func TestUntilError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	expected := fmt.Errorf("the copy failed")
+	attempts := 0
+	state, err := Until(ctx, time.Millisecond, func(ctx context.Context) (string, bool, error) {
+		attempts++
+		if attempts == 2 {
+			return "failed", false, expected
+		}
+		return "pending", false, nil
+	})
+	if !errors.Is(err, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, err)
+	}
+	if state != "failed" {
+		t.Fatalf("expected the state to be %q but got %q", "failed", state)
+	}
+}
+
+func TestUntilTimeoutReturnsLastState(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	state, err := Until(ctx, time.Hour, func(ctx context.Context) (string, bool, error) {
+		return "pending", false, nil
+	})
+	var timeoutErr TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected a TimeoutError but got %+v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the error to wrap context.DeadlineExceeded but got %+v", err)
+	}
+	if timeoutErr.Attempts != 1 {
+		t.Fatalf("expected 1 attempt but got %d", timeoutErr.Attempts)
+	}
+	if state != "pending" {
+		t.Fatalf("expected the last observed state %q to be returned but got %q", "pending", state)
+	}
+}
+
+func TestNextInterval(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Current  time.Duration
+		Initial  time.Duration
+		Expected time.Duration
+	}{
+		{
+			Name:     "Backs Off",
+			Current:  10 * time.Second,
+			Initial:  10 * time.Second,
+			Expected: 15 * time.Second,
+		},
+		{
+			Name:     "Capped at the Maximum",
+			Current:  50 * time.Second,
+			Initial:  10 * time.Second,
+			Expected: maxInterval,
+		},
+		{
+			Name:     "Initial Interval longer than the Maximum",
+			Current:  5 * time.Minute,
+			Initial:  5 * time.Minute,
+			Expected: 5 * time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if actual := nextInterval(v.Current, v.Initial); actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	interval := 10 * time.Second
+	lower := time.Duration(float64(interval) * (1 - jitterFraction))
+	upper := time.Duration(float64(interval) * (1 + jitterFraction))
+
+	for i := 0; i < 100; i++ {
+		if actual := withJitter(interval); actual < lower || actual > upper {
+			t.Fatalf("expected the interval to be between %s and %s but got %s", lower, upper, actual)
+		}
+	}
+}
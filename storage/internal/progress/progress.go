@@ -0,0 +1,121 @@
+package progress
+
+import (
+	"io"
+	"sync"
+)
+
+// Func is called with the cumulative number of bytes transferred, and the total number of bytes
+// which are expected to be transferred (or -1 when this isn't known in advance)
+type Func func(bytesTransferred, totalBytes int64)
+
+// Tracker reports the cumulative number of bytes transferred to a Func, and is safe to use from
+// multiple goroutines (for example when uploading/downloading chunks in parallel). A nil Tracker
+// can be used when no Func has been specified, in which case nothing is reported.
+type Tracker struct {
+	fn          Func
+	total       int64
+	transferred int64
+	completed   bool
+	lock        sync.Mutex
+}
+
+// NewTracker returns a Tracker which reports to `fn`, or nil if `fn` is nil
+func NewTracker(fn Func, totalBytes int64) *Tracker {
+	if fn == nil {
+		return nil
+	}
+	return &Tracker{
+		fn:    fn,
+		total: totalBytes,
+	}
+}
+
+// Add records that `n` more bytes have been transferred
+func (t *Tracker) Add(n int64) {
+	if t == nil || n <= 0 {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.completed {
+		return
+	}
+	t.transferred += n
+	t.fn(t.transferred, t.total)
+}
+
+// Complete fires the final callback once the operation has completed, at which point the total
+// number of bytes becomes the number of bytes transferred if this wasn't known in advance. Any
+// calls after the first are ignored.
+func (t *Tracker) Complete() {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.completed {
+		return
+	}
+	t.completed = true
+	if t.total < 0 {
+		t.total = t.transferred
+	}
+	t.fn(t.transferred, t.total)
+}
+
+// NewReader returns an io.Reader which records the bytes read from `r`
+func NewReader(r io.Reader, t *Tracker) io.Reader {
+	if t == nil {
+		return r
+	}
+	return &reader{
+		reader:  r,
+		tracker: t,
+	}
+}
+
+// NewReadCloser returns an io.ReadCloser which records the bytes read from `r`, and which fires the
+// final callback once `r` has been read in its entirety
+func NewReadCloser(r io.ReadCloser, t *Tracker) io.ReadCloser {
+	if t == nil {
+		return r
+	}
+	return &readCloser{
+		reader: reader{
+			reader:  r,
+			tracker: t,
+		},
+		closer: r,
+	}
+}
+
+type reader struct {
+	reader  io.Reader
+	tracker *Tracker
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.tracker.Add(int64(n))
+	return n, err
+}
+
+type readCloser struct {
+	reader
+	closer io.Closer
+}
+
+func (r *readCloser) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if err == io.EOF {
+		r.tracker.Complete()
+	}
+	return n, err
+}
+
+func (r *readCloser) Close() error {
+	return r.closer.Close()
+}
@@ -0,0 +1,111 @@
+package progress
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+)
+
+func TestNilTracker(t *testing.T) {
+	tracker := NewTracker(nil, 10)
+	if tracker != nil {
+		t.Fatalf("expected a nil Tracker when no Func is specified")
+	}
+
+	// these should be no-ops rather than panicking
+	tracker.Add(5)
+	tracker.Complete()
+
+	r := bytes.NewReader([]byte("hello"))
+	if NewReader(r, tracker) != io.Reader(r) {
+		t.Fatalf("expected the reader to be returned unmodified for a nil Tracker")
+	}
+}
+
+func TestTrackerConcurrentAdds(t *testing.T) {
+	var lastTransferred int64
+	var calls int
+	tracker := NewTracker(func(bytesTransferred, totalBytes int64) {
+		calls++
+		if bytesTransferred < lastTransferred {
+			t.Errorf("expected the bytes transferred to be cumulative but went from %d to %d", lastTransferred, bytesTransferred)
+		}
+		lastTransferred = bytesTransferred
+		if totalBytes != 1000 {
+			t.Errorf("expected the total to be 1000 but got %d", totalBytes)
+		}
+	}, 1000)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				tracker.Add(10)
+			}
+		}()
+	}
+	wg.Wait()
+	tracker.Complete()
+
+	if lastTransferred != 1000 {
+		t.Fatalf("expected 1000 bytes to be transferred but got %d", lastTransferred)
+	}
+	if calls != 101 {
+		t.Fatalf("expected 100 progress callbacks and a final callback but got %d", calls)
+	}
+}
+
+func TestTrackerCompleteUnknownTotal(t *testing.T) {
+	var finalTransferred, finalTotal int64
+	calls := 0
+	tracker := NewTracker(func(bytesTransferred, totalBytes int64) {
+		calls++
+		finalTransferred = bytesTransferred
+		finalTotal = totalBytes
+	}, -1)
+
+	tracker.Add(3)
+	tracker.Add(4)
+	tracker.Complete()
+	tracker.Complete()
+	tracker.Add(5)
+
+	if calls != 3 {
+		t.Fatalf("expected 3 callbacks but got %d", calls)
+	}
+	if finalTransferred != 7 || finalTotal != 7 {
+		t.Fatalf("expected the final callback to report 7/7 but got %d/%d", finalTransferred, finalTotal)
+	}
+}
+
+func TestReadCloserCompletesOnEOF(t *testing.T) {
+	completed := false
+	var transferred int64
+	tracker := NewTracker(func(bytesTransferred, totalBytes int64) {
+		transferred = bytesTransferred
+		if bytesTransferred == totalBytes {
+			completed = true
+		}
+	}, 11)
+
+	r := NewReadCloser(io.NopCloser(bytes.NewReader([]byte("hello world"))), tracker)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	if string(data) != "hello world" {
+		t.Fatalf("expected the contents to be unmodified but got %q", string(data))
+	}
+	if transferred != 11 {
+		t.Fatalf("expected 11 bytes to be transferred but got %d", transferred)
+	}
+	if !completed {
+		t.Fatalf("expected the final callback to be fired")
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("closing: %+v", err)
+	}
+}
@@ -0,0 +1,28 @@
+package signedidentifiers
+
+import "encoding/xml"
+
+// SignedIdentifiers is the XML representation of the Stored Access Policies for a Container, Queue, Share or Table
+type SignedIdentifiers struct {
+	XMLName           xml.Name           `xml:"SignedIdentifiers"`
+	SignedIdentifiers []SignedIdentifier `xml:"SignedIdentifier"`
+}
+
+// SignedIdentifier is a Stored Access Policy, which can be referenced by a Shared Access Signature
+type SignedIdentifier struct {
+	// The unique ID of this Stored Access Policy, up to 64 characters
+	Id string `xml:"Id"`
+
+	AccessPolicy AccessPolicy `xml:"AccessPolicy"`
+}
+
+type AccessPolicy struct {
+	// The time at which this Access Policy becomes valid, in ISO 8601 format
+	Start string `xml:"Start,omitempty"`
+
+	// The time at which this Access Policy expires, in ISO 8601 format
+	Expiry string `xml:"Expiry,omitempty"`
+
+	// The permissions granted by this Access Policy, for example `rwd`
+	Permission string `xml:"Permission,omitempty"`
+}
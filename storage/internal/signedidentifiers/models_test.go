@@ -0,0 +1,97 @@
+package signedidentifiers
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestSignedIdentifiersRoundTrip(t *testing.T) {
+	input := `<SignedIdentifiers><SignedIdentifier><Id>policy1</Id><AccessPolicy><Start>2024-01-01T00:00:00.0000000Z</Start><Expiry>2024-01-02T00:00:00.0000000Z</Expiry><Permission>rwd</Permission></AccessPolicy></SignedIdentifier><SignedIdentifier><Id>policy2</Id><AccessPolicy><Permission>r</Permission></AccessPolicy></SignedIdentifier></SignedIdentifiers>`
+
+	var model SignedIdentifiers
+	if err := xml.Unmarshal([]byte(input), &model); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	expected := []SignedIdentifier{
+		{
+			Id: "policy1",
+			AccessPolicy: AccessPolicy{
+				Start:      "2024-01-01T00:00:00.0000000Z",
+				Expiry:     "2024-01-02T00:00:00.0000000Z",
+				Permission: "rwd",
+			},
+		},
+		{
+			Id: "policy2",
+			AccessPolicy: AccessPolicy{
+				Permission: "r",
+			},
+		},
+	}
+	if !reflect.DeepEqual(model.SignedIdentifiers, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, model.SignedIdentifiers)
+	}
+
+	actual, err := xml.Marshal(SignedIdentifiers{SignedIdentifiers: model.SignedIdentifiers})
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+	if string(actual) != input {
+		t.Fatalf("expected the round-tripped XML to be %q but got %q", input, string(actual))
+	}
+}
+
+func TestSignedIdentifiersMarshalEmpty(t *testing.T) {
+	actual, err := xml.Marshal(SignedIdentifiers{})
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+	expected := "<SignedIdentifiers></SignedIdentifiers>"
+	if string(actual) != expected {
+		t.Fatalf("expected %q but got %q", expected, string(actual))
+	}
+}
+
+func TestSignedIdentifiersMarshalRoundTrip(t *testing.T) {
+	input := SignedIdentifiers{
+		SignedIdentifiers: []SignedIdentifier{
+			{
+				Id: "full",
+				AccessPolicy: AccessPolicy{
+					Start:      "2024-01-02T03:04:05.0000000Z",
+					Expiry:     "2024-02-02T03:04:05.0000000Z",
+					Permission: "rwd",
+				},
+			},
+			{
+				Id: "permission-only",
+				AccessPolicy: AccessPolicy{
+					Permission: "r",
+				},
+			},
+		},
+	}
+
+	b, err := xml.Marshal(input)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+	expected := `<SignedIdentifiers>` +
+		`<SignedIdentifier><Id>full</Id><AccessPolicy><Start>2024-01-02T03:04:05.0000000Z</Start><Expiry>2024-02-02T03:04:05.0000000Z</Expiry><Permission>rwd</Permission></AccessPolicy></SignedIdentifier>` +
+		`<SignedIdentifier><Id>permission-only</Id><AccessPolicy><Permission>r</Permission></AccessPolicy></SignedIdentifier>` +
+		`</SignedIdentifiers>`
+	if string(b) != expected {
+		t.Fatalf("expected %q but got %q", expected, string(b))
+	}
+
+	var actual SignedIdentifiers
+	if err := xml.Unmarshal(b, &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+	actual.XMLName = xml.Name{}
+	if !reflect.DeepEqual(input, actual) {
+		t.Fatalf("expected %+v but got %+v", input, actual)
+	}
+}
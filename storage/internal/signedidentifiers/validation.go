@@ -0,0 +1,32 @@
+package signedidentifiers
+
+import "fmt"
+
+// maxNumberOfSignedIdentifiers is the maximum number of Stored Access Policies which can be set on a resource
+const maxNumberOfSignedIdentifiers = 5
+
+// maxIdLength is the maximum length of the ID for a Stored Access Policy
+const maxIdLength = 64
+
+// Validate validates the specified Signed Identifiers against the limits enforced by the Storage Service
+func Validate(input []SignedIdentifier) error {
+	if len(input) > maxNumberOfSignedIdentifiers {
+		return fmt.Errorf("a maximum of %d Signed Identifiers can be specified but got %d", maxNumberOfSignedIdentifiers, len(input))
+	}
+
+	ids := make(map[string]struct{}, len(input))
+	for _, v := range input {
+		if v.Id == "" {
+			return fmt.Errorf("the ID for a Signed Identifier cannot be an empty string")
+		}
+		if len(v.Id) > maxIdLength {
+			return fmt.Errorf("the ID %q for a Signed Identifier must be at most %d characters", v.Id, maxIdLength)
+		}
+		if _, exists := ids[v.Id]; exists {
+			return fmt.Errorf("the ID %q for a Signed Identifier was specified more than once", v.Id)
+		}
+		ids[v.Id] = struct{}{}
+	}
+
+	return nil
+}
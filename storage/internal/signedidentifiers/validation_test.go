@@ -0,0 +1,77 @@
+package signedidentifiers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         []SignedIdentifier
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "None",
+			Input:         []SignedIdentifier{},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Single",
+			Input: []SignedIdentifier{
+				{Id: "policy1"},
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Empty ID",
+			Input: []SignedIdentifier{
+				{Id: ""},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "ID too long",
+			Input: []SignedIdentifier{
+				{Id: strings.Repeat("a", 65)},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Duplicate IDs",
+			Input: []SignedIdentifier{
+				{Id: "policy1"},
+				{Id: "policy1"},
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Too many",
+			Input:         buildSignedIdentifiers(6),
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := Validate(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected the input to be valid but got: %+v", err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected the input to be invalid but it was valid")
+		}
+	}
+}
+
+func buildSignedIdentifiers(count int) []SignedIdentifier {
+	out := make([]SignedIdentifier, 0, count)
+	for i := 0; i < count; i++ {
+		out = append(out, SignedIdentifier{
+			Id: fmt.Sprintf("policy%d", i),
+		})
+	}
+	return out
+}
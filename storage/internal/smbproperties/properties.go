@@ -0,0 +1,151 @@
+package smbproperties
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// maxInlinePermissionLength is the maximum size of an SDDL permission which can be specified inline
+// using the `x-ms-file-permission` header, larger permissions must be created at the Share level and
+// referenced using the `x-ms-file-permission-key` header instead.
+const maxInlinePermissionLength = 8 * 1024
+
+// timeFormat is the ISO 8601 format used for the `x-ms-file-creation-time` and `x-ms-file-last-write-time` headers
+const timeFormat = "2006-01-02T15:04:05.0000000Z"
+
+// Properties are the SMB properties which can be set on a File or Directory
+type Properties struct {
+	// An optional SDDL permission, this cannot be specified alongside FilePermissionKey
+	FilePermission *string
+
+	// An optional key for a permission previously created at the Share level, this cannot
+	// be specified alongside FilePermission
+	FilePermissionKey *string
+
+	// An optional pipe-separated list of attributes, e.g. `ReadOnly|Hidden`
+	FileAttributes *string
+
+	CreationTime  *time.Time
+	LastWriteTime *time.Time
+}
+
+// Defaults are the values sent for any Properties which are omitted, which differ between
+// creating (e.g. `inherit` and `now`) and updating (`preserve`) a File or Directory
+type Defaults struct {
+	Permission string
+	Attributes string
+	Time       string
+}
+
+// CreateDefaults are the Defaults used when creating a File or Directory, where the permission is inherited
+// from the parent directory
+var CreateDefaults = Defaults{
+	Permission: "inherit",
+	Attributes: "None",
+	Time:       "now",
+}
+
+// UpdateDefaults are the Defaults used when setting the properties of a File or Directory, which leave any
+// omitted properties unchanged
+var UpdateDefaults = Defaults{
+	Permission: "preserve",
+	Attributes: "preserve",
+	Time:       "preserve",
+}
+
+// Validate validates that exactly one of an inline permission and a permission key is sent - that is either
+// FilePermission or FilePermissionKey, or when neither is specified the default permission from `defaults` -
+// and that an inline FilePermission isn't larger than the service allows
+func (p Properties) Validate(defaults Defaults) error {
+	if p.FilePermission != nil && p.FilePermissionKey != nil {
+		return fmt.Errorf("exactly one of `FilePermission` and `FilePermissionKey` must be specified, but both were")
+	}
+	if p.FilePermission == nil && p.FilePermissionKey == nil && defaults.Permission == "" {
+		return fmt.Errorf("exactly one of `FilePermission` and `FilePermissionKey` must be specified, since there's no default permission")
+	}
+	if p.FilePermission != nil {
+		if *p.FilePermission == "" {
+			return fmt.Errorf("`FilePermission` cannot be an empty string")
+		}
+		if len(*p.FilePermission) > maxInlinePermissionLength {
+			return fmt.Errorf("`FilePermission` can be at most %d bytes when specified inline, larger permissions should be created at the Share level and specified using `FilePermissionKey`", maxInlinePermissionLength)
+		}
+	}
+	if p.FilePermissionKey != nil && *p.FilePermissionKey == "" {
+		return fmt.Errorf("`FilePermissionKey` cannot be an empty string")
+	}
+	return nil
+}
+
+// ValidateForNFS validates that none of the SMB-only properties (which the service rejects for Files
+// and Directories within an NFS Share) have been specified
+func (p Properties) ValidateForNFS() error {
+	if p.FilePermission != nil {
+		return fmt.Errorf("`FilePermission` is only supported for SMB Shares and cannot be specified alongside NFS properties")
+	}
+	if p.FilePermissionKey != nil {
+		return fmt.Errorf("`FilePermissionKey` is only supported for SMB Shares and cannot be specified alongside NFS properties")
+	}
+	if p.FileAttributes != nil {
+		return fmt.Errorf("`FileAttributes` is only supported for SMB Shares and cannot be specified alongside NFS properties")
+	}
+	return nil
+}
+
+// Headers returns the headers for these Properties, using the specified Defaults for any omitted values
+func (p Properties) Headers(defaults Defaults) client.Headers {
+	headers := client.Headers{}
+
+	if p.FilePermissionKey != nil {
+		headers.Append("x-ms-file-permission-key", *p.FilePermissionKey)
+	} else if p.FilePermission != nil {
+		headers.Append("x-ms-file-permission", *p.FilePermission)
+	} else {
+		headers.Append("x-ms-file-permission", defaults.Permission)
+	}
+
+	attributes := defaults.Attributes
+	if p.FileAttributes != nil {
+		attributes = *p.FileAttributes
+	}
+	headers.Append("x-ms-file-attributes", attributes)
+
+	headers.Append("x-ms-file-creation-time", formatTime(p.CreationTime, defaults.Time))
+	headers.Append("x-ms-file-last-write-time", formatTime(p.LastWriteTime, defaults.Time))
+
+	return headers
+}
+
+func formatTime(input *time.Time, defaultValue string) string {
+	if input == nil {
+		return defaultValue
+	}
+	return input.UTC().Format(timeFormat)
+}
+
+// Info are the SMB properties returned by the service when retrieving the properties of a File or Directory
+type Info struct {
+	FileAttributes    string
+	FileCreationTime  string
+	FileLastWriteTime string
+	FileChangeTime    string
+	FilePermissionKey string
+	FileID            string
+	FileParentID      string
+}
+
+// ParseFromHeaders parses the SMB properties from the `x-ms-file-*` headers of a response
+func ParseFromHeaders(headers http.Header) Info {
+	return Info{
+		FileAttributes:    headers.Get("x-ms-file-attributes"),
+		FileCreationTime:  headers.Get("x-ms-file-creation-time"),
+		FileLastWriteTime: headers.Get("x-ms-file-last-write-time"),
+		FileChangeTime:    headers.Get("x-ms-file-change-time"),
+		FilePermissionKey: headers.Get("x-ms-file-permission-key"),
+		FileID:            headers.Get("x-ms-file-id"),
+		FileParentID:      headers.Get("x-ms-file-parent-id"),
+	}
+}
@@ -0,0 +1,223 @@
+package smbproperties
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	permission := "O:SYG:SYD:(A;;FA;;;SY)"
+	emptyString := ""
+	key := "4066528134148476695*1"
+	tooLarge := strings.Repeat("a", maxInlinePermissionLength+1)
+
+	testData := []struct {
+		Name          string
+		Input         Properties
+		Defaults      *Defaults
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Neither",
+			Input:         Properties{},
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Neither without a Default Permission",
+			Input:         Properties{},
+			Defaults:      &Defaults{},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Permission",
+			Input: Properties{
+				FilePermission: &permission,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Permission Key",
+			Input: Properties{
+				FilePermissionKey: &key,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Both",
+			Input: Properties{
+				FilePermission:    &permission,
+				FilePermissionKey: &key,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Empty Permission",
+			Input: Properties{
+				FilePermission: &emptyString,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Empty Permission Key",
+			Input: Properties{
+				FilePermissionKey: &emptyString,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Permission Too Large",
+			Input: Properties{
+				FilePermission: &tooLarge,
+			},
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		defaults := UpdateDefaults
+		if v.Defaults != nil {
+			defaults = *v.Defaults
+		}
+		err := v.Input.Validate(defaults)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected the input to be valid but got: %+v", err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected the input to be invalid but it was valid")
+		}
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	defaults := Defaults{
+		Permission: "inherit",
+		Attributes: "None",
+		Time:       "now",
+	}
+
+	h := Properties{}.Headers(defaults)
+	headers := h.Headers()
+	expected := map[string]string{
+		"x-ms-file-permission":      "inherit",
+		"x-ms-file-attributes":      "None",
+		"x-ms-file-creation-time":   "now",
+		"x-ms-file-last-write-time": "now",
+	}
+	for k, v := range expected {
+		if actual := headers.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+
+	key := "4066528134148476695*1"
+	attributes := "ReadOnly|Hidden"
+	createdAt := time.Date(2024, 1, 2, 4, 4, 5, 123456700, time.FixedZone("UTC+1", 3600))
+	h = Properties{
+		FilePermissionKey: &key,
+		FileAttributes:    &attributes,
+		CreationTime:      &createdAt,
+	}.Headers(defaults)
+	headers = h.Headers()
+	expected = map[string]string{
+		"x-ms-file-permission-key":  key,
+		"x-ms-file-attributes":      attributes,
+		"x-ms-file-creation-time":   "2024-01-02T03:04:05.1234567Z",
+		"x-ms-file-last-write-time": "now",
+	}
+	for k, v := range expected {
+		if actual := headers.Get(k); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+	if v := headers.Get("x-ms-file-permission"); v != "" {
+		t.Fatalf("expected `x-ms-file-permission` to be omitted when a permission key is specified but got %q", v)
+	}
+}
+
+func TestParseFromHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("x-ms-file-attributes", "Directory|Hidden")
+	headers.Set("x-ms-file-creation-time", "2024-01-02T03:04:05.1234567Z")
+	headers.Set("x-ms-file-last-write-time", "2024-01-02T03:04:06.1234567Z")
+	headers.Set("x-ms-file-change-time", "2024-01-02T03:04:07.1234567Z")
+	headers.Set("x-ms-file-permission-key", "4066528134148476695*1")
+	headers.Set("x-ms-file-id", "13835128424026341376")
+	headers.Set("x-ms-file-parent-id", "0")
+
+	expected := Info{
+		FileAttributes:    "Directory|Hidden",
+		FileCreationTime:  "2024-01-02T03:04:05.1234567Z",
+		FileLastWriteTime: "2024-01-02T03:04:06.1234567Z",
+		FileChangeTime:    "2024-01-02T03:04:07.1234567Z",
+		FilePermissionKey: "4066528134148476695*1",
+		FileID:            "13835128424026341376",
+		FileParentID:      "0",
+	}
+	if actual := ParseFromHeaders(headers); actual != expected {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestValidateForNFS(t *testing.T) {
+	permission := "O:SYG:SYD:(A;;FA;;;SY)"
+	key := "4066528134148476695*1"
+	attributes := "ReadOnly"
+	createdAt := time.Now()
+
+	testData := []struct {
+		Name          string
+		Input         Properties
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Neither",
+			Input:         Properties{},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Timestamps",
+			Input: Properties{
+				CreationTime:  &createdAt,
+				LastWriteTime: &createdAt,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Permission",
+			Input: Properties{
+				FilePermission: &permission,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Permission Key",
+			Input: Properties{
+				FilePermissionKey: &key,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Attributes",
+			Input: Properties{
+				FileAttributes: &attributes,
+			},
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := v.Input.ValidateForNFS()
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected the input to be valid but got: %+v", err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected the input to be invalid but it was valid")
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package tags
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// BlobTags is an ordered set of Blob Index Tags, which retains the order in which the Tags were specified
+// (or were returned by the service)
+type BlobTags []Tag
+
+// Get returns the Value of the Tag with the specified Key, and whether the Tag exists
+func (t BlobTags) Get(key string) (string, bool) {
+	for _, tag := range t {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// Set sets the Value of the Tag with the specified Key, which is appended when the Tag doesn't already exist
+func (t *BlobTags) Set(key, value string) {
+	for i := range *t {
+		if (*t)[i].Key == key {
+			(*t)[i].Value = value
+			return
+		}
+	}
+	*t = append(*t, Tag{
+		Key:   key,
+		Value: value,
+	})
+}
+
+// Keys returns the Keys of the Tags, in order
+func (t BlobTags) Keys() []string {
+	out := make([]string, 0, len(t))
+	for _, tag := range t {
+		out = append(out, tag.Key)
+	}
+	return out
+}
+
+// ToMap flattens the Tags into a map
+func (t BlobTags) ToMap() map[string]string {
+	out := make(map[string]string, len(t))
+	for _, tag := range t {
+		out[tag.Key] = tag.Value
+	}
+	return out
+}
+
+// Validate ensures the Tags conform to the documented limits for Blob Index Tags, namely that there are at
+// most 10 Tags with unique Keys, that Keys are between 1 and 128 characters, that Values are at most 256
+// characters and that both only contain alphanumeric characters, spaces and the characters `+ - . / : = _`
+func (t BlobTags) Validate() error {
+	if len(t) > maxNumberOfTags {
+		return fmt.Errorf("at most %d Tags can be specified but got %d", maxNumberOfTags, len(t))
+	}
+
+	seen := make(map[string]struct{}, len(t))
+	for _, tag := range t {
+		if _, ok := seen[tag.Key]; ok {
+			return fmt.Errorf("the Tag Key %q was specified more than once", tag.Key)
+		}
+		seen[tag.Key] = struct{}{}
+
+		if err := validateTag(tag.Key, tag.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *BlobTags) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var model Tags
+	if err := d.DecodeElement(&model, &start); err != nil {
+		return err
+	}
+	*t = model.ToBlobTags()
+	return nil
+}
+
+// FromBlobTags builds the XML representation of the specified Tags, retaining their order
+func FromBlobTags(input BlobTags) Tags {
+	out := Tags{}
+	out.TagSet.Tags = append(out.TagSet.Tags, input...)
+	return out
+}
+
+// ToBlobTags returns the XML representation of a set of Tags as BlobTags, retaining their order
+func (t Tags) ToBlobTags() BlobTags {
+	out := make(BlobTags, 0, len(t.TagSet.Tags))
+	return append(out, t.TagSet.Tags...)
+}
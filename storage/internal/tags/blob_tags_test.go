@@ -0,0 +1,106 @@
+package tags
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+)
+
+func TestBlobTagsGetAndSet(t *testing.T) {
+	var input BlobTags
+	input.Set("project", "giovanni")
+	input.Set("env", "test")
+	input.Set("project", "updated")
+
+	if v, ok := input.Get("project"); !ok || v != "updated" {
+		t.Fatalf("expected `project` to be %q but got %q (exists: %t)", "updated", v, ok)
+	}
+	if _, ok := input.Get("missing"); ok {
+		t.Fatalf("expected `missing` not to exist")
+	}
+
+	keys := input.Keys()
+	if len(keys) != 2 || keys[0] != "project" || keys[1] != "env" {
+		t.Fatalf("expected the keys to be retained in order but got %+v", keys)
+	}
+}
+
+func TestBlobTagsValidate(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         BlobTags
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Empty",
+			Input:         BlobTags{},
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Valid",
+			Input:         BlobTags{{Key: "project", Value: "giovanni"}},
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Duplicate Keys",
+			Input:         BlobTags{{Key: "project", Value: "a"}, {Key: "project", Value: "b"}},
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Invalid Key",
+			Input:         BlobTags{{Key: "hello!", Value: "world"}},
+			ShouldBeValid: false,
+		},
+	}
+
+	tooMany := BlobTags{}
+	for i := 0; i <= maxNumberOfTags; i++ {
+		tooMany.Set(fmt.Sprintf("key%d", i), "value")
+	}
+	testData = append(testData, struct {
+		Name          string
+		Input         BlobTags
+		ShouldBeValid bool
+	}{
+		Name:          "Too Many Tags",
+		Input:         tooMany,
+		ShouldBeValid: false,
+	})
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := v.Input.Validate()
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %+v to be valid but got an error: %s", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %+v to be invalid but didn't get an error", v.Input)
+		}
+	}
+}
+
+func TestBlobTagsRoundTrip(t *testing.T) {
+	input := BlobTags{
+		{Key: "project", Value: "giovanni"},
+		{Key: "env", Value: "test"},
+	}
+
+	body, err := xml.Marshal(FromBlobTags(input))
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	expected := `<Tags><TagSet><Tag><Key>project</Key><Value>giovanni</Value></Tag><Tag><Key>env</Key><Value>test</Value></Tag></TagSet></Tags>`
+	if string(body) != expected {
+		t.Fatalf("expected %q but got %q", expected, string(body))
+	}
+
+	var parsed BlobTags
+	if err := xml.Unmarshal(body, &parsed); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+	if len(parsed) != 2 || parsed[0] != input[0] || parsed[1] != input[1] {
+		t.Fatalf("expected %+v but got %+v", input, parsed)
+	}
+}
@@ -0,0 +1,59 @@
+package tags
+
+import (
+	"encoding/xml"
+	"net/url"
+	"sort"
+)
+
+// Tags is the XML representation of a set of Blob Index Tags, as used in both request and response bodies
+type Tags struct {
+	XMLName xml.Name `xml:"Tags"`
+	TagSet  TagSet   `xml:"TagSet"`
+}
+
+type TagSet struct {
+	Tags []Tag `xml:"Tag"`
+}
+
+type Tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+// FromMap builds the XML representation of the specified Tags, ordered by Key
+func FromMap(input map[string]string) Tags {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := Tags{}
+	for _, k := range keys {
+		out.TagSet.Tags = append(out.TagSet.Tags, Tag{
+			Key:   k,
+			Value: input[k],
+		})
+	}
+	return out
+}
+
+// ToMap flattens the XML representation of a set of Tags into a map
+func (t Tags) ToMap() map[string]string {
+	out := make(map[string]string, len(t.TagSet.Tags))
+	for _, tag := range t.TagSet.Tags {
+		out[tag.Key] = tag.Value
+	}
+	return out
+}
+
+// ToHeaderValue returns the query-string encoded representation of the specified Tags (ordered by Key),
+// as used in the `x-ms-tags` header when setting Tags whilst creating or copying a Blob
+func ToHeaderValue(input map[string]string) string {
+	values := url.Values{}
+	for k, v := range input {
+		values.Set(k, v)
+	}
+	return values.Encode()
+}
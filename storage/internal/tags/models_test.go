@@ -0,0 +1,51 @@
+package tags
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestTagsRoundTrip(t *testing.T) {
+	input := map[string]string{
+		"project": "giovanni",
+		"env":     "test",
+	}
+
+	body, err := xml.Marshal(FromMap(input))
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	expected := `<Tags><TagSet><Tag><Key>env</Key><Value>test</Value></Tag><Tag><Key>project</Key><Value>giovanni</Value></Tag></TagSet></Tags>`
+	if string(body) != expected {
+		t.Fatalf("expected %q but got %q", expected, string(body))
+	}
+
+	var parsed Tags
+	if err := xml.Unmarshal(body, &parsed); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	actual := parsed.ToMap()
+	if len(actual) != len(input) {
+		t.Fatalf("expected %d tags but got %d", len(input), len(actual))
+	}
+	for k, v := range input {
+		if actual[k] != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual[k])
+		}
+	}
+}
+
+func TestToHeaderValue(t *testing.T) {
+	input := map[string]string{
+		"project": "giovanni",
+		"env":     "test value",
+		"path":    "a/b=c",
+	}
+
+	expected := "env=test+value&path=a%2Fb%3Dc&project=giovanni"
+	if actual := ToHeaderValue(input); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -0,0 +1,46 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// maxNumberOfTags is the maximum number of Index Tags which can be assigned to a single Blob
+const maxNumberOfTags = 10
+
+var validCharacters = regexp.MustCompile(`^[A-Za-z0-9 +\-./:=_]*$`)
+
+// Validate ensures the provided Tags conform to the documented limits for Blob Index Tags, namely that there
+// are at most 10 Tags, that Keys are between 1 and 128 characters, that Values are at most 256 characters and
+// that both only contain alphanumeric characters, spaces and the characters `+ - . / : = _`
+func Validate(input map[string]string) error {
+	if len(input) > maxNumberOfTags {
+		return fmt.Errorf("at most %d Tags can be specified but got %d", maxNumberOfTags, len(input))
+	}
+
+	for k, v := range input {
+		if err := validateTag(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateTag(k, v string) error {
+	if len(k) < 1 || len(k) > 128 {
+		return fmt.Errorf("Tag Keys must be between 1 and 128 characters but %q is %d characters", k, len(k))
+	}
+	if !validCharacters.MatchString(k) {
+		return fmt.Errorf("Tag Key %q contains invalid characters - only alphanumeric characters, spaces and `+ - . / : = _` are allowed", k)
+	}
+
+	if len(v) > 256 {
+		return fmt.Errorf("Tag Values must be at most 256 characters but the Value for %q is %d characters", k, len(v))
+	}
+	if !validCharacters.MatchString(v) {
+		return fmt.Errorf("the Value for Tag %q contains invalid characters - only alphanumeric characters, spaces and `+ - . / : = _` are allowed", k)
+	}
+
+	return nil
+}
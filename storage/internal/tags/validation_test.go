@@ -0,0 +1,103 @@
+package tags
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidation(t *testing.T) {
+	testData := []struct {
+		Input         map[string]string
+		ShouldBeValid bool
+	}{
+		{
+			Input:         map[string]string{},
+			ShouldBeValid: true,
+		},
+		{
+			Input: map[string]string{
+				"project": "giovanni",
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Input: map[string]string{
+				"Date Created": "2024-01-01T00:00:00Z",
+				"path/to-key":  "a+b=c_d.e",
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Input: map[string]string{
+				"empty": "",
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Input: map[string]string{
+				"": "value",
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Input: map[string]string{
+				"hello!": "world",
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Input: map[string]string{
+				"hello": "wor#ld",
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Input: map[string]string{
+				strings.Repeat("a", 128): "value",
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Input: map[string]string{
+				strings.Repeat("a", 129): "value",
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Input: map[string]string{
+				"key": strings.Repeat("a", 256),
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Input: map[string]string{
+				"key": strings.Repeat("a", 257),
+			},
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.Input)
+
+		err := Validate(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("Expected %+v to be valid but got an error: %s", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("Expected %+v to be invalid but didn't get an error", v.Input)
+		}
+	}
+}
+
+func TestValidationTooManyTags(t *testing.T) {
+	input := make(map[string]string)
+	for i := 0; i <= maxNumberOfTags; i++ {
+		input[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	if err := Validate(input); err == nil {
+		t.Fatalf("Expected an error for %d tags but didn't get one", len(input))
+	}
+}
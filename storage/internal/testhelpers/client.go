@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"os"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -13,7 +12,6 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
-	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane/storage"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 )
 
@@ -79,23 +77,45 @@ func (c Client) buildTestResources(ctx context.Context, resourceGroup, name stri
 		return nil, fmt.Errorf("error creating %s: %+v", storageAccountId, err)
 	}
 
-	var options storageaccounts.ListKeysOperationOptions
-	keys, err := c.StorageAccountClient.ListKeys(ctx, storageAccountId, options)
+	accountKey, err := c.waitForAccountKey(ctx, storageAccountId)
 	if err != nil {
-		return nil, fmt.Errorf("error listing keys for %s: %+v", storageAccountId, err)
+		return nil, err
 	}
 
-	// sure we could poll to get around the inconsistency, but where's the fun in that
-	time.Sleep(5 * time.Second)
-
-	accountKeys := *keys.Model.Keys
 	return &TestResources{
 		ResourceGroup:      resourceGroup,
 		StorageAccountName: name,
-		StorageAccountKey:  *(accountKeys[0]).Value,
+		StorageAccountKey:  accountKey,
 	}, nil
 }
 
+// waitForAccountKey polls ListKeys until an Access Key is returned for the Storage Account, since the keys for a
+// newly created Storage Account are eventually consistent
+func (c Client) waitForAccountKey(ctx context.Context, storageAccountId commonids.StorageAccountId) (string, error) {
+	var accountKey string
+	err := WaitFor(ctx, 0, func(ctx context.Context) (bool, error) {
+		var options storageaccounts.ListKeysOperationOptions
+		keys, err := c.StorageAccountClient.ListKeys(ctx, storageAccountId, options)
+		if err != nil {
+			return false, fmt.Errorf("error listing keys for %s: %+v", storageAccountId, err)
+		}
+		if keys.Model == nil || keys.Model.Keys == nil {
+			return false, nil
+		}
+		for _, key := range *keys.Model.Keys {
+			if key.Value != nil && *key.Value != "" {
+				accountKey = *key.Value
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("waiting for an Access Key for %s: %+v", storageAccountId, err)
+	}
+	return accountKey, nil
+}
+
 func (c Client) DestroyTestResources(ctx context.Context, resourceGroup, name string) error {
 	storageAccountId := commonids.NewStorageAccountID(c.SubscriptionId, resourceGroup, name)
 	if _, err := c.StorageAccountClient.Delete(ctx, storageAccountId); err != nil {
@@ -179,11 +199,17 @@ func (c Client) Configure(client *client.Client, authorizer auth.Authorizer) {
 	// TODO: add logging
 }
 
-func (c Client) PrepareWithResourceManagerAuth(input *storage.Client) {
+// authorizable is implemented by both the base Storage client from `go-azure-sdk` and the `baseclient.Client`
+// which wraps it
+type authorizable interface {
+	SetAuthorizer(auth.Authorizer)
+}
+
+func (c Client) PrepareWithResourceManagerAuth(input authorizable) {
 	input.SetAuthorizer(c.storageAuth)
 }
 
-func (c Client) PrepareWithSharedKeyAuth(input *storage.Client, data *TestResources, keyType auth.SharedKeyType) error {
+func (c Client) PrepareWithSharedKeyAuth(input authorizable, data *TestResources, keyType auth.SharedKeyType) error {
 	auth, err := auth.NewSharedKeyAuthorizer(data.StorageAccountName, data.StorageAccountKey, keyType)
 	if err != nil {
 		return fmt.Errorf("building SharedKey authorizer: %+v", err)
@@ -0,0 +1,41 @@
+package testhelpers
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// defaultPollInterval is the interval between attempts when waiting for an eventually-consistent condition
+const defaultPollInterval = 1 * time.Second
+
+// ConditionFunc returns whether the condition being waited for has been met. Returning an error stops
+// waiting immediately.
+type ConditionFunc func(ctx context.Context) (bool, error)
+
+// WaitFor calls `condition` every `interval` (defaulting to 1 second, when 0) until it returns true, returns an
+// error, or the context is cancelled/its deadline passes - in which case the (wrapped) context error is returned.
+func WaitFor(ctx context.Context, interval time.Duration, condition ConditionFunc) error {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := condition(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for condition: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
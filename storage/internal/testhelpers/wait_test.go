@@ -0,0 +1,60 @@
+package testhelpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWaitFor(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	attempts := 0
+	err := WaitFor(ctx, time.Millisecond, func(ctx context.Context) (bool, error) {
+		attempts++
+		return attempts == 3, nil
+	})
+	if err != nil {
+		t.Fatalf("waiting: %+v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts but got %d", attempts)
+	}
+}
+
+func TestWaitForError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	expected := fmt.Errorf("boom")
+	attempts := 0
+	err := WaitFor(ctx, time.Millisecond, func(ctx context.Context) (bool, error) {
+		attempts++
+		return false, expected
+	})
+	if !errors.Is(err, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt but got %d", attempts)
+	}
+}
+
+func TestWaitForHonoursDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := WaitFor(ctx, time.Hour, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a context.DeadlineExceeded error but got %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("expected WaitFor to return once the deadline passed but took %s", elapsed)
+	}
+}
@@ -0,0 +1,28 @@
+// Package metadata contains helpers for working with the MetaData returned by the Storage APIs.
+package metadata
+
+import (
+	"strings"
+)
+
+// WithCasingOf returns a copy of `metaData` where each key which matches (case-insensitively) a key within
+// `reference` uses the casing from `reference`, and any other keys are returned as-is.
+//
+// MetaData returned within the `x-ms-meta-*` headers of a response (for example from `GetMetaData` and
+// `GetProperties`) has lower-cased keys, since the casing of header names isn't retained when the response
+// is parsed - and so the MetaData which was set on the resource can be used as the `reference` to restore it.
+func WithCasingOf(metaData, reference map[string]string) map[string]string {
+	casing := make(map[string]string, len(reference))
+	for k := range reference {
+		casing[strings.ToLower(k)] = k
+	}
+
+	out := make(map[string]string, len(metaData))
+	for k, v := range metaData {
+		if original, ok := casing[strings.ToLower(k)]; ok {
+			k = original
+		}
+		out[k] = v
+	}
+	return out
+}
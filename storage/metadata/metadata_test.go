@@ -0,0 +1,31 @@
+package metadata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWithCasingOf(t *testing.T) {
+	input := map[string]string{
+		"hello":   "world",
+		"abc_123": "value",
+		"other":   "thing",
+	}
+	reference := map[string]string{
+		"Hello":   "world",
+		"ABC_123": "value",
+		"Missing": "value",
+	}
+
+	expected := map[string]string{
+		"Hello":   "world",
+		"ABC_123": "value",
+		"other":   "thing",
+	}
+	if actual := WithCasingOf(input, reference); !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+	if _, ok := input["Hello"]; ok {
+		t.Fatalf("Expected the original MetaData not to be modified")
+	}
+}
@@ -0,0 +1,149 @@
+// Package naming validates the names of Storage resources against the naming rules documented for each
+// Storage Service, so that invalid names can be rejected before a request is sent (rather than failing
+// with a 400 Bad Request from the Storage Service).
+package naming
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// maxBlobNameLength is the maximum length of a Blob name, in characters
+	maxBlobNameLength = 1024
+
+	// maxBlobNamePathSegments is the maximum number of path segments (separated by `/`) within a Blob name
+	maxBlobNamePathSegments = 254
+
+	// maxDirectoryPathLength is the maximum length of the path to a Directory within a Share, in characters
+	maxDirectoryPathLength = 2048
+
+	// maxDirectoryPathSegmentLength is the maximum length of each Directory name within a path, in characters
+	maxDirectoryPathSegmentLength = 255
+
+	// maxDirectoryPathDepth is the maximum number of nested Directories within a Share
+	maxDirectoryPathDepth = 250
+)
+
+// invalidDirectoryNameCharacters are the characters which can't be used in the name of a Directory (or File)
+// within a Share, in addition to the control characters
+const invalidDirectoryNameCharacters = `"\:|<>*?`
+
+// lowerCaseAlphanumericHyphenRegex matches names comprised of lower-case letters, numbers and hyphens, where
+// the name starts and ends with a letter or number and contains no consecutive hyphens
+var lowerCaseAlphanumericHyphenRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// reservedContainerNames are the names of the Containers created by the Storage Service, which don't follow the
+// usual naming rules - for example the `$web` Container used for Static Websites
+var reservedContainerNames = map[string]struct{}{
+	"$logs": {},
+	"$root": {},
+	"$web":  {},
+}
+
+// ValidateContainerName validates that `input` is a valid Container name, which must be between 3 and 63 characters,
+// contain only lower-case letters, numbers and hyphens, start and end with a letter or number and not contain
+// consecutive hyphens. The names of the Containers created by the Storage Service (`$logs`, `$root` and `$web`)
+// are also valid.
+// https://learn.microsoft.com/en-us/rest/api/storageservices/naming-and-referencing-containers--blobs--and-metadata#container-names
+func ValidateContainerName(input string) error {
+	if _, ok := reservedContainerNames[input]; ok {
+		return nil
+	}
+	return validateLowerCaseAlphanumericHyphenName("container", input)
+}
+
+// ValidateBlobName validates that `input` is a valid Blob name, which must be between 1 and 1024 characters
+// and contain at most 254 path segments (separated by `/`).
+// https://learn.microsoft.com/en-us/rest/api/storageservices/naming-and-referencing-containers--blobs--and-metadata#blob-names
+func ValidateBlobName(input string) error {
+	if input == "" {
+		return fmt.Errorf("blob names cannot be empty")
+	}
+
+	if length := len([]rune(input)); length > maxBlobNameLength {
+		return fmt.Errorf("blob names must be at most %d characters but got %d characters", maxBlobNameLength, length)
+	}
+
+	if segments := len(strings.Split(strings.Trim(input, "/"), "/")); segments > maxBlobNamePathSegments {
+		return fmt.Errorf("blob names can contain at most %d path segments but got %d path segments", maxBlobNamePathSegments, segments)
+	}
+
+	return nil
+}
+
+// ValidateQueueName validates that `input` is a valid Queue name, which must be between 3 and 63 characters,
+// contain only lower-case letters, numbers and hyphens, start and end with a letter or number and not contain
+// consecutive hyphens.
+// https://learn.microsoft.com/en-us/rest/api/storageservices/naming-queues-and-metadata#queue-names
+func ValidateQueueName(input string) error {
+	return validateLowerCaseAlphanumericHyphenName("queue", input)
+}
+
+// ValidateShareName validates that `input` is a valid Share name, which must be between 3 and 63 characters,
+// contain only lower-case letters, numbers and hyphens, start and end with a letter or number and not contain
+// consecutive hyphens.
+// https://learn.microsoft.com/en-us/rest/api/storageservices/naming-and-referencing-shares--directories--files--and-metadata#share-names
+func ValidateShareName(input string) error {
+	return validateLowerCaseAlphanumericHyphenName("share", input)
+}
+
+// ValidateDirectoryPath validates that `input` is a valid path to a Directory within a Share, which must be at most
+// 2048 characters and contain at most 250 Directories (separated by `/`). Each Directory name must be between 1 and
+// 255 characters, must not be `.` or `..`, must not end with a `.` and must not contain control characters or any of
+// the characters `"\:|<>*?`.
+// https://learn.microsoft.com/en-us/rest/api/storageservices/naming-and-referencing-shares--directories--files--and-metadata#directory-and-file-names
+func ValidateDirectoryPath(input string) error {
+	if input == "" {
+		return fmt.Errorf("directory paths cannot be empty")
+	}
+
+	if length := len([]rune(input)); length > maxDirectoryPathLength {
+		return fmt.Errorf("directory paths must be at most %d characters but got %d characters", maxDirectoryPathLength, length)
+	}
+
+	segments := strings.Split(input, "/")
+	if len(segments) > maxDirectoryPathDepth {
+		return fmt.Errorf("directory paths can contain at most %d directories but got %d directories", maxDirectoryPathDepth, len(segments))
+	}
+
+	for _, segment := range segments {
+		if segment == "" {
+			return fmt.Errorf("directory paths cannot start or end with a `/` or contain consecutive `/` characters but got %q", input)
+		}
+		if length := len([]rune(segment)); length > maxDirectoryPathSegmentLength {
+			return fmt.Errorf("directory names must be at most %d characters but got %d characters", maxDirectoryPathSegmentLength, length)
+		}
+		if segment == "." || segment == ".." || strings.HasSuffix(segment, ".") {
+			return fmt.Errorf("directory names cannot be `.` or `..`, or end with a `.` but got %q", segment)
+		}
+		for _, r := range segment {
+			if r < 0x20 || r == 0x7f || strings.ContainsRune(invalidDirectoryNameCharacters, r) {
+				return fmt.Errorf("directory names cannot contain control characters or any of the characters %s but got %q", invalidDirectoryNameCharacters, segment)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateLowerCaseAlphanumericHyphenName(resourceType, input string) error {
+	if len(input) < 3 || len(input) > 63 {
+		return fmt.Errorf("%s names must be between 3 and 63 characters but got %d characters", resourceType, len(input))
+	}
+
+	if strings.ToLower(input) != input {
+		return fmt.Errorf("%s names must be lower-cased but got %q", resourceType, input)
+	}
+
+	if strings.Contains(input, "--") {
+		return fmt.Errorf("%s names cannot contain consecutive hyphens but got %q", resourceType, input)
+	}
+
+	if !lowerCaseAlphanumericHyphenRegex.MatchString(input) {
+		return fmt.Errorf("%s names must contain only lower-case letters, numbers and hyphens, and must start and end with a letter or number but got %q", resourceType, input)
+	}
+
+	return nil
+}
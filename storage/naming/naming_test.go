@@ -0,0 +1,258 @@
+package naming
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateContainerName(t *testing.T) {
+	testData := []struct {
+		Input         string
+		ShouldBeValid bool
+	}{
+		{Input: "abc", ShouldBeValid: true},
+		{Input: "container-1", ShouldBeValid: true},
+		{Input: "1container", ShouldBeValid: true},
+		{Input: strings.Repeat("a", 63), ShouldBeValid: true},
+		{Input: "$logs", ShouldBeValid: true},
+		{Input: "$root", ShouldBeValid: true},
+		{Input: "$web", ShouldBeValid: true},
+		{Input: "", ShouldBeValid: false},
+		{Input: "ab", ShouldBeValid: false},
+		{Input: strings.Repeat("a", 64), ShouldBeValid: false},
+		{Input: "Container", ShouldBeValid: false},
+		{Input: "con--tainer", ShouldBeValid: false},
+		{Input: "-container", ShouldBeValid: false},
+		{Input: "container-", ShouldBeValid: false},
+		{Input: "con_tainer", ShouldBeValid: false},
+		{Input: "$other", ShouldBeValid: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		err := ValidateContainerName(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Input)
+		}
+	}
+}
+
+func TestValidateBlobName(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         string
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Single Character",
+			Input:         "a",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Nested",
+			Input:         "folder/nested/File Name (1).txt",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Maximum Length",
+			Input:         strings.Repeat("a", 1024),
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Maximum Length in Multi-Byte Characters",
+			Input:         strings.Repeat("é", 1024),
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Maximum Path Segments",
+			Input:         strings.Repeat("a/", 253) + "a",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Empty",
+			Input:         "",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Too Long",
+			Input:         strings.Repeat("a", 1025),
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Too Many Path Segments",
+			Input:         strings.Repeat("a/", 254) + "a",
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := ValidateBlobName(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Name, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Name)
+		}
+	}
+}
+
+func TestValidateQueueName(t *testing.T) {
+	testData := []struct {
+		Input         string
+		ShouldBeValid bool
+	}{
+		{Input: "queue", ShouldBeValid: true},
+		{Input: "my-queue-1", ShouldBeValid: true},
+		{Input: "q1", ShouldBeValid: false},
+		{Input: "Queue", ShouldBeValid: false},
+		{Input: "my--queue", ShouldBeValid: false},
+		{Input: "queue-", ShouldBeValid: false},
+		{Input: "$queue", ShouldBeValid: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		err := ValidateQueueName(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Input)
+		}
+	}
+}
+
+func TestValidateShareName(t *testing.T) {
+	testData := []struct {
+		Input         string
+		ShouldBeValid bool
+	}{
+		{Input: "share", ShouldBeValid: true},
+		{Input: "share-1", ShouldBeValid: true},
+		{Input: "sh", ShouldBeValid: false},
+		{Input: "Share", ShouldBeValid: false},
+		{Input: "sh--are", ShouldBeValid: false},
+		{Input: "-share", ShouldBeValid: false},
+		{Input: "$root", ShouldBeValid: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		err := ValidateShareName(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Input)
+		}
+	}
+}
+
+func TestValidateDirectoryPath(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         string
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "Single Directory",
+			Input:         "directory",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Nested",
+			Input:         "parent/child/Grand Child (1)",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Maximum Depth",
+			Input:         strings.Repeat("a/", 249) + "a",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Maximum Directory Name Length",
+			Input:         strings.Repeat("a", 255),
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Empty",
+			Input:         "",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Too Deep",
+			Input:         strings.Repeat("a/", 250) + "a",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Directory Name Too Long",
+			Input:         "parent/" + strings.Repeat("a", 256),
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Path Too Long",
+			Input:         strings.Repeat(strings.Repeat("a", 200)+"/", 10) + strings.Repeat("a", 100),
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Leading Slash",
+			Input:         "/parent",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Trailing Slash",
+			Input:         "parent/",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Consecutive Slashes",
+			Input:         "parent//child",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Relative",
+			Input:         "parent/../child",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Trailing Dot",
+			Input:         "parent.",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Invalid Character",
+			Input:         "parent/ch*ld",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Backslash",
+			Input:         `parent\child`,
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Control Character",
+			Input:         "parent\tchild",
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := ValidateDirectoryPath(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Name, err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.Name)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package requestoptions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// maxTimeout is the maximum server-side timeout (in seconds) which can be specified for most operations.
+// Operations which upload or download content are instead given a time budget by the service based on
+// the number of bytes transferred, and as such aren't subject to this maximum.
+const maxTimeout = 30
+
+// maxClientRequestIDLength is the maximum length of the Client Request ID which is recorded in the analytics logs
+const maxClientRequestIDLength = 1024
+
+// RequestOptions are options which can be specified for any operation, by attaching them to the
+// context passed to the operation using WithRequestOptions.
+type RequestOptions struct {
+	// Optional - The number of seconds the service should spend processing the operation before returning
+	// an `OperationTimedOut` error. This is a server-side timeout which is sent as the `timeout` query
+	// parameter, unlike the deadline on the context which bounds the entire operation (including retries).
+	//
+	// This must be between 1 and 30 seconds, except for operations which upload or download content.
+	Timeout *int
+
+	// Optional - A client-generated correlation ID (of at most 1024 visible ASCII characters) which is sent in the
+	// `x-ms-client-request-id` header, recorded in the analytics logs and echoed back in the response - see
+	// `baseclient.ClientRequestID`. When not specified one can be generated for each request by configuring
+	// `GenerateClientRequestID` on the base client.
+	ClientRequestID string
+
+	// Optional - The Authorizer used to authorize this request, instead of the Authorizer configured on the client.
+	// This allows a single client to be shared when requests are sent using different identities (for example a
+	// bearer token for each tenant), rather than building a client for each identity.
+	Authorizer auth.Authorizer
+}
+
+type contextKey struct{}
+
+// WithRequestOptions returns a copy of `ctx` with the specified RequestOptions attached, which are
+// then used for any operation called using the returned context
+func WithRequestOptions(ctx context.Context, options RequestOptions) context.Context {
+	return context.WithValue(ctx, contextKey{}, options)
+}
+
+// FromContext returns the RequestOptions attached to `ctx`, if any
+func FromContext(ctx context.Context) (RequestOptions, bool) {
+	options, ok := ctx.Value(contextKey{}).(RequestOptions)
+	return options, ok
+}
+
+// Apply validates the RequestOptions attached to `ctx` (if any) and applies them to the request. This
+// must be called before the request is sent, since the query string forms part of the signature when
+// using SharedKey authorization.
+func Apply(ctx context.Context, req *client.Request) error {
+	options, ok := FromContext(ctx)
+	if !ok || req == nil || req.Request == nil || req.URL == nil {
+		return nil
+	}
+
+	if options.Timeout != nil {
+		if err := validateTimeout(*options.Timeout, isDataTransfer(req.Request)); err != nil {
+			return err
+		}
+
+		query := req.URL.Query()
+		query.Set("timeout", strconv.Itoa(*options.Timeout))
+		req.URL.RawQuery = query.Encode()
+	}
+
+	if options.ClientRequestID != "" {
+		if err := validateClientRequestID(options.ClientRequestID); err != nil {
+			return err
+		}
+		if req.Header == nil {
+			req.Header = http.Header{}
+		}
+		req.Header.Set("x-ms-client-request-id", options.ClientRequestID)
+	}
+
+	return nil
+}
+
+func validateTimeout(timeout int, dataTransfer bool) error {
+	if timeout < 1 {
+		return fmt.Errorf("`Timeout` must be at least 1 second but got %d", timeout)
+	}
+	if !dataTransfer && timeout > maxTimeout {
+		return fmt.Errorf("`Timeout` can be at most %d seconds for this operation but got %d", maxTimeout, timeout)
+	}
+	return nil
+}
+
+func validateClientRequestID(input string) error {
+	if len(input) > maxClientRequestIDLength {
+		return fmt.Errorf("`ClientRequestID` can be at most %d characters but got %d", maxClientRequestIDLength, len(input))
+	}
+	for _, r := range input {
+		if r < 0x20 || r > 0x7e {
+			return fmt.Errorf("`ClientRequestID` can only contain visible ASCII characters but got %q", input)
+		}
+	}
+	return nil
+}
+
+// isDataTransfer determines whether the request uploads or downloads the contents of a Blob, File or Path
+func isDataTransfer(req *http.Request) bool {
+	query := req.URL.Query()
+	comp := strings.ToLower(query.Get("comp"))
+
+	switch strings.ToUpper(req.Method) {
+	case http.MethodGet:
+		// Get Blob, Get File and Read Path are all GETs against the resource itself, without any of the
+		// query string parameters used for the other operations - however Get Messages also takes this form
+		segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+		if len(segments) < 2 || strings.EqualFold(segments[len(segments)-1], "messages") {
+			return false
+		}
+		for _, v := range []string{"comp", "restype", "resource", "action"} {
+			if query.Has(v) {
+				return false
+			}
+		}
+		return true
+
+	case http.MethodPut:
+		switch comp {
+		case "appendblock", "block", "page", "range":
+			return true
+		case "":
+			// Put Blob
+			return req.Header.Get("x-ms-blob-type") != ""
+		}
+
+	case http.MethodPatch:
+		switch strings.ToLower(query.Get("action")) {
+		case "append", "flush":
+			return true
+		}
+	}
+
+	return false
+}
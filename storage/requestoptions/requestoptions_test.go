@@ -0,0 +1,217 @@
+package requestoptions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestApply(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Method        string
+		Path          string
+		Query         string
+		Timeout       int
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "List Blobs",
+			Method:        http.MethodGet,
+			Path:          "/container",
+			Query:         "comp=list&restype=container",
+			Timeout:       30,
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "List Blobs exceeding the maximum",
+			Method:        http.MethodGet,
+			Path:          "/container",
+			Query:         "comp=list&restype=container",
+			Timeout:       31,
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Zero",
+			Method:        http.MethodGet,
+			Path:          "/container",
+			Query:         "restype=container",
+			Timeout:       0,
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Get Blob",
+			Method:        http.MethodGet,
+			Path:          "/container/blob.txt",
+			Timeout:       600,
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Get Messages",
+			Method:        http.MethodGet,
+			Path:          "/queue/messages",
+			Timeout:       600,
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Put Block",
+			Method:        http.MethodPut,
+			Path:          "/container/blob.txt",
+			Query:         "comp=block&blockid=YQ%3D%3D",
+			Timeout:       600,
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Set Blob Metadata",
+			Method:        http.MethodPut,
+			Path:          "/container/blob.txt",
+			Query:         "comp=metadata",
+			Timeout:       600,
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Append Path",
+			Method:        http.MethodPatch,
+			Path:          "/filesystem/file.txt",
+			Query:         "action=append&position=0",
+			Timeout:       600,
+			ShouldBeValid: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		req := &client.Request{
+			Request: &http.Request{
+				Method: v.Method,
+				Header: http.Header{},
+				URL: &url.URL{
+					Path:     v.Path,
+					RawQuery: v.Query,
+				},
+			},
+		}
+		ctx := WithRequestOptions(context.Background(), RequestOptions{
+			Timeout: pointer.To(v.Timeout),
+		})
+		err := Apply(ctx, req)
+		valid := err == nil
+		if valid != v.ShouldBeValid {
+			t.Fatalf("expected valid to be %t but got %t: %+v", v.ShouldBeValid, valid, err)
+		}
+		if valid {
+			if actual := req.URL.Query().Get("timeout"); actual != strconv.Itoa(v.Timeout) {
+				t.Fatalf("expected the `timeout` query parameter to be %d but got %q", v.Timeout, actual)
+			}
+		}
+	}
+}
+
+func TestApplyWithoutRequestOptions(t *testing.T) {
+	req := &client.Request{
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL: &url.URL{
+				Path:     "/container",
+				RawQuery: "restype=container",
+			},
+		},
+	}
+	if err := Apply(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if req.URL.Query().Has("timeout") {
+		t.Fatalf("expected the `timeout` query parameter to be omitted")
+	}
+}
+
+func TestApplyIsRepeatable(t *testing.T) {
+	req := &client.Request{
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL: &url.URL{
+				Path:     "/container",
+				RawQuery: "restype=container",
+			},
+		},
+	}
+	ctx := WithRequestOptions(context.Background(), RequestOptions{
+		Timeout: pointer.To(10),
+	})
+	for i := 0; i < 2; i++ {
+		if err := Apply(ctx, req); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+	if actual := req.URL.Query()["timeout"]; len(actual) != 1 || actual[0] != "10" {
+		t.Fatalf("expected a single `timeout` of 10 but got %v", actual)
+	}
+}
+
+func TestApplyClientRequestID(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         string
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "UUID",
+			Input:         "00000000-0000-0000-0000-000000000001",
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Maximum Length",
+			Input:         strings.Repeat("a", 1024),
+			ShouldBeValid: true,
+		},
+		{
+			Name:          "Exceeding the Maximum Length",
+			Input:         strings.Repeat("a", 1025),
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Control Character",
+			Input:         "abc\n123",
+			ShouldBeValid: false,
+		},
+		{
+			Name:          "Non-ASCII Character",
+			Input:         "abc-über",
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		req := &client.Request{
+			Request: &http.Request{
+				Method: http.MethodGet,
+				URL: &url.URL{
+					Path:     "/container",
+					RawQuery: "restype=container",
+				},
+				Header: http.Header{},
+			},
+		}
+		ctx := WithRequestOptions(context.Background(), RequestOptions{
+			ClientRequestID: v.Input,
+		})
+		err := Apply(ctx, req)
+		valid := err == nil
+		if valid != v.ShouldBeValid {
+			t.Fatalf("expected valid to be %t but got %t: %+v", v.ShouldBeValid, valid, err)
+		}
+		if valid {
+			if actual := req.Header.Get("x-ms-client-request-id"); actual != v.Input {
+				t.Fatalf("expected the `x-ms-client-request-id` header to be %q but got %q", v.Input, actual)
+			}
+		}
+	}
+}
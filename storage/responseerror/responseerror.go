@@ -0,0 +1,185 @@
+package responseerror
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+var _ error = ResponseError{}
+var _ error = ConditionNotMetError{}
+
+// ResponseError is returned when the Storage API returns an unexpected response, allowing callers to
+// inspect the HTTP Status Code and the error returned by the API (using `errors.As`) rather than
+// matching on the error message.
+type ResponseError struct {
+	// The HTTP Status Code returned by the API
+	StatusCode int
+
+	// The error code returned by the API, for example `ContainerNotFound`
+	Code string
+
+	// The error message returned by the API, where one was returned. This is
+	// never returned for HEAD requests, since these have no response body.
+	Message string
+
+	// The ID of the request, which is useful when raising a support ticket
+	RequestID string
+
+	// The headers returned by the API, which can contain further information about the error
+	// (for example `x-ms-copy-status` or `Retry-After`)
+	Headers http.Header
+
+	err error
+}
+
+// Error returns the underlying error message
+func (e ResponseError) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+	if e.Message != "" {
+		return e.Message
+	}
+	return http.StatusText(e.StatusCode)
+}
+
+// Unwrap returns the underlying error, as returned when executing the request
+func (e ResponseError) Unwrap() error {
+	return e.err
+}
+
+// ConditionNotMetError is returned when one of the conditional headers (for example `If-Match`)
+// specified for an operation wasn't met, and wraps the ResponseError for the 412 response - or, for a
+// conditional read using `If-None-Match` or `If-Modified-Since`, the 304 Not Modified response.
+type ConditionNotMetError struct {
+	ResponseError ResponseError
+}
+
+func (e ConditionNotMetError) Error() string {
+	return fmt.Sprintf("the conditions specified using the conditional headers were not met: %s", e.ResponseError.Error())
+}
+
+// Unwrap returns the ResponseError for the 412 (or 304) response
+func (e ConditionNotMetError) Unwrap() error {
+	return e.ResponseError
+}
+
+// storageError is the XML error body returned by the Blob, File and Queue APIs
+type storageError struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+// New returns a ResponseError parsed from the specified response, wrapping `err`. A ResponseError is
+// only returned when both `err` and the response are non-nil - otherwise `err` is returned unmodified
+// (for example when the request couldn't be sent). When the API returns a 412 Precondition Failed, or a
+// 304 Not Modified for a conditional read, the ResponseError is wrapped in a ConditionNotMetError.
+func New(resp *client.Response, err error) error {
+	out := parse(resp, err)
+	if v, ok := out.(ResponseError); ok && (v.StatusCode == http.StatusPreconditionFailed || v.StatusCode == http.StatusNotModified) {
+		return ConditionNotMetError{
+			ResponseError: v,
+		}
+	}
+	return out
+}
+
+func parse(resp *client.Response, err error) error {
+	if err == nil || resp == nil || resp.Response == nil {
+		return err
+	}
+
+	out := ResponseError{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header.Clone(),
+		err:        err,
+	}
+
+	if resp.Header != nil {
+		out.Code = resp.Header.Get("x-ms-error-code")
+		out.RequestID = resp.Header.Get("x-ms-request-id")
+	}
+
+	// the Data Lake and Table APIs return JSON which has already been parsed
+	if resp.OData != nil && resp.OData.Error != nil {
+		if out.Code == "" && resp.OData.Error.Code != nil {
+			out.Code = *resp.OData.Error.Code
+		}
+		if resp.OData.Error.Message != nil {
+			out.Message = *resp.OData.Error.Message
+		}
+		return out
+	}
+
+	// otherwise the Blob, File and Queue APIs return XML, when a body is returned
+	if resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if readErr == nil && len(body) > 0 {
+			var parsed storageError
+			if xml.Unmarshal(bytes.TrimPrefix(body, []byte("\xef\xbb\xbf")), &parsed) == nil {
+				if out.Code == "" {
+					out.Code = parsed.Code
+				}
+				out.Message = parsed.Message
+			}
+		}
+	}
+
+	return out
+}
+
+// StatusCode returns the HTTP Status Code from the ResponseError within `err`, if any
+func StatusCode(err error) (int, bool) {
+	var responseErr ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.StatusCode, true
+	}
+	return 0, false
+}
+
+// HasStatusCode returns whether `err` contains a ResponseError with the specified HTTP Status Code
+func HasStatusCode(err error, statusCode int) bool {
+	actual, ok := StatusCode(err)
+	return ok && actual == statusCode
+}
+
+// IsNotFound returns whether `err` contains a ResponseError with a 404 Not Found HTTP Status Code
+func IsNotFound(err error) bool {
+	return HasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConditionNotMet returns whether `err` contains a ConditionNotMetError, meaning that one of the
+// conditional headers specified for the operation wasn't met
+func IsConditionNotMet(err error) bool {
+	var conditionErr ConditionNotMetError
+	return errors.As(err, &conditionErr)
+}
+
+// IsNotModified returns whether `err` contains a ConditionNotMetError for a 304 Not Modified response, meaning
+// that the resource hasn't changed since the `If-None-Match` or `If-Modified-Since` condition for a read
+func IsNotModified(err error) bool {
+	var conditionErr ConditionNotMetError
+	return errors.As(err, &conditionErr) && conditionErr.ResponseError.StatusCode == http.StatusNotModified
+}
+
+// IsSourceConditionNotMet returns whether `err` contains a ConditionNotMetError for one of the conditional headers
+// specified for the source of a copy (for example `x-ms-source-if-match`), rather than for the destination
+func IsSourceConditionNotMet(err error) bool {
+	var conditionErr ConditionNotMetError
+	return errors.As(err, &conditionErr) && conditionErr.ResponseError.Code == "SourceConditionNotMet"
+}
+
+// IsConflict returns whether `err` contains a ResponseError with a 409 Conflict HTTP Status Code
+func IsConflict(err error) bool {
+	return HasStatusCode(err, http.StatusConflict)
+}
@@ -0,0 +1,207 @@
+package responseerror
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+func TestNewFromXMLBody(t *testing.T) {
+	body := `<?xml version="1.0" encoding="utf-8"?><Error><Code>ContainerNotFound</Code><Message>The specified container does not exist.</Message></Error>`
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header: http.Header{
+				"X-Ms-Request-Id": []string{"abc123"},
+			},
+			Body: io.NopCloser(strings.NewReader(body)),
+		},
+	}
+	underlying := fmt.Errorf("unexpected status 404")
+
+	err := fmt.Errorf("executing request: %w", New(resp, underlying))
+
+	var actual ResponseError
+	if !errors.As(err, &actual) {
+		t.Fatalf("expected a ResponseError but got %T", err)
+	}
+	if actual.Code != "ContainerNotFound" {
+		t.Fatalf("expected the Code to be %q but got %q", "ContainerNotFound", actual.Code)
+	}
+	if actual.Message != "The specified container does not exist." {
+		t.Fatalf("expected the Message to be %q but got %q", "The specified container does not exist.", actual.Message)
+	}
+	if actual.RequestID != "abc123" {
+		t.Fatalf("expected the RequestID to be %q but got %q", "abc123", actual.RequestID)
+	}
+	if !IsNotFound(err) {
+		t.Fatalf("expected IsNotFound to be true")
+	}
+	if IsConflict(err) {
+		t.Fatalf("expected IsConflict to be false")
+	}
+	if err.Error() != "executing request: unexpected status 404" {
+		t.Fatalf("expected the error message to be unchanged but got %q", err.Error())
+	}
+}
+
+func TestNewFromHeaders(t *testing.T) {
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusConflict,
+			Header: http.Header{
+				"X-Ms-Error-Code": []string{"ShareAlreadyExists"},
+			},
+		},
+	}
+
+	err := New(resp, fmt.Errorf("unexpected status 409"))
+	var actual ResponseError
+	if !errors.As(err, &actual) {
+		t.Fatalf("expected a ResponseError but got %T", err)
+	}
+	if actual.Code != "ShareAlreadyExists" {
+		t.Fatalf("expected the Code to be %q but got %q", "ShareAlreadyExists", actual.Code)
+	}
+	if !IsConflict(err) {
+		t.Fatalf("expected IsConflict to be true")
+	}
+}
+
+func TestNewFromOData(t *testing.T) {
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{},
+		},
+		OData: &odata.OData{
+			Error: &odata.Error{
+				Code:    pointer.To("PathNotFound"),
+				Message: pointer.To("The specified path does not exist."),
+			},
+		},
+	}
+
+	err := New(resp, fmt.Errorf("unexpected status 404"))
+	var actual ResponseError
+	if !errors.As(err, &actual) {
+		t.Fatalf("expected a ResponseError but got %T", err)
+	}
+	if actual.Code != "PathNotFound" {
+		t.Fatalf("expected the Code to be %q but got %q", "PathNotFound", actual.Code)
+	}
+	if actual.Message != "The specified path does not exist." {
+		t.Fatalf("expected the Message to be %q but got %q", "The specified path does not exist.", actual.Message)
+	}
+}
+
+func TestNewWithoutResponse(t *testing.T) {
+	underlying := fmt.Errorf("connection reset")
+	if err := New(nil, underlying); err != underlying {
+		t.Fatalf("expected the error to be returned unmodified but got %+v", err)
+	}
+	if IsNotFound(underlying) {
+		t.Fatalf("expected IsNotFound to be false for an error without a response")
+	}
+	if err := New(&client.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, nil); err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+}
+
+func TestNewPreconditionFailed(t *testing.T) {
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusPreconditionFailed,
+			Header: http.Header{
+				"X-Ms-Error-Code": []string{"ConditionNotMet"},
+			},
+		},
+	}
+
+	err := fmt.Errorf("executing request: %w", New(resp, fmt.Errorf("unexpected status 412")))
+
+	var conditionErr ConditionNotMetError
+	if !errors.As(err, &conditionErr) {
+		t.Fatalf("expected a ConditionNotMetError but got %T", err)
+	}
+	if conditionErr.ResponseError.Code != "ConditionNotMet" {
+		t.Fatalf("expected the Code to be %q but got %q", "ConditionNotMet", conditionErr.ResponseError.Code)
+	}
+	if !IsConditionNotMet(err) {
+		t.Fatalf("expected IsConditionNotMet to be true")
+	}
+	if !HasStatusCode(err, http.StatusPreconditionFailed) {
+		t.Fatalf("expected the ResponseError to be accessible through the ConditionNotMetError")
+	}
+	if IsSourceConditionNotMet(err) {
+		t.Fatalf("expected IsSourceConditionNotMet to be false for a destination condition")
+	}
+	if IsNotModified(err) {
+		t.Fatalf("expected IsNotModified to be false for a 412")
+	}
+}
+
+func TestNewNotModified(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://account1.blob.core.windows.net/container/blob", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set("If-None-Match", "\"0x8D9\"")
+
+	// a 304 has no body, so only the headers are returned
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusNotModified,
+			Header: http.Header{
+				"Etag":            []string{"\"0x8D9\""},
+				"X-Ms-Request-Id": []string{"request-1"},
+			},
+			Request: req,
+		},
+	}
+
+	err = fmt.Errorf("executing request: %w", New(resp, fmt.Errorf("unexpected status 304 (Not Modified) received with no body")))
+
+	var conditionErr ConditionNotMetError
+	if !errors.As(err, &conditionErr) {
+		t.Fatalf("expected a ConditionNotMetError but got %T", err)
+	}
+	if conditionErr.ResponseError.RequestID != "request-1" {
+		t.Fatalf("expected the RequestID to be %q but got %q", "request-1", conditionErr.ResponseError.RequestID)
+	}
+	if !IsConditionNotMet(err) {
+		t.Fatalf("expected IsConditionNotMet to be true")
+	}
+	if !IsNotModified(err) {
+		t.Fatalf("expected IsNotModified to be true")
+	}
+	if !HasStatusCode(err, http.StatusNotModified) {
+		t.Fatalf("expected the ResponseError to be accessible through the ConditionNotMetError")
+	}
+}
+
+func TestNewSourceConditionNotMet(t *testing.T) {
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusPreconditionFailed,
+			Header: http.Header{
+				"X-Ms-Error-Code": []string{"SourceConditionNotMet"},
+			},
+		},
+	}
+
+	err := fmt.Errorf("executing request: %w", New(resp, fmt.Errorf("unexpected status 412")))
+	if !IsConditionNotMet(err) {
+		t.Fatalf("expected IsConditionNotMet to be true")
+	}
+	if !IsSourceConditionNotMet(err) {
+		t.Fatalf("expected IsSourceConditionNotMet to be true")
+	}
+}
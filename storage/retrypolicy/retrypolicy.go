@@ -0,0 +1,302 @@
+package retrypolicy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// Policy determines whether a request which failed with a transient error (a 500, a 503 or a timeout)
+// should be retried. Retries are performed using an exponential backoff with jitter, unless the API
+// returns a `Retry-After` header - in which case that is honoured instead.
+//
+// A Policy is configured on the BaseClient (see `baseclient.Client.SetRetryPolicy`), in which case it replaces
+// the retries performed by the underlying transport layer - so MaxRetries is the retry budget for each request.
+type Policy struct {
+	// The maximum number of times a request should be retried, a value of 0 disables retries
+	MaxRetries int
+
+	// The delay before the first retry, which is doubled for each subsequent retry
+	RetryDelay time.Duration
+
+	// The maximum delay between retries, regardless of the number of retries performed
+	MaxRetryDelay time.Duration
+
+	// Whether non-idempotent operations (for example Append Block, Put Message, Copy, Rename, Lease operations
+	// and conditional creates using `If-None-Match: *`) should also be retried. Since these operations may have
+	// been applied before the transient error was returned, retrying them can result in the operation being
+	// applied more than once.
+	RetryNonIdempotent bool
+
+	// ShouldRetry optionally determines whether a request which failed should be retried, in place of the default
+	// classification of transient errors (see IsTransient) - for example to also retry a 409 Conflict returned when
+	// racing to create a Container. This is called with the response (which can be nil when the request couldn't be
+	// sent) and the error returned for each failed attempt, and is never called once the context has been cancelled.
+	ShouldRetry func(resp *http.Response, err error) bool
+}
+
+// Default returns the default Policy, which retries idempotent operations up to 3 times
+func Default() Policy {
+	return Policy{
+		MaxRetries:    3,
+		RetryDelay:    4 * time.Second,
+		MaxRetryDelay: 2 * time.Minute,
+	}
+}
+
+// SendFunc sends a single attempt of a request
+type SendFunc func(ctx context.Context, req *client.Request) (*client.Response, error)
+
+// Execute sends the request using `send` and retries it according to the Policy, which is called by the
+// BaseClient when a Policy is configured.
+//
+// In addition to transient errors, requests which are rate limited (a 429) or which the RetryFunc for the request
+// identifies as an eventual consistency failure (for example a 409 when a Container is being deleted) are retried
+// regardless of whether they're idempotent, since these weren't applied - these retries count towards MaxRetries.
+func (p Policy) Execute(ctx context.Context, req *client.Request, send SendFunc) (*client.Response, error) {
+	if p.MaxRetries <= 0 {
+		return send(ctx, req)
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	// the body is consumed when the request is sent, so it's buffered to allow it to be sent again
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %+v", err)
+		}
+		req.Body.Close()
+	}
+
+	canRetry := p.RetryNonIdempotent || isIdempotent(req.Request)
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+			req.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+
+		resp, err := send(ctx, req)
+		if attempt >= p.MaxRetries || !p.retry(ctx, req, canRetry, resp, err) {
+			return resp, err
+		}
+
+		var httpResp *http.Response
+		if resp != nil {
+			httpResp = resp.Response
+		}
+		wait := p.delay(attempt, httpResp)
+		if httpResp != nil && httpResp.Body != nil {
+			// drain the body so that the connection can be reused
+			_, _ = io.Copy(io.Discard, httpResp.Body)
+			httpResp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, fmt.Errorf("waiting to retry request: %+v", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// retry determines whether the attempt should be retried, where `canRetry` is whether the request can be retried
+// when it failed with a transient error
+func (p Policy) retry(ctx context.Context, req *client.Request, canRetry bool, resp *client.Response, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	if r := httpResponse(resp); r != nil {
+		if r.StatusCode == http.StatusTooManyRequests {
+			return true
+		}
+
+		if f := req.RetryFunc; f != nil {
+			// this is best-effort, since the Blob, File and Queue APIs return XML rather than JSON
+			o, _ := odata.FromResponse(r)
+			if shouldRetry, _ := f(r, o); shouldRetry {
+				return true
+			}
+		}
+	}
+
+	return canRetry && p.shouldRetry(ctx, resp, err)
+}
+
+func (p Policy) validate() error {
+	if p.RetryDelay <= 0 {
+		return fmt.Errorf("`RetryDelay` must be greater than 0")
+	}
+	if p.MaxRetryDelay < p.RetryDelay {
+		return fmt.Errorf("`MaxRetryDelay` must be greater than or equal to `RetryDelay`")
+	}
+	return nil
+}
+
+// delay returns how long to wait before retrying the request, where `attempt` is the zero-based
+// number of the attempt which failed
+func (p Policy) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if v, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return v
+		}
+	}
+
+	backoff := float64(p.RetryDelay) * math.Pow(2, float64(attempt))
+	if backoff > float64(p.MaxRetryDelay) {
+		backoff = float64(p.MaxRetryDelay)
+	}
+
+	// apply a jitter of up to 20% either side, so that concurrent clients don't retry in lockstep
+	jitter := backoff * 0.2 * (rand.Float64()*2 - 1)
+	wait := time.Duration(backoff + jitter)
+	if wait > p.MaxRetryDelay {
+		wait = p.MaxRetryDelay
+	}
+	return wait
+}
+
+// parseRetryAfter parses the `Retry-After` header, which can either be a number of seconds or a date
+func parseRetryAfter(input string) (time.Duration, bool) {
+	if input == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(input); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(input); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// nonIdempotentHeaders are the headers which make a PUT non-idempotent, since once the operation has been applied
+// sending it again fails - for example a Rename returns a 404 since the source no longer exists
+var nonIdempotentHeaders = []string{
+	"x-ms-copy-source",
+	"x-ms-rename-source",
+}
+
+// nonIdempotentComps are the `comp` values which make a PUT non-idempotent
+var nonIdempotentComps = map[string]struct{}{
+	// Append Block appends the block to the end of the blob each time it's sent
+	"appendblock": {},
+
+	// Acquiring, Changing and Breaking a Lease fail with a 409 once the lease has been acquired/changed/broken
+	"lease": {},
+}
+
+// isIdempotent determines whether the request can be sent more than once without changing the result
+func isIdempotent(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+
+	switch strings.ToUpper(req.Method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+
+	case http.MethodPut:
+		// a conditional create fails with a 409/412 once the resource has been created
+		if strings.TrimSpace(req.Header.Get("If-None-Match")) == "*" {
+			return false
+		}
+
+		comp := ""
+		if req.URL != nil {
+			comp = strings.ToLower(req.URL.Query().Get("comp"))
+		}
+		if _, ok := nonIdempotentComps[comp]; ok {
+			return false
+		}
+
+		// the Copy Source is also used when uploading a Block or Page from a URL, which can be sent again
+		if comp == "" {
+			for _, header := range nonIdempotentHeaders {
+				if req.Header.Get(header) != "" {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// shouldRetry determines whether the request should be retried, using the ShouldRetry function when specified
+func (p Policy) shouldRetry(ctx context.Context, resp *client.Response, err error) bool {
+	if p.ShouldRetry == nil {
+		return isRetryable(ctx, resp, err)
+	}
+	// ShouldRetry is only called for failed attempts, so that a successful write isn't sent again
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	return p.ShouldRetry(httpResponse(resp), err)
+}
+
+// isRetryable determines whether the request failed with a transient error
+func isRetryable(ctx context.Context, resp *client.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	return IsTransient(httpResponse(resp), err)
+}
+
+// IsTransient determines whether a request failed with a transient error, meaning that the API returned a 408,
+// a 500, a 503 or a 504 - or, when no response was returned, that the request timed out. This is the default
+// classification used when ShouldRetry isn't specified, and can be called from ShouldRetry to extend it.
+func IsTransient(resp *http.Response, err error) bool {
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusRequestTimeout, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+func httpResponse(resp *client.Response) *http.Response {
+	if resp == nil {
+		return nil
+	}
+	return resp.Response
+}
@@ -0,0 +1,522 @@
+package retrypolicy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+func TestIsIdempotent(t *testing.T) {
+	testData := []struct {
+		Method   string
+		Query    string
+		Headers  map[string]string
+		Expected bool
+	}{
+		{
+			Method:   http.MethodGet,
+			Expected: true,
+		},
+		{
+			Method:   http.MethodHead,
+			Expected: true,
+		},
+		{
+			Method:   http.MethodDelete,
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPut,
+			Query:    "comp=block&blockid=abc",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPut,
+			Query:    "comp=appendblock",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPut,
+			Query:    "comp=lease",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPut,
+			Query:    "restype=container&comp=lease",
+			Expected: false,
+		},
+		{
+			Method: http.MethodPut,
+			Headers: map[string]string{
+				"x-ms-copy-source": "https://account1.blob.core.windows.net/container/blob",
+			},
+			Expected: false,
+		},
+		{
+			Method: http.MethodPut,
+			Query:  "comp=block&blockid=abc",
+			Headers: map[string]string{
+				"x-ms-copy-source": "https://account1.blob.core.windows.net/container/blob",
+			},
+			Expected: true,
+		},
+		{
+			Method: http.MethodPut,
+			Headers: map[string]string{
+				"x-ms-rename-source": "/filesystem/directory",
+			},
+			Expected: false,
+		},
+		{
+			Method: http.MethodPut,
+			Headers: map[string]string{
+				"If-None-Match": "*",
+			},
+			Expected: false,
+		},
+		{
+			Method: http.MethodPut,
+			Headers: map[string]string{
+				"If-Match": "\"0x8D9\"",
+			},
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPatch,
+			Expected: false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s %q..", v.Method, v.Query)
+
+		req := &http.Request{
+			Method: v.Method,
+			URL: &url.URL{
+				Path:     "/container/blob",
+				RawQuery: v.Query,
+			},
+			Header: http.Header{},
+		}
+		for k, val := range v.Headers {
+			req.Header.Set(k, val)
+		}
+		if actual := isIdempotent(req); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	testData := []struct {
+		StatusCode int
+		Err        error
+		Expected   bool
+	}{
+		{
+			StatusCode: http.StatusInternalServerError,
+			Expected:   true,
+		},
+		{
+			StatusCode: http.StatusServiceUnavailable,
+			Expected:   true,
+		},
+		{
+			StatusCode: http.StatusGatewayTimeout,
+			Expected:   true,
+		},
+		{
+			StatusCode: http.StatusNotImplemented,
+			Expected:   false,
+		},
+		{
+			StatusCode: http.StatusConflict,
+			Expected:   false,
+		},
+		{
+			Err:      fmt.Errorf("sending request: %w", timeoutError{}),
+			Expected: true,
+		},
+		{
+			Err:      fmt.Errorf("connection refused"),
+			Expected: false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %d / %v..", v.StatusCode, v.Err)
+
+		var resp *client.Response
+		if v.StatusCode != 0 {
+			resp = &client.Response{
+				Response: &http.Response{
+					StatusCode: v.StatusCode,
+				},
+			}
+		}
+		if actual := isRetryable(context.Background(), resp, v.Err); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestIsRetryableCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+		},
+	}
+	if isRetryable(ctx, resp, nil) {
+		t.Fatalf("expected a request with a cancelled context not to be retried")
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	policy := Policy{
+		ShouldRetry: func(resp *http.Response, err error) bool {
+			if resp != nil && resp.StatusCode == http.StatusConflict {
+				return true
+			}
+			return IsTransient(resp, err)
+		},
+	}
+
+	testData := []struct {
+		StatusCode int
+		Expected   bool
+	}{
+		{
+			StatusCode: http.StatusConflict,
+			Expected:   true,
+		},
+		{
+			StatusCode: http.StatusServiceUnavailable,
+			Expected:   true,
+		},
+		{
+			StatusCode: http.StatusNotFound,
+			Expected:   false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %d..", v.StatusCode)
+
+		resp := &client.Response{
+			Response: &http.Response{
+				StatusCode: v.StatusCode,
+			},
+		}
+		err := fmt.Errorf("unexpected status %d", v.StatusCode)
+		if actual := policy.shouldRetry(context.Background(), resp, err); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+
+	if !Default().shouldRetry(context.Background(), nil, fmt.Errorf("sending request: %w", timeoutError{})) {
+		t.Fatalf("expected the default classification to be used when `ShouldRetry` isn't specified")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	conflict := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusConflict,
+		},
+	}
+	if policy.shouldRetry(ctx, conflict, fmt.Errorf("unexpected status 409")) {
+		t.Fatalf("expected a request with a cancelled context not to be retried")
+	}
+}
+
+func TestShouldRetryIsNotCalledForSuccessfulAttempts(t *testing.T) {
+	called := false
+	policy := Policy{
+		ShouldRetry: func(resp *http.Response, err error) bool {
+			called = true
+			return true
+		},
+	}
+
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusCreated,
+		},
+	}
+	if policy.shouldRetry(context.Background(), resp, nil) {
+		t.Fatalf("expected a successful attempt not to be retried")
+	}
+	if called {
+		t.Fatalf("expected `ShouldRetry` not to be called for a successful attempt")
+	}
+}
+
+func TestExecute(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testData := []struct {
+		Name               string
+		Method             string
+		StatusCode         int
+		RetryFunc          client.RequestRetryFunc
+		RetryNonIdempotent bool
+		ExpectedAttempts   int
+	}{
+		{
+			Name:             "Idempotent Transient Error",
+			Method:           http.MethodGet,
+			StatusCode:       http.StatusServiceUnavailable,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "Idempotent Permanent Error",
+			Method:           http.MethodGet,
+			StatusCode:       http.StatusNotFound,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "Non-Idempotent Transient Error",
+			Method:           http.MethodPost,
+			StatusCode:       http.StatusServiceUnavailable,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:               "Non-Idempotent Transient Error with RetryNonIdempotent",
+			Method:             http.MethodPost,
+			StatusCode:         http.StatusServiceUnavailable,
+			RetryNonIdempotent: true,
+			ExpectedAttempts:   3,
+		},
+		{
+			Name:             "Non-Idempotent Rate Limited",
+			Method:           http.MethodPost,
+			StatusCode:       http.StatusTooManyRequests,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:       "Eventual Consistency Failure",
+			Method:     http.MethodPut,
+			StatusCode: http.StatusConflict,
+			RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+				return resp != nil && resp.StatusCode == http.StatusConflict, nil
+			},
+			ExpectedAttempts: 3,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		policy := Policy{
+			MaxRetries:         2,
+			RetryDelay:         time.Millisecond,
+			MaxRetryDelay:      time.Millisecond,
+			RetryNonIdempotent: v.RetryNonIdempotent,
+		}
+		req := &client.Request{
+			Request: &http.Request{
+				Method: v.Method,
+				URL: &url.URL{
+					Path: "/container/blob",
+				},
+				Header: http.Header{},
+				Body:   io.NopCloser(strings.NewReader("hello world")),
+			},
+			RetryFunc: v.RetryFunc,
+		}
+
+		attempts := 0
+		_, err := policy.Execute(ctx, req, func(ctx context.Context, req *client.Request) (*client.Response, error) {
+			attempts++
+
+			// the body must be sent in full for each attempt
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("reading body: %+v", err)
+			}
+			if string(body) != "hello world" {
+				t.Fatalf("expected the body for attempt %d to be %q but got %q", attempts, "hello world", string(body))
+			}
+
+			resp := &client.Response{
+				Response: &http.Response{
+					StatusCode: v.StatusCode,
+					Body:       http.NoBody,
+				},
+			}
+			return resp, fmt.Errorf("unexpected status %d", v.StatusCode)
+		})
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if attempts != v.ExpectedAttempts {
+			t.Fatalf("expected %d attempts but got %d", v.ExpectedAttempts, attempts)
+		}
+	}
+}
+
+func TestExecuteDoesNotRetrySuccessfulWrites(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req := &client.Request{
+		Request: &http.Request{
+			Method: http.MethodPut,
+			URL: &url.URL{
+				Path: "/container",
+			},
+			Header: http.Header{},
+		},
+	}
+
+	// a naive classifier which retries everything
+	policy := Policy{
+		MaxRetries:    3,
+		RetryDelay:    time.Millisecond,
+		MaxRetryDelay: time.Millisecond,
+		ShouldRetry: func(resp *http.Response, err error) bool {
+			return true
+		},
+	}
+
+	attempts := 0
+	_, err := policy.Execute(ctx, req, func(ctx context.Context, req *client.Request) (*client.Response, error) {
+		attempts++
+		return &client.Response{
+			Response: &http.Response{
+				StatusCode: http.StatusCreated,
+			},
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("executing request: %+v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected the successful write to be sent once but it was sent %d times", attempts)
+	}
+}
+
+func TestDelay(t *testing.T) {
+	policy := Policy{
+		MaxRetries:    5,
+		RetryDelay:    time.Second,
+		MaxRetryDelay: 10 * time.Second,
+	}
+
+	testData := []struct {
+		Attempt int
+		Minimum time.Duration
+		Maximum time.Duration
+	}{
+		{
+			Attempt: 0,
+			Minimum: 800 * time.Millisecond,
+			Maximum: 1200 * time.Millisecond,
+		},
+		{
+			Attempt: 2,
+			Minimum: 3200 * time.Millisecond,
+			Maximum: 4800 * time.Millisecond,
+		},
+		{
+			Attempt: 10,
+			Minimum: 8 * time.Second,
+			Maximum: 10 * time.Second,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing attempt %d..", v.Attempt)
+
+		actual := policy.delay(v.Attempt, nil)
+		if actual < v.Minimum || actual > v.Maximum {
+			t.Fatalf("expected a delay between %s and %s but got %s", v.Minimum, v.Maximum, actual)
+		}
+	}
+}
+
+func TestDelayRetryAfter(t *testing.T) {
+	policy := Default()
+	resp := &http.Response{
+		Header: http.Header{
+			"Retry-After": []string{"7"},
+		},
+	}
+	if actual := policy.delay(0, resp); actual != 7*time.Second {
+		t.Fatalf("expected the `Retry-After` header to be honoured but got %s", actual)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Valid    bool
+		Expected time.Duration
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input:    "30",
+			Valid:    true,
+			Expected: 30 * time.Second,
+		},
+		{
+			Input: "-1",
+			Valid: false,
+		},
+		{
+			Input:    "Mon, 01 Jan 2024 00:00:00 GMT",
+			Valid:    true,
+			Expected: 0,
+		},
+		{
+			Input: "soon",
+			Valid: false,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual, ok := parseRetryAfter(v.Input)
+		if ok != v.Valid {
+			t.Fatalf("expected valid to be %t but got %t", v.Valid, ok)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Default().validate(); err != nil {
+		t.Fatalf("expected the default policy to be valid but got: %+v", err)
+	}
+
+	invalid := Policy{
+		MaxRetries:    1,
+		RetryDelay:    time.Minute,
+		MaxRetryDelay: time.Second,
+	}
+	if err := invalid.validate(); err == nil {
+		t.Fatalf("expected an error when `MaxRetryDelay` is less than `RetryDelay`")
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
@@ -0,0 +1,150 @@
+package transfermanager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Options configures the limits shared by every transfer using a TransferManager
+type Options struct {
+	// Optional - The maximum number of requests (e.g. ranges downloaded or blocks uploaded) which can be in-flight
+	// at once across all transfers using this TransferManager. Defaults to 0, meaning no limit.
+	MaxConcurrency int
+
+	// Optional - The maximum number of bytes per second which can be transferred across all transfers using this
+	// TransferManager. Defaults to 0, meaning no limit.
+	BytesPerSecond int64
+}
+
+// TransferManager is a concurrency budget and bandwidth limit which can be shared between multiple (concurrent)
+// uploads and downloads - such that together they respect the global limits, rather than each transfer being
+// limited independently. A TransferManager is safe for concurrent use, and a nil TransferManager imposes no limits.
+type TransferManager struct {
+	slots   chan struct{}
+	limiter *limiter
+}
+
+// New returns a TransferManager which enforces the specified Options
+func New(input Options) (*TransferManager, error) {
+	if input.MaxConcurrency < 0 {
+		return nil, fmt.Errorf("`input.MaxConcurrency` must be greater than or equal to 0")
+	}
+	if input.BytesPerSecond < 0 {
+		return nil, fmt.Errorf("`input.BytesPerSecond` must be greater than or equal to 0")
+	}
+
+	m := &TransferManager{}
+	if input.MaxConcurrency > 0 {
+		m.slots = make(chan struct{}, input.MaxConcurrency)
+	}
+	if input.BytesPerSecond > 0 {
+		m.limiter = newLimiter(input.BytesPerSecond)
+	}
+	return m, nil
+}
+
+// Acquire blocks until a request can be made within the concurrency budget, or `ctx` is done. Each successful
+// call must be followed by a call to Release once the request has completed.
+func (m *TransferManager) Acquire(ctx context.Context) error {
+	if m == nil || m.slots == nil {
+		return ctx.Err()
+	}
+
+	select {
+	case m.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release returns the slot obtained from Acquire to the concurrency budget
+func (m *TransferManager) Release() {
+	if m == nil || m.slots == nil {
+		return
+	}
+	<-m.slots
+}
+
+// WaitN blocks until `n` bytes can be transferred within the bandwidth limit, or `ctx` is done. Since the limit is
+// applied before each request, the bandwidth is averaged over the size of the requests being made.
+func (m *TransferManager) WaitN(ctx context.Context, n int64) error {
+	if m == nil || m.limiter == nil {
+		return ctx.Err()
+	}
+	return m.limiter.waitN(ctx, n)
+}
+
+// Do calls `fn` once a request transferring `n` bytes can be made within both the concurrency budget and the
+// bandwidth limit, returning the error from `fn` (or from `ctx` if it was done before `fn` could be called)
+func (m *TransferManager) Do(ctx context.Context, n int64, fn func(ctx context.Context) error) error {
+	if err := m.Acquire(ctx); err != nil {
+		return err
+	}
+	defer m.Release()
+
+	if err := m.WaitN(ctx, n); err != nil {
+		return err
+	}
+
+	return fn(ctx)
+}
+
+// limiter is a token bucket which is refilled at `rate` bytes per second, up to one second's worth of bytes.
+// Requests larger than the bucket are permitted, but leave the bucket in debt - so that subsequent requests
+// wait until the debt has been repaid.
+type limiter struct {
+	lock   sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(bytesPerSecond int64) *limiter {
+	return &limiter{
+		rate:   float64(bytesPerSecond),
+		tokens: float64(bytesPerSecond),
+		last:   time.Now(),
+	}
+}
+
+func (l *limiter) waitN(ctx context.Context, n int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := l.reserve(n)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes `n` tokens from the bucket, returning how long the caller must wait until they're available
+func (l *limiter) reserve(n int64) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
@@ -0,0 +1,178 @@
+package transfermanager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         Options
+		ShouldBeValid bool
+	}{
+		{
+			Name:          "No Limits",
+			Input:         Options{},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Limits",
+			Input: Options{
+				MaxConcurrency: 4,
+				BytesPerSecond: 1024,
+			},
+			ShouldBeValid: true,
+		},
+		{
+			Name: "Negative Concurrency",
+			Input: Options{
+				MaxConcurrency: -1,
+			},
+			ShouldBeValid: false,
+		},
+		{
+			Name: "Negative Bandwidth",
+			Input: Options{
+				BytesPerSecond: -1,
+			},
+			ShouldBeValid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, err := New(v.Input)
+		if v.ShouldBeValid && err != nil {
+			t.Fatalf("expected the input to be valid but got: %+v", err)
+		}
+		if !v.ShouldBeValid && err == nil {
+			t.Fatalf("expected the input to be invalid but it was valid")
+		}
+	}
+}
+
+func TestNilTransferManager(t *testing.T) {
+	var m *TransferManager
+	called := false
+	err := m.Do(context.Background(), 1024, func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if !called {
+		t.Fatalf("expected `fn` to be called")
+	}
+}
+
+func TestDoSharesTheConcurrencyBudget(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	m, err := New(Options{
+		MaxConcurrency: 2,
+	})
+	if err != nil {
+		t.Fatalf("building TransferManager: %+v", err)
+	}
+
+	var inFlight, maxInFlight atomic.Int64
+	var waitGroup sync.WaitGroup
+
+	// two transfers each running 4 requests at once should only have 2 requests in-flight between them
+	for transfer := 0; transfer < 2; transfer++ {
+		for i := 0; i < 4; i++ {
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				err := m.Do(ctx, 0, func(ctx context.Context) error {
+					current := inFlight.Add(1)
+					for {
+						previous := maxInFlight.Load()
+						if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+							break
+						}
+					}
+					time.Sleep(10 * time.Millisecond)
+					inFlight.Add(-1)
+					return nil
+				})
+				if err != nil {
+					t.Errorf("unexpected error: %+v", err)
+				}
+			}()
+		}
+	}
+	waitGroup.Wait()
+
+	if v := maxInFlight.Load(); v != 2 {
+		t.Fatalf("expected at most 2 requests to be in-flight but got %d", v)
+	}
+}
+
+func TestDoLimitsTheBandwidth(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	m, err := New(Options{
+		BytesPerSecond: 10000,
+	})
+	if err != nil {
+		t.Fatalf("building TransferManager: %+v", err)
+	}
+
+	noop := func(ctx context.Context) error {
+		return nil
+	}
+
+	// the first second's worth of bytes is available immediately
+	start := time.Now()
+	if err := m.Do(ctx, 10000, noop); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("expected the first request not to wait but it took %s", elapsed)
+	}
+
+	// after which 2000 bytes takes ~200ms
+	start = time.Now()
+	if err := m.Do(ctx, 2000, noop); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected the second request to wait for ~200ms but it took %s", elapsed)
+	}
+}
+
+func TestDoReturnsWhenTheContextIsDone(t *testing.T) {
+	m, err := New(Options{
+		MaxConcurrency: 1,
+		BytesPerSecond: 1,
+	})
+	if err != nil {
+		t.Fatalf("building TransferManager: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = m.Do(ctx, 1000, func(ctx context.Context) error {
+		return fmt.Errorf("`fn` shouldn't be called")
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected %q but got: %+v", context.DeadlineExceeded, err)
+	}
+
+	// the slot should have been released, so another request can be made
+	if err := m.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	m.Release()
+}